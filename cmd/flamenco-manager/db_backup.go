@@ -0,0 +1,54 @@
+package main
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"git.blender.org/flamenco/internal/manager/config"
+	"git.blender.org/flamenco/internal/manager/persistence"
+)
+
+// runBackupCommand handles the -backup and -restore CLI arguments.
+func runBackupCommand(configService *config.Service) {
+	dsn := configService.Get().DatabaseDSN
+	if dsn == "" {
+		log.Fatal().Msg("configure the database in flamenco-manager.yaml")
+	}
+	if cliArgs.backupPath != "" && cliArgs.restorePath != "" {
+		log.Fatal().Msg("use either -backup or -restore, not both")
+	}
+
+	ctx := context.Background()
+
+	if cliArgs.restorePath != "" {
+		logger := log.With().Str("backup", cliArgs.restorePath).Logger()
+		previousPath, err := persistence.RestoreBackup(ctx, dsn, cliArgs.restorePath)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("could not restore database backup")
+		}
+		logger.Info().
+			Str("previousDatabase", previousPath).
+			Msg("database backup restored, the previous database was kept")
+		return
+	}
+
+	// Don't migrate the database, as the backup should reflect the database as
+	// it is. It may also be in use by a running Manager.
+	db, err := persistence.OpenDBWithoutMigrating(ctx, dsn, "")
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Str("dsn", persistence.RedactDSN(dsn)).
+			Msg("error opening database")
+	}
+	defer db.Close()
+
+	logger := log.With().Str("path", cliArgs.backupPath).Logger()
+	if err := db.Backup(ctx, cliArgs.backupPath); err != nil {
+		logger.Fatal().Err(err).Msg("could not back up database")
+	}
+	logger.Info().Msg("database backed up")
+}
@@ -0,0 +1,68 @@
+package main
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/rs/zerolog/log"
+
+	"git.blender.org/flamenco/internal/manager/config"
+	"git.blender.org/flamenco/internal/manager/persistence"
+)
+
+// runMigrationCommand handles the -migrate-status and -migrate-down CLI
+// arguments. These work on the database without migrating it first.
+func runMigrationCommand(configService *config.Service) {
+	dsn := configService.Get().DatabaseDSN
+	if dsn == "" {
+		log.Fatal().Msg("configure the database in flamenco-manager.yaml")
+	}
+
+	ctx := context.Background()
+	backupDir := configService.Get().DatabaseBackup.Directory
+	db, err := persistence.OpenDBWithoutMigrating(ctx, dsn, backupDir)
+	if err != nil {
+		log.Fatal().
+			Err(err).
+			Str("dsn", persistence.RedactDSN(dsn)).
+			Msg("error opening database")
+	}
+
+	if cliArgs.migrateDown {
+		rolledBack, err := db.MigrateDown(ctx)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not roll back database migration")
+		}
+		log.Info().Int("version", rolledBack).Msg("rolled back database migration")
+	}
+
+	printMigrationStatus(ctx, db)
+}
+
+func printMigrationStatus(ctx context.Context, db *persistence.DB) {
+	statuses, err := db.MigrationStatus(ctx)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not determine database migration status")
+	}
+	version, err := db.SchemaVersion()
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not determine database schema version")
+	}
+
+	fmt.Printf("Database schema version: %d\n\n", version)
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "VERSION\tAPPLIED\tDESCRIPTION")
+	for _, status := range statuses {
+		applied := "pending"
+		if status.Applied {
+			applied = status.AppliedAt.Local().Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\n", status.Version, applied, status.Description)
+	}
+	tw.Flush()
+}
@@ -36,15 +36,20 @@ import (
 	"git.blender.org/flamenco/internal/manager/api_impl"
 	"git.blender.org/flamenco/internal/manager/api_impl/dummy"
 	"git.blender.org/flamenco/internal/manager/config"
+	"git.blender.org/flamenco/internal/manager/db_backup"
 	"git.blender.org/flamenco/internal/manager/job_compilers"
+	"git.blender.org/flamenco/internal/manager/job_deleter"
 	"git.blender.org/flamenco/internal/manager/last_rendered"
 	"git.blender.org/flamenco/internal/manager/local_storage"
+	"git.blender.org/flamenco/internal/manager/metrics"
 	"git.blender.org/flamenco/internal/manager/persistence"
+	"git.blender.org/flamenco/internal/manager/schedule_notifier"
 	"git.blender.org/flamenco/internal/manager/sleep_scheduler"
 	"git.blender.org/flamenco/internal/manager/swagger_ui"
 	"git.blender.org/flamenco/internal/manager/task_logs"
 	"git.blender.org/flamenco/internal/manager/task_state_machine"
 	"git.blender.org/flamenco/internal/manager/timeout_checker"
+	"git.blender.org/flamenco/internal/manager/webhooks"
 	"git.blender.org/flamenco/internal/manager/webupdates"
 	"git.blender.org/flamenco/internal/own_url"
 	"git.blender.org/flamenco/internal/upnp_ssdp"
@@ -59,6 +64,10 @@ var cliArgs struct {
 	delayResponses bool
 	setupAssistant bool
 	pprof          bool
+	migrateStatus  bool
+	migrateDown    bool
+	backupPath     string
+	restorePath    string
 }
 
 const (
@@ -134,6 +143,16 @@ func runFlamencoManager() bool {
 		return false
 	}
 
+	if cliArgs.migrateStatus || cliArgs.migrateDown {
+		runMigrationCommand(configService)
+		return false
+	}
+
+	if cliArgs.backupPath != "" || cliArgs.restorePath != "" {
+		runBackupCommand(configService)
+		return false
+	}
+
 	// TODO: enable TLS via Let's Encrypt.
 	listen := configService.Get().Listen
 	_, port, _ := net.SplitHostPort(listen)
@@ -157,19 +176,35 @@ func runFlamencoManager() bool {
 	// go persist.PeriodicMaintenanceLoop(mainCtx)
 
 	timeService := clock.New()
+	persist.SetClock(timeService)
 	webUpdater := webupdates.New()
+	webhookService := webhooks.New(timeService, persist, configService.Get().Webhooks)
+	webUpdater.AddListener(webhookService)
+	scheduleNotifier := schedule_notifier.New(timeService)
+	webUpdater.AddListener(scheduleNotifier)
 
 	localStorage := local_storage.NewNextToExe(configService.Get().LocalManagerStoragePath)
 	logStorage := task_logs.NewStorage(localStorage, timeService, webUpdater)
 
-	taskStateMachine := task_state_machine.NewStateMachine(persist, webUpdater, logStorage)
+	taskStateMachine := task_state_machine.NewStateMachine(
+		timeService, persist, webUpdater, logStorage, configService.Get().TaskRetryBackoff)
 	sleepScheduler := sleep_scheduler.New(timeService, persist, webUpdater)
 	lastRender := last_rendered.New(localStorage)
+	jobDeleter := job_deleter.New(timeService, persist, localStorage, webUpdater, taskStateMachine,
+		configService.Get().JobRetention)
+	dbBackupper := db_backup.New(timeService, persist, configService.Get().DatabaseBackup)
+
+	compiler, err := job_compilers.Load(timeService)
+	if err != nil {
+		log.Fatal().Err(err).Msg("error loading job compilers")
+	}
 
 	shamanServer := buildShamanServer(configService, isFirstRun)
-	flamenco := buildFlamencoAPI(timeService, configService, persist, taskStateMachine,
-		shamanServer, logStorage, webUpdater, lastRender, localStorage, sleepScheduler)
-	e := buildWebService(flamenco, persist, ssdp, webUpdater, urls, localStorage)
+	metricsService := metrics.New(timeService, persist, shamanServer)
+	flamenco := buildFlamencoAPI(timeService, configService, metricsService.TimeScheduleTask(persist),
+		compiler, taskStateMachine, shamanServer, logStorage, webUpdater, lastRender, localStorage,
+		sleepScheduler, jobDeleter, dbBackupper, scheduleNotifier)
+	e := buildWebService(flamenco, persist, configService, ssdp, webUpdater, metricsService, urls, localStorage)
 
 	timeoutChecker := timeout_checker.New(
 		configService.Get().TaskTimeout,
@@ -186,6 +221,12 @@ func runFlamencoManager() bool {
 	// Before doing anything new, clean up in case we made a mess in an earlier run.
 	taskStateMachine.CheckStuck(mainCtx)
 
+	if configService.Get().UserAuthentication {
+		if err := api_impl.CreateInitialAdmin(mainCtx, persist, os.Stderr); err != nil {
+			log.Error().Err(err).Msg("unable to create initial admin account")
+		}
+	}
+
 	// All main goroutines should sync with this waitgroup. Once the waitgroup is
 	// done, the main() function will return and the process will stop.
 	wg := new(sync.WaitGroup)
@@ -235,6 +276,34 @@ func runFlamencoManager() bool {
 		sleepScheduler.Run(mainCtx)
 	}()
 
+	// Run the job deleter.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		jobDeleter.Run(mainCtx)
+	}()
+
+	// Deliver webhook notifications.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		webhookService.Run(mainCtx)
+	}()
+
+	// Make periodic database backups, if configured.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		dbBackupper.Run(mainCtx)
+	}()
+
+	// Reload the job compiler scripts when they change on disk.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		compiler.Run(mainCtx, webUpdater)
+	}()
+
 	// Log the URLs last, hopefully that makes them more visible / encouraging to go to for users.
 	go func() {
 		time.Sleep(100 * time.Millisecond)
@@ -261,7 +330,8 @@ func runFlamencoManager() bool {
 func buildFlamencoAPI(
 	timeService clock.Clock,
 	configService *config.Service,
-	persist *persistence.DB,
+	persist api_impl.PersistenceService,
+	compiler *job_compilers.Service,
 	taskStateMachine *task_state_machine.StateMachine,
 	shamanServer api_impl.Shaman,
 	logStorage *task_logs.Storage,
@@ -269,23 +339,24 @@ func buildFlamencoAPI(
 	lastRender *last_rendered.LastRenderedProcessor,
 	localStorage local_storage.StorageInfo,
 	sleepScheduler *sleep_scheduler.SleepScheduler,
+	jobDeleter *job_deleter.Service,
+	dbBackupper *db_backup.Service,
+	scheduleNotifier *schedule_notifier.Notifier,
 ) *api_impl.Flamenco {
-	compiler, err := job_compilers.Load(timeService)
-	if err != nil {
-		log.Fatal().Err(err).Msg("error loading job compilers")
-	}
 	flamenco := api_impl.NewFlamenco(
 		compiler, persist, webUpdater, logStorage, configService,
 		taskStateMachine, shamanServer, timeService, lastRender,
-		localStorage, sleepScheduler)
+		localStorage, sleepScheduler, jobDeleter, dbBackupper, scheduleNotifier)
 	return flamenco
 }
 
 func buildWebService(
 	flamenco api.ServerInterface,
 	persist api_impl.PersistenceService,
+	configService *config.Service,
 	ssdp *upnp_ssdp.Server,
 	webUpdater *webupdates.BiDirComms,
+	metricsService *metrics.Metrics,
 	ownURLs []url.URL,
 	localStorage local_storage.StorageInfo,
 ) *echo.Echo {
@@ -311,6 +382,10 @@ func buildWebService(
 	// Ensure panics when serving a web request won't bring down the server.
 	e.Use(middleware.Recover())
 
+	// Count task updates for the metrics. This is done before any validation
+	// & authentication, so that rejected updates are counted too.
+	e.Use(metricsService.Middleware)
+
 	// For development of the web interface, to get a less predictable order of asynchronous requests.
 	if cliArgs.delayResponses {
 		e.Use(randomDelayMiddleware)
@@ -359,13 +434,14 @@ func buildWebService(
 	if err != nil {
 		log.Fatal().Err(err).Msg("unable to get swagger")
 	}
-	validator := api_impl.SwaggerValidator(swagger, persist)
+	validator := api_impl.SwaggerValidator(swagger, persist, configService)
 	e.Use(validator)
 	registerOAPIBodyDecoders()
 
 	// Register routes.
 	api.RegisterHandlers(e, flamenco)
 	webUpdater.RegisterHandlers(e)
+	metricsService.RegisterHandlers(e)
 	swagger_ui.RegisterSwaggerUIStaticFiles(e)
 	e.GET("/api/v3/openapi3.json", func(c echo.Context) error {
 		return c.JSON(http.StatusOK, swagger)
@@ -516,6 +592,12 @@ func parseCliArgs() {
 		"Add a random delay to any HTTP responses. This aids in development of Flamenco Manager's web frontend.")
 	flag.BoolVar(&cliArgs.setupAssistant, "setup-assistant", false, "Open a webbrowser with the setup assistant.")
 	flag.BoolVar(&cliArgs.pprof, "pprof", false, "Expose profiler endpoints on /debug/pprof/.")
+	flag.BoolVar(&cliArgs.migrateStatus, "migrate-status", false, "Shows the database schema version and its migrations, then exits.")
+	flag.BoolVar(&cliArgs.migrateDown, "migrate-down", false,
+		"Rolls back the most recent database migration, then exits. The database is backed up first.")
+	flag.StringVar(&cliArgs.backupPath, "backup", "", "Backs up the database to the given file, then exits. This can be done while the Manager is running.")
+	flag.StringVar(&cliArgs.restorePath, "restore", "",
+		"Replaces the database with the given backup file, then exits. Only do this while the Manager is not running.")
 
 	flag.Parse()
 
@@ -540,16 +622,26 @@ func openDB(configService config.Service) *persistence.DB {
 		log.Fatal().Msg("configure the database in flamenco-manager.yaml")
 	}
 
-	dbCtx, dbCtxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	// This can include backing up & migrating the database, so give it some time.
+	dbCtx, dbCtxCancel := context.WithTimeout(context.Background(), 5*time.Minute)
 	defer dbCtxCancel()
-	persist, err := persistence.OpenDB(dbCtx, dsn)
+	backupDir := configService.Get().DatabaseBackup.Directory
+	persist, err := persistence.OpenDB(dbCtx, dsn, backupDir)
 	if err != nil {
 		log.Fatal().
 			Err(err).
-			Str("dsn", dsn).
+			Str("dsn", persistence.RedactDSN(dsn)).
 			Msg("error opening database")
 	}
 
+	policy := persistence.SchedulingPolicy(configService.Get().SchedulingPolicy)
+	if err := persist.SetSchedulingPolicy(policy); err != nil {
+		log.Fatal().
+			Err(err).
+			Str("scheduling_policy", string(policy)).
+			Msg("configure a valid scheduling policy in flamenco-manager.yaml")
+	}
+
 	return persist
 }
 
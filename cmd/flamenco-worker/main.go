@@ -133,11 +133,17 @@ func main() {
 		return
 	}
 
+	workerConfig, err := configWrangler.WorkerConfig()
+	if err != nil {
+		log.Fatal().Err(err).Msg("error loading worker configuration")
+	}
+
 	cliRunner := cli_runner.NewCLIRunner()
 	listener = worker.NewListener(client, buffer)
-	cmdRunner := worker.NewCommandExecutor(cliRunner, listener, timeService)
+	cmdRunner := worker.NewCommandExecutor(cliRunner, listener, timeService,
+		workerConfig.ExecAllowlist, workerConfig.ExecEnvAllowlist, workerConfig.DeleteRoot)
 	taskRunner := worker.NewTaskExecutor(cmdRunner, listener)
-	w = worker.NewWorker(client, taskRunner)
+	w = worker.NewWorker(client, taskRunner, workerConfig.TaskSlots)
 
 	// Handle Ctrl+C
 	c := make(chan os.Signal, 1)
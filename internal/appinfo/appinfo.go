@@ -7,6 +7,14 @@ import "fmt"
 // ApplicationName contains the application name.
 const ApplicationName = "Flamenco"
 
+// FeatureTaskLongPoll is advertised by the Manager when Workers can wait for a
+// task to become available, instead of repeatedly asking for one.
+const FeatureTaskLongPoll = "task-long-poll"
+
+// FeatureTaskSlots is advertised by the Manager when Workers can run multiple
+// tasks at the same time, by telling which tasks they are still running.
+const FeatureTaskSlots = "task-slots"
+
 // ApplicationVersion is the version number of the application.
 // It is set during the build.
 var ApplicationVersion = "set-during-build"
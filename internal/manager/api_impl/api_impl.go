@@ -27,6 +27,11 @@ type Flamenco struct {
 	lastRender     LastRendered
 	localStorage   LocalStorage
 	sleepScheduler WorkerSleepScheduler
+	jobDeleter     JobDeleter
+	dbBackupper    DatabaseBackupper
+
+	// scheduleNotifier wakes up Workers that are waiting for a task.
+	scheduleNotifier ScheduleNotifier
 
 	// The task scheduler can be locked to prevent multiple Workers from getting
 	// the same task. It is also used for certain other queries, like
@@ -53,6 +58,9 @@ func NewFlamenco(
 	lr LastRendered,
 	localStorage LocalStorage,
 	wss WorkerSleepScheduler,
+	jd JobDeleter,
+	dbb DatabaseBackupper,
+	sn ScheduleNotifier,
 ) *Flamenco {
 	return &Flamenco{
 		jobCompiler:    jc,
@@ -66,6 +74,10 @@ func NewFlamenco(
 		lastRender:     lr,
 		localStorage:   localStorage,
 		sleepScheduler: wss,
+		jobDeleter:     jd,
+		dbBackupper:    dbb,
+
+		scheduleNotifier: sn,
 
 		done: make(chan struct{}),
 	}
@@ -14,9 +14,12 @@ import (
 	"github.com/rs/zerolog"
 
 	"git.blender.org/flamenco/internal/manager/config"
+	"git.blender.org/flamenco/internal/manager/db_backup"
 	"git.blender.org/flamenco/internal/manager/job_compilers"
+	"git.blender.org/flamenco/internal/manager/job_deleter"
 	"git.blender.org/flamenco/internal/manager/last_rendered"
 	"git.blender.org/flamenco/internal/manager/persistence"
+	"git.blender.org/flamenco/internal/manager/schedule_notifier"
 	"git.blender.org/flamenco/internal/manager/sleep_scheduler"
 	"git.blender.org/flamenco/internal/manager/task_state_machine"
 	"git.blender.org/flamenco/internal/manager/webupdates"
@@ -25,17 +28,24 @@ import (
 )
 
 // Generate mock implementations of these interfaces.
-//go:generate go run github.com/golang/mock/mockgen -destination mocks/api_impl_mock.gen.go -package mocks git.blender.org/flamenco/internal/manager/api_impl PersistenceService,ChangeBroadcaster,JobCompiler,LogStorage,ConfigService,TaskStateMachine,Shaman,LastRendered,LocalStorage,WorkerSleepScheduler
+//go:generate go run github.com/golang/mock/mockgen -destination mocks/api_impl_mock.gen.go -package mocks git.blender.org/flamenco/internal/manager/api_impl PersistenceService,ChangeBroadcaster,JobCompiler,LogStorage,ConfigService,TaskStateMachine,Shaman,LastRendered,LocalStorage,WorkerSleepScheduler,JobDeleter,DatabaseBackupper,ScheduleNotifier
 
 type PersistenceService interface {
 	StoreAuthoredJob(ctx context.Context, authoredJob job_compilers.AuthoredJob) error
+	// StoreRecompiledJob replaces the tasks of the job, keeping the completed ones, and records its previous settings.
+	StoreRecompiledJob(ctx context.Context, authoredJob job_compilers.AuthoredJob) error
+	FetchJobHistory(ctx context.Context, job *persistence.Job) ([]persistence.JobHistoryEntry, error)
 	// FetchJob fetches a single job, without fetching its tasks.
 	FetchJob(ctx context.Context, jobID string) (*persistence.Job, error)
+	SaveJobPriority(ctx context.Context, job *persistence.Job) error
+	SaveJobMaxWorkers(ctx context.Context, job *persistence.Job) error
+	FetchJobDependencies(ctx context.Context, job *persistence.Job) ([]*persistence.Job, error)
 	// FetchTask fetches the given task and the accompanying job.
 	FetchTask(ctx context.Context, taskID string) (*persistence.Task, error)
 	FetchTaskFailureList(context.Context, *persistence.Task) ([]*persistence.Worker, error)
 	SaveTask(ctx context.Context, task *persistence.Task) error
 	SaveTaskActivity(ctx context.Context, t *persistence.Task) error
+	SaveTaskProgress(ctx context.Context, t *persistence.Task) error
 	// TaskTouchedByWorker marks the task as 'touched' by a worker. This is used for timeout detection.
 	TaskTouchedByWorker(context.Context, *persistence.Task) error
 
@@ -47,9 +57,34 @@ type PersistenceService interface {
 	SaveWorkerStatus(ctx context.Context, w *persistence.Worker) error
 	WorkerSeen(ctx context.Context, w *persistence.Worker) error
 
+	CreateWorkerTag(ctx context.Context, tag *persistence.WorkerTag) error
+	FetchWorkerTag(ctx context.Context, uuid string) (*persistence.WorkerTag, error)
+	FetchWorkerTags(ctx context.Context) ([]*persistence.WorkerTag, error)
+	FetchTagsOfWorker(ctx context.Context, workerUUID string) ([]*persistence.WorkerTag, error)
+	SaveWorkerTag(ctx context.Context, tag *persistence.WorkerTag) error
+	DeleteWorkerTag(ctx context.Context, uuid string) error
+	// WorkerSetTags replaces the tags of the worker with the given ones.
+	WorkerSetTags(ctx context.Context, worker *persistence.Worker, tagUUIDs []string) error
+
+	CreateUser(ctx context.Context, user *persistence.User) error
+	FetchUser(ctx context.Context, uuid string) (*persistence.User, error)
+	FetchUserByName(ctx context.Context, name string) (*persistence.User, error)
+	FetchUsers(ctx context.Context) ([]*persistence.User, error)
+	CountUsers(ctx context.Context) (int, error)
+	DeleteUser(ctx context.Context, uuid string) error
+	CreateUserSession(ctx context.Context, session *persistence.UserSession) error
+	// FetchUserSession returns the non-expired session with the given token hash, including its user.
+	FetchUserSession(ctx context.Context, tokenHash string) (*persistence.UserSession, error)
+	DeleteUserSession(ctx context.Context, tokenHash string) error
+	DeleteExpiredUserSessions(ctx context.Context) error
+
 	// ScheduleTask finds a task to execute by the given worker, and assigns it to that worker.
 	// If no task is available, (nil, nil) is returned, as this is not an error situation.
-	ScheduleTask(ctx context.Context, w *persistence.Worker) (*persistence.Task, error)
+	ScheduleTask(ctx context.Context, w *persistence.Worker, runningTaskUUIDs ...string) (*persistence.Task, error)
+	// NextDeferredTaskTime returns the next moment a task becomes available
+	// because its job's start time or its retry time passes. Returns the zero
+	// time when there is no such moment.
+	NextDeferredTaskTime(ctx context.Context) (time.Time, error)
 	AddWorkerToTaskFailedList(context.Context, *persistence.Task, *persistence.Worker) (numFailed int, err error)
 	// ClearFailureListOfTask clears the list of workers that failed this task.
 	ClearFailureListOfTask(context.Context, *persistence.Task) error
@@ -69,6 +104,7 @@ type PersistenceService interface {
 
 	// Database queries.
 	QueryJobs(ctx context.Context, query api.JobsQuery) ([]*persistence.Job, error)
+	FetchJobsProgress(ctx context.Context, jobs []*persistence.Job) (map[uint]int, error)
 	QueryJobTaskSummaries(ctx context.Context, jobUUID string) ([]*persistence.Task, error)
 
 	// SetLastRendered sets this job as the one with the most recent rendered image.
@@ -96,7 +132,9 @@ var _ TaskStateMachine = (*task_state_machine.StateMachine)(nil)
 type ChangeBroadcaster interface {
 	// BroadcastNewJob sends a 'new job' notification to all SocketIO clients.
 	BroadcastNewJob(jobUpdate api.SocketIOJobUpdate)
+	BroadcastJobUpdate(jobUpdate api.SocketIOJobUpdate)
 	BroadcastLastRenderedImage(update api.SocketIOLastRenderedUpdate)
+	BroadcastTaskUpdate(taskUpdate api.SocketIOTaskUpdate)
 
 	// Note that there is no BroadcastNewTask. The 'new job' broadcast is sent
 	// after the job's tasks have been created, and thus there is no need for a
@@ -117,6 +155,7 @@ type JobCompiler interface {
 	ListJobTypes() api.AvailableJobTypes
 	GetJobType(typeName string) (api.AvailableJobType, error)
 	Compile(ctx context.Context, job api.SubmittedJob) (*job_compilers.AuthoredJob, error)
+	Recompile(ctx context.Context, jobID string, job api.SubmittedJob, changedSettings map[string]interface{}) (*job_compilers.AuthoredJob, error)
 }
 
 // LogStorage handles incoming task logs.
@@ -194,6 +233,9 @@ type Shaman interface {
 	// return early when another client finishes uploading the exact same file, to
 	// prevent double uploads.
 	FileStore(ctx context.Context, file io.ReadCloser, checksum string, filesize int64, canDefer bool, originalFilename string) error
+
+	// FileStoreSize returns the total size in bytes of the files in the file store.
+	FileStoreSize() (int64, error)
 }
 
 var _ Shaman = (*shaman.Server)(nil)
@@ -202,6 +244,7 @@ var _ Shaman = (*shaman.Server)(nil)
 // way that allows mocking.
 type TimeService interface {
 	Now() time.Time
+	After(d time.Duration) <-chan time.Time
 }
 
 var _ TimeService = (clock.Clock)(nil)
@@ -213,3 +256,37 @@ type WorkerSleepScheduler interface {
 }
 
 var _ WorkerSleepScheduler = (*sleep_scheduler.SleepScheduler)(nil)
+
+type JobDeleter interface {
+	// QueueJobDeletion marks the job for deletion, and queues it for actual
+	// deletion in the background.
+	QueueJobDeletion(ctx context.Context, job *persistence.Job) error
+
+	// QueueMassJobDeletion marks all finished jobs that were last updated
+	// before the given timestamp for deletion. Returns the UUIDs of those jobs.
+	QueueMassJobDeletion(ctx context.Context, finishedBefore time.Time) ([]string, error)
+}
+
+var _ JobDeleter = (*job_deleter.Service)(nil)
+
+type ScheduleNotifier interface {
+	// Wait registers the Worker as waiting for a task. The returned channel is
+	// closed when a task may have become available for it. The returned
+	// function must be called when the Worker is done waiting; after a wake-up,
+	// only once it has looked for a task again.
+	Wait(workerUUID string) (<-chan struct{}, func())
+
+	// NotifyAt wakes up the Worker that has been waiting the longest at the
+	// given time.
+	NotifyAt(t time.Time)
+}
+
+var _ ScheduleNotifier = (*schedule_notifier.Notifier)(nil)
+
+type DatabaseBackupper interface {
+	// BackupNow makes a backup of the database, and returns the path of the
+	// backup file.
+	BackupNow(ctx context.Context) (string, error)
+}
+
+var _ DatabaseBackupper = (*db_backup.Service)(nil)
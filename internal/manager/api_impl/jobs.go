@@ -3,6 +3,7 @@ package api_impl
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -60,6 +61,60 @@ func (f *Flamenco) GetJobType(e echo.Context, typeName string) error {
 	return e.JSON(http.StatusOK, jobType)
 }
 
+// ValidateJobType compiles a job of the given type, without storing anything.
+// This is intended for developing job compiler scripts.
+func (f *Flamenco) ValidateJobType(e echo.Context, typeName string) error {
+	logger := requestLogger(e)
+
+	if f.jobCompiler == nil {
+		logger.Error().Msg("Flamenco is running without job compiler")
+		return sendAPIError(e, http.StatusInternalServerError, "no job types available")
+	}
+
+	var validation api.ValidateJobTypeJSONRequestBody
+	if err := e.Bind(&validation); err != nil {
+		logger.Warn().Err(err).Msg("bad request received")
+		return sendAPIError(e, http.StatusBadRequest, "invalid format")
+	}
+
+	logger = logger.With().Str("typeName", typeName).Logger()
+	logger.Debug().Msg("validating job type")
+
+	submittedJob := api.SubmittedJob{
+		Name:              "Job Type Validation",
+		Type:              typeName,
+		Priority:          50,
+		Settings:          &validation.Settings,
+		Metadata:          validation.Metadata,
+		SubmitterPlatform: runtime.GOOS,
+	}
+
+	ctx := e.Request().Context()
+	authoredJob, err := f.jobCompiler.Compile(ctx, submittedJob)
+	var scriptErr *job_compilers.ScriptError
+	switch {
+	case errors.Is(err, job_compilers.ErrJobTypeUnknown):
+		return sendAPIError(e, http.StatusNotFound, "no such job type known")
+	case errors.As(err, &scriptErr):
+		logger.Info().Err(err).Msg("job type script produced an error")
+		return e.JSON(http.StatusUnprocessableEntity, api.JobScriptError{
+			Code:    http.StatusUnprocessableEntity,
+			Message: scriptErr.Message,
+			Script:  scriptErr.Script,
+			Line:    scriptErr.Line,
+			Column:  scriptErr.Column,
+		})
+	case err != nil:
+		logger.Info().Err(err).Msg("error compiling job")
+		return sendAPIError(e, http.StatusBadRequest, "error compiling job: %v", err)
+	}
+
+	result := api.JobTypeValidationResult{
+		Tasks: authoredTasksToAPI(authoredJob.Tasks),
+	}
+	return e.JSON(http.StatusOK, result)
+}
+
 func (f *Flamenco) SubmitJob(e echo.Context) error {
 	logger := requestLogger(e)
 
@@ -76,38 +131,39 @@ func (f *Flamenco) SubmitJob(e echo.Context) error {
 	logger.Info().Msg("new Flamenco job received")
 
 	ctx := e.Request().Context()
-	submittedJob := api.SubmittedJob(job)
-
-	// Replace the special "manager" platform with the Manager's actual platform.
-	if submittedJob.SubmitterPlatform == "manager" {
-		submittedJob.SubmitterPlatform = runtime.GOOS
-	}
-
-	if submittedJob.TypeEtag == nil || *submittedJob.TypeEtag == "" {
-		logger.Warn().Msg("job submitted without job type etag, refresh the job types in the Blender add-on")
+	authoredJob, err := f.compileSubmittedJob(ctx, logger, api.SubmittedJob(job))
+	if err != nil {
+		return sendJobCompileError(e, logger, err)
 	}
 
-	// Before compiling the job, replace the two-way variables. This ensures all
-	// the tasks also use those.
-	replaceTwoWayVariables(f.config, submittedJob)
+	logger = logger.With().Str("job_id", authoredJob.JobID).Logger()
 
-	authoredJob, err := f.jobCompiler.Compile(ctx, submittedJob)
-	switch {
-	case errors.Is(err, job_compilers.ErrJobTypeBadEtag):
-		logger.Warn().Err(err).Msg("rejecting submitted job, job type etag does not match")
-		return sendAPIError(e, http.StatusPreconditionFailed, "rejecting job, job type etag does not match")
-	case err != nil:
-		logger.Warn().Err(err).Msg("error compiling job")
-		// TODO: make this a more specific error object for this API call.
-		return sendAPIError(e, http.StatusBadRequest, fmt.Sprintf("error compiling job: %v", err))
+	if user := requestUser(e); user != nil {
+		authoredJob.UserUUID = user.UUID
+		logger = logger.With().Str("user", user.Name).Logger()
 	}
 
-	logger = logger.With().Str("job_id", authoredJob.JobID).Logger()
-
 	// TODO: check whether this job should be queued immediately or start paused.
-	authoredJob.Status = api.JobStatusQueued
+	// Jobs that depend on other jobs are put in 'waiting' status after they have
+	// been stored, as that requires checking the status of those other jobs.
+	// Until then they are still under construction.
+	hasDependencies := len(authoredJob.DependsOn) > 0
+	if hasDependencies {
+		authoredJob.Status = api.JobStatusUnderConstruction
+	} else {
+		authoredJob.Status = api.JobStatusQueued
+	}
 
-	if err := f.persist.StoreAuthoredJob(ctx, *authoredJob); err != nil {
+	err = f.persist.StoreAuthoredJob(ctx, *authoredJob)
+	if errors.Is(err, persistence.ErrWorkerTagNotFound) {
+		logger.Warn().Err(err).Str("workerTag", authoredJob.WorkerTagUUID).Msg("rejecting submitted job, worker tag does not exist")
+		return sendAPIError(e, http.StatusBadRequest, "worker tag %q does not exist", authoredJob.WorkerTagUUID)
+	}
+	if errors.Is(err, persistence.ErrJobNotFound) {
+		logger.Warn().Err(err).Strs("dependsOn", authoredJob.DependsOn).Msg("rejecting submitted job, it depends on a job that does not exist")
+		return sendAPIError(e, http.StatusBadRequest, "job depends on a job that does not exist: %v", err)
+	}
+	if err != nil {
 		logger.Error().Err(err).Msg("error persisting job in database")
 		return sendAPIError(e, http.StatusInternalServerError, "error persisting job in database")
 	}
@@ -121,10 +177,95 @@ func (f *Flamenco) SubmitJob(e echo.Context) error {
 	jobUpdate := webupdates.NewJobUpdate(dbJob)
 	f.broadcaster.BroadcastNewJob(jobUpdate)
 
+	if hasDependencies {
+		err := f.stateMachine.JobStatusChange(ctx, dbJob, api.JobStatusWaiting,
+			"waiting for the jobs it depends on to complete")
+		if err != nil {
+			logger.Error().Err(err).Msg("error putting job in waiting status")
+			return sendAPIError(e, http.StatusInternalServerError, "error putting job in waiting status")
+		}
+	}
+
 	apiJob := jobDBtoAPI(dbJob)
+	if hasDependencies {
+		apiJob.DependsOn = &authoredJob.DependsOn
+	}
+	return e.JSON(http.StatusOK, apiJob)
+}
+
+// SubmitJobDryRun compiles the submitted job, just like SubmitJob does, but
+// returns the result instead of storing it.
+func (f *Flamenco) SubmitJobDryRun(e echo.Context, params api.SubmitJobDryRunParams) error {
+	logger := requestLogger(e)
+
+	var job api.SubmitJobDryRunJSONRequestBody
+	if err := e.Bind(&job); err != nil {
+		logger.Warn().Err(err).Msg("bad request received")
+		return sendAPIError(e, http.StatusBadRequest, "invalid format")
+	}
+
+	logger = logger.With().
+		Str("type", job.Type).
+		Str("name", job.Name).
+		Logger()
+	logger.Info().Msg("Flamenco job received for dry run")
+
+	ctx := e.Request().Context()
+	authoredJob, err := f.compileSubmittedJob(ctx, logger, api.SubmittedJob(job))
+	if err != nil {
+		return sendJobCompileError(e, logger, err)
+	}
+
+	apiJob := authoredJobToAPI(*authoredJob)
+
+	// Show the commands as a Worker on the given platform would receive them.
+	if params.WorkerPlatform != nil && *params.WorkerPlatform != "" {
+		worker := persistence.Worker{Platform: *params.WorkerPlatform}
+		for i := range apiJob.Tasks {
+			task := api.AssignedTask{Commands: apiJob.Tasks[i].Commands}
+			apiJob.Tasks[i].Commands = replaceTaskVariables(f.config, task, worker).Commands
+		}
+	}
+
 	return e.JSON(http.StatusOK, apiJob)
 }
 
+// compileSubmittedJob replaces the two-way variables in the submitted job, and
+// compiles it.
+func (f *Flamenco) compileSubmittedJob(
+	ctx context.Context,
+	logger zerolog.Logger,
+	submittedJob api.SubmittedJob,
+) (*job_compilers.AuthoredJob, error) {
+	// Replace the special "manager" platform with the Manager's actual platform.
+	if submittedJob.SubmitterPlatform == "manager" {
+		submittedJob.SubmitterPlatform = runtime.GOOS
+	}
+
+	if submittedJob.TypeEtag == nil || *submittedJob.TypeEtag == "" {
+		logger.Warn().Msg("job submitted without job type etag, refresh the job types in the Blender add-on")
+	}
+
+	// Before compiling the job, replace the two-way variables. This ensures all
+	// the tasks also use those.
+	replaceTwoWayVariables(f.config, submittedJob)
+
+	return f.jobCompiler.Compile(ctx, submittedJob)
+}
+
+// sendJobCompileError sends the response for an error returned by
+// compileSubmittedJob().
+func sendJobCompileError(e echo.Context, logger zerolog.Logger, err error) error {
+	if errors.Is(err, job_compilers.ErrJobTypeBadEtag) {
+		logger.Warn().Err(err).Msg("rejecting submitted job, job type etag does not match")
+		return sendAPIError(e, http.StatusPreconditionFailed, "rejecting job, job type etag does not match")
+	}
+
+	logger.Warn().Err(err).Msg("error compiling job")
+	// TODO: make this a more specific error object for this API call.
+	return sendAPIError(e, http.StatusBadRequest, fmt.Sprintf("error compiling job: %v", err))
+}
+
 // SetJobStatus is used by the web interface to change a job's status.
 func (f *Flamenco) SetJobStatus(e echo.Context, jobID string) error {
 	logger := requestLogger(e)
@@ -146,6 +287,9 @@ func (f *Flamenco) SetJobStatus(e echo.Context, jobID string) error {
 		logger.Error().Err(err).Msg("error fetching job")
 		return sendAPIError(e, http.StatusInternalServerError, "error fetching job")
 	}
+	if !requestUserMayModifyJob(e, dbJob) {
+		return sendAPIError(e, http.StatusForbidden, "only the owner of the job or an admin can change its status")
+	}
 
 	logger = logger.With().
 		Str("currentstatus", string(dbJob.Status)).
@@ -178,6 +322,332 @@ func (f *Flamenco) SetJobStatus(e echo.Context, jobID string) error {
 	return e.NoContent(http.StatusNoContent)
 }
 
+// DeleteJob queues the job for deletion. The actual deletion is performed in
+// the background by the job deleter.
+func (f *Flamenco) DeleteJob(e echo.Context, jobID string) error {
+	logger := requestLogger(e)
+	ctx := e.Request().Context()
+
+	logger = logger.With().Str("job", jobID).Logger()
+
+	if !uuid.IsValid(jobID) {
+		logger.Debug().Msg("invalid job ID received")
+		return sendAPIError(e, http.StatusBadRequest, "job ID not valid")
+	}
+
+	dbJob, err := f.persist.FetchJob(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrJobNotFound) {
+			return sendAPIError(e, http.StatusNotFound, "no such job")
+		}
+		logger.Error().Err(err).Msg("error fetching job")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching job")
+	}
+	if !requestUserMayModifyJob(e, dbJob) {
+		return sendAPIError(e, http.StatusForbidden, "only the owner of the job or an admin can delete it")
+	}
+
+	logger = logger.With().
+		Str("currentstatus", string(dbJob.Status)).
+		Logger()
+
+	// Deleting a job while its tasks may be running on a Worker would leave that
+	// Worker in a confused state.
+	switch dbJob.Status {
+	case api.JobStatusActive, api.JobStatusCancelRequested, api.JobStatusRequeueing:
+		logger.Warn().Msg("refusing to delete job that may still have tasks running")
+		return sendAPIError(e, http.StatusConflict,
+			"job is %s; cancel or pause it before deleting it", dbJob.Status)
+	}
+
+	logger.Info().Msg("job deletion requested")
+	if err := f.jobDeleter.QueueJobDeletion(ctx, dbJob); err != nil {
+		logger.Error().Err(err).Msg("error queueing job for deletion")
+		return sendAPIError(e, http.StatusInternalServerError, "error queueing job for deletion")
+	}
+
+	return e.NoContent(http.StatusNoContent)
+}
+
+// DeleteJobMass queues all jobs that finished before a certain timestamp for deletion.
+func (f *Flamenco) DeleteJobMass(e echo.Context) error {
+	logger := requestLogger(e)
+	ctx := e.Request().Context()
+
+	if !requestUserIsAdmin(e) {
+		return sendAPIError(e, http.StatusForbidden, "only admins can delete jobs in bulk")
+	}
+
+	var selection api.DeleteJobMassJSONRequestBody
+	if err := e.Bind(&selection); err != nil {
+		logger.Warn().Err(err).Msg("bad request received")
+		return sendAPIError(e, http.StatusBadRequest, "invalid format")
+	}
+
+	if selection.FinishedBefore.IsZero() {
+		return sendAPIError(e, http.StatusBadRequest, "finished_before timestamp is required")
+	}
+
+	logger = logger.With().
+		Time("finishedBefore", selection.FinishedBefore).
+		Logger()
+	logger.Info().Msg("mass job deletion requested")
+
+	jobUUIDs, err := f.jobDeleter.QueueMassJobDeletion(ctx, selection.FinishedBefore.UTC())
+	if err != nil {
+		logger.Error().Err(err).Msg("error queueing jobs for deletion")
+		return sendAPIError(e, http.StatusInternalServerError, "error queueing jobs for deletion")
+	}
+
+	if jobUUIDs == nil {
+		// Make sure this is returned as an empty list, and not as `null`.
+		jobUUIDs = []string{}
+	}
+	return e.JSON(http.StatusOK, api.JobMassDeletionResult{JobIds: jobUUIDs})
+}
+
+// SetJobPriority changes the job's priority. This immediately affects which
+// task is handed out to the next Worker asking for one.
+func (f *Flamenco) SetJobPriority(e echo.Context, jobID string) error {
+	logger := requestLogger(e)
+	ctx := e.Request().Context()
+
+	logger = logger.With().Str("job", jobID).Logger()
+
+	if !uuid.IsValid(jobID) {
+		logger.Debug().Msg("invalid job ID received")
+		return sendAPIError(e, http.StatusBadRequest, "job ID not valid")
+	}
+
+	var prioChange api.SetJobPriorityJSONRequestBody
+	if err := e.Bind(&prioChange); err != nil {
+		logger.Warn().Err(err).Msg("bad request received")
+		return sendAPIError(e, http.StatusBadRequest, "invalid format")
+	}
+
+	dbJob, err := f.persist.FetchJob(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrJobNotFound) {
+			return sendAPIError(e, http.StatusNotFound, "no such job")
+		}
+		logger.Error().Err(err).Msg("error fetching job")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching job")
+	}
+	if !requestUserMayModifyJob(e, dbJob) {
+		return sendAPIError(e, http.StatusForbidden, "only the owner of the job or an admin can change its priority")
+	}
+
+	logger = logger.With().
+		Int("prioCurrent", dbJob.Priority).
+		Int("prioRequested", prioChange.Priority).
+		Logger()
+	logger.Info().Msg("job priority change requested")
+
+	dbJob.Priority = prioChange.Priority
+	if err := f.persist.SaveJobPriority(ctx, dbJob); err != nil {
+		logger.Error().Err(err).Msg("error saving job priority")
+		return sendAPIError(e, http.StatusInternalServerError, "error saving job priority")
+	}
+
+	jobUpdate := webupdates.NewJobUpdate(dbJob)
+	f.broadcaster.BroadcastJobUpdate(jobUpdate)
+
+	return e.NoContent(http.StatusNoContent)
+}
+
+// SetJobMaxWorkers changes the maximum number of Workers that can work on the
+// job simultaneously. Tasks that are already running are not affected.
+func (f *Flamenco) SetJobMaxWorkers(e echo.Context, jobID string) error {
+	logger := requestLogger(e)
+	ctx := e.Request().Context()
+
+	logger = logger.With().Str("job", jobID).Logger()
+
+	if !uuid.IsValid(jobID) {
+		logger.Debug().Msg("invalid job ID received")
+		return sendAPIError(e, http.StatusBadRequest, "job ID not valid")
+	}
+
+	var change api.SetJobMaxWorkersJSONRequestBody
+	if err := e.Bind(&change); err != nil {
+		logger.Warn().Err(err).Msg("bad request received")
+		return sendAPIError(e, http.StatusBadRequest, "invalid format")
+	}
+	if change.MaxWorkers < 0 {
+		return sendAPIError(e, http.StatusBadRequest, "max_workers cannot be negative")
+	}
+
+	dbJob, err := f.persist.FetchJob(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrJobNotFound) {
+			return sendAPIError(e, http.StatusNotFound, "no such job")
+		}
+		logger.Error().Err(err).Msg("error fetching job")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching job")
+	}
+	if !requestUserMayModifyJob(e, dbJob) {
+		return sendAPIError(e, http.StatusForbidden, "only the owner of the job or an admin can change its maximum number of workers")
+	}
+
+	logger = logger.With().
+		Int("maxWorkersCurrent", dbJob.MaxWorkers).
+		Int("maxWorkersRequested", change.MaxWorkers).
+		Logger()
+	logger.Info().Msg("job max workers change requested")
+
+	dbJob.MaxWorkers = change.MaxWorkers
+	if err := f.persist.SaveJobMaxWorkers(ctx, dbJob); err != nil {
+		logger.Error().Err(err).Msg("error saving job max workers")
+		return sendAPIError(e, http.StatusInternalServerError, "error saving job max workers")
+	}
+
+	jobUpdate := webupdates.NewJobUpdate(dbJob)
+	f.broadcaster.BroadcastJobUpdate(jobUpdate)
+
+	return e.NoContent(http.StatusNoContent)
+}
+
+// editableJobStatuses are the statuses in which a job can be edited. Jobs in
+// other statuses may have tasks running, which would be removed by recompiling
+// the job.
+var editableJobStatuses = map[api.JobStatus]bool{
+	api.JobStatusPaused:    true,
+	api.JobStatusFailed:    true,
+	api.JobStatusCanceled:  true,
+	api.JobStatusCompleted: true,
+}
+
+// EditJob changes settings of the job, and recompiles it with those settings.
+func (f *Flamenco) EditJob(e echo.Context, jobID string) error {
+	logger := requestLogger(e)
+	ctx := e.Request().Context()
+
+	logger = logger.With().Str("job", jobID).Logger()
+
+	if !uuid.IsValid(jobID) {
+		logger.Debug().Msg("invalid job ID received")
+		return sendAPIError(e, http.StatusBadRequest, "job ID not valid")
+	}
+
+	var edit api.EditJobJSONRequestBody
+	if err := e.Bind(&edit); err != nil {
+		logger.Warn().Err(err).Msg("bad request received")
+		return sendAPIError(e, http.StatusBadRequest, "invalid format")
+	}
+	if len(edit.Settings.AdditionalProperties) == 0 {
+		return sendAPIError(e, http.StatusBadRequest, "no settings to change")
+	}
+
+	dbJob, err := f.persist.FetchJob(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrJobNotFound) {
+			return sendAPIError(e, http.StatusNotFound, "no such job")
+		}
+		logger.Error().Err(err).Msg("error fetching job")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching job")
+	}
+	if !requestUserMayModifyJob(e, dbJob) {
+		return sendAPIError(e, http.StatusForbidden, "only the owner of the job or an admin can edit it")
+	}
+	if !editableJobStatuses[dbJob.Status] {
+		return sendAPIError(e, http.StatusConflict,
+			"job is in status %q, pause it before editing", dbJob.Status)
+	}
+
+	// The new settings come from the web interface, so they use the Manager's
+	// paths. Just like with job submission, these should be turned into
+	// variables.
+	changedSettings := api.SubmittedJob{
+		Settings:          &edit.Settings,
+		SubmitterPlatform: runtime.GOOS,
+	}
+	replaceTwoWayVariables(f.config, changedSettings)
+
+	submittedJob := jobDBtoAPI(dbJob).SubmittedJob
+	authoredJob, err := f.jobCompiler.Recompile(ctx, dbJob.UUID, submittedJob, edit.Settings.AdditionalProperties)
+	switch {
+	case errors.Is(err, job_compilers.ErrSettingNotOverridable):
+		logger.Warn().Err(err).Msg("rejecting job edit")
+		return sendAPIError(e, http.StatusUnprocessableEntity, "%v", err)
+	case err != nil:
+		logger.Warn().Err(err).Msg("error recompiling job")
+		return sendAPIError(e, http.StatusUnprocessableEntity, "error compiling job: %v", err)
+	}
+
+	logger.Info().
+		Interface("settings", edit.Settings.AdditionalProperties).
+		Int("numTasks", len(authoredJob.Tasks)).
+		Msg("job edited")
+
+	if err := f.persist.StoreRecompiledJob(ctx, *authoredJob); err != nil {
+		logger.Error().Err(err).Msg("error storing recompiled job")
+		return sendAPIError(e, http.StatusInternalServerError, "error storing recompiled job")
+	}
+
+	dbJob, err = f.persist.FetchJob(ctx, jobID)
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to retrieve just-edited job from database")
+		return sendAPIError(e, http.StatusInternalServerError, "error retrieving job from database")
+	}
+
+	// The new tasks are queued, so the job should be as well. Just like with a
+	// newly submitted job, it has to wait for the jobs it depends on first.
+	deps, err := f.persist.FetchJobDependencies(ctx, dbJob)
+	if err != nil {
+		logger.Error().Err(err).Msg("error fetching dependencies of edited job")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching dependencies of edited job")
+	}
+	newJobStatus := api.JobStatusQueued
+	reason := "job was edited"
+	if len(deps) > 0 {
+		newJobStatus = api.JobStatusWaiting
+		reason = "job was edited, waiting for the jobs it depends on to complete"
+	}
+
+	err = f.stateMachine.JobStatusChange(ctx, dbJob, newJobStatus, reason)
+	if err != nil {
+		logger.Error().Err(err).Msg("error queueing edited job")
+		return sendAPIError(e, http.StatusInternalServerError, "error queueing edited job")
+	}
+
+	return e.NoContent(http.StatusNoContent)
+}
+
+// FetchJobHistory returns the settings the job had before each time it was edited.
+func (f *Flamenco) FetchJobHistory(e echo.Context, jobID string) error {
+	if !uuid.IsValid(jobID) {
+		return sendAPIError(e, http.StatusBadRequest, "job ID should be a UUID")
+	}
+
+	logger := requestLogger(e).With().Str("job", jobID).Logger()
+	ctx := e.Request().Context()
+
+	dbJob, err := f.persist.FetchJob(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrJobNotFound) {
+			return sendAPIError(e, http.StatusNotFound, "no such job")
+		}
+		logger.Error().Err(err).Msg("error fetching job")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching job")
+	}
+
+	entries, err := f.persist.FetchJobHistory(ctx, dbJob)
+	if err != nil {
+		logger.Error().Err(err).Msg("error fetching job history")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching job history: %v", err)
+	}
+
+	history := api.JobHistory{Entries: []api.JobHistoryEntry{}}
+	for _, entry := range entries {
+		history.Entries = append(history.Entries, api.JobHistoryEntry{
+			Timestamp:        entry.CreatedAt,
+			PreviousSettings: api.JobSettings{AdditionalProperties: entry.PreviousSettings},
+		})
+	}
+
+	return e.JSON(http.StatusOK, history)
+}
+
 // SetTaskStatus is used by the web interface to change a task's status.
 func (f *Flamenco) SetTaskStatus(e echo.Context, taskID string) error {
 	logger := requestLogger(e)
@@ -199,6 +669,9 @@ func (f *Flamenco) SetTaskStatus(e echo.Context, taskID string) error {
 		logger.Error().Err(err).Msg("error fetching task")
 		return sendAPIError(e, http.StatusInternalServerError, "error fetching task")
 	}
+	if !requestUserMayModifyJob(e, dbTask.Job) {
+		return sendAPIError(e, http.StatusForbidden, "only the owner of the job or an admin can change its tasks")
+	}
 
 	logger = logger.With().
 		Str("currentstatus", string(dbTask.Status)).
@@ -380,6 +853,18 @@ func (f *Flamenco) RemoveJobBlocklist(e echo.Context, jobID string) error {
 		return sendAPIError(e, http.StatusBadRequest, "empty list of blocklist entries given")
 	}
 
+	dbJob, err := f.persist.FetchJob(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, persistence.ErrJobNotFound) {
+			return sendAPIError(e, http.StatusNotFound, "no such job")
+		}
+		logger.Error().Err(err).Msg("error fetching job")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching job")
+	}
+	if !requestUserMayModifyJob(e, dbJob) {
+		return sendAPIError(e, http.StatusForbidden, "only the owner of the job or an admin can change its blocklist")
+	}
+
 	var lastErr error
 	for _, entry := range entriesToRemove {
 		sublogger := logger.With().
@@ -481,6 +966,30 @@ func jobDBtoAPI(dbJob *persistence.Job) api.Job {
 	apiJob.Settings = &api.JobSettings{AdditionalProperties: dbJob.Settings}
 	apiJob.Metadata = &api.JobMetadata{AdditionalProperties: dbJob.Metadata}
 
+	if dbJob.WorkerTag != nil {
+		apiJob.WorkerTag = &dbJob.WorkerTag.UUID
+	}
+	if dbJob.MaxWorkers > 0 {
+		apiJob.MaxWorkers = &dbJob.MaxWorkers
+	}
+	if len(dbJob.Dependencies) > 0 {
+		dependsOn := make([]string, len(dbJob.Dependencies))
+		for i, dep := range dbJob.Dependencies {
+			dependsOn[i] = dep.UUID
+		}
+		apiJob.DependsOn = &dependsOn
+	}
+	if dbJob.StartAfter.Valid {
+		apiJob.StartAfter = &dbJob.StartAfter.Time
+	}
+	if dbJob.DeleteRequestedAt.Valid {
+		apiJob.DeleteRequestedAt = &dbJob.DeleteRequestedAt.Time
+	}
+	if dbJob.User != nil {
+		apiUser := userDBtoAPI(*dbJob.User)
+		apiJob.User = &apiUser
+	}
+
 	return apiJob
 }
 
@@ -494,6 +1003,7 @@ func taskDBtoAPI(dbTask *persistence.Task) api.Task {
 		Updated:  dbTask.UpdatedAt,
 		Status:   dbTask.Status,
 		Activity: dbTask.Activity,
+		Progress: &dbTask.Progress,
 		Commands: make([]api.Command, len(dbTask.Commands)),
 		Worker:   workerToTaskWorker(dbTask.Worker),
 	}
@@ -520,6 +1030,72 @@ func commandDBtoAPI(dbCommand persistence.Command) api.Command {
 	}
 }
 
+func authoredJobToAPI(authoredJob job_compilers.AuthoredJob) api.AuthoredJob {
+	apiJob := api.AuthoredJob{
+		Name:       authoredJob.Name,
+		Type:       authoredJob.JobType,
+		Priority:   authoredJob.Priority,
+		Settings:   api.JobSettings{AdditionalProperties: authoredJob.Settings},
+		Metadata:   api.JobMetadata{AdditionalProperties: authoredJob.Metadata},
+		MaxWorkers: authoredJob.MaxWorkers,
+		DependsOn:  authoredJob.DependsOn,
+		Tasks:      authoredTasksToAPI(authoredJob.Tasks),
+	}
+	if apiJob.DependsOn == nil {
+		apiJob.DependsOn = []string{}
+	}
+	if authoredJob.WorkerTagUUID != "" {
+		apiJob.WorkerTag = &authoredJob.WorkerTagUUID
+	}
+	if !authoredJob.StartAfter.IsZero() {
+		apiJob.StartAfter = &authoredJob.StartAfter
+	}
+
+	requirements := authoredJob.Requirements
+	if requirements.CPUCores > 0 || requirements.MemoryMB > 0 || len(requirements.Capabilities) > 0 {
+		apiReqs := api.WorkerRequirements{}
+		if requirements.CPUCores > 0 {
+			apiReqs.CpuCores = &requirements.CPUCores
+		}
+		if requirements.MemoryMB > 0 {
+			apiReqs.MemoryMb = &requirements.MemoryMB
+		}
+		if len(requirements.Capabilities) > 0 {
+			apiReqs.Capabilities = &api.WorkerRequirements_Capabilities{
+				AdditionalProperties: requirements.Capabilities,
+			}
+		}
+		apiJob.Requirements = &apiReqs
+	}
+
+	return apiJob
+}
+
+func authoredTasksToAPI(authoredTasks []job_compilers.AuthoredTask) []api.AuthoredTask {
+	apiTasks := make([]api.AuthoredTask, len(authoredTasks))
+	for i, authoredTask := range authoredTasks {
+		apiTask := api.AuthoredTask{
+			Uuid:         authoredTask.UUID,
+			Name:         authoredTask.Name,
+			Type:         authoredTask.Type,
+			Priority:     authoredTask.Priority,
+			Commands:     make([]api.Command, len(authoredTask.Commands)),
+			Dependencies: make([]string, len(authoredTask.Dependencies)),
+		}
+		for j, command := range authoredTask.Commands {
+			apiTask.Commands[j] = api.Command{
+				Name:       command.Name,
+				Parameters: command.Parameters,
+			}
+		}
+		for j, dependency := range authoredTask.Dependencies {
+			apiTask.Dependencies[j] = dependency.UUID
+		}
+		apiTasks[i] = apiTask
+	}
+	return apiTasks
+}
+
 // workerToTaskWorker is nil-safe.
 func workerToTaskWorker(worker *persistence.Worker) *api.TaskWorker {
 	if worker == nil {
@@ -32,7 +32,21 @@ func (f *Flamenco) FetchJob(e echo.Context, jobID string) error {
 		return sendAPIError(e, http.StatusNotFound, fmt.Sprintf("job %+v not found", jobID))
 	}
 
+	dbJob.Dependencies, err = f.persist.FetchJobDependencies(ctx, dbJob)
+	if err != nil {
+		logger.Error().Err(err).Msg("cannot fetch job dependencies")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching job dependencies")
+	}
+
+	jobsProgress, err := f.persist.FetchJobsProgress(ctx, []*persistence.Job{dbJob})
+	if err != nil {
+		logger.Error().Err(err).Msg("cannot fetch job progress")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching job progress")
+	}
+
 	apiJob := jobDBtoAPI(dbJob)
+	progress := jobsProgress[dbJob.ID]
+	apiJob.Progress = &progress
 	return e.JSON(http.StatusOK, apiJob)
 }
 
@@ -52,9 +66,17 @@ func (f *Flamenco) QueryJobs(e echo.Context) error {
 		return sendAPIError(e, http.StatusInternalServerError, "error querying for jobs")
 	}
 
+	jobsProgress, err := f.persist.FetchJobsProgress(ctx, dbJobs)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error fetching job progress")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching job progress")
+	}
+
 	apiJobs := make([]api.Job, len(dbJobs))
 	for i, dbJob := range dbJobs {
 		apiJobs[i] = jobDBtoAPI(dbJob)
+		progress := jobsProgress[dbJob.ID]
+		apiJobs[i].Progress = &progress
 	}
 	result := api.JobsQueryResult{
 		Jobs: apiJobs,
@@ -135,5 +157,6 @@ func taskDBtoSummary(task *persistence.Task) api.TaskSummary {
 		Status:   task.Status,
 		TaskType: task.Type,
 		Updated:  task.UpdatedAt,
+		Progress: &task.Progress,
 	}
 }
@@ -41,6 +41,7 @@ func TestFetchTask(t *testing.T) {
 		Worker:       &taskWorker,
 		Dependencies: []*persistence.Task{},
 		Activity:     "used in unit test",
+		Progress:     25,
 
 		Commands: []persistence.Command{
 			{Name: "move-directory",
@@ -58,6 +59,7 @@ func TestFetchTask(t *testing.T) {
 		JobId:    jobUUID,
 		Name:     "симпатичная задача",
 		Priority: 47,
+		Progress: ptr(25),
 		Status:   api.TaskStatusQueued,
 		TaskType: "misc",
 		Updated:  dbTask.UpdatedAt,
@@ -3,11 +3,14 @@ package api_impl
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"os"
+	"runtime"
 	"testing"
+	"time"
 
 	"git.blender.org/flamenco/internal/manager/config"
 	"git.blender.org/flamenco/internal/manager/job_compilers"
@@ -88,6 +91,230 @@ func TestSubmitJobWithoutSettings(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestSubmitJobDryRun(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+
+	submittedJob := api.SubmittedJob{
+		Name:              "поднео посао",
+		Type:              "test",
+		Priority:          50,
+		SubmitterPlatform: worker.Platform,
+		Settings: &api.JobSettings{AdditionalProperties: map[string]interface{}{
+			"frames": "1-10",
+		}},
+	}
+
+	mf.expectConvertTwoWayVariables(t,
+		config.VariableAudienceWorkers,
+		config.VariablePlatform(worker.Platform),
+		map[string]string{},
+	)
+
+	// Expect the job compiler to be called. As this is a dry run, nothing
+	// should be stored in the database.
+	render := job_compilers.AuthoredTask{
+		UUID:     "1ee98b8d-0e6e-4a29-a4ba-7a6b2a4bd1e8",
+		Name:     "render",
+		Type:     "blender",
+		Priority: 50,
+		Commands: []job_compilers.AuthoredCommand{
+			{Name: "blender-render", Parameters: job_compilers.AuthoredCommandParameters{"exe": "{blender}"}},
+		},
+	}
+	preview := job_compilers.AuthoredTask{
+		UUID:         "8c6a5b7d-5e27-41c0-a8a2-ba8fbd8b0e33",
+		Name:         "preview",
+		Type:         "ffmpeg",
+		Priority:     50,
+		Dependencies: []*job_compilers.AuthoredTask{&render},
+	}
+	authoredJob := job_compilers.AuthoredJob{
+		JobID:      "afc47568-bd9d-4368-8016-e91d945db36d",
+		Name:       submittedJob.Name,
+		JobType:    submittedJob.Type,
+		Priority:   submittedJob.Priority,
+		Status:     api.JobStatusUnderConstruction,
+		Created:    mf.clock.Now(),
+		MaxWorkers: 2,
+		Requirements: job_compilers.WorkerRequirements{
+			MemoryMB: 16384,
+		},
+		Settings: job_compilers.JobSettings{"frames": "1-10"},
+		Metadata: job_compilers.JobMetadata{},
+		Tasks:    []job_compilers.AuthoredTask{render, preview},
+	}
+	mf.jobCompiler.EXPECT().Compile(gomock.Any(), submittedJob).Return(&authoredJob, nil)
+
+	// Do the call.
+	echoCtx := mf.prepareMockedJSONRequest(submittedJob)
+	requestWorkerStore(echoCtx, &worker)
+	err := mf.flamenco.SubmitJobDryRun(echoCtx, api.SubmitJobDryRunParams{})
+	assert.NoError(t, err)
+
+	assertResponseJSON(t, echoCtx, http.StatusOK, api.AuthoredJob{
+		Name:         submittedJob.Name,
+		Type:         "test",
+		Priority:     50,
+		Settings:     api.JobSettings{AdditionalProperties: map[string]interface{}{"frames": "1-10"}},
+		Metadata:     api.JobMetadata{AdditionalProperties: map[string]string{}},
+		MaxWorkers:   2,
+		DependsOn:    []string{},
+		Requirements: &api.WorkerRequirements{MemoryMb: ptr(16384)},
+		Tasks: []api.AuthoredTask{
+			{
+				Uuid:     render.UUID,
+				Name:     "render",
+				Type:     "blender",
+				Priority: 50,
+				Commands: []api.Command{
+					{Name: "blender-render", Parameters: map[string]interface{}{"exe": "{blender}"}},
+				},
+				Dependencies: []string{},
+			},
+			{
+				Uuid:         preview.UUID,
+				Name:         "preview",
+				Type:         "ffmpeg",
+				Priority:     50,
+				Commands:     []api.Command{},
+				Dependencies: []string{render.UUID},
+			},
+		},
+	})
+}
+
+func TestSubmitJobDryRun_workerPlatform(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	submittedJob := api.SubmittedJob{
+		Name:              "поднео посао",
+		Type:              "test",
+		Priority:          50,
+		SubmitterPlatform: "linux",
+	}
+
+	mf.expectConvertTwoWayVariables(t,
+		config.VariableAudienceWorkers,
+		config.VariablePlatform("linux"),
+		map[string]string{},
+	)
+	mf.expectExpandVariables(t,
+		config.VariableAudienceWorkers,
+		config.VariablePlatform("windows"),
+		map[string]string{"blender": `C:\Blender\blender.exe`},
+	)
+
+	authoredJob := job_compilers.AuthoredJob{
+		JobID:    "afc47568-bd9d-4368-8016-e91d945db36d",
+		Name:     submittedJob.Name,
+		JobType:  submittedJob.Type,
+		Priority: submittedJob.Priority,
+		Tasks: []job_compilers.AuthoredTask{{
+			UUID:     "1ee98b8d-0e6e-4a29-a4ba-7a6b2a4bd1e8",
+			Name:     "render",
+			Type:     "blender",
+			Priority: 50,
+			Commands: []job_compilers.AuthoredCommand{
+				{Name: "blender-render", Parameters: job_compilers.AuthoredCommandParameters{"exe": "{blender}"}},
+			},
+		}},
+	}
+	mf.jobCompiler.EXPECT().Compile(gomock.Any(), submittedJob).Return(&authoredJob, nil)
+
+	echoCtx := mf.prepareMockedJSONRequest(submittedJob)
+	err := mf.flamenco.SubmitJobDryRun(echoCtx, api.SubmitJobDryRunParams{
+		WorkerPlatform: ptr("windows"),
+	})
+	assert.NoError(t, err)
+
+	var apiJob api.AuthoredJob
+	getResponseJSON(t, echoCtx, http.StatusOK, &apiJob)
+	if assert.Len(t, apiJob.Tasks, 1) && assert.Len(t, apiJob.Tasks[0].Commands, 1) {
+		assert.Equal(t, `C:\Blender\blender.exe`, apiJob.Tasks[0].Commands[0].Parameters["exe"])
+	}
+}
+
+func TestSubmitJobWithDependencies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+
+	dependsOn := []string{"9a39b5f6-a2b0-4ed7-9f9b-81a7a5fc2d8b"}
+	submittedJob := api.SubmittedJob{
+		Name:              "comp job",
+		Type:              "test",
+		Priority:          50,
+		SubmitterPlatform: worker.Platform,
+		DependsOn:         &dependsOn,
+	}
+
+	mf.expectConvertTwoWayVariables(t,
+		config.VariableAudienceWorkers,
+		config.VariablePlatform(worker.Platform),
+		map[string]string{},
+	)
+
+	authoredJob := job_compilers.AuthoredJob{
+		JobID:     "afc47568-bd9d-4368-8016-e91d945db36d",
+		Name:      submittedJob.Name,
+		JobType:   submittedJob.Type,
+		Priority:  submittedJob.Priority,
+		Created:   mf.clock.Now(),
+		DependsOn: dependsOn,
+	}
+	// Return a copy, as SubmitJob modifies the job.
+	compiledJob := authoredJob
+	mf.jobCompiler.EXPECT().Compile(gomock.Any(), submittedJob).Return(&compiledJob, nil)
+
+	// Expect the job to be saved while still under construction.
+	authoredJob.Status = api.JobStatusUnderConstruction
+	mf.persistence.EXPECT().StoreAuthoredJob(gomock.Any(), authoredJob).Return(nil)
+
+	dbJob := persistence.Job{
+		UUID:     authoredJob.JobID,
+		Name:     authoredJob.Name,
+		JobType:  authoredJob.JobType,
+		Priority: authoredJob.Priority,
+		Status:   authoredJob.Status,
+		Settings: persistence.StringInterfaceMap{},
+		Metadata: persistence.StringStringMap{},
+	}
+	mf.persistence.EXPECT().FetchJob(gomock.Any(), authoredJob.JobID).Return(&dbJob, nil)
+	mf.broadcaster.EXPECT().BroadcastNewJob(gomock.Any()).Do(func(jobUpdate api.SocketIOJobUpdate) {
+		assert.Equal(t, api.JobStatusUnderConstruction, jobUpdate.Status)
+	})
+
+	// Expect the job to go to 'waiting' status via the state machine.
+	mf.stateMachine.EXPECT().
+		JobStatusChange(gomock.Any(), &dbJob, api.JobStatusWaiting, "waiting for the jobs it depends on to complete").
+		DoAndReturn(func(ctx context.Context, job *persistence.Job, status api.JobStatus, reason string) error {
+			job.Status = status
+			return nil
+		})
+
+	// Do the call.
+	echoCtx := mf.prepareMockedJSONRequest(submittedJob)
+	requestWorkerStore(echoCtx, &worker)
+	err := mf.flamenco.SubmitJob(echoCtx)
+	assert.NoError(t, err)
+
+	var apiJob api.Job
+	getResponseJSON(t, echoCtx, http.StatusOK, &apiJob)
+	assert.Equal(t, api.JobStatusWaiting, apiJob.Status)
+	if assert.NotNil(t, apiJob.DependsOn) {
+		assert.Equal(t, dependsOn, *apiJob.DependsOn)
+	}
+}
+
 func TestSubmitJobWithSettings(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -297,6 +524,112 @@ func TestGetJobTypeError(t *testing.T) {
 	assertResponseAPIError(t, echoCtx, http.StatusInternalServerError, "error getting job type")
 }
 
+func TestValidateJobType(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mf := newMockedFlamenco(mockCtrl)
+
+	settings := api.JobSettings{AdditionalProperties: map[string]interface{}{
+		"frames": "1-10",
+	}}
+	render := job_compilers.AuthoredTask{
+		UUID:     "1ee98b8d-0e6e-4a29-a4ba-7a6b2a4bd1e8",
+		Name:     "render",
+		Type:     "blender",
+		Priority: 50,
+		Commands: []job_compilers.AuthoredCommand{
+			{Name: "blender-render", Parameters: job_compilers.AuthoredCommandParameters{"frames": "1-10"}},
+		},
+	}
+	preview := job_compilers.AuthoredTask{
+		UUID:         "8c6a5b7d-5e27-41c0-a8a2-ba8fbd8b0e33",
+		Name:         "preview",
+		Type:         "ffmpeg",
+		Priority:     50,
+		Dependencies: []*job_compilers.AuthoredTask{&render},
+	}
+
+	mf.jobCompiler.EXPECT().Compile(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, sj api.SubmittedJob) (*job_compilers.AuthoredJob, error) {
+			assert.Equal(t, "test-job-type", sj.Type)
+			assert.Equal(t, &settings, sj.Settings)
+			return &job_compilers.AuthoredJob{
+				JobType: sj.Type,
+				Tasks:   []job_compilers.AuthoredTask{render, preview},
+			}, nil
+		})
+
+	echoCtx := mf.prepareMockedJSONRequest(api.JobTypeValidation{Settings: settings})
+	err := mf.flamenco.ValidateJobType(echoCtx, "test-job-type")
+	assert.NoError(t, err)
+
+	assertResponseJSON(t, echoCtx, http.StatusOK, api.JobTypeValidationResult{
+		Tasks: []api.AuthoredTask{
+			{
+				Uuid:     render.UUID,
+				Name:     "render",
+				Type:     "blender",
+				Priority: 50,
+				Commands: []api.Command{
+					{Name: "blender-render", Parameters: map[string]interface{}{"frames": "1-10"}},
+				},
+				Dependencies: []string{},
+			},
+			{
+				Uuid:         preview.UUID,
+				Name:         "preview",
+				Type:         "ffmpeg",
+				Priority:     50,
+				Commands:     []api.Command{},
+				Dependencies: []string{render.UUID},
+			},
+		},
+	})
+}
+
+func TestValidateJobType_scriptError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mf := newMockedFlamenco(mockCtrl)
+
+	scriptErr := job_compilers.ScriptError{
+		Script:  "test_job_type.js",
+		Line:    47,
+		Column:  12,
+		Message: "TypeError: Cannot read property 'toUpperCase' of undefined",
+	}
+	mf.jobCompiler.EXPECT().Compile(gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("wrapped: %w", &scriptErr))
+
+	settings := api.JobSettings{AdditionalProperties: map[string]interface{}{}}
+	echoCtx := mf.prepareMockedJSONRequest(api.JobTypeValidation{Settings: settings})
+	err := mf.flamenco.ValidateJobType(echoCtx, "test-job-type")
+	assert.NoError(t, err)
+
+	assertResponseJSON(t, echoCtx, http.StatusUnprocessableEntity, api.JobScriptError{
+		Code:    http.StatusUnprocessableEntity,
+		Message: scriptErr.Message,
+		Script:  "test_job_type.js",
+		Line:    47,
+		Column:  12,
+	})
+}
+
+func TestValidateJobType_unknown(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mf := newMockedFlamenco(mockCtrl)
+
+	mf.jobCompiler.EXPECT().Compile(gomock.Any(), gomock.Any()).
+		Return(nil, job_compilers.ErrJobTypeUnknown)
+
+	settings := api.JobSettings{AdditionalProperties: map[string]interface{}{}}
+	echoCtx := mf.prepareMockedJSONRequest(api.JobTypeValidation{Settings: settings})
+	err := mf.flamenco.ValidateJobType(echoCtx, "nonexistent-type")
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusNotFound, "no such job type known")
+}
+
 func TestSetJobStatus_nonexistentJob(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -387,6 +720,311 @@ func TestSetJobStatusFailedToRequeueing(t *testing.T) {
 	assertResponseNoContent(t, echoCtx)
 }
 
+func TestSetJobPriority(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	prioUpdate := api.JobPriorityChange{
+		Priority: 47,
+	}
+	dbJob := persistence.Job{
+		UUID:     jobID,
+		Name:     "test job",
+		Priority: 50,
+		Settings: persistence.StringInterfaceMap{},
+		Metadata: persistence.StringStringMap{},
+	}
+
+	// Set up expectations.
+	ctx := gomock.Any()
+	mf.persistence.EXPECT().FetchJob(ctx, jobID).Return(&dbJob, nil).Times(1)
+	jobWithNewPrio := dbJob
+	jobWithNewPrio.Priority = prioUpdate.Priority
+	mf.persistence.EXPECT().SaveJobPriority(ctx, &jobWithNewPrio)
+
+	expectUpdate := api.SocketIOJobUpdate{
+		Id:       dbJob.UUID,
+		Name:     &dbJob.Name,
+		Priority: prioUpdate.Priority,
+		Status:   dbJob.Status,
+		Updated:  dbJob.UpdatedAt,
+	}
+	mf.broadcaster.EXPECT().BroadcastJobUpdate(expectUpdate)
+
+	// Do the call.
+	echoCtx := mf.prepareMockedJSONRequest(prioUpdate)
+	err := mf.flamenco.SetJobPriority(echoCtx, jobID)
+	assert.NoError(t, err)
+
+	assertResponseNoContent(t, echoCtx)
+}
+
+func TestSetJobPriority_nonexistentJob(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	mf.persistence.EXPECT().FetchJob(gomock.Any(), jobID).Return(nil, persistence.ErrJobNotFound)
+
+	echoCtx := mf.prepareMockedJSONRequest(api.JobPriorityChange{Priority: 47})
+	err := mf.flamenco.SetJobPriority(echoCtx, jobID)
+	assert.NoError(t, err)
+
+	assertResponseAPIError(t, echoCtx, http.StatusNotFound, "no such job")
+}
+
+func TestSetJobMaxWorkers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	change := api.JobMaxWorkersChange{
+		MaxWorkers: 4,
+	}
+	dbJob := persistence.Job{
+		UUID:     jobID,
+		Name:     "test job",
+		Priority: 50,
+		Settings: persistence.StringInterfaceMap{},
+		Metadata: persistence.StringStringMap{},
+	}
+
+	// Set up expectations.
+	ctx := gomock.Any()
+	mf.persistence.EXPECT().FetchJob(ctx, jobID).Return(&dbJob, nil)
+	jobWithLimit := dbJob
+	jobWithLimit.MaxWorkers = change.MaxWorkers
+	mf.persistence.EXPECT().SaveJobMaxWorkers(ctx, &jobWithLimit)
+	mf.broadcaster.EXPECT().BroadcastJobUpdate(gomock.Any())
+
+	// Do the call.
+	echoCtx := mf.prepareMockedJSONRequest(change)
+	err := mf.flamenco.SetJobMaxWorkers(echoCtx, jobID)
+	assert.NoError(t, err)
+
+	assertResponseNoContent(t, echoCtx)
+}
+
+func TestSetJobMaxWorkers_negative(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	echoCtx := mf.prepareMockedJSONRequest(api.JobMaxWorkersChange{MaxWorkers: -1})
+	err := mf.flamenco.SetJobMaxWorkers(echoCtx, jobID)
+	assert.NoError(t, err)
+
+	assertResponseAPIError(t, echoCtx, http.StatusBadRequest, "max_workers cannot be negative")
+}
+
+func TestEditJob(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	dbJob := persistence.Job{
+		Model:    persistence.Model{ID: 47},
+		UUID:     jobID,
+		Name:     "test job",
+		JobType:  "simple-blender-render",
+		Priority: 50,
+		Status:   api.JobStatusPaused,
+		Settings: persistence.StringInterfaceMap{
+			"frames":             "1-10",
+			"render_output_path": "/wrong/path/######",
+		},
+		Metadata: persistence.StringStringMap{},
+	}
+	edit := api.JobEdit{Settings: api.JobSettings{AdditionalProperties: map[string]interface{}{
+		"render_output_path": "/render/frames/######",
+	}}}
+
+	mf.expectConvertTwoWayVariables(t,
+		config.VariableAudienceWorkers,
+		config.VariablePlatform(runtime.GOOS),
+		map[string]string{"frames": "/render/frames"},
+	)
+
+	ctx := gomock.Any()
+	mf.persistence.EXPECT().FetchJob(ctx, jobID).Return(&dbJob, nil).Times(2)
+
+	// The job should be recompiled with the variable-replaced settings.
+	authoredJob := job_compilers.AuthoredJob{
+		JobID:    jobID,
+		Name:     dbJob.Name,
+		JobType:  dbJob.JobType,
+		Priority: dbJob.Priority,
+		Status:   api.JobStatusUnderConstruction,
+		Settings: job_compilers.JobSettings{
+			"frames":             "1-10",
+			"render_output_path": "{frames}/######",
+		},
+	}
+	mf.jobCompiler.EXPECT().Recompile(ctx, jobID, jobDBtoAPI(&dbJob).SubmittedJob,
+		map[string]interface{}{"render_output_path": "{frames}/######"},
+	).Return(&authoredJob, nil)
+	mf.persistence.EXPECT().StoreRecompiledJob(ctx, authoredJob)
+	mf.persistence.EXPECT().FetchJobDependencies(ctx, &dbJob).Return([]*persistence.Job{}, nil)
+	mf.stateMachine.EXPECT().JobStatusChange(ctx, &dbJob, api.JobStatusQueued, "job was edited")
+
+	echoCtx := mf.prepareMockedJSONRequest(edit)
+	err := mf.flamenco.EditJob(echoCtx, jobID)
+	assert.NoError(t, err)
+	assertResponseNoContent(t, echoCtx)
+}
+
+func TestEditJob_withDependencies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	dbJob := persistence.Job{
+		Model:    persistence.Model{ID: 47},
+		UUID:     jobID,
+		Name:     "test job",
+		JobType:  "simple-blender-render",
+		Priority: 50,
+		Status:   api.JobStatusFailed,
+		Settings: persistence.StringInterfaceMap{"frames": "1-10"},
+		Metadata: persistence.StringStringMap{},
+	}
+	upstream := persistence.Job{
+		UUID:   "7c0a3b2e-38c4-4a59-8d16-1c6b0a5c3f43",
+		Status: api.JobStatusActive,
+	}
+	edit := api.JobEdit{Settings: api.JobSettings{AdditionalProperties: map[string]interface{}{
+		"frames": "1-20",
+	}}}
+
+	mf.expectConvertTwoWayVariables(t,
+		config.VariableAudienceWorkers,
+		config.VariablePlatform(runtime.GOOS),
+		map[string]string{},
+	)
+
+	ctx := gomock.Any()
+	mf.persistence.EXPECT().FetchJob(ctx, jobID).Return(&dbJob, nil).Times(2)
+
+	authoredJob := job_compilers.AuthoredJob{JobID: jobID, Status: api.JobStatusUnderConstruction}
+	mf.jobCompiler.EXPECT().Recompile(ctx, jobID, jobDBtoAPI(&dbJob).SubmittedJob,
+		map[string]interface{}{"frames": "1-20"},
+	).Return(&authoredJob, nil)
+	mf.persistence.EXPECT().StoreRecompiledJob(ctx, authoredJob)
+
+	// The job depends on another job, so it should wait for it, just like it
+	// did when it was submitted.
+	mf.persistence.EXPECT().FetchJobDependencies(ctx, &dbJob).Return([]*persistence.Job{&upstream}, nil)
+	mf.stateMachine.EXPECT().JobStatusChange(ctx, &dbJob, api.JobStatusWaiting,
+		"job was edited, waiting for the jobs it depends on to complete")
+
+	echoCtx := mf.prepareMockedJSONRequest(edit)
+	err := mf.flamenco.EditJob(echoCtx, jobID)
+	assert.NoError(t, err)
+	assertResponseNoContent(t, echoCtx)
+}
+
+func TestEditJob_activeJob(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	dbJob := persistence.Job{
+		UUID:     jobID,
+		Name:     "test job",
+		Status:   api.JobStatusActive,
+		Settings: persistence.StringInterfaceMap{},
+		Metadata: persistence.StringStringMap{},
+	}
+	mf.persistence.EXPECT().FetchJob(gomock.Any(), jobID).Return(&dbJob, nil)
+
+	echoCtx := mf.prepareMockedJSONRequest(api.JobEdit{Settings: api.JobSettings{
+		AdditionalProperties: map[string]interface{}{"frames": "1-20"},
+	}})
+	err := mf.flamenco.EditJob(echoCtx, jobID)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusConflict, `job is in status "active", pause it before editing`)
+}
+
+func TestEditJob_notOverridable(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	dbJob := persistence.Job{
+		UUID:     jobID,
+		Name:     "test job",
+		Status:   api.JobStatusFailed,
+		Settings: persistence.StringInterfaceMap{},
+		Metadata: persistence.StringStringMap{},
+	}
+	mf.persistence.EXPECT().FetchJob(gomock.Any(), jobID).Return(&dbJob, nil)
+	mf.expectConvertTwoWayVariables(t,
+		config.VariableAudienceWorkers,
+		config.VariablePlatform(runtime.GOOS),
+		map[string]string{},
+	)
+	mf.jobCompiler.EXPECT().Recompile(gomock.Any(), jobID, gomock.Any(), gomock.Any()).
+		Return(nil, fmt.Errorf("%w: %q", job_compilers.ErrSettingNotOverridable, "blendfile"))
+
+	echoCtx := mf.prepareMockedJSONRequest(api.JobEdit{Settings: api.JobSettings{
+		AdditionalProperties: map[string]interface{}{"blendfile": "/other.blend"},
+	}})
+	err := mf.flamenco.EditJob(echoCtx, jobID)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echoCtx, http.StatusUnprocessableEntity,
+		`job setting cannot be changed after submission: "blendfile"`)
+}
+
+func TestFetchJobHistory(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	dbJob := persistence.Job{
+		Model:    persistence.Model{ID: 47},
+		UUID:     jobID,
+		Settings: persistence.StringInterfaceMap{"frames": "1-20"},
+	}
+	editTime := mf.clock.Now()
+	entries := []persistence.JobHistoryEntry{{
+		Model:            persistence.Model{ID: 1, CreatedAt: editTime},
+		JobID:            dbJob.ID,
+		PreviousSettings: persistence.StringInterfaceMap{"frames": "1-10"},
+	}}
+
+	mf.persistence.EXPECT().FetchJob(gomock.Any(), jobID).Return(&dbJob, nil)
+	mf.persistence.EXPECT().FetchJobHistory(gomock.Any(), &dbJob).Return(entries, nil)
+
+	echoCtx := mf.prepareMockedRequest(nil)
+	err := mf.flamenco.FetchJobHistory(echoCtx, jobID)
+	assert.NoError(t, err)
+	assertResponseJSON(t, echoCtx, http.StatusOK, api.JobHistory{
+		Entries: []api.JobHistoryEntry{{
+			Timestamp:        editTime,
+			PreviousSettings: api.JobSettings{AdditionalProperties: map[string]interface{}{"frames": "1-10"}},
+		}},
+	})
+}
+
 func TestSetTaskStatusQueued(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -617,3 +1255,98 @@ func TestFetchGlobalLastRenderedInfo(t *testing.T) {
 	}
 
 }
+
+func TestDeleteJob(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	dbJob := persistence.Job{
+		UUID:     jobID,
+		Name:     "test job",
+		Status:   api.JobStatusCompleted,
+		Settings: persistence.StringInterfaceMap{},
+		Metadata: persistence.StringStringMap{},
+	}
+
+	// Set up expectations.
+	ctx := gomock.Any()
+	mf.persistence.EXPECT().FetchJob(ctx, jobID).Return(&dbJob, nil)
+	mf.jobDeleter.EXPECT().QueueJobDeletion(ctx, &dbJob)
+
+	// Do the call.
+	echoCtx := mf.prepareMockedRequest(nil)
+	err := mf.flamenco.DeleteJob(echoCtx, jobID)
+	assert.NoError(t, err)
+
+	assertResponseNoContent(t, echoCtx)
+}
+
+func TestDeleteJob_nonexistentJob(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	mf.persistence.EXPECT().FetchJob(gomock.Any(), jobID).Return(nil, persistence.ErrJobNotFound)
+
+	echoCtx := mf.prepareMockedRequest(nil)
+	err := mf.flamenco.DeleteJob(echoCtx, jobID)
+	assert.NoError(t, err)
+
+	assertResponseAPIError(t, echoCtx, http.StatusNotFound, "no such job")
+}
+
+func TestDeleteJob_activeJob(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	dbJob := persistence.Job{
+		UUID:   jobID,
+		Name:   "test job",
+		Status: api.JobStatusActive,
+	}
+
+	// Active jobs should not be queued for deletion.
+	mf.persistence.EXPECT().FetchJob(gomock.Any(), jobID).Return(&dbJob, nil)
+
+	echoCtx := mf.prepareMockedRequest(nil)
+	err := mf.flamenco.DeleteJob(echoCtx, jobID)
+	assert.NoError(t, err)
+
+	assertResponseAPIError(t, echoCtx, http.StatusConflict,
+		"job is active; cancel or pause it before deleting it")
+}
+
+func TestDeleteJobMass(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	finishedBefore, err := time.Parse(time.RFC3339, "2022-09-15T11:14:41+02:00")
+	if err != nil {
+		panic(err)
+	}
+	jobUUIDs := []string{"18a9b096-d77e-438c-9be2-74397038298b"}
+
+	mf.jobDeleter.EXPECT().
+		QueueMassJobDeletion(gomock.Any(), finishedBefore.UTC()).
+		Return(jobUUIDs, nil)
+
+	echoCtx := mf.prepareMockedJSONRequest(api.JobMassDeletionSelection{
+		FinishedBefore: finishedBefore,
+	})
+	err = mf.flamenco.DeleteJobMass(echoCtx)
+	assert.NoError(t, err)
+
+	assertResponseJSON(t, echoCtx, http.StatusOK, api.JobMassDeletionResult{
+		JobIds: jobUUIDs,
+	})
+}
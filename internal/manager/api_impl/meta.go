@@ -17,14 +17,16 @@ import (
 	"git.blender.org/flamenco/internal/appinfo"
 	"git.blender.org/flamenco/internal/find_blender"
 	"git.blender.org/flamenco/internal/manager/config"
+	"git.blender.org/flamenco/internal/manager/persistence"
 	"git.blender.org/flamenco/pkg/api"
 	"github.com/labstack/echo/v4"
 )
 
 func (f *Flamenco) GetVersion(e echo.Context) error {
 	return e.JSON(http.StatusOK, api.FlamencoVersion{
-		Version: appinfo.ExtendedVersion(),
-		Name:    appinfo.ApplicationName,
+		Version:  appinfo.ExtendedVersion(),
+		Name:     appinfo.ApplicationName,
+		Features: &[]string{appinfo.FeatureTaskLongPoll, appinfo.FeatureTaskSlots},
 	})
 }
 
@@ -44,10 +46,34 @@ func (f *Flamenco) GetConfiguration(e echo.Context) error {
 }
 
 func (f *Flamenco) GetConfigurationFile(e echo.Context) error {
-	config := f.config.Get()
+	if !requestUserIsAdmin(e) {
+		return sendAPIError(e, http.StatusForbidden, "only admins can view the Manager configuration")
+	}
+
+	// Copy the configuration, so that the DSN can be redacted without affecting
+	// the Manager itself. A PostgreSQL DSN can contain a password.
+	config := *f.config.Get()
+	config.DatabaseDSN = persistence.RedactDSN(config.DatabaseDSN)
 	return e.JSON(http.StatusOK, config)
 }
 
+func (f *Flamenco) BackupDatabase(e echo.Context) error {
+	logger := requestLogger(e)
+
+	if !requestUserIsAdmin(e) {
+		return sendAPIError(e, http.StatusForbidden, "only admins can back up the database")
+	}
+
+	path, err := f.dbBackupper.BackupNow(e.Request().Context())
+	if err != nil {
+		logger.Error().Err(err).Msg("error backing up database")
+		return sendAPIError(e, http.StatusInternalServerError, "error backing up database: %v", err)
+	}
+
+	logger.Info().Str("path", path).Msg("database backed up")
+	return e.JSON(http.StatusOK, api.DatabaseBackupResult{Path: path})
+}
+
 func (f *Flamenco) GetVariables(e echo.Context, audience api.ManagerVariableAudience, platform string) error {
 	variables := f.config.ResolveVariables(
 		config.VariableAudience(audience),
@@ -70,6 +96,10 @@ func (f *Flamenco) GetVariables(e echo.Context, audience api.ManagerVariableAudi
 func (f *Flamenco) CheckSharedStoragePath(e echo.Context) error {
 	logger := requestLogger(e)
 
+	if !requestUserIsAdmin(e) {
+		return sendAPIError(e, http.StatusForbidden, "only admins can check the shared storage path")
+	}
+
 	var toCheck api.CheckSharedStoragePathJSONBody
 	if err := e.Bind(&toCheck); err != nil {
 		logger.Warn().Err(err).Msg("bad request received")
@@ -202,6 +232,10 @@ func (f *Flamenco) FindBlenderExePath(e echo.Context) error {
 func (f *Flamenco) CheckBlenderExePath(e echo.Context) error {
 	logger := requestLogger(e)
 
+	if !requestUserIsAdmin(e) {
+		return sendAPIError(e, http.StatusForbidden, "only admins can check the Blender executable")
+	}
+
 	var toCheck api.CheckSharedStoragePathJSONBody
 	if err := e.Bind(&toCheck); err != nil {
 		logger.Warn().Err(err).Msg("bad request received")
@@ -243,6 +277,10 @@ func (f *Flamenco) CheckBlenderExePath(e echo.Context) error {
 func (f *Flamenco) SaveSetupAssistantConfig(e echo.Context) error {
 	logger := requestLogger(e)
 
+	if !requestUserIsAdmin(e) {
+		return sendAPIError(e, http.StatusForbidden, "only admins can change the Manager configuration")
+	}
+
 	var setupAssistantCfg api.SetupAssistantConfig
 	if err := e.Bind(&setupAssistantCfg); err != nil {
 		logger.Warn().Err(err).Msg("setup assistant: bad request received")
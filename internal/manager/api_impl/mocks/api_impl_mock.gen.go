@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: git.blender.org/flamenco/internal/manager/api_impl (interfaces: PersistenceService,ChangeBroadcaster,JobCompiler,LogStorage,ConfigService,TaskStateMachine,Shaman,LastRendered,LocalStorage,WorkerSleepScheduler)
+// Source: git.blender.org/flamenco/internal/manager/api_impl (interfaces: PersistenceService,ChangeBroadcaster,JobCompiler,LogStorage,ConfigService,TaskStateMachine,Shaman,LastRendered,LocalStorage,WorkerSleepScheduler,JobDeleter,DatabaseBackupper,ScheduleNotifier)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -8,6 +8,7 @@ import (
 	context "context"
 	io "io"
 	reflect "reflect"
+	time "time"
 
 	config "git.blender.org/flamenco/internal/manager/config"
 	job_compilers "git.blender.org/flamenco/internal/manager/job_compilers"
@@ -127,6 +128,49 @@ func (mr *MockPersistenceServiceMockRecorder) CountTaskFailuresOfWorker(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTaskFailuresOfWorker", reflect.TypeOf((*MockPersistenceService)(nil).CountTaskFailuresOfWorker), arg0, arg1, arg2, arg3)
 }
 
+// CountUsers mocks base method.
+func (m *MockPersistenceService) CountUsers(arg0 context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUsers", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUsers indicates an expected call of CountUsers.
+func (mr *MockPersistenceServiceMockRecorder) CountUsers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsers", reflect.TypeOf((*MockPersistenceService)(nil).CountUsers), arg0)
+}
+
+// CreateUser mocks base method.
+func (m *MockPersistenceService) CreateUser(arg0 context.Context, arg1 *persistence.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockPersistenceServiceMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockPersistenceService)(nil).CreateUser), arg0, arg1)
+}
+
+// CreateUserSession mocks base method.
+func (m *MockPersistenceService) CreateUserSession(arg0 context.Context, arg1 *persistence.UserSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUserSession indicates an expected call of CreateUserSession.
+func (mr *MockPersistenceServiceMockRecorder) CreateUserSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserSession", reflect.TypeOf((*MockPersistenceService)(nil).CreateUserSession), arg0, arg1)
+}
+
 // CreateWorker mocks base method.
 func (m *MockPersistenceService) CreateWorker(arg0 context.Context, arg1 *persistence.Worker) error {
 	m.ctrl.T.Helper()
@@ -141,6 +185,76 @@ func (mr *MockPersistenceServiceMockRecorder) CreateWorker(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorker", reflect.TypeOf((*MockPersistenceService)(nil).CreateWorker), arg0, arg1)
 }
 
+// CreateWorkerTag mocks base method.
+func (m *MockPersistenceService) CreateWorkerTag(arg0 context.Context, arg1 *persistence.WorkerTag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkerTag", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWorkerTag indicates an expected call of CreateWorkerTag.
+func (mr *MockPersistenceServiceMockRecorder) CreateWorkerTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkerTag", reflect.TypeOf((*MockPersistenceService)(nil).CreateWorkerTag), arg0, arg1)
+}
+
+// DeleteExpiredUserSessions mocks base method.
+func (m *MockPersistenceService) DeleteExpiredUserSessions(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredUserSessions", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpiredUserSessions indicates an expected call of DeleteExpiredUserSessions.
+func (mr *MockPersistenceServiceMockRecorder) DeleteExpiredUserSessions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredUserSessions", reflect.TypeOf((*MockPersistenceService)(nil).DeleteExpiredUserSessions), arg0)
+}
+
+// DeleteUser mocks base method.
+func (m *MockPersistenceService) DeleteUser(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockPersistenceServiceMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockPersistenceService)(nil).DeleteUser), arg0, arg1)
+}
+
+// DeleteUserSession mocks base method.
+func (m *MockPersistenceService) DeleteUserSession(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserSession indicates an expected call of DeleteUserSession.
+func (mr *MockPersistenceServiceMockRecorder) DeleteUserSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSession", reflect.TypeOf((*MockPersistenceService)(nil).DeleteUserSession), arg0, arg1)
+}
+
+// DeleteWorkerTag mocks base method.
+func (m *MockPersistenceService) DeleteWorkerTag(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkerTag", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkerTag indicates an expected call of DeleteWorkerTag.
+func (mr *MockPersistenceServiceMockRecorder) DeleteWorkerTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkerTag", reflect.TypeOf((*MockPersistenceService)(nil).DeleteWorkerTag), arg0, arg1)
+}
+
 // FetchJob mocks base method.
 func (m *MockPersistenceService) FetchJob(arg0 context.Context, arg1 string) (*persistence.Job, error) {
 	m.ctrl.T.Helper()
@@ -171,6 +285,66 @@ func (mr *MockPersistenceServiceMockRecorder) FetchJobBlocklist(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobBlocklist", reflect.TypeOf((*MockPersistenceService)(nil).FetchJobBlocklist), arg0, arg1)
 }
 
+// FetchJobDependencies mocks base method.
+func (m *MockPersistenceService) FetchJobDependencies(arg0 context.Context, arg1 *persistence.Job) ([]*persistence.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchJobDependencies", arg0, arg1)
+	ret0, _ := ret[0].([]*persistence.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobDependencies indicates an expected call of FetchJobDependencies.
+func (mr *MockPersistenceServiceMockRecorder) FetchJobDependencies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobDependencies", reflect.TypeOf((*MockPersistenceService)(nil).FetchJobDependencies), arg0, arg1)
+}
+
+// FetchJobHistory mocks base method.
+func (m *MockPersistenceService) FetchJobHistory(arg0 context.Context, arg1 *persistence.Job) ([]persistence.JobHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchJobHistory", arg0, arg1)
+	ret0, _ := ret[0].([]persistence.JobHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobHistory indicates an expected call of FetchJobHistory.
+func (mr *MockPersistenceServiceMockRecorder) FetchJobHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobHistory", reflect.TypeOf((*MockPersistenceService)(nil).FetchJobHistory), arg0, arg1)
+}
+
+// FetchJobsProgress mocks base method.
+func (m *MockPersistenceService) FetchJobsProgress(arg0 context.Context, arg1 []*persistence.Job) (map[uint]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchJobsProgress", arg0, arg1)
+	ret0, _ := ret[0].(map[uint]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobsProgress indicates an expected call of FetchJobsProgress.
+func (mr *MockPersistenceServiceMockRecorder) FetchJobsProgress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobsProgress", reflect.TypeOf((*MockPersistenceService)(nil).FetchJobsProgress), arg0, arg1)
+}
+
+// FetchTagsOfWorker mocks base method.
+func (m *MockPersistenceService) FetchTagsOfWorker(arg0 context.Context, arg1 string) ([]*persistence.WorkerTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTagsOfWorker", arg0, arg1)
+	ret0, _ := ret[0].([]*persistence.WorkerTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchTagsOfWorker indicates an expected call of FetchTagsOfWorker.
+func (mr *MockPersistenceServiceMockRecorder) FetchTagsOfWorker(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTagsOfWorker", reflect.TypeOf((*MockPersistenceService)(nil).FetchTagsOfWorker), arg0, arg1)
+}
+
 // FetchTask mocks base method.
 func (m *MockPersistenceService) FetchTask(arg0 context.Context, arg1 string) (*persistence.Task, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +375,66 @@ func (mr *MockPersistenceServiceMockRecorder) FetchTaskFailureList(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaskFailureList", reflect.TypeOf((*MockPersistenceService)(nil).FetchTaskFailureList), arg0, arg1)
 }
 
+// FetchUser mocks base method.
+func (m *MockPersistenceService) FetchUser(arg0 context.Context, arg1 string) (*persistence.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchUser", arg0, arg1)
+	ret0, _ := ret[0].(*persistence.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchUser indicates an expected call of FetchUser.
+func (mr *MockPersistenceServiceMockRecorder) FetchUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUser", reflect.TypeOf((*MockPersistenceService)(nil).FetchUser), arg0, arg1)
+}
+
+// FetchUserByName mocks base method.
+func (m *MockPersistenceService) FetchUserByName(arg0 context.Context, arg1 string) (*persistence.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchUserByName", arg0, arg1)
+	ret0, _ := ret[0].(*persistence.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchUserByName indicates an expected call of FetchUserByName.
+func (mr *MockPersistenceServiceMockRecorder) FetchUserByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUserByName", reflect.TypeOf((*MockPersistenceService)(nil).FetchUserByName), arg0, arg1)
+}
+
+// FetchUserSession mocks base method.
+func (m *MockPersistenceService) FetchUserSession(arg0 context.Context, arg1 string) (*persistence.UserSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchUserSession", arg0, arg1)
+	ret0, _ := ret[0].(*persistence.UserSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchUserSession indicates an expected call of FetchUserSession.
+func (mr *MockPersistenceServiceMockRecorder) FetchUserSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUserSession", reflect.TypeOf((*MockPersistenceService)(nil).FetchUserSession), arg0, arg1)
+}
+
+// FetchUsers mocks base method.
+func (m *MockPersistenceService) FetchUsers(arg0 context.Context) ([]*persistence.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchUsers", arg0)
+	ret0, _ := ret[0].([]*persistence.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchUsers indicates an expected call of FetchUsers.
+func (mr *MockPersistenceServiceMockRecorder) FetchUsers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUsers", reflect.TypeOf((*MockPersistenceService)(nil).FetchUsers), arg0)
+}
+
 // FetchWorker mocks base method.
 func (m *MockPersistenceService) FetchWorker(arg0 context.Context, arg1 string) (*persistence.Worker, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +450,36 @@ func (mr *MockPersistenceServiceMockRecorder) FetchWorker(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchWorker", reflect.TypeOf((*MockPersistenceService)(nil).FetchWorker), arg0, arg1)
 }
 
+// FetchWorkerTag mocks base method.
+func (m *MockPersistenceService) FetchWorkerTag(arg0 context.Context, arg1 string) (*persistence.WorkerTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchWorkerTag", arg0, arg1)
+	ret0, _ := ret[0].(*persistence.WorkerTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchWorkerTag indicates an expected call of FetchWorkerTag.
+func (mr *MockPersistenceServiceMockRecorder) FetchWorkerTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchWorkerTag", reflect.TypeOf((*MockPersistenceService)(nil).FetchWorkerTag), arg0, arg1)
+}
+
+// FetchWorkerTags mocks base method.
+func (m *MockPersistenceService) FetchWorkerTags(arg0 context.Context) ([]*persistence.WorkerTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchWorkerTags", arg0)
+	ret0, _ := ret[0].([]*persistence.WorkerTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchWorkerTags indicates an expected call of FetchWorkerTags.
+func (mr *MockPersistenceServiceMockRecorder) FetchWorkerTags(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchWorkerTags", reflect.TypeOf((*MockPersistenceService)(nil).FetchWorkerTags), arg0)
+}
+
 // FetchWorkerTask mocks base method.
 func (m *MockPersistenceService) FetchWorkerTask(arg0 context.Context, arg1 *persistence.Worker) (*persistence.Task, error) {
 	m.ctrl.T.Helper()
@@ -261,6 +525,21 @@ func (mr *MockPersistenceServiceMockRecorder) GetLastRenderedJobUUID(arg0 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastRenderedJobUUID", reflect.TypeOf((*MockPersistenceService)(nil).GetLastRenderedJobUUID), arg0)
 }
 
+// NextDeferredTaskTime mocks base method.
+func (m *MockPersistenceService) NextDeferredTaskTime(arg0 context.Context) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextDeferredTaskTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextDeferredTaskTime indicates an expected call of NextDeferredTaskTime.
+func (mr *MockPersistenceServiceMockRecorder) NextDeferredTaskTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextDeferredTaskTime", reflect.TypeOf((*MockPersistenceService)(nil).NextDeferredTaskTime), arg0)
+}
+
 // QueryJobTaskSummaries mocks base method.
 func (m *MockPersistenceService) QueryJobTaskSummaries(arg0 context.Context, arg1 string) ([]*persistence.Task, error) {
 	m.ctrl.T.Helper()
@@ -305,6 +584,34 @@ func (mr *MockPersistenceServiceMockRecorder) RemoveFromJobBlocklist(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromJobBlocklist", reflect.TypeOf((*MockPersistenceService)(nil).RemoveFromJobBlocklist), arg0, arg1, arg2, arg3)
 }
 
+// SaveJobMaxWorkers mocks base method.
+func (m *MockPersistenceService) SaveJobMaxWorkers(arg0 context.Context, arg1 *persistence.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveJobMaxWorkers", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveJobMaxWorkers indicates an expected call of SaveJobMaxWorkers.
+func (mr *MockPersistenceServiceMockRecorder) SaveJobMaxWorkers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveJobMaxWorkers", reflect.TypeOf((*MockPersistenceService)(nil).SaveJobMaxWorkers), arg0, arg1)
+}
+
+// SaveJobPriority mocks base method.
+func (m *MockPersistenceService) SaveJobPriority(arg0 context.Context, arg1 *persistence.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveJobPriority", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveJobPriority indicates an expected call of SaveJobPriority.
+func (mr *MockPersistenceServiceMockRecorder) SaveJobPriority(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveJobPriority", reflect.TypeOf((*MockPersistenceService)(nil).SaveJobPriority), arg0, arg1)
+}
+
 // SaveTask mocks base method.
 func (m *MockPersistenceService) SaveTask(arg0 context.Context, arg1 *persistence.Task) error {
 	m.ctrl.T.Helper()
@@ -333,6 +640,20 @@ func (mr *MockPersistenceServiceMockRecorder) SaveTaskActivity(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTaskActivity", reflect.TypeOf((*MockPersistenceService)(nil).SaveTaskActivity), arg0, arg1)
 }
 
+// SaveTaskProgress mocks base method.
+func (m *MockPersistenceService) SaveTaskProgress(arg0 context.Context, arg1 *persistence.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTaskProgress", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTaskProgress indicates an expected call of SaveTaskProgress.
+func (mr *MockPersistenceServiceMockRecorder) SaveTaskProgress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTaskProgress", reflect.TypeOf((*MockPersistenceService)(nil).SaveTaskProgress), arg0, arg1)
+}
+
 // SaveWorker mocks base method.
 func (m *MockPersistenceService) SaveWorker(arg0 context.Context, arg1 *persistence.Worker) error {
 	m.ctrl.T.Helper()
@@ -361,19 +682,38 @@ func (mr *MockPersistenceServiceMockRecorder) SaveWorkerStatus(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWorkerStatus", reflect.TypeOf((*MockPersistenceService)(nil).SaveWorkerStatus), arg0, arg1)
 }
 
+// SaveWorkerTag mocks base method.
+func (m *MockPersistenceService) SaveWorkerTag(arg0 context.Context, arg1 *persistence.WorkerTag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveWorkerTag", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveWorkerTag indicates an expected call of SaveWorkerTag.
+func (mr *MockPersistenceServiceMockRecorder) SaveWorkerTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWorkerTag", reflect.TypeOf((*MockPersistenceService)(nil).SaveWorkerTag), arg0, arg1)
+}
+
 // ScheduleTask mocks base method.
-func (m *MockPersistenceService) ScheduleTask(arg0 context.Context, arg1 *persistence.Worker) (*persistence.Task, error) {
+func (m *MockPersistenceService) ScheduleTask(arg0 context.Context, arg1 *persistence.Worker, arg2 ...string) (*persistence.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ScheduleTask", arg0, arg1)
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ScheduleTask", varargs...)
 	ret0, _ := ret[0].(*persistence.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ScheduleTask indicates an expected call of ScheduleTask.
-func (mr *MockPersistenceServiceMockRecorder) ScheduleTask(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockPersistenceServiceMockRecorder) ScheduleTask(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleTask", reflect.TypeOf((*MockPersistenceService)(nil).ScheduleTask), arg0, arg1)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleTask", reflect.TypeOf((*MockPersistenceService)(nil).ScheduleTask), varargs...)
 }
 
 // SetLastRendered mocks base method.
@@ -404,6 +744,20 @@ func (mr *MockPersistenceServiceMockRecorder) StoreAuthoredJob(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreAuthoredJob", reflect.TypeOf((*MockPersistenceService)(nil).StoreAuthoredJob), arg0, arg1)
 }
 
+// StoreRecompiledJob mocks base method.
+func (m *MockPersistenceService) StoreRecompiledJob(arg0 context.Context, arg1 job_compilers.AuthoredJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreRecompiledJob", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreRecompiledJob indicates an expected call of StoreRecompiledJob.
+func (mr *MockPersistenceServiceMockRecorder) StoreRecompiledJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreRecompiledJob", reflect.TypeOf((*MockPersistenceService)(nil).StoreRecompiledJob), arg0, arg1)
+}
+
 // TaskTouchedByWorker mocks base method.
 func (m *MockPersistenceService) TaskTouchedByWorker(arg0 context.Context, arg1 *persistence.Task) error {
 	m.ctrl.T.Helper()
@@ -432,6 +786,20 @@ func (mr *MockPersistenceServiceMockRecorder) WorkerSeen(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkerSeen", reflect.TypeOf((*MockPersistenceService)(nil).WorkerSeen), arg0, arg1)
 }
 
+// WorkerSetTags mocks base method.
+func (m *MockPersistenceService) WorkerSetTags(arg0 context.Context, arg1 *persistence.Worker, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkerSetTags", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WorkerSetTags indicates an expected call of WorkerSetTags.
+func (mr *MockPersistenceServiceMockRecorder) WorkerSetTags(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkerSetTags", reflect.TypeOf((*MockPersistenceService)(nil).WorkerSetTags), arg0, arg1, arg2)
+}
+
 // WorkersLeftToRun mocks base method.
 func (m *MockPersistenceService) WorkersLeftToRun(arg0 context.Context, arg1 *persistence.Job, arg2 string) (map[string]bool, error) {
 	m.ctrl.T.Helper()
@@ -470,6 +838,18 @@ func (m *MockChangeBroadcaster) EXPECT() *MockChangeBroadcasterMockRecorder {
 	return m.recorder
 }
 
+// BroadcastJobUpdate mocks base method.
+func (m *MockChangeBroadcaster) BroadcastJobUpdate(arg0 api.SocketIOJobUpdate) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BroadcastJobUpdate", arg0)
+}
+
+// BroadcastJobUpdate indicates an expected call of BroadcastJobUpdate.
+func (mr *MockChangeBroadcasterMockRecorder) BroadcastJobUpdate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastJobUpdate", reflect.TypeOf((*MockChangeBroadcaster)(nil).BroadcastJobUpdate), arg0)
+}
+
 // BroadcastLastRenderedImage mocks base method.
 func (m *MockChangeBroadcaster) BroadcastLastRenderedImage(arg0 api.SocketIOLastRenderedUpdate) {
 	m.ctrl.T.Helper()
@@ -506,6 +886,18 @@ func (mr *MockChangeBroadcasterMockRecorder) BroadcastNewWorker(arg0 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastNewWorker", reflect.TypeOf((*MockChangeBroadcaster)(nil).BroadcastNewWorker), arg0)
 }
 
+// BroadcastTaskUpdate mocks base method.
+func (m *MockChangeBroadcaster) BroadcastTaskUpdate(arg0 api.SocketIOTaskUpdate) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BroadcastTaskUpdate", arg0)
+}
+
+// BroadcastTaskUpdate indicates an expected call of BroadcastTaskUpdate.
+func (mr *MockChangeBroadcasterMockRecorder) BroadcastTaskUpdate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastTaskUpdate", reflect.TypeOf((*MockChangeBroadcaster)(nil).BroadcastTaskUpdate), arg0)
+}
+
 // BroadcastWorkerUpdate mocks base method.
 func (m *MockChangeBroadcaster) BroadcastWorkerUpdate(arg0 api.SocketIOWorkerUpdate) {
 	m.ctrl.T.Helper()
@@ -585,6 +977,21 @@ func (mr *MockJobCompilerMockRecorder) ListJobTypes() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobTypes", reflect.TypeOf((*MockJobCompiler)(nil).ListJobTypes))
 }
 
+// Recompile mocks base method.
+func (m *MockJobCompiler) Recompile(arg0 context.Context, arg1 string, arg2 api.SubmittedJob, arg3 map[string]interface{}) (*job_compilers.AuthoredJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recompile", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*job_compilers.AuthoredJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recompile indicates an expected call of Recompile.
+func (mr *MockJobCompilerMockRecorder) Recompile(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recompile", reflect.TypeOf((*MockJobCompiler)(nil).Recompile), arg0, arg1, arg2, arg3)
+}
+
 // MockLogStorage is a mock of LogStorage interface.
 type MockLogStorage struct {
 	ctrl     *gomock.Controller
@@ -967,6 +1374,21 @@ func (mr *MockShamanMockRecorder) FileStoreCheck(arg0, arg1, arg2 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileStoreCheck", reflect.TypeOf((*MockShaman)(nil).FileStoreCheck), arg0, arg1, arg2)
 }
 
+// FileStoreSize mocks base method.
+func (m *MockShaman) FileStoreSize() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FileStoreSize")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FileStoreSize indicates an expected call of FileStoreSize.
+func (mr *MockShamanMockRecorder) FileStoreSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileStoreSize", reflect.TypeOf((*MockShaman)(nil).FileStoreSize))
+}
+
 // IsEnabled mocks base method.
 func (m *MockShaman) IsEnabled() bool {
 	m.ctrl.T.Helper()
@@ -1179,3 +1601,143 @@ func (mr *MockWorkerSleepSchedulerMockRecorder) WorkerStatus(arg0, arg1 interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkerStatus", reflect.TypeOf((*MockWorkerSleepScheduler)(nil).WorkerStatus), arg0, arg1)
 }
+
+// MockJobDeleter is a mock of JobDeleter interface.
+type MockJobDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobDeleterMockRecorder
+}
+
+// MockJobDeleterMockRecorder is the mock recorder for MockJobDeleter.
+type MockJobDeleterMockRecorder struct {
+	mock *MockJobDeleter
+}
+
+// NewMockJobDeleter creates a new mock instance.
+func NewMockJobDeleter(ctrl *gomock.Controller) *MockJobDeleter {
+	mock := &MockJobDeleter{ctrl: ctrl}
+	mock.recorder = &MockJobDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobDeleter) EXPECT() *MockJobDeleterMockRecorder {
+	return m.recorder
+}
+
+// QueueJobDeletion mocks base method.
+func (m *MockJobDeleter) QueueJobDeletion(arg0 context.Context, arg1 *persistence.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueJobDeletion", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QueueJobDeletion indicates an expected call of QueueJobDeletion.
+func (mr *MockJobDeleterMockRecorder) QueueJobDeletion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueJobDeletion", reflect.TypeOf((*MockJobDeleter)(nil).QueueJobDeletion), arg0, arg1)
+}
+
+// QueueMassJobDeletion mocks base method.
+func (m *MockJobDeleter) QueueMassJobDeletion(arg0 context.Context, arg1 time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueMassJobDeletion", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueMassJobDeletion indicates an expected call of QueueMassJobDeletion.
+func (mr *MockJobDeleterMockRecorder) QueueMassJobDeletion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueMassJobDeletion", reflect.TypeOf((*MockJobDeleter)(nil).QueueMassJobDeletion), arg0, arg1)
+}
+
+// MockDatabaseBackupper is a mock of DatabaseBackupper interface.
+type MockDatabaseBackupper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabaseBackupperMockRecorder
+}
+
+// MockDatabaseBackupperMockRecorder is the mock recorder for MockDatabaseBackupper.
+type MockDatabaseBackupperMockRecorder struct {
+	mock *MockDatabaseBackupper
+}
+
+// NewMockDatabaseBackupper creates a new mock instance.
+func NewMockDatabaseBackupper(ctrl *gomock.Controller) *MockDatabaseBackupper {
+	mock := &MockDatabaseBackupper{ctrl: ctrl}
+	mock.recorder = &MockDatabaseBackupperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDatabaseBackupper) EXPECT() *MockDatabaseBackupperMockRecorder {
+	return m.recorder
+}
+
+// BackupNow mocks base method.
+func (m *MockDatabaseBackupper) BackupNow(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupNow", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupNow indicates an expected call of BackupNow.
+func (mr *MockDatabaseBackupperMockRecorder) BackupNow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupNow", reflect.TypeOf((*MockDatabaseBackupper)(nil).BackupNow), arg0)
+}
+
+// MockScheduleNotifier is a mock of ScheduleNotifier interface.
+type MockScheduleNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleNotifierMockRecorder
+}
+
+// MockScheduleNotifierMockRecorder is the mock recorder for MockScheduleNotifier.
+type MockScheduleNotifierMockRecorder struct {
+	mock *MockScheduleNotifier
+}
+
+// NewMockScheduleNotifier creates a new mock instance.
+func NewMockScheduleNotifier(ctrl *gomock.Controller) *MockScheduleNotifier {
+	mock := &MockScheduleNotifier{ctrl: ctrl}
+	mock.recorder = &MockScheduleNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleNotifier) EXPECT() *MockScheduleNotifierMockRecorder {
+	return m.recorder
+}
+
+// NotifyAt mocks base method.
+func (m *MockScheduleNotifier) NotifyAt(arg0 time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NotifyAt", arg0)
+}
+
+// NotifyAt indicates an expected call of NotifyAt.
+func (mr *MockScheduleNotifierMockRecorder) NotifyAt(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyAt", reflect.TypeOf((*MockScheduleNotifier)(nil).NotifyAt), arg0)
+}
+
+// Wait mocks base method.
+func (m *MockScheduleNotifier) Wait(arg0 string) (<-chan struct{}, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Wait", arg0)
+	ret0, _ := ret[0].(<-chan struct{})
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MockScheduleNotifierMockRecorder) Wait(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockScheduleNotifier)(nil).Wait), arg0)
+}
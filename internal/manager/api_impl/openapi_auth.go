@@ -19,11 +19,11 @@ import (
 var urlVariablesReplacer = regexp.MustCompile("/:([^/]+)(/?)")
 
 // SwaggerValidator constructs the OpenAPI validator, which also handles authentication.
-func SwaggerValidator(swagger *openapi3.T, persist PersistenceService) echo.MiddlewareFunc {
+func SwaggerValidator(swagger *openapi3.T, persist PersistenceService, config ConfigService) echo.MiddlewareFunc {
 	options := oapi_middle.Options{
 		Options: openapi3filter.Options{
 			AuthenticationFunc: func(ctx context.Context, authInfo *openapi3filter.AuthenticationInput) error {
-				return authenticator(ctx, authInfo, persist)
+				return authenticator(ctx, authInfo, persist, config)
 			},
 		},
 
@@ -44,10 +44,12 @@ func SwaggerValidator(swagger *openapi3.T, persist PersistenceService) echo.Midd
 
 // authenticator runs the appropriate authentication function given the security
 // scheme name.
-func authenticator(ctx context.Context, authInfo *openapi3filter.AuthenticationInput, persist PersistenceService) error {
+func authenticator(ctx context.Context, authInfo *openapi3filter.AuthenticationInput, persist PersistenceService, config ConfigService) error {
 	switch authInfo.SecuritySchemeName {
 	case "worker_auth":
 		return WorkerAuth(ctx, authInfo, persist)
+	case "user_auth":
+		return UserAuth(ctx, authInfo, persist, config)
 	default:
 		log.Warn().Str("scheme", authInfo.SecuritySchemeName).Msg("unknown security scheme")
 		return errors.New("unknown security scheme")
@@ -0,0 +1,156 @@
+package api_impl
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	oapi_middle "github.com/deepmap/oapi-codegen/pkg/middleware"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/crypto/bcrypt"
+
+	"git.blender.org/flamenco/internal/manager/persistence"
+)
+
+type userContextKey string
+
+const (
+	userKey = userContextKey("user")
+
+	// userSessionDuration determines how long a login token stays valid.
+	userSessionDuration = 7 * 24 * time.Hour
+
+	// userTokenNumBytes is the number of random bytes in a login token.
+	userTokenNumBytes = 32
+)
+
+var (
+	errUserAuthBad = errors.New("invalid or expired token")
+
+	userPasswordHasher WorkerPasswordHasher = UserBCryptHasher{}
+)
+
+// UserBCryptHasher uses BCrypt to hash user passwords. Unlike Worker secrets,
+// these are chosen by people, so they get the default BCrypt cost.
+type UserBCryptHasher struct{}
+
+func (h UserBCryptHasher) GenerateHashedPassword(password []byte) ([]byte, error) {
+	return bcrypt.GenerateFromPassword(password, bcrypt.DefaultCost)
+}
+func (h UserBCryptHasher) CompareHashAndPassword(hashedPassword, password []byte) error {
+	return bcrypt.CompareHashAndPassword(hashedPassword, password)
+}
+
+// OpenAPI authentication function for authing users.
+// When user authentication is disabled, all requests are allowed. Otherwise
+// the user will be fetched from the database and stored in the request context.
+//
+// A missing or invalid token results in a "401 Unauthorized" response, so that
+// the web interface knows it should ask the user to log in.
+func UserAuth(ctx context.Context, authInfo *openapi3filter.AuthenticationInput, persist PersistenceService, config ConfigService) error {
+	if !config.Get().UserAuthentication {
+		return nil
+	}
+
+	e := ctx.Value(oapi_middle.EchoContextKey).(echo.Context)
+	logger := requestLogger(e)
+
+	token, ok := bearerToken(e)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no bearer token found")
+	}
+
+	session, err := persist.FetchUserSession(ctx, hashUserToken(token))
+	switch {
+	case errors.Is(err, persistence.ErrUserSessionNotFound):
+		logger.Warn().Msg("user authentication error")
+		return echo.NewHTTPError(http.StatusUnauthorized, errUserAuthBad.Error())
+	case err != nil:
+		logger.Error().Err(err).Msg("error fetching user session")
+		return authInfo.NewError(errors.New("error checking token"))
+	}
+
+	requestUserStore(e, session.User)
+	return nil
+}
+
+// bearerToken returns the token from the HTTP Authorization header.
+func bearerToken(e echo.Context) (string, bool) {
+	const prefix = "Bearer "
+	header := e.Request().Header.Get(echo.HeaderAuthorization)
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return "", false
+	}
+	return header[len(prefix):], true
+}
+
+// generateUserToken returns a new random login token.
+func generateUserToken() (string, error) {
+	tokenBytes := make([]byte, userTokenNumBytes)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", fmt.Errorf("generating random token: %w", err)
+	}
+	return hex.EncodeToString(tokenBytes), nil
+}
+
+// hashUserToken returns the hash of the token, as it is stored in the database.
+func hashUserToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// Store the User in the request context, so that it doesn't need to be fetched again later.
+func requestUserStore(e echo.Context, u *persistence.User) {
+	req := e.Request()
+	reqCtx := context.WithValue(req.Context(), userKey, u)
+
+	// Update the logger in this context to reflect the User.
+	logger := requestLogger(e).With().
+		Str("user", u.Name).
+		Logger()
+
+	newCtx := logger.WithContext(reqCtx)
+	e.SetRequest(req.WithContext(newCtx))
+}
+
+// requestUser returns the User associated with this HTTP request, or nil if
+// there is none. There is no user when user authentication is disabled.
+func requestUser(e echo.Context) *persistence.User {
+	ctx := e.Request().Context()
+	user, ok := ctx.Value(userKey).(*persistence.User)
+	if ok {
+		return user
+	}
+	return nil
+}
+
+// requestUserIsAdmin returns whether the user of this request is allowed to
+// perform admin operations. Without user authentication, everybody is.
+func requestUserIsAdmin(e echo.Context) bool {
+	user := requestUser(e)
+	return user == nil || user.IsAdmin()
+}
+
+// requestUserMayModifyJob returns whether the user of this request is allowed
+// to modify the job. Only the user who submitted the job and admins are.
+func requestUserMayModifyJob(e echo.Context, job *persistence.Job) bool {
+	user := requestUser(e)
+	switch {
+	case user == nil, user.IsAdmin():
+		return true
+	case job.UserID == nil:
+		// Jobs without owner can only be managed by admins.
+		return false
+	default:
+		return *job.UserID == user.ID
+	}
+}
@@ -0,0 +1,247 @@
+package api_impl
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"git.blender.org/flamenco/internal/manager/persistence"
+	"git.blender.org/flamenco/internal/uuid"
+	"git.blender.org/flamenco/pkg/api"
+)
+
+// initialAdminName is the name of the admin account that is created when user
+// authentication is enabled, and there are no user accounts yet.
+const initialAdminName = "admin"
+
+func (f *Flamenco) LoginUser(e echo.Context) error {
+	logger := requestLogger(e)
+	ctx := e.Request().Context()
+
+	var login api.LoginUserJSONRequestBody
+	if err := e.Bind(&login); err != nil {
+		logger.Warn().Err(err).Msg("bad request received")
+		return sendAPIError(e, http.StatusBadRequest, "invalid format")
+	}
+	logger = logger.With().Str("user", login.Name).Logger()
+
+	// Fetch the user, making sure there is always _some_ password hash to check.
+	// This helps in making this a constant-time operation.
+	var hashedPassword string
+	dbUser, err := f.persist.FetchUserByName(ctx, login.Name)
+	switch {
+	case err == nil:
+		hashedPassword = dbUser.PasswordHash
+	case errors.Is(err, persistence.ErrUserNotFound):
+		hashedPassword = "this is not a BCrypt hash, so it'll fail"
+	default:
+		logger.Error().Err(err).Msg("error fetching user")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching user")
+	}
+
+	err = userPasswordHasher.CompareHashAndPassword([]byte(hashedPassword), []byte(login.Password))
+	if err != nil {
+		logger.Warn().Msg("user login failed")
+		return sendAPIError(e, http.StatusUnauthorized, "invalid username or password")
+	}
+
+	token, err := generateUserToken()
+	if err != nil {
+		logger.Error().Err(err).Msg("error generating login token")
+		return sendAPIError(e, http.StatusInternalServerError, "error generating login token")
+	}
+
+	session := persistence.UserSession{
+		TokenHash: hashUserToken(token),
+		UserID:    dbUser.ID,
+		ExpiresAt: f.clock.Now().Add(userSessionDuration),
+	}
+	if err := f.persist.CreateUserSession(ctx, &session); err != nil {
+		logger.Error().Err(err).Msg("error storing user session")
+		return sendAPIError(e, http.StatusInternalServerError, "error storing user session")
+	}
+
+	// This is a good moment to clean up old sessions.
+	if err := f.persist.DeleteExpiredUserSessions(ctx); err != nil {
+		logger.Warn().Err(err).Msg("error deleting expired user sessions")
+	}
+
+	logger.Info().Msg("user logged in")
+	return e.JSON(http.StatusOK, api.UserLoginResponse{
+		Token:   token,
+		Expires: session.ExpiresAt,
+		User:    userDBtoAPI(*dbUser),
+	})
+}
+
+func (f *Flamenco) LogoutUser(e echo.Context) error {
+	logger := requestLogger(e)
+
+	token, ok := bearerToken(e)
+	if !ok {
+		// Without user authentication there may not be any token, and then there
+		// is nothing to log out of.
+		return e.NoContent(http.StatusNoContent)
+	}
+
+	if err := f.persist.DeleteUserSession(e.Request().Context(), hashUserToken(token)); err != nil {
+		logger.Error().Err(err).Msg("error deleting user session")
+		return sendAPIError(e, http.StatusInternalServerError, "error logging out")
+	}
+
+	logger.Info().Msg("user logged out")
+	return e.NoContent(http.StatusNoContent)
+}
+
+func (f *Flamenco) FetchCurrentUser(e echo.Context) error {
+	user := requestUser(e)
+	if user == nil {
+		return e.NoContent(http.StatusNoContent)
+	}
+	return e.JSON(http.StatusOK, userDBtoAPI(*user))
+}
+
+func (f *Flamenco) FetchUsers(e echo.Context) error {
+	logger := requestLogger(e)
+
+	if !requestUserIsAdmin(e) {
+		return sendAPIError(e, http.StatusForbidden, "only admins can manage user accounts")
+	}
+
+	dbUsers, err := f.persist.FetchUsers(e.Request().Context())
+	if err != nil {
+		logger.Error().Err(err).Msg("error fetching all users")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching users: %v", err)
+	}
+
+	apiUsers := make([]api.User, len(dbUsers))
+	for i := range dbUsers {
+		apiUsers[i] = userDBtoAPI(*dbUsers[i])
+	}
+	return e.JSON(http.StatusOK, api.UserList{Users: apiUsers})
+}
+
+func (f *Flamenco) CreateUser(e echo.Context) error {
+	logger := requestLogger(e)
+	ctx := e.Request().Context()
+
+	if !requestUserIsAdmin(e) {
+		return sendAPIError(e, http.StatusForbidden, "only admins can manage user accounts")
+	}
+
+	var newUser api.CreateUserJSONRequestBody
+	if err := e.Bind(&newUser); err != nil {
+		logger.Warn().Err(err).Msg("bad request received")
+		return sendAPIError(e, http.StatusBadRequest, "invalid format")
+	}
+	logger = logger.With().Str("newUser", newUser.Name).Logger()
+
+	_, err := f.persist.FetchUserByName(ctx, newUser.Name)
+	switch {
+	case err == nil:
+		return sendAPIError(e, http.StatusConflict, "user %q already exists", newUser.Name)
+	case !errors.Is(err, persistence.ErrUserNotFound):
+		logger.Error().Err(err).Msg("error checking for existing user")
+		return sendAPIError(e, http.StatusInternalServerError, "error checking for existing user")
+	}
+
+	dbUser, err := createUser(ctx, f.persist, newUser.Name, newUser.Password, newUser.Role)
+	if err != nil {
+		logger.Error().Err(err).Msg("error creating user")
+		return sendAPIError(e, http.StatusInternalServerError, "error creating user")
+	}
+
+	logger.Info().Str("role", string(dbUser.Role)).Msg("user account created")
+	return e.JSON(http.StatusOK, userDBtoAPI(*dbUser))
+}
+
+func (f *Flamenco) DeleteUser(e echo.Context, userUUID string) error {
+	logger := requestLogger(e)
+	logger = logger.With().Str("userToDelete", userUUID).Logger()
+
+	if !uuid.IsValid(userUUID) {
+		return sendAPIError(e, http.StatusBadRequest, "not a valid UUID")
+	}
+	if !requestUserIsAdmin(e) {
+		return sendAPIError(e, http.StatusForbidden, "only admins can manage user accounts")
+	}
+	if user := requestUser(e); user != nil && user.UUID == userUUID {
+		return sendAPIError(e, http.StatusConflict, "you cannot delete your own user account")
+	}
+
+	err := f.persist.DeleteUser(e.Request().Context(), userUUID)
+	switch {
+	case errors.Is(err, persistence.ErrUserNotFound):
+		return sendAPIError(e, http.StatusNotFound, "no such user")
+	case err != nil:
+		logger.Error().Err(err).Msg("error deleting user")
+		return sendAPIError(e, http.StatusInternalServerError, "error deleting user")
+	}
+
+	logger.Info().Msg("user account deleted")
+	return e.NoContent(http.StatusNoContent)
+}
+
+// CreateInitialAdmin creates an admin account when there are no user accounts
+// yet. The password is randomly generated, and written to `out` so that it can
+// be used to log in and create other accounts. It is kept out of the log, as
+// that may be stored or shared.
+func CreateInitialAdmin(ctx context.Context, persist PersistenceService, out io.Writer) error {
+	numUsers, err := persist.CountUsers(ctx)
+	if err != nil {
+		return err
+	}
+	if numUsers > 0 {
+		return nil
+	}
+
+	password, err := generateUserToken()
+	if err != nil {
+		return err
+	}
+	if _, err := createUser(ctx, persist, initialAdminName, password, api.UserRoleAdmin); err != nil {
+		return err
+	}
+
+	log.Warn().
+		Str("name", initialAdminName).
+		Msg("created initial admin account, its password is shown only once")
+	fmt.Fprintf(out, "\nCreated the initial admin account. Log in with these credentials to create other user accounts:\n"+
+		"    name:     %s\n"+
+		"    password: %s\n\n",
+		initialAdminName, password)
+	return nil
+}
+
+func createUser(ctx context.Context, persist PersistenceService, name, password string, role api.UserRole) (*persistence.User, error) {
+	hashedPassword, err := userPasswordHasher.GenerateHashedPassword([]byte(password))
+	if err != nil {
+		return nil, fmt.Errorf("hashing password: %w", err)
+	}
+
+	dbUser := persistence.User{
+		UUID:         uuid.New(),
+		Name:         name,
+		PasswordHash: string(hashedPassword),
+		Role:         role,
+	}
+	if err := persist.CreateUser(ctx, &dbUser); err != nil {
+		return nil, err
+	}
+	return &dbUser, nil
+}
+
+func userDBtoAPI(user persistence.User) api.User {
+	return api.User{
+		Id:   user.UUID,
+		Name: user.Name,
+		Role: user.Role,
+	}
+}
@@ -0,0 +1,406 @@
+package api_impl
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+
+	oapi_middle "github.com/deepmap/oapi-codegen/pkg/middleware"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+
+	"git.blender.org/flamenco/internal/manager/config"
+	"git.blender.org/flamenco/internal/manager/persistence"
+	"git.blender.org/flamenco/pkg/api"
+)
+
+func testUser(t *testing.T, role api.UserRole, password string) persistence.User {
+	hashedPassword, err := userPasswordHasher.GenerateHashedPassword([]byte(password))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	return persistence.User{
+		Model:        persistence.Model{ID: 47},
+		UUID:         "3d5a3cd1-0e0e-4b5c-9c6c-2c6e5bcfcbd6",
+		Name:         "Harry",
+		PasswordHash: string(hashedPassword),
+		Role:         role,
+	}
+}
+
+func TestLoginUser(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	user := testUser(t, api.UserRoleUser, "correct horse battery staple")
+
+	var storedSession *persistence.UserSession
+	mf.persistence.EXPECT().FetchUserByName(gomock.Any(), user.Name).Return(&user, nil)
+	mf.persistence.EXPECT().CreateUserSession(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, session *persistence.UserSession) error {
+			storedSession = session
+			return nil
+		})
+	mf.persistence.EXPECT().DeleteExpiredUserSessions(gomock.Any())
+
+	echo := mf.prepareMockedJSONRequest(api.UserLoginRequest{
+		Name:     user.Name,
+		Password: "correct horse battery staple",
+	})
+	err := mf.flamenco.LoginUser(echo)
+	assert.NoError(t, err)
+
+	var response api.UserLoginResponse
+	getResponseJSON(t, echo, http.StatusOK, &response)
+
+	// Only the hash of the token should be stored.
+	if !assert.NotNil(t, storedSession) {
+		t.FailNow()
+	}
+	assert.NotEmpty(t, response.Token)
+	assert.Equal(t, hashUserToken(response.Token), storedSession.TokenHash)
+	assert.Equal(t, user.ID, storedSession.UserID)
+
+	expectExpires := mf.clock.Now().Add(userSessionDuration)
+	assert.Equal(t, expectExpires, storedSession.ExpiresAt)
+	assert.True(t, expectExpires.Equal(response.Expires))
+	assert.Equal(t, userDBtoAPI(user), response.User)
+}
+
+func TestLoginUser_badCredentials(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	user := testUser(t, api.UserRoleUser, "correct horse battery staple")
+
+	// Wrong password.
+	mf.persistence.EXPECT().FetchUserByName(gomock.Any(), user.Name).Return(&user, nil)
+	echo := mf.prepareMockedJSONRequest(api.UserLoginRequest{
+		Name:     user.Name,
+		Password: "Tr0ub4dor&3",
+	})
+	err := mf.flamenco.LoginUser(echo)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echo, http.StatusUnauthorized, "invalid username or password")
+
+	// Unknown user, which should produce the same response.
+	mf.persistence.EXPECT().FetchUserByName(gomock.Any(), "Sally").Return(nil, persistence.ErrUserNotFound)
+	echo = mf.prepareMockedJSONRequest(api.UserLoginRequest{
+		Name:     "Sally",
+		Password: "correct horse battery staple",
+	})
+	err = mf.flamenco.LoginUser(echo)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echo, http.StatusUnauthorized, "invalid username or password")
+}
+
+func TestUserAuth(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	user := testUser(t, api.UserRoleUser, "password")
+
+	conf := config.Conf{Base: config.Base{UserAuthentication: true}}
+	mf.config.EXPECT().Get().Return(&conf).AnyTimes()
+
+	authenticate := func(authHeader string) (echo.Context, error) {
+		echoCtx := mf.prepareMockedRequest(nil)
+		if authHeader != "" {
+			echoCtx.Request().Header.Set(echo.HeaderAuthorization, authHeader)
+		}
+		ctx := context.WithValue(context.Background(), oapi_middle.EchoContextKey, echoCtx)
+		err := UserAuth(ctx, &openapi3filter.AuthenticationInput{}, mf.persistence, mf.config)
+		return echoCtx, err
+	}
+
+	// Without token, the web interface should be told to log in.
+	_, err := authenticate("")
+	assert.Equal(t, echo.NewHTTPError(http.StatusUnauthorized, "no bearer token found"), err)
+
+	// Same for an unknown or expired token.
+	mf.persistence.EXPECT().
+		FetchUserSession(gomock.Any(), hashUserToken("unknown")).
+		Return(nil, persistence.ErrUserSessionNotFound)
+	_, err = authenticate("Bearer unknown")
+	assert.Equal(t, echo.NewHTTPError(http.StatusUnauthorized, errUserAuthBad.Error()), err)
+
+	// A valid token should make the user available to the request handlers.
+	mf.persistence.EXPECT().
+		FetchUserSession(gomock.Any(), hashUserToken("valid")).
+		Return(&persistence.UserSession{UserID: user.ID, User: &user}, nil)
+	echoCtx, err := authenticate("Bearer valid")
+	assert.NoError(t, err)
+	assert.Equal(t, &user, requestUser(echoCtx))
+}
+
+func TestFetchCurrentUser(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	// Without user authentication, there is no current user.
+	echo := mf.prepareMockedRequest(nil)
+	err := mf.flamenco.FetchCurrentUser(echo)
+	assert.NoError(t, err)
+	assertResponseNoContent(t, echo)
+
+	user := testUser(t, api.UserRoleUser, "password")
+	echo = mf.prepareMockedRequest(nil)
+	requestUserStore(echo, &user)
+	err = mf.flamenco.FetchCurrentUser(echo)
+	assert.NoError(t, err)
+	assertResponseJSON(t, echo, http.StatusOK, userDBtoAPI(user))
+}
+
+func TestCreateUser(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	newUser := api.NewUser{
+		Name:     "Sally",
+		Password: "correct horse battery staple",
+		Role:     api.UserRoleUser,
+	}
+
+	// Regular users cannot create other users.
+	user := testUser(t, api.UserRoleUser, "password")
+	echo := mf.prepareMockedJSONRequest(newUser)
+	requestUserStore(echo, &user)
+	err := mf.flamenco.CreateUser(echo)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echo, http.StatusForbidden, "only admins can manage user accounts")
+
+	// Admins can.
+	admin := testUser(t, api.UserRoleAdmin, "password")
+	var createdUser *persistence.User
+	mf.persistence.EXPECT().FetchUserByName(gomock.Any(), newUser.Name).Return(nil, persistence.ErrUserNotFound)
+	mf.persistence.EXPECT().CreateUser(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, user *persistence.User) error {
+			createdUser = user
+			return nil
+		})
+
+	echo = mf.prepareMockedJSONRequest(newUser)
+	requestUserStore(echo, &admin)
+	err = mf.flamenco.CreateUser(echo)
+	assert.NoError(t, err)
+
+	if !assert.NotNil(t, createdUser) {
+		t.FailNow()
+	}
+	assert.Equal(t, newUser.Name, createdUser.Name)
+	assert.Equal(t, newUser.Role, createdUser.Role)
+	assert.NotEqual(t, newUser.Password, createdUser.PasswordHash, "the password should be hashed")
+	assert.NoError(t, userPasswordHasher.CompareHashAndPassword(
+		[]byte(createdUser.PasswordHash), []byte(newUser.Password)))
+	assertResponseJSON(t, echo, http.StatusOK, userDBtoAPI(*createdUser))
+}
+
+func TestCreateUser_alreadyExists(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	user := testUser(t, api.UserRoleUser, "password")
+
+	mf.persistence.EXPECT().FetchUserByName(gomock.Any(), user.Name).Return(&user, nil)
+
+	echo := mf.prepareMockedJSONRequest(api.NewUser{
+		Name:     user.Name,
+		Password: "correct horse battery staple",
+		Role:     api.UserRoleUser,
+	})
+	err := mf.flamenco.CreateUser(echo)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echo, http.StatusConflict, "user %q already exists", user.Name)
+}
+
+func TestDeleteUser(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	admin := testUser(t, api.UserRoleAdmin, "password")
+	otherUUID := "a3a6b9b4-4b4f-4a8e-8f47-1a7bd8d0a9d3"
+
+	// Deleting yourself is not allowed.
+	echo := mf.prepareMockedRequest(nil)
+	requestUserStore(echo, &admin)
+	err := mf.flamenco.DeleteUser(echo, admin.UUID)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echo, http.StatusConflict, "you cannot delete your own user account")
+
+	// Deleting others is.
+	mf.persistence.EXPECT().DeleteUser(gomock.Any(), otherUUID)
+	echo = mf.prepareMockedRequest(nil)
+	requestUserStore(echo, &admin)
+	err = mf.flamenco.DeleteUser(echo, otherUUID)
+	assert.NoError(t, err)
+	assertResponseNoContent(t, echo)
+
+	// Unless they do not exist.
+	mf.persistence.EXPECT().DeleteUser(gomock.Any(), otherUUID).Return(persistence.ErrUserNotFound)
+	echo = mf.prepareMockedRequest(nil)
+	requestUserStore(echo, &admin)
+	err = mf.flamenco.DeleteUser(echo, otherUUID)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echo, http.StatusNotFound, "no such user")
+}
+
+func TestSetJobStatus_notOwner(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	user := testUser(t, api.UserRoleUser, "password")
+	otherUserID := user.ID + 1
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	dbJob := persistence.Job{
+		UUID:   jobID,
+		Name:   "test job",
+		Status: api.JobStatusActive,
+		UserID: &otherUserID,
+	}
+	mf.persistence.EXPECT().FetchJob(gomock.Any(), jobID).Return(&dbJob, nil)
+
+	echo := mf.prepareMockedJSONRequest(api.JobStatusChange{
+		Status: api.JobStatusCancelRequested,
+		Reason: "someone else's job",
+	})
+	requestUserStore(echo, &user)
+	err := mf.flamenco.SetJobStatus(echo, jobID)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echo, http.StatusForbidden,
+		"only the owner of the job or an admin can change its status")
+}
+
+func TestRemoveJobBlocklist_notOwner(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	user := testUser(t, api.UserRoleUser, "password")
+	otherUserID := user.ID + 1
+
+	jobID := "18a9b096-d77e-438c-9be2-74397038298b"
+	dbJob := persistence.Job{
+		UUID:   jobID,
+		Name:   "test job",
+		Status: api.JobStatusActive,
+		UserID: &otherUserID,
+	}
+	mf.persistence.EXPECT().FetchJob(gomock.Any(), jobID).Return(&dbJob, nil)
+
+	echo := mf.prepareMockedJSONRequest(api.RemoveJobBlocklistJSONRequestBody{
+		{TaskType: "blender", WorkerId: "e7632d62-c3b8-4af0-9e78-01752928952c"},
+	})
+	requestUserStore(echo, &user)
+	err := mf.flamenco.RemoveJobBlocklist(echo, jobID)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echo, http.StatusForbidden,
+		"only the owner of the job or an admin can change its blocklist")
+}
+
+func TestSaveSetupAssistantConfig_notAdmin(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	user := testUser(t, api.UserRoleUser, "password")
+
+	echo := mf.prepareMockedJSONRequest(api.SetupAssistantConfig{StorageLocation: "/tmp"})
+	requestUserStore(echo, &user)
+	err := mf.flamenco.SaveSetupAssistantConfig(echo)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echo, http.StatusForbidden,
+		"only admins can change the Manager configuration")
+}
+
+func TestRequestUserMayModifyJob(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	user := testUser(t, api.UserRoleUser, "password")
+	admin := testUser(t, api.UserRoleAdmin, "password")
+	admin.ID = user.ID + 1
+
+	ownJob := persistence.Job{UserID: &user.ID}
+	otherJob := persistence.Job{UserID: &admin.ID}
+	unownedJob := persistence.Job{}
+
+	// Without user authentication, everything is allowed.
+	echo := mf.prepareMockedRequest(nil)
+	assert.True(t, requestUserMayModifyJob(echo, &ownJob))
+	assert.True(t, requestUserMayModifyJob(echo, &otherJob))
+	assert.True(t, requestUserMayModifyJob(echo, &unownedJob))
+
+	echo = mf.prepareMockedRequest(nil)
+	requestUserStore(echo, &user)
+	assert.True(t, requestUserMayModifyJob(echo, &ownJob))
+	assert.False(t, requestUserMayModifyJob(echo, &otherJob))
+	assert.False(t, requestUserMayModifyJob(echo, &unownedJob))
+
+	echo = mf.prepareMockedRequest(nil)
+	requestUserStore(echo, &admin)
+	assert.True(t, requestUserMayModifyJob(echo, &ownJob))
+	assert.True(t, requestUserMayModifyJob(echo, &otherJob))
+	assert.True(t, requestUserMayModifyJob(echo, &unownedJob))
+}
+
+func TestCreateInitialAdmin(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	ctx := context.Background()
+
+	var logOutput bytes.Buffer
+	origLogger := log.Logger
+	log.Logger = zerolog.New(&logOutput)
+	defer func() { log.Logger = origLogger }()
+
+	var createdUser persistence.User
+	mf.persistence.EXPECT().CountUsers(ctx).Return(0, nil)
+	mf.persistence.EXPECT().CreateUser(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, user *persistence.User) error {
+			createdUser = *user
+			return nil
+		})
+
+	var out bytes.Buffer
+	assert.NoError(t, CreateInitialAdmin(ctx, mf.persistence, &out))
+	assert.Equal(t, initialAdminName, createdUser.Name)
+	assert.Equal(t, api.UserRoleAdmin, createdUser.Role)
+
+	// The password should be shown, but kept out of the log.
+	match := regexp.MustCompile(`password: (\S+)`).FindStringSubmatch(out.String())
+	if !assert.Len(t, match, 2, "password not found in %q", out.String()) {
+		t.FailNow()
+	}
+	password := match[1]
+	assert.NoError(t, userPasswordHasher.CompareHashAndPassword(
+		[]byte(createdUser.PasswordHash), []byte(password)))
+	assert.NotContains(t, logOutput.String(), password)
+
+	// Once there are users, no admin should be created.
+	mf.persistence.EXPECT().CountUsers(ctx).Return(1, nil)
+	out.Reset()
+	assert.NoError(t, CreateInitialAdmin(ctx, mf.persistence, &out))
+	assert.Empty(t, out.String())
+}
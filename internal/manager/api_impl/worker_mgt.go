@@ -51,6 +51,12 @@ func (f *Flamenco) FetchWorker(e echo.Context, workerUUID string) error {
 		return sendAPIError(e, http.StatusInternalServerError, "error fetching worker: %v", err)
 	}
 
+	dbWorker.Tags, err = f.persist.FetchTagsOfWorker(ctx, workerUUID)
+	if err != nil {
+		logger.Error().Err(err).Msg("error fetching worker tags")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching worker tags: %v", err)
+	}
+
 	dbTask, err := f.persist.FetchWorkerTask(ctx, dbWorker)
 	if err != nil {
 		logger.Error().Err(err).Msg("error fetching task assigned to worker")
@@ -72,6 +78,10 @@ func (f *Flamenco) FetchWorker(e echo.Context, workerUUID string) error {
 }
 
 func (f *Flamenco) RequestWorkerStatusChange(e echo.Context, workerUUID string) error {
+	if !requestUserIsAdmin(e) {
+		return sendAPIError(e, http.StatusForbidden, "only admins can manage workers")
+	}
+
 	logger := requestLogger(e)
 	logger = logger.With().Str("worker", workerUUID).Logger()
 
@@ -125,6 +135,225 @@ func (f *Flamenco) RequestWorkerStatusChange(e echo.Context, workerUUID string)
 	return e.NoContent(http.StatusNoContent)
 }
 
+func (f *Flamenco) SetWorkerTags(e echo.Context, workerUUID string) error {
+	if !requestUserIsAdmin(e) {
+		return sendAPIError(e, http.StatusForbidden, "only admins can manage workers")
+	}
+
+	ctx := e.Request().Context()
+	logger := requestLogger(e)
+	logger = logger.With().Str("worker", workerUUID).Logger()
+
+	if !uuid.IsValid(workerUUID) {
+		return sendAPIError(e, http.StatusBadRequest, "not a valid UUID")
+	}
+
+	// Decode the request body.
+	var change api.WorkerTagChangeRequest
+	if err := e.Bind(&change); err != nil {
+		logger.Warn().Err(err).Msg("bad request received")
+		return sendAPIError(e, http.StatusBadRequest, "invalid format")
+	}
+	for _, tagUUID := range change.TagIds {
+		if !uuid.IsValid(tagUUID) {
+			return sendAPIError(e, http.StatusBadRequest, "invalid tag UUID %q", tagUUID)
+		}
+	}
+
+	// Fetch the worker.
+	dbWorker, err := f.persist.FetchWorker(ctx, workerUUID)
+	if errors.Is(err, persistence.ErrWorkerNotFound) {
+		logger.Debug().Msg("non-existent worker requested")
+		return sendAPIError(e, http.StatusNotFound, "worker %q not found", workerUUID)
+	}
+	if err != nil {
+		logger.Error().Err(err).Msg("error fetching worker")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching worker: %v", err)
+	}
+
+	logger = logger.With().
+		Strs("tags", change.TagIds).
+		Logger()
+	logger.Info().Msg("worker tag change requested")
+
+	// Store the new tag assignment.
+	if err := f.persist.WorkerSetTags(ctx, dbWorker, change.TagIds); err != nil {
+		logger.Error().Err(err).Msg("error saving worker after tag change request")
+		return sendAPIError(e, http.StatusInternalServerError, "error saving worker: %v", err)
+	}
+
+	// Broadcast the change.
+	update := webupdates.NewWorkerUpdate(dbWorker)
+	f.broadcaster.BroadcastWorkerUpdate(update)
+
+	return e.NoContent(http.StatusNoContent)
+}
+
+func (f *Flamenco) FetchWorkerTags(e echo.Context) error {
+	logger := requestLogger(e)
+
+	dbTags, err := f.persist.FetchWorkerTags(e.Request().Context())
+	if err != nil {
+		logger.Error().Err(err).Msg("fetching worker tags")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching worker tags: %v", err)
+	}
+
+	apiTags := make([]api.WorkerTag, len(dbTags))
+	for i := range dbTags {
+		apiTags[i] = workerTagDBtoAPI(*dbTags[i])
+	}
+
+	tagList := api.WorkerTagList{
+		Tags: apiTags,
+	}
+	return e.JSON(http.StatusOK, &tagList)
+}
+
+func (f *Flamenco) CreateWorkerTag(e echo.Context) error {
+	if !requestUserIsAdmin(e) {
+		return sendAPIError(e, http.StatusForbidden, "only admins can manage worker tags")
+	}
+
+	logger := requestLogger(e)
+
+	var apiTag api.CreateWorkerTagJSONRequestBody
+	if err := e.Bind(&apiTag); err != nil {
+		logger.Warn().Err(err).Msg("bad request received")
+		return sendAPIError(e, http.StatusBadRequest, "invalid format")
+	}
+
+	// Convert to persistence layer model.
+	var tagUUID string
+	if apiTag.Id != nil && *apiTag.Id != "" {
+		tagUUID = *apiTag.Id
+		if !uuid.IsValid(tagUUID) {
+			return sendAPIError(e, http.StatusBadRequest, "not a valid UUID")
+		}
+	} else {
+		tagUUID = uuid.New()
+	}
+
+	if apiTag.Name == "" {
+		return sendAPIError(e, http.StatusBadRequest, "a worker tag must have a name")
+	}
+
+	dbTag := persistence.WorkerTag{
+		UUID: tagUUID,
+		Name: apiTag.Name,
+	}
+	if apiTag.Description != nil {
+		dbTag.Description = *apiTag.Description
+	}
+
+	logger = logger.With().
+		Str("tag", dbTag.UUID).
+		Str("name", dbTag.Name).
+		Logger()
+
+	// Store in the database.
+	if err := f.persist.CreateWorkerTag(e.Request().Context(), &dbTag); err != nil {
+		logger.Error().Err(err).Msg("creating worker tag")
+		return sendAPIError(e, http.StatusInternalServerError, "error creating worker tag: %v", err)
+	}
+
+	logger.Info().Msg("created new worker tag")
+	return e.JSON(http.StatusOK, workerTagDBtoAPI(dbTag))
+}
+
+func (f *Flamenco) FetchWorkerTag(e echo.Context, tagUUID string) error {
+	logger := requestLogger(e)
+	logger = logger.With().Str("tag", tagUUID).Logger()
+
+	if !uuid.IsValid(tagUUID) {
+		return sendAPIError(e, http.StatusBadRequest, "not a valid UUID")
+	}
+
+	tag, err := f.persist.FetchWorkerTag(e.Request().Context(), tagUUID)
+	switch {
+	case errors.Is(err, persistence.ErrWorkerTagNotFound):
+		logger.Debug().Msg("non-existent worker tag requested")
+		return sendAPIError(e, http.StatusNotFound, "worker tag %q not found", tagUUID)
+	case err != nil:
+		logger.Error().Err(err).Msg("fetching worker tag")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching worker tag: %v", err)
+	}
+
+	return e.JSON(http.StatusOK, workerTagDBtoAPI(*tag))
+}
+
+func (f *Flamenco) UpdateWorkerTag(e echo.Context, tagUUID string) error {
+	if !requestUserIsAdmin(e) {
+		return sendAPIError(e, http.StatusForbidden, "only admins can manage worker tags")
+	}
+
+	ctx := e.Request().Context()
+	logger := requestLogger(e)
+	logger = logger.With().Str("tag", tagUUID).Logger()
+
+	if !uuid.IsValid(tagUUID) {
+		return sendAPIError(e, http.StatusBadRequest, "not a valid UUID")
+	}
+
+	var update api.UpdateWorkerTagJSONRequestBody
+	if err := e.Bind(&update); err != nil {
+		logger.Warn().Err(err).Msg("bad request received")
+		return sendAPIError(e, http.StatusBadRequest, "invalid format")
+	}
+	if update.Name == "" {
+		return sendAPIError(e, http.StatusBadRequest, "a worker tag must have a name")
+	}
+
+	dbTag, err := f.persist.FetchWorkerTag(ctx, tagUUID)
+	switch {
+	case errors.Is(err, persistence.ErrWorkerTagNotFound):
+		logger.Debug().Msg("non-existent worker tag requested")
+		return sendAPIError(e, http.StatusNotFound, "worker tag %q not found", tagUUID)
+	case err != nil:
+		logger.Error().Err(err).Msg("fetching worker tag")
+		return sendAPIError(e, http.StatusInternalServerError, "error fetching worker tag: %v", err)
+	}
+
+	// Update the tag. The UUID cannot be changed.
+	dbTag.Name = update.Name
+	if update.Description != nil {
+		dbTag.Description = *update.Description
+	}
+
+	if err := f.persist.SaveWorkerTag(ctx, dbTag); err != nil {
+		logger.Error().Err(err).Msg("saving worker tag")
+		return sendAPIError(e, http.StatusInternalServerError, "error saving worker tag")
+	}
+
+	logger.Info().Str("name", dbTag.Name).Msg("worker tag updated")
+	return e.NoContent(http.StatusNoContent)
+}
+
+func (f *Flamenco) DeleteWorkerTag(e echo.Context, tagUUID string) error {
+	if !requestUserIsAdmin(e) {
+		return sendAPIError(e, http.StatusForbidden, "only admins can manage worker tags")
+	}
+
+	logger := requestLogger(e)
+	logger = logger.With().Str("tag", tagUUID).Logger()
+
+	if !uuid.IsValid(tagUUID) {
+		return sendAPIError(e, http.StatusBadRequest, "not a valid UUID")
+	}
+
+	err := f.persist.DeleteWorkerTag(e.Request().Context(), tagUUID)
+	switch {
+	case errors.Is(err, persistence.ErrWorkerTagNotFound):
+		logger.Debug().Msg("non-existent worker tag requested")
+		return sendAPIError(e, http.StatusNotFound, "worker tag %q not found", tagUUID)
+	case err != nil:
+		logger.Error().Err(err).Msg("deleting worker tag")
+		return sendAPIError(e, http.StatusInternalServerError, "error deleting worker tag: %v", err)
+	}
+
+	logger.Info().Msg("worker tag deleted")
+	return e.NoContent(http.StatusNoContent)
+}
+
 func workerSummary(w persistence.Worker) api.WorkerSummary {
 	summary := api.WorkerSummary{
 		Id:      w.UUID,
@@ -154,5 +383,49 @@ func workerDBtoAPI(w persistence.Worker) api.Worker {
 		SupportedTaskTypes: w.TaskTypes(),
 	}
 
+	if len(w.Tags) > 0 {
+		tags := make([]api.WorkerTag, len(w.Tags))
+		for i := range w.Tags {
+			tags[i] = workerTagDBtoAPI(*w.Tags[i])
+		}
+		apiWorker.Tags = &tags
+	}
+
+	if w.CPUCores > 0 || w.MemoryMB > 0 || len(w.Capabilities) > 0 {
+		apiWorker.Resources = workerResourcesDBtoAPI(w)
+	}
+
 	return apiWorker
 }
+
+func workerResourcesDBtoAPI(w persistence.Worker) *api.WorkerResources {
+	cpuCores := w.CPUCores
+	memoryMB := w.MemoryMB
+	resources := api.WorkerResources{
+		CpuCores: &cpuCores,
+		MemoryMb: &memoryMB,
+	}
+	if len(w.Capabilities) > 0 {
+		capabilities := api.WorkerResources_Capabilities{
+			AdditionalProperties: map[string]string{},
+		}
+		for name, value := range w.Capabilities {
+			capabilities.AdditionalProperties[name] = value
+		}
+		resources.Capabilities = &capabilities
+	}
+	return &resources
+}
+
+func workerTagDBtoAPI(tag persistence.WorkerTag) api.WorkerTag {
+	tagUUID := tag.UUID // Take a copy for safety.
+
+	apiTag := api.WorkerTag{
+		Id:   &tagUUID,
+		Name: tag.Name,
+	}
+	if len(tag.Description) > 0 {
+		apiTag.Description = &tag.Description
+	}
+	return apiTag
+}
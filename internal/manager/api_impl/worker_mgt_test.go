@@ -87,11 +87,13 @@ func TestFetchWorker(t *testing.T) {
 	// Test with worker that does NOT have a status change requested, and DOES have an assigned task.
 	mf.persistence.EXPECT().FetchWorker(gomock.Any(), workerUUID).Return(&worker, nil)
 	assignedTask := persistence.Task{
-		UUID:   "806057d5-759a-4e75-86a4-356d43f28cff",
-		Name:   "test task",
-		Job:    &persistence.Job{UUID: "f0e25ee4-0d13-4291-afc3-e9446b555aaf"},
-		Status: api.TaskStatusActive,
+		UUID:     "806057d5-759a-4e75-86a4-356d43f28cff",
+		Name:     "test task",
+		Job:      &persistence.Job{UUID: "f0e25ee4-0d13-4291-afc3-e9446b555aaf"},
+		Status:   api.TaskStatusActive,
+		Progress: 40,
 	}
+	mf.persistence.EXPECT().FetchTagsOfWorker(gomock.Any(), workerUUID).Return([]*persistence.WorkerTag{}, nil)
 	mf.persistence.EXPECT().FetchWorkerTask(gomock.Any(), &worker).Return(&assignedTask, nil)
 
 	echo = mf.prepareMockedRequest(nil)
@@ -109,9 +111,10 @@ func TestFetchWorker(t *testing.T) {
 		SupportedTaskTypes: []string{"blender", "ffmpeg", "file-management", "misc"},
 		Task: &api.WorkerTask{
 			TaskSummary: api.TaskSummary{
-				Id:     assignedTask.UUID,
-				Name:   assignedTask.Name,
-				Status: assignedTask.Status,
+				Id:       assignedTask.UUID,
+				Name:     assignedTask.Name,
+				Status:   assignedTask.Status,
+				Progress: ptr(40),
 			},
 			JobId: assignedTask.Job.UUID,
 		},
@@ -121,6 +124,7 @@ func TestFetchWorker(t *testing.T) {
 	requestedStatus := api.WorkerStatusAsleep
 	worker.StatusChangeRequest(requestedStatus, false)
 	mf.persistence.EXPECT().FetchWorker(gomock.Any(), workerUUID).Return(&worker, nil)
+	mf.persistence.EXPECT().FetchTagsOfWorker(gomock.Any(), workerUUID).Return([]*persistence.WorkerTag{}, nil)
 	mf.persistence.EXPECT().FetchWorkerTask(gomock.Any(), &worker).Return(nil, nil)
 
 	echo = mf.prepareMockedRequest(nil)
@@ -222,3 +226,103 @@ func TestRequestWorkerStatusChangeRevert(t *testing.T) {
 	assert.NoError(t, err)
 	assertResponseNoContent(t, echo)
 }
+
+func TestWorkerTagCRUDHappyFlow(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+
+	// Create a tag.
+	UUID := "18d9234e-5135-458f-a1ba-a350c3d4e837"
+	apiTag := api.WorkerTag{
+		Id:          &UUID,
+		Name:        "ʻO nā manu ʻino",
+		Description: ptr("Ke aloha"),
+	}
+	expectDBTag := persistence.WorkerTag{
+		UUID:        UUID,
+		Name:        apiTag.Name,
+		Description: *apiTag.Description,
+	}
+	mf.persistence.EXPECT().CreateWorkerTag(gomock.Any(), &expectDBTag)
+	echo := mf.prepareMockedJSONRequest(apiTag)
+	assert.NoError(t, mf.flamenco.CreateWorkerTag(echo))
+	assertResponseJSON(t, echo, http.StatusOK, &apiTag)
+
+	// Fetch the tag.
+	mf.persistence.EXPECT().FetchWorkerTag(gomock.Any(), UUID).Return(&expectDBTag, nil)
+	echo = mf.prepareMockedRequest(nil)
+	assert.NoError(t, mf.flamenco.FetchWorkerTag(echo, UUID))
+	assertResponseJSON(t, echo, http.StatusOK, &apiTag)
+
+	// Update & save.
+	newUUID := "60442762-83d3-4fc3-bf75-6ab5799cdbaa"
+	newAPITag := api.WorkerTag{
+		Id:   &newUUID, // Intentionally change the UUID. This should just be ignored.
+		Name: "updated name",
+	}
+	expectNewDBTag := persistence.WorkerTag{
+		UUID:        UUID,
+		Name:        newAPITag.Name,
+		Description: expectDBTag.Description, // Not updated, as it's not in the request.
+	}
+	mf.persistence.EXPECT().FetchWorkerTag(gomock.Any(), UUID).Return(&expectDBTag, nil)
+	mf.persistence.EXPECT().SaveWorkerTag(gomock.Any(), &expectNewDBTag)
+	echo = mf.prepareMockedJSONRequest(newAPITag)
+	assert.NoError(t, mf.flamenco.UpdateWorkerTag(echo, UUID))
+	assertResponseNoContent(t, echo)
+
+	// Delete.
+	mf.persistence.EXPECT().DeleteWorkerTag(gomock.Any(), UUID)
+	echo = mf.prepareMockedJSONRequest(newAPITag)
+	assert.NoError(t, mf.flamenco.DeleteWorkerTag(echo, UUID))
+	assertResponseNoContent(t, echo)
+}
+
+func TestWorkerTagNotFound(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	tagUUID := "18d9234e-5135-458f-a1ba-a350c3d4e837"
+
+	mf.persistence.EXPECT().FetchWorkerTag(gomock.Any(), tagUUID).
+		Return(nil, fmt.Errorf("wrapped: %w", persistence.ErrWorkerTagNotFound))
+	echo := mf.prepareMockedRequest(nil)
+	assert.NoError(t, mf.flamenco.FetchWorkerTag(echo, tagUUID))
+	assertResponseAPIError(t, echo, http.StatusNotFound, fmt.Sprintf("worker tag %q not found", tagUUID))
+
+	mf.persistence.EXPECT().DeleteWorkerTag(gomock.Any(), tagUUID).Return(persistence.ErrWorkerTagNotFound)
+	echo = mf.prepareMockedRequest(nil)
+	assert.NoError(t, mf.flamenco.DeleteWorkerTag(echo, tagUUID))
+	assertResponseAPIError(t, echo, http.StatusNotFound, fmt.Sprintf("worker tag %q not found", tagUUID))
+}
+
+func TestSetWorkerTags(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+	workerUUID := worker.UUID
+	tagUUIDs := []string{
+		"18d9234e-5135-458f-a1ba-a350c3d4e837",
+		"60442762-83d3-4fc3-bf75-6ab5799cdbaa",
+	}
+
+	mf.persistence.EXPECT().FetchWorker(gomock.Any(), workerUUID).Return(&worker, nil)
+	mf.persistence.EXPECT().WorkerSetTags(gomock.Any(), &worker, tagUUIDs)
+	mf.broadcaster.EXPECT().BroadcastWorkerUpdate(gomock.Any())
+
+	echo := mf.prepareMockedJSONRequest(api.WorkerTagChangeRequest{TagIds: tagUUIDs})
+	err := mf.flamenco.SetWorkerTags(echo, workerUUID)
+	assert.NoError(t, err)
+	assertResponseNoContent(t, echo)
+
+	// Invalid tag UUIDs should be rejected.
+	echo = mf.prepareMockedJSONRequest(api.WorkerTagChangeRequest{TagIds: []string{"not-a-uuid"}})
+	err = mf.flamenco.SetWorkerTags(echo, workerUUID)
+	assert.NoError(t, err)
+	assertResponseAPIError(t, echo, http.StatusBadRequest, "invalid tag UUID \"not-a-uuid\"")
+}
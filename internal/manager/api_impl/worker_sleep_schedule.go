@@ -43,6 +43,10 @@ func (f *Flamenco) FetchWorkerSleepSchedule(e echo.Context, workerUUID string) e
 }
 
 func (f *Flamenco) SetWorkerSleepSchedule(e echo.Context, workerUUID string) error {
+	if !requestUserIsAdmin(e) {
+		return sendAPIError(e, http.StatusForbidden, "only admins can manage workers")
+	}
+
 	if !uuid.IsValid(workerUUID) {
 		return sendAPIError(e, http.StatusBadRequest, "not a valid UUID")
 	}
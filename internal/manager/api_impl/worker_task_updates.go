@@ -13,6 +13,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"git.blender.org/flamenco/internal/manager/persistence"
+	"git.blender.org/flamenco/internal/manager/webupdates"
 	"git.blender.org/flamenco/internal/uuid"
 	"git.blender.org/flamenco/pkg/api"
 )
@@ -99,7 +100,7 @@ func (f *Flamenco) doTaskUpdate(
 		logger.Panic().Msg("dbTask.Job is nil, unable to continue")
 	}
 
-	var dbErrActivity error
+	var dbErrActivity, dbErrProgress error
 
 	if update.Activity != nil {
 		dbTask.Activity = *update.Activity
@@ -108,6 +109,18 @@ func (f *Flamenco) doTaskUpdate(
 		dbErrActivity = f.persist.SaveTaskActivity(ctx, dbTask)
 	}
 
+	if update.Progress != nil {
+		dbTask.Progress = clampProgress(*update.Progress)
+		dbErrProgress = f.persist.SaveTaskProgress(ctx, dbTask)
+
+		// Status changes are broadcast by the state machine, so only broadcast
+		// progress-only updates here.
+		if dbErrProgress == nil && update.TaskStatus == nil {
+			f.broadcaster.BroadcastTaskUpdate(webupdates.NewTaskUpdate(dbTask))
+			f.broadcastJobProgress(ctx, logger, dbTask.Job)
+		}
+	}
+
 	// Write the log first, because that's likely to contain the cause of the task
 	// state change. Any subsequent task logs, for example generated by the
 	// Manager in response to a status change, should be logged after that.
@@ -117,7 +130,10 @@ func (f *Flamenco) doTaskUpdate(
 	}
 
 	if update.TaskStatus == nil {
-		return dbErrActivity
+		if dbErrActivity != nil {
+			return dbErrActivity
+		}
+		return dbErrProgress
 	}
 
 	oldTaskStatus := dbTask.Status
@@ -142,6 +158,33 @@ func (f *Flamenco) doTaskUpdate(
 	return nil
 }
 
+// broadcastJobProgress sends the job's progress to SocketIO clients. Errors are
+// only logged, as they should not fail the task update.
+func (f *Flamenco) broadcastJobProgress(ctx context.Context, logger zerolog.Logger, dbJob *persistence.Job) {
+	jobsProgress, err := f.persist.FetchJobsProgress(ctx, []*persistence.Job{dbJob})
+	if err != nil {
+		logger.Warn().Err(err).Msg("unable to fetch job progress")
+		return
+	}
+
+	progress := jobsProgress[dbJob.ID]
+	jobUpdate := webupdates.NewJobUpdate(dbJob)
+	jobUpdate.Progress = &progress
+	f.broadcaster.BroadcastJobUpdate(jobUpdate)
+}
+
+// clampProgress limits the progress percentage to the 0-100 range.
+func clampProgress(progress int) int {
+	switch {
+	case progress < 0:
+		return 0
+	case progress > 100:
+		return 100
+	default:
+		return progress
+	}
+}
+
 // onTaskFailed decides whether a task is soft- or hard-failed. Note that this
 // means that the task may NOT go to the status mentioned in the `update`
 // parameter, but go to `soft-failed` instead.
@@ -85,6 +85,80 @@ func TestTaskUpdate(t *testing.T) {
 	assert.Equal(t, "testing", actUpdatedTask.Activity)
 }
 
+func TestTaskUpdateProgress(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+
+	// Out-of-range progress should be clamped to 100%.
+	taskUpdate := api.TaskUpdateJSONRequestBody{
+		Progress: ptr(150),
+	}
+
+	taskID := "181eab68-1123-4790-93b1-94309a899411"
+	jobID := "e4719398-7cfa-4877-9bab-97c2d6c158b5"
+	mockJob := persistence.Job{
+		Model:    persistence.Model{ID: 47},
+		UUID:     jobID,
+		Name:     "test job",
+		Status:   api.JobStatusActive,
+		JobType:  "simple-blender-render",
+		Priority: 50,
+	}
+	mockTask := persistence.Task{
+		UUID:     taskID,
+		Name:     "render-1-10",
+		Status:   api.TaskStatusActive,
+		Worker:   &worker,
+		WorkerID: &worker.ID,
+		Job:      &mockJob,
+		Progress: 10,
+	}
+
+	mf.persistence.EXPECT().FetchTask(gomock.Any(), taskID).Return(&mockTask, nil)
+
+	// Expect the progress to be saved and broadcast over SocketIO.
+	var progressUpdatedTask persistence.Task
+	mf.persistence.EXPECT().SaveTaskProgress(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, task *persistence.Task) error {
+			progressUpdatedTask = *task
+			return nil
+		})
+	mf.broadcaster.EXPECT().BroadcastTaskUpdate(api.SocketIOTaskUpdate{
+		Id:       taskID,
+		JobId:    jobID,
+		Name:     "render-1-10",
+		Status:   api.TaskStatusActive,
+		Progress: ptr(100),
+	})
+
+	// The job's progress changes with the progress of its tasks.
+	mf.persistence.EXPECT().FetchJobsProgress(gomock.Any(), []*persistence.Job{&mockJob}).
+		Return(map[uint]int{mockJob.ID: 25}, nil)
+	mf.broadcaster.EXPECT().BroadcastJobUpdate(api.SocketIOJobUpdate{
+		Id:       jobID,
+		Name:     &mockJob.Name,
+		Status:   api.JobStatusActive,
+		Type:     "simple-blender-render",
+		Priority: 50,
+		Progress: ptr(25),
+	})
+
+	mf.persistence.EXPECT().TaskTouchedByWorker(gomock.Any(), gomock.Any())
+	mf.persistence.EXPECT().WorkerSeen(gomock.Any(), &worker)
+
+	// Do the call.
+	echoCtx := mf.prepareMockedJSONRequest(taskUpdate)
+	requestWorkerStore(echoCtx, &worker)
+	err := mf.flamenco.TaskUpdate(echoCtx, taskID)
+
+	assert.NoError(t, err)
+	assertResponseNoContent(t, echoCtx)
+	assert.Equal(t, 100, progressUpdatedTask.Progress)
+}
+
 func TestTaskUpdateFailed(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog"
@@ -21,6 +22,10 @@ import (
 	"git.blender.org/flamenco/pkg/api"
 )
 
+// maxScheduleTaskWait is the longest time a Worker can wait for a task in a
+// single request.
+const maxScheduleTaskWait = 30 * time.Second
+
 // RegisterWorker registers a new worker and stores it in the database.
 func (f *Flamenco) RegisterWorker(e echo.Context) error {
 	logger := requestLogger(e)
@@ -131,6 +136,11 @@ func (f *Flamenco) workerUpdateAfterSignOn(e echo.Context, update api.SignOnJSON
 	}
 	w.SupportedTaskTypes = strings.Join(update.SupportedTaskTypes, ",")
 
+	// Workers that do not report their resources are treated as having unknown
+	// resources, and will only get jobs without requirements.
+	setWorkerResources(w, update.Resources)
+	setWorkerTaskSlots(w, update.TaskSlots)
+
 	// Save the new Worker info to the database.
 	err := f.persist.SaveWorker(ctx, w)
 	if err != nil {
@@ -266,12 +276,116 @@ func (f *Flamenco) WorkerStateChanged(e echo.Context) error {
 	return e.NoContent(http.StatusNoContent)
 }
 
-func (f *Flamenco) ScheduleTask(e echo.Context) error {
+func (f *Flamenco) ScheduleTask(e echo.Context, params api.ScheduleTaskParams) error {
 	logger := requestLogger(e)
 	worker := requestWorkerOrPanic(e)
 	reqCtx := e.Request().Context()
 	logger.Debug().Msg("worker requesting task")
 
+	// Workers with task slots report the tasks they are still running, so that
+	// they can get another one for a free slot.
+	var runningTasks []string
+	if params.Running != nil {
+		runningTasks = *params.Running
+	}
+
+	// When the Worker wants to wait for a task, set up a timeout for that.
+	// Without waiting, `waitTimeout` is nil and thus blocks forever.
+	var waitTimeout <-chan time.Time
+	if waitDuration := f.scheduleTaskWaitDuration(params.Wait); waitDuration > 0 {
+		waitTimeout = f.clock.After(waitDuration)
+	}
+
+	// Set when the Worker was woken up to look for a task again. Calling it after
+	// having looked passes the wake-up on to the next waiting Worker, as only one
+	// Worker may have been woken up. Other tasks may be available, or the task
+	// may be meant for another Worker.
+	var stopWoken func()
+	defer func() {
+		if stopWoken != nil {
+			stopWoken()
+		}
+	}()
+
+	for {
+		// Start waiting before looking for a task, so that tasks that become
+		// available while looking are not missed.
+		taskAvailable, stopWaiting := f.scheduleNotifier.Wait(worker.UUID)
+
+		responded, err := f.scheduleTask(e, logger, worker, runningTasks)
+		if responded || err != nil {
+			stopWaiting()
+			return err
+		}
+		if stopWoken != nil {
+			stopWoken()
+			stopWoken = nil
+		}
+		if waitTimeout == nil {
+			stopWaiting()
+			return e.NoContent(http.StatusNoContent)
+		}
+
+		// Tasks can become available when their job's start time, or their own
+		// retry time, passes.
+		nextDeferredTask, err := f.persist.NextDeferredTaskTime(reqCtx)
+		switch {
+		case err != nil:
+			logger.Warn().Err(err).Msg("error finding when deferred tasks become available")
+		case !nextDeferredTask.IsZero():
+			f.scheduleNotifier.NotifyAt(nextDeferredTask)
+		}
+
+		select {
+		case <-taskAvailable:
+			logger.Debug().Msg("there may be a task available now, checking")
+			stopWoken = stopWaiting
+		case <-waitTimeout:
+			stopWaiting()
+			return e.NoContent(http.StatusNoContent)
+		case <-reqCtx.Done():
+			stopWaiting()
+			logger.Debug().Msg("worker stopped waiting for a task")
+			return e.NoContent(http.StatusNoContent)
+		}
+
+		// The Worker may have been given a status change request while waiting.
+		worker, err = f.persist.FetchWorker(reqCtx, worker.UUID)
+		if err != nil {
+			logger.Warn().Err(err).Msg("error refreshing worker while waiting for a task")
+			return sendAPIError(e, http.StatusInternalServerError, "error fetching worker: %v", err)
+		}
+	}
+}
+
+// scheduleTaskWaitDuration returns how long a Worker can wait for a task to
+// become available. This is kept well below the worker timeout, as waiting
+// Workers are not marked as 'seen'.
+func (f *Flamenco) scheduleTaskWaitDuration(requestedSeconds *int) time.Duration {
+	if requestedSeconds == nil || *requestedSeconds <= 0 {
+		return 0
+	}
+
+	waitDuration := time.Duration(*requestedSeconds) * time.Second
+	if waitDuration > maxScheduleTaskWait {
+		waitDuration = maxScheduleTaskWait
+	}
+	if workerTimeout := f.config.Get().WorkerTimeout; workerTimeout > 0 && waitDuration > workerTimeout/2 {
+		waitDuration = workerTimeout / 2
+	}
+	return waitDuration
+}
+
+// scheduleTask tries to find a task for the Worker. Returns true when a
+// response was sent, and false when there was no task available.
+func (f *Flamenco) scheduleTask(
+	e echo.Context,
+	logger zerolog.Logger,
+	worker *persistence.Worker,
+	runningTasks []string,
+) (bool, error) {
+	reqCtx := e.Request().Context()
+
 	f.taskSchedulerMutex.Lock()
 	defer f.taskSchedulerMutex.Unlock()
 
@@ -279,7 +393,7 @@ func (f *Flamenco) ScheduleTask(e echo.Context) error {
 	// regardless of any failures below, or whether there actually is a task to
 	// run.
 	if err := f.workerSeen(logger, worker); err != nil {
-		return sendAPIError(e, http.StatusInternalServerError,
+		return true, sendAPIError(e, http.StatusInternalServerError,
 			"error storing worker 'last seen' timestamp in database")
 	}
 
@@ -289,7 +403,7 @@ func (f *Flamenco) ScheduleTask(e echo.Context) error {
 			Str("workerStatus", string(worker.Status)).
 			Str("requestedStatus", string(worker.StatusRequested)).
 			Msg("worker asking for task but needs state change first")
-		return e.JSON(http.StatusLocked, api.WorkerStateChange{
+		return true, e.JSON(http.StatusLocked, api.WorkerStateChange{
 			StatusRequested: worker.StatusRequested,
 		})
 	}
@@ -300,22 +414,22 @@ func (f *Flamenco) ScheduleTask(e echo.Context) error {
 			Str("workerStatus", string(worker.Status)).
 			Str("requiredStatus", string(requiredStatusToGetTask)).
 			Msg("worker asking for task but is in wrong state")
-		return sendAPIError(e, http.StatusConflict,
+		return true, sendAPIError(e, http.StatusConflict,
 			fmt.Sprintf("worker is in state %q, requires state %q to execute tasks", worker.Status, requiredStatusToGetTask))
 	}
 
 	// Get a task to execute:
-	dbTask, err := f.persist.ScheduleTask(reqCtx, worker)
+	dbTask, err := f.persist.ScheduleTask(reqCtx, worker, runningTasks...)
 	if err != nil {
 		if persistence.ErrIsDBBusy(err) {
 			logger.Warn().Msg("database busy scheduling task for worker")
-			return sendAPIErrorDBBusy(e, "too busy to find a task for you, try again later")
+			return true, sendAPIErrorDBBusy(e, "too busy to find a task for you, try again later")
 		}
 		logger.Warn().Err(err).Msg("error scheduling task for worker")
-		return sendAPIError(e, http.StatusInternalServerError, "internal error finding a task for you: %v", err)
+		return true, sendAPIError(e, http.StatusInternalServerError, "internal error finding a task for you: %v", err)
 	}
 	if dbTask == nil {
-		return e.NoContent(http.StatusNoContent)
+		return false, nil
 	}
 
 	// The task is assigned to the Worker now. Even when it disconnects, the
@@ -326,18 +440,18 @@ func (f *Flamenco) ScheduleTask(e echo.Context) error {
 	// Add a note to the task log about the worker assignment.
 	msg := fmt.Sprintf("Task assigned to worker %s (%s)", worker.Name, worker.UUID)
 	if err := f.logStorage.WriteTimestamped(logger, dbTask.Job.UUID, dbTask.UUID, msg); err != nil {
-		return sendAPIError(e, http.StatusInternalServerError, "internal error appending to task log: %v", err)
+		return true, sendAPIError(e, http.StatusInternalServerError, "internal error appending to task log: %v", err)
 	}
 
 	// Move the task to 'active' status so that it won't be assigned to another
 	// worker. This also enables the task timeout monitoring.
 	if err := f.stateMachine.TaskStatusChange(bgCtx, dbTask, api.TaskStatusActive); err != nil {
-		return sendAPIError(e, http.StatusInternalServerError, "internal error marking task as active: %v", err)
+		return true, sendAPIError(e, http.StatusInternalServerError, "internal error marking task as active: %v", err)
 	}
 
 	// Start timeout measurement as soon as the Worker gets the task assigned.
 	if err := f.workerPingedTask(logger, dbTask); err != nil {
-		return sendAPIError(e, http.StatusInternalServerError, "internal error updating task for timeout calculation: %v", err)
+		return true, sendAPIError(e, http.StatusInternalServerError, "internal error updating task for timeout calculation: %v", err)
 	}
 
 	// Broadcast a worker update so that the web frontend will show the newly assigned task.
@@ -366,7 +480,7 @@ func (f *Flamenco) ScheduleTask(e echo.Context) error {
 
 	// Perform variable replacement before sending to the Worker.
 	customisedTask := replaceTaskVariables(f.config, apiTask, *worker)
-	return e.JSON(http.StatusOK, customisedTask)
+	return true, e.JSON(http.StatusOK, customisedTask)
 }
 
 func (f *Flamenco) TaskOutputProduced(e echo.Context, taskID string) error {
@@ -564,3 +678,45 @@ func mayWorkerRun(worker *persistence.Worker, dbTask *persistence.Task) api.MayK
 	}
 	return api.MayKeepRunning{MayKeepRunning: true}
 }
+
+// setWorkerResources stores the resources reported by the Worker. Negative
+// numbers are treated as "unknown".
+func setWorkerResources(w *persistence.Worker, resources *api.WorkerResources) {
+	w.CPUCores = 0
+	w.MemoryMB = 0
+	w.Capabilities = nil
+	if resources == nil {
+		return
+	}
+
+	if resources.CpuCores != nil && *resources.CpuCores > 0 {
+		w.CPUCores = *resources.CpuCores
+	}
+	if resources.MemoryMb != nil && *resources.MemoryMb > 0 {
+		w.MemoryMB = *resources.MemoryMb
+	}
+	if resources.Capabilities != nil && len(resources.Capabilities.AdditionalProperties) > 0 {
+		w.Capabilities = persistence.StringStringMap{}
+		for name, value := range resources.Capabilities.AdditionalProperties {
+			w.Capabilities[strings.TrimSpace(name)] = strings.TrimSpace(value)
+		}
+	}
+}
+
+// setWorkerTaskSlots stores the task slots reported by the Worker. Slots for
+// zero tasks are kept, as that prevents the Worker from getting tasks of that
+// type at all.
+func setWorkerTaskSlots(w *persistence.Worker, taskSlots *api.WorkerSignOn_TaskSlots) {
+	w.TaskSlots = nil
+	if taskSlots == nil || len(taskSlots.AdditionalProperties) == 0 {
+		return
+	}
+
+	w.TaskSlots = persistence.StringIntMap{}
+	for taskType, slots := range taskSlots.AdditionalProperties {
+		if slots < 0 {
+			slots = 0
+		}
+		w.TaskSlots[strings.TrimSpace(taskType)] = slots
+	}
+}
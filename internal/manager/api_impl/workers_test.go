@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/labstack/echo/v4"
@@ -16,6 +17,7 @@ import (
 	"git.blender.org/flamenco/internal/manager/config"
 	"git.blender.org/flamenco/internal/manager/last_rendered"
 	"git.blender.org/flamenco/internal/manager/persistence"
+	"git.blender.org/flamenco/internal/manager/schedule_notifier"
 	"git.blender.org/flamenco/pkg/api"
 )
 
@@ -28,6 +30,7 @@ func TestTaskScheduleHappy(t *testing.T) {
 
 	echo := mf.prepareMockedRequest(nil)
 	requestWorkerStore(echo, &worker)
+	mf.scheduleNotifier.EXPECT().Wait(worker.UUID).Return(make(chan struct{}), func() {})
 
 	// Expect a call into the persistence layer, which should return a scheduled task.
 	job := persistence.Job{
@@ -60,7 +63,7 @@ func TestTaskScheduleHappy(t *testing.T) {
 	mf.stateMachine.EXPECT().TaskStatusChange(bgCtx, &task, api.TaskStatusActive)
 	mf.broadcaster.EXPECT().BroadcastWorkerUpdate(gomock.Any())
 
-	err := mf.flamenco.ScheduleTask(echo)
+	err := mf.flamenco.ScheduleTask(echo, api.ScheduleTaskParams{})
 	assert.NoError(t, err)
 
 	// Check the response
@@ -87,6 +90,7 @@ func TestTaskScheduleNoTaskAvailable(t *testing.T) {
 
 	echo := mf.prepareMockedRequest(nil)
 	requestWorkerStore(echo, &worker)
+	mf.scheduleNotifier.EXPECT().Wait(worker.UUID).Return(make(chan struct{}), func() {})
 
 	// Expect a call into the persistence layer, which should return nil.
 	ctx := echo.Request().Context()
@@ -97,11 +101,281 @@ func TestTaskScheduleNoTaskAvailable(t *testing.T) {
 	// actively asking for tasks.
 	mf.persistence.EXPECT().WorkerSeen(bgCtx, &worker)
 
-	err := mf.flamenco.ScheduleTask(echo)
+	err := mf.flamenco.ScheduleTask(echo, api.ScheduleTaskParams{})
 	assert.NoError(t, err)
 	assertResponseNoContent(t, echo)
 }
 
+func TestTaskScheduleRunningTasks(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+
+	echo := mf.prepareMockedRequest(nil)
+	requestWorkerStore(echo, &worker)
+	mf.scheduleNotifier.EXPECT().Wait(worker.UUID).Return(make(chan struct{}), func() {})
+
+	// The tasks the Worker is still running should be passed to the persistence
+	// layer, so that it can find a task for another slot.
+	running := []string{
+		"4107c7aa-e86d-4244-858b-6c4fce2af503",
+		"83fe8d3c-d1e8-4a69-8b9b-e6cdc9c8f7f8",
+	}
+	ctx := echo.Request().Context()
+	bgCtx := gomock.Not(ctx)
+	mf.persistence.EXPECT().ScheduleTask(ctx, &worker, running[0], running[1]).Return(nil, nil)
+	mf.persistence.EXPECT().WorkerSeen(bgCtx, &worker)
+
+	err := mf.flamenco.ScheduleTask(echo, api.ScheduleTaskParams{Running: &running})
+	assert.NoError(t, err)
+	assertResponseNoContent(t, echo)
+}
+
+func TestTaskScheduleWaitTimeout(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+
+	echo := mf.prepareMockedRequest(nil)
+	requestWorkerStore(echo, &worker)
+
+	conf := config.Conf{Base: config.Base{WorkerTimeout: time.Minute}}
+	mf.config.EXPECT().Get().Return(&conf)
+
+	// Nothing happens while waiting, so the Worker should get no task.
+	stoppedWaiting := false
+	mf.scheduleNotifier.EXPECT().Wait(worker.UUID).Return(make(chan struct{}), func() { stoppedWaiting = true })
+	ctx := echo.Request().Context()
+	mf.persistence.EXPECT().NextDeferredTaskTime(ctx).Return(time.Time{}, nil)
+	scheduleCalled := make(chan struct{})
+	mf.persistence.EXPECT().ScheduleTask(ctx, &worker).DoAndReturn(
+		func(context.Context, *persistence.Worker, ...string) (*persistence.Task, error) {
+			close(scheduleCalled)
+			return nil, nil
+		})
+	mf.persistence.EXPECT().WorkerSeen(gomock.Not(ctx), &worker)
+
+	errChan := make(chan error)
+	go func() {
+		errChan <- mf.flamenco.ScheduleTask(echo, api.ScheduleTaskParams{Wait: ptr(10)})
+	}()
+
+	<-scheduleCalled
+	mf.clock.Add(10 * time.Second)
+
+	assert.NoError(t, <-errChan)
+	assertResponseNoContent(t, echo)
+	assert.True(t, stoppedWaiting, "the Worker should no longer be registered as waiting")
+}
+
+func TestTaskScheduleWaitDeferredTask(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+
+	echo := mf.prepareMockedRequest(nil)
+	requestWorkerStore(echo, &worker)
+
+	conf := config.Conf{Base: config.Base{WorkerTimeout: time.Minute}}
+	mf.config.EXPECT().Get().Return(&conf)
+
+	// A task becomes available in 5 seconds, so the notifier should wake up a
+	// Worker at that moment.
+	ctx := echo.Request().Context()
+	deferredTime := mf.clock.Now().Add(5 * time.Second)
+	mf.scheduleNotifier.EXPECT().Wait(worker.UUID).Return(make(chan struct{}), func() {})
+	mf.persistence.EXPECT().ScheduleTask(ctx, &worker).Return(nil, nil)
+	mf.persistence.EXPECT().WorkerSeen(gomock.Not(ctx), &worker)
+	mf.persistence.EXPECT().NextDeferredTaskTime(ctx).Return(deferredTime, nil)
+
+	notifyAtCalled := make(chan struct{})
+	mf.scheduleNotifier.EXPECT().NotifyAt(deferredTime).Do(func(time.Time) { close(notifyAtCalled) })
+
+	errChan := make(chan error)
+	go func() {
+		errChan <- mf.flamenco.ScheduleTask(echo, api.ScheduleTaskParams{Wait: ptr(10)})
+	}()
+
+	<-notifyAtCalled
+	mf.clock.Add(10 * time.Second)
+
+	assert.NoError(t, <-errChan)
+	assertResponseNoContent(t, echo)
+}
+
+func TestTaskScheduleWaitNotified(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+
+	echo := mf.prepareMockedRequest(nil)
+	requestWorkerStore(echo, &worker)
+
+	conf := config.Conf{Base: config.Base{WorkerTimeout: time.Minute}}
+	mf.config.EXPECT().Get().Return(&conf).AnyTimes()
+
+	job := persistence.Job{UUID: "583a7d59-887a-4c6c-b3e4-a753018f71b0"}
+	task := persistence.Task{UUID: "4107c7aa-e86d-4244-858b-6c4fce2af503", Job: &job}
+
+	// The first attempt finds no task, but a notification says there may be one.
+	notified := make(chan struct{})
+	close(notified)
+	ctx := echo.Request().Context()
+	bgCtx := gomock.Not(ctx)
+
+	// Other Workers may be waiting for the remaining tasks, so the wake-up should
+	// be passed on after looking for a task again.
+	passedOn := false
+	gomock.InOrder(
+		mf.scheduleNotifier.EXPECT().Wait(worker.UUID).Return(notified, func() { passedOn = true }),
+		mf.persistence.EXPECT().ScheduleTask(ctx, &worker).Return(nil, nil),
+		mf.persistence.EXPECT().NextDeferredTaskTime(ctx).Return(time.Time{}, nil),
+		mf.persistence.EXPECT().FetchWorker(ctx, worker.UUID).Return(&worker, nil),
+		mf.scheduleNotifier.EXPECT().Wait(worker.UUID).Return(make(chan struct{}), func() {}),
+		mf.persistence.EXPECT().ScheduleTask(ctx, &worker).DoAndReturn(
+			func(context.Context, *persistence.Worker, ...string) (*persistence.Task, error) {
+				assert.False(t, passedOn, "the wake-up should be passed on after looking for a task")
+				return &task, nil
+			}),
+	)
+	mf.persistence.EXPECT().WorkerSeen(bgCtx, &worker).Times(2)
+	mf.persistence.EXPECT().TaskTouchedByWorker(bgCtx, &task)
+	mf.logStorage.EXPECT().WriteTimestamped(bgCtx, job.UUID, task.UUID, gomock.Any())
+	mf.stateMachine.EXPECT().TaskStatusChange(bgCtx, &task, api.TaskStatusActive)
+	mf.broadcaster.EXPECT().BroadcastWorkerUpdate(gomock.Any())
+	mf.expectExpandVariables(t,
+		config.VariableAudienceWorkers,
+		config.VariablePlatform(worker.Platform),
+		map[string]string{},
+	)
+
+	err := mf.flamenco.ScheduleTask(echo, api.ScheduleTaskParams{Wait: ptr(10)})
+	assert.NoError(t, err)
+	assert.True(t, passedOn, "the wake-up should be passed on to other Workers")
+
+	resp := getRecordedResponse(echo)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+// TestTaskScheduleWaitNotifiedOtherWorker checks that a task notification
+// reaches the Worker that can run the task, when the Worker that has been
+// waiting the longest cannot.
+func TestTaskScheduleWaitNotifiedOtherWorker(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	notifier := schedule_notifier.New(mf.clock)
+	mf.flamenco.scheduleNotifier = notifier
+
+	conf := config.Conf{Base: config.Base{WorkerTimeout: time.Minute}}
+	mf.config.EXPECT().Get().Return(&conf).AnyTimes()
+
+	ineligible := testWorker()
+	eligible := testWorker()
+	eligible.ID = 2
+	eligible.UUID = "62c7f5d0-5f79-4d28-bbbd-5a7f2d1dbcd5"
+
+	echoIneligible := mf.prepareMockedRequest(nil)
+	requestWorkerStore(echoIneligible, &ineligible)
+	echoEligible := mf.prepareMockedRequest(nil)
+	requestWorkerStore(echoEligible, &eligible)
+	ctxIneligible := echoIneligible.Request().Context()
+	ctxEligible := echoEligible.Request().Context()
+
+	job := persistence.Job{UUID: "583a7d59-887a-4c6c-b3e4-a753018f71b0"}
+	task := persistence.Task{UUID: "4107c7aa-e86d-4244-858b-6c4fce2af503", Job: &job}
+
+	// Signalled every time a Worker has looked for a task and is about to wait.
+	waiting := make(chan string, 10)
+
+	// The ineligible Worker never gets a task.
+	mf.persistence.EXPECT().ScheduleTask(ctxIneligible, &ineligible).Return(nil, nil).AnyTimes()
+	mf.persistence.EXPECT().FetchWorker(ctxIneligible, ineligible.UUID).Return(&ineligible, nil).AnyTimes()
+	mf.persistence.EXPECT().NextDeferredTaskTime(ctxIneligible).DoAndReturn(
+		func(context.Context) (time.Time, error) {
+			waiting <- ineligible.UUID
+			return time.Time{}, nil
+		}).AnyTimes()
+
+	// The eligible Worker gets the task after the notification.
+	gomock.InOrder(
+		mf.persistence.EXPECT().ScheduleTask(ctxEligible, &eligible).Return(nil, nil),
+		mf.persistence.EXPECT().NextDeferredTaskTime(ctxEligible).DoAndReturn(
+			func(context.Context) (time.Time, error) {
+				waiting <- eligible.UUID
+				return time.Time{}, nil
+			}),
+		mf.persistence.EXPECT().FetchWorker(ctxEligible, eligible.UUID).Return(&eligible, nil),
+		mf.persistence.EXPECT().ScheduleTask(ctxEligible, &eligible).Return(&task, nil),
+	)
+	mf.persistence.EXPECT().WorkerSeen(gomock.Any(), gomock.Any()).AnyTimes()
+	mf.persistence.EXPECT().TaskTouchedByWorker(gomock.Any(), &task)
+	mf.logStorage.EXPECT().WriteTimestamped(gomock.Any(), job.UUID, task.UUID, gomock.Any())
+	mf.stateMachine.EXPECT().TaskStatusChange(gomock.Any(), &task, api.TaskStatusActive)
+	mf.broadcaster.EXPECT().BroadcastWorkerUpdate(gomock.Any())
+	mf.expectExpandVariables(t,
+		config.VariableAudienceWorkers,
+		config.VariablePlatform(eligible.Platform),
+		map[string]string{},
+	)
+
+	// Make sure the ineligible Worker is the one that has been waiting longest.
+	errIneligible := make(chan error)
+	go func() {
+		errIneligible <- mf.flamenco.ScheduleTask(echoIneligible, api.ScheduleTaskParams{Wait: ptr(10)})
+	}()
+	assert.Equal(t, ineligible.UUID, <-waiting)
+	errEligible := make(chan error)
+	go func() {
+		errEligible <- mf.flamenco.ScheduleTask(echoEligible, api.ScheduleTaskParams{Wait: ptr(10)})
+	}()
+	assert.Equal(t, eligible.UUID, <-waiting)
+
+	// A single task became available, which wakes up a single Worker.
+	notifier.NotifyOne()
+
+	select {
+	case err := <-errEligible:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the eligible Worker was not woken up")
+	}
+	resp := getRecordedResponse(echoEligible)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// The ineligible Worker keeps waiting until its timeout.
+	mf.clock.Add(10 * time.Second)
+	assert.NoError(t, <-errIneligible)
+	assertResponseNoContent(t, echoIneligible)
+}
+
+func TestScheduleTaskWaitDuration(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	conf := config.Conf{Base: config.Base{WorkerTimeout: time.Minute}}
+	mf.config.EXPECT().Get().Return(&conf).AnyTimes()
+
+	assert.Equal(t, time.Duration(0), mf.flamenco.scheduleTaskWaitDuration(nil))
+	assert.Equal(t, time.Duration(0), mf.flamenco.scheduleTaskWaitDuration(ptr(-5)))
+	assert.Equal(t, 10*time.Second, mf.flamenco.scheduleTaskWaitDuration(ptr(10)))
+	assert.Equal(t, maxScheduleTaskWait, mf.flamenco.scheduleTaskWaitDuration(ptr(3600)))
+
+	// Waiting should stay well below the worker timeout.
+	conf.WorkerTimeout = 20 * time.Second
+	assert.Equal(t, 10*time.Second, mf.flamenco.scheduleTaskWaitDuration(ptr(25)))
+}
+
 func TestTaskScheduleNonActiveStatus(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -112,13 +386,14 @@ func TestTaskScheduleNonActiveStatus(t *testing.T) {
 
 	echoCtx := mf.prepareMockedRequest(nil)
 	requestWorkerStore(echoCtx, &worker)
+	mf.scheduleNotifier.EXPECT().Wait(worker.UUID).Return(make(chan struct{}), func() {})
 
 	// The worker should be marked as 'seen', even when it's in a state that
 	// doesn't allow task execution.
 	bgCtx := gomock.Not(echoCtx.Request().Context())
 	mf.persistence.EXPECT().WorkerSeen(bgCtx, &worker)
 
-	err := mf.flamenco.ScheduleTask(echoCtx)
+	err := mf.flamenco.ScheduleTask(echoCtx, api.ScheduleTaskParams{})
 	assert.NoError(t, err)
 
 	resp := getRecordedResponse(echoCtx)
@@ -135,13 +410,14 @@ func TestTaskScheduleOtherStatusRequested(t *testing.T) {
 
 	echoCtx := mf.prepareMockedRequest(nil)
 	requestWorkerStore(echoCtx, &worker)
+	mf.scheduleNotifier.EXPECT().Wait(worker.UUID).Return(make(chan struct{}), func() {})
 
 	// The worker should be marked as 'seen', even when it's in a state that
 	// doesn't allow task execution.
 	bgCtx := gomock.Not(echoCtx.Request().Context())
 	mf.persistence.EXPECT().WorkerSeen(bgCtx, &worker)
 
-	err := mf.flamenco.ScheduleTask(echoCtx)
+	err := mf.flamenco.ScheduleTask(echoCtx, api.ScheduleTaskParams{})
 	assert.NoError(t, err)
 
 	expectBody := api.WorkerStateChange{StatusRequested: api.WorkerStatusAsleep}
@@ -162,13 +438,14 @@ func TestTaskScheduleOtherStatusRequestedAndBadState(t *testing.T) {
 
 	echoCtx := mf.prepareMockedRequest(nil)
 	requestWorkerStore(echoCtx, &worker)
+	mf.scheduleNotifier.EXPECT().Wait(worker.UUID).Return(make(chan struct{}), func() {})
 
 	// The worker should be marked as 'seen', even when it's in a state that
 	// doesn't allow task execution.
 	bgCtx := gomock.Not(echoCtx.Request().Context())
 	mf.persistence.EXPECT().WorkerSeen(bgCtx, &worker)
 
-	err := mf.flamenco.ScheduleTask(echoCtx)
+	err := mf.flamenco.ScheduleTask(echoCtx, api.ScheduleTaskParams{})
 	assert.NoError(t, err)
 
 	expectBody := api.WorkerStateChange{StatusRequested: api.WorkerStatusAwake}
@@ -213,6 +490,110 @@ func TestWorkerSignOn(t *testing.T) {
 	})
 }
 
+func TestWorkerSignOnWithResources(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+
+	mf.sleepScheduler.EXPECT().WorkerStatus(gomock.Any(), worker.UUID).
+		Return(api.WorkerStatusAwake, nil)
+	mf.broadcaster.EXPECT().BroadcastWorkerUpdate(gomock.Any())
+	mf.persistence.EXPECT().WorkerSeen(gomock.Any(), &worker)
+
+	var savedWorker persistence.Worker
+	mf.persistence.EXPECT().SaveWorker(gomock.Any(), &worker).
+		DoAndReturn(func(ctx context.Context, w *persistence.Worker) error {
+			savedWorker = *w
+			return nil
+		})
+
+	cpuCores := 16
+	memoryMB := 65536
+	echo := mf.prepareMockedJSONRequest(api.WorkerSignOn{
+		Name:               "Big Boi",
+		SoftwareVersion:    "3.0-testing",
+		SupportedTaskTypes: []string{"blender"},
+		Resources: &api.WorkerResources{
+			CpuCores: &cpuCores,
+			MemoryMb: &memoryMB,
+			Capabilities: &api.WorkerResources_Capabilities{
+				AdditionalProperties: map[string]string{" blender_version ": "3.3 "},
+			},
+		},
+	})
+	requestWorkerStore(echo, &worker)
+	err := mf.flamenco.SignOn(echo)
+	assert.NoError(t, err)
+	assertResponseJSON(t, echo, http.StatusOK, api.WorkerStateChange{
+		StatusRequested: api.WorkerStatusAwake,
+	})
+
+	assert.Equal(t, 16, savedWorker.CPUCores)
+	assert.Equal(t, 65536, savedWorker.MemoryMB)
+	assert.Equal(t, persistence.StringStringMap{"blender_version": "3.3"}, savedWorker.Capabilities)
+
+	// The resources should be shown in the worker details.
+	apiWorker := workerDBtoAPI(savedWorker)
+	if assert.NotNil(t, apiWorker.Resources) {
+		assert.Equal(t, cpuCores, *apiWorker.Resources.CpuCores)
+		assert.Equal(t, memoryMB, *apiWorker.Resources.MemoryMb)
+		assert.Equal(t, "3.3", apiWorker.Resources.Capabilities.AdditionalProperties["blender_version"])
+	}
+}
+
+func TestWorkerSignOnWithTaskSlots(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mf := newMockedFlamenco(mockCtrl)
+	worker := testWorker()
+	worker.TaskSlots = persistence.StringIntMap{"ffmpeg": 3}
+
+	mf.sleepScheduler.EXPECT().WorkerStatus(gomock.Any(), worker.UUID).
+		Return(api.WorkerStatusAwake, nil)
+	mf.broadcaster.EXPECT().BroadcastWorkerUpdate(gomock.Any())
+	mf.persistence.EXPECT().WorkerSeen(gomock.Any(), &worker).Times(2)
+
+	var savedWorker persistence.Worker
+	mf.persistence.EXPECT().SaveWorker(gomock.Any(), &worker).
+		DoAndReturn(func(ctx context.Context, w *persistence.Worker) error {
+			savedWorker = *w
+			return nil
+		}).Times(2)
+
+	echo := mf.prepareMockedJSONRequest(api.WorkerSignOn{
+		Name:               "Multi Boi",
+		SoftwareVersion:    "3.0-testing",
+		SupportedTaskTypes: []string{"blender", "file-management"},
+		TaskSlots: &api.WorkerSignOn_TaskSlots{
+			AdditionalProperties: map[string]int{"blender": 1, " file-management": 4, "misc": -1},
+		},
+	})
+	requestWorkerStore(echo, &worker)
+	err := mf.flamenco.SignOn(echo)
+	assert.NoError(t, err)
+
+	assert.Equal(t,
+		persistence.StringIntMap{"blender": 1, "file-management": 4, "misc": 0},
+		savedWorker.TaskSlots)
+
+	// Signing on without task slots should clear them.
+	mf.sleepScheduler.EXPECT().WorkerStatus(gomock.Any(), worker.UUID).
+		Return(api.WorkerStatusAwake, nil)
+	mf.broadcaster.EXPECT().BroadcastWorkerUpdate(gomock.Any())
+	echo = mf.prepareMockedJSONRequest(api.WorkerSignOn{
+		Name:               "Multi Boi",
+		SoftwareVersion:    "3.0-testing",
+		SupportedTaskTypes: []string{"blender", "file-management"},
+	})
+	requestWorkerStore(echo, &worker)
+	err = mf.flamenco.SignOn(echo)
+	assert.NoError(t, err)
+	assert.Nil(t, savedWorker.TaskSlots)
+}
+
 func TestWorkerSignoffTaskRequeue(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -43,6 +43,24 @@ var defaultConfig = Conf{
 
 		BlocklistThreshold:         3,
 		TaskFailAfterSoftFailCount: 3,
+		TaskRetryBackoff: TaskRetryBackoff{
+			Initial: 1 * time.Minute,
+			Max:     15 * time.Minute,
+		},
+
+		SchedulingPolicy: "strict",
+
+		// Automatic deletion of finished jobs is disabled by default. Setting this
+		// to, for example, 30 * 24 * time.Hour deletes them after about a month.
+		JobRetention: 0,
+
+		UserAuthentication: false,
+
+		DatabaseBackup: DatabaseBackup{
+			Directory: "database-backups",
+			Period:    0, // Only back up on request.
+			Retain:    10,
+		},
 
 		// WorkerCleanupStatus: []string{string(api.WorkerStatusOffline)},
 
@@ -0,0 +1,159 @@
+// Package db_backup makes backups of the Manager's database while it is
+// running, either on request or periodically, and removes old backups.
+package db_backup
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/rs/zerolog/log"
+
+	"git.blender.org/flamenco/internal/manager/config"
+)
+
+// backupFilePrefix is the start of the filename of every backup. It is
+// followed by a timestamp in backupTimestampFormat. Only files named like that
+// are considered for removal; other backups, like those made before migrating
+// the database, are kept.
+const (
+	backupFilePrefix      = "flamenco-manager-"
+	backupTimestampFormat = "2006-01-02_150405"
+)
+
+// Service makes database backups.
+type Service struct {
+	clock   clock.Clock
+	persist PersistenceService
+	config  config.DatabaseBackup
+
+	// mutex ensures only one backup is made at a time.
+	mutex sync.Mutex
+}
+
+// New creates a new database backup service.
+func New(clock clock.Clock, persist PersistenceService, config config.DatabaseBackup) *Service {
+	return &Service{
+		clock:   clock,
+		persist: persist,
+		config:  config,
+	}
+}
+
+// Run makes periodic backups, if configured to do so.
+// This function only returns when the context is done.
+func (s *Service) Run(ctx context.Context) {
+	if s.config.Period <= 0 {
+		log.Debug().Msg("database backup: no period configured, not making periodic backups")
+		return
+	}
+
+	log.Info().
+		Str("period", s.config.Period.String()).
+		Str("directory", s.config.Directory).
+		Int("retain", s.config.Retain).
+		Msg("database backup: starting up")
+	defer log.Info().Msg("database backup: shutting down")
+
+	ticker := s.clock.Ticker(s.config.Period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.BackupNow(ctx); err != nil {
+				log.Error().Err(err).Msg("database backup: periodic backup failed")
+			}
+		}
+	}
+}
+
+// BackupNow makes a backup of the database in the configured directory, and
+// removes old backups. It returns the path of the new backup.
+func (s *Service) BackupNow(ctx context.Context) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := os.MkdirAll(s.config.Directory, 0o755); err != nil {
+		return "", fmt.Errorf("creating backup directory: %w", err)
+	}
+
+	timestamp := s.clock.Now().UTC().Format(backupTimestampFormat)
+	filename := backupFilePrefix + timestamp + s.persist.BackupFileExtension()
+	path := filepath.Join(s.config.Directory, filename)
+
+	logger := log.With().Str("path", path).Logger()
+	logger.Info().Msg("database backup: backing up database")
+
+	startTime := s.clock.Now()
+	if err := s.persist.Backup(ctx, path); err != nil {
+		return "", err
+	}
+	logger.Info().
+		Str("duration", s.clock.Since(startTime).String()).
+		Msg("database backup: backup complete")
+
+	if err := s.removeOldBackups(); err != nil {
+		// The backup itself was fine, so don't let this fail the entire operation.
+		logger.Error().Err(err).Msg("database backup: error removing old backups")
+	}
+
+	return path, nil
+}
+
+// removeOldBackups removes all but the newest backups, as configured.
+func (s *Service) removeOldBackups() error {
+	if s.config.Retain <= 0 {
+		return nil
+	}
+
+	entries, err := os.ReadDir(s.config.Directory)
+	if err != nil {
+		return err
+	}
+
+	// The timestamp in the filename makes them sort from old to new.
+	extension := s.persist.BackupFileExtension()
+	backups := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !isBackupFilename(name, extension) {
+			continue
+		}
+		backups = append(backups, name)
+	}
+	sort.Strings(backups)
+
+	if len(backups) <= s.config.Retain {
+		return nil
+	}
+	for _, name := range backups[:len(backups)-s.config.Retain] {
+		path := filepath.Join(s.config.Directory, name)
+		log.Info().Str("path", path).Msg("database backup: removing old backup")
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isBackupFilename returns whether the filename is that of a backup made by
+// this service.
+func isBackupFilename(name, extension string) bool {
+	if !strings.HasPrefix(name, backupFilePrefix) || !strings.HasSuffix(name, extension) {
+		return false
+	}
+	timestamp := strings.TrimSuffix(strings.TrimPrefix(name, backupFilePrefix), extension)
+	_, err := time.Parse(backupTimestampFormat, timestamp)
+	return err == nil
+}
@@ -0,0 +1,161 @@
+package db_backup
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"git.blender.org/flamenco/internal/manager/config"
+	"git.blender.org/flamenco/internal/manager/db_backup/mocks"
+)
+
+type BackupMocks struct {
+	clock   *clock.Mock
+	persist *mocks.MockPersistenceService
+	ctx     context.Context
+}
+
+func backupTestFixtures(t *testing.T, retain int) (*Service, func(), *BackupMocks) {
+	mockCtrl := gomock.NewController(t)
+
+	mocks := &BackupMocks{
+		clock:   clock.NewMock(),
+		persist: mocks.NewMockPersistenceService(mockCtrl),
+	}
+
+	mockedNow, err := time.Parse(time.RFC3339, "2022-09-15T11:14:41+02:00")
+	if err != nil {
+		panic(err)
+	}
+	mocks.clock.Set(mockedNow)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mocks.ctx = ctx
+
+	cfg := config.DatabaseBackup{
+		Directory: t.TempDir(),
+		Retain:    retain,
+	}
+	service := New(mocks.clock, mocks.persist, cfg)
+
+	finish := func() {
+		cancel()
+		mockCtrl.Finish()
+	}
+	return service, finish, mocks
+}
+
+// expectBackup makes the mocked persistence layer write an empty file for
+// each backup.
+func (m *BackupMocks) expectBackup() {
+	m.persist.EXPECT().BackupFileExtension().Return(".sqlite").AnyTimes()
+	m.persist.EXPECT().Backup(m.ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, path string) error {
+			return os.WriteFile(path, []byte{}, 0o644)
+		}).AnyTimes()
+}
+
+func TestBackupNow(t *testing.T) {
+	s, finish, mocks := backupTestFixtures(t, 0)
+	defer finish()
+	mocks.expectBackup()
+
+	path, err := s.BackupNow(mocks.ctx)
+	assert.NoError(t, err)
+	expectPath := filepath.Join(s.config.Directory, "flamenco-manager-2022-09-15_091441.sqlite")
+	assert.Equal(t, expectPath, path)
+	assert.FileExists(t, expectPath)
+}
+
+func TestBackupNowError(t *testing.T) {
+	s, finish, mocks := backupTestFixtures(t, 0)
+	defer finish()
+
+	backupErr := errors.New("disk full")
+	mocks.persist.EXPECT().BackupFileExtension().Return(".sqlite")
+	mocks.persist.EXPECT().Backup(mocks.ctx, gomock.Any()).Return(backupErr)
+
+	path, err := s.BackupNow(mocks.ctx)
+	assert.ErrorIs(t, err, backupErr)
+	assert.Empty(t, path)
+}
+
+func TestBackupRetention(t *testing.T) {
+	s, finish, mocks := backupTestFixtures(t, 2)
+	defer finish()
+	mocks.expectBackup()
+
+	// Files that are not backups should be left alone.
+	unrelated := filepath.Join(s.config.Directory, "something-else.sqlite")
+	if !assert.NoError(t, os.WriteFile(unrelated, []byte{}, 0o644)) {
+		t.FailNow()
+	}
+
+	paths := []string{}
+	for i := 0; i < 4; i++ {
+		path, err := s.BackupNow(mocks.ctx)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		paths = append(paths, path)
+		mocks.clock.Add(1 * time.Hour)
+	}
+
+	assert.NoFileExists(t, paths[0])
+	assert.NoFileExists(t, paths[1])
+	assert.FileExists(t, paths[2])
+	assert.FileExists(t, paths[3])
+	assert.FileExists(t, unrelated)
+}
+
+func TestBackupRetentionOtherBackups(t *testing.T) {
+	s, finish, mocks := backupTestFixtures(t, 1)
+	defer finish()
+	mocks.persist.EXPECT().BackupFileExtension().Return(".pgdump").AnyTimes()
+	mocks.persist.EXPECT().Backup(mocks.ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, path string) error {
+			return os.WriteFile(path, []byte{}, 0o644)
+		}).AnyTimes()
+
+	// Backups made before migrating the database, both in the old location and
+	// in their own subdirectory, sort after the regular backups. They should
+	// neither count towards the retention, nor be removed.
+	migrationBackups := []string{
+		filepath.Join(s.config.Directory, "flamenco-manager-v3-2022-09-15_081441.pgdump"),
+		filepath.Join(s.config.Directory, "migrations", "flamenco-manager-v4-2022-09-15_091441.pgdump"),
+	}
+	for _, path := range migrationBackups {
+		if !assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755)) {
+			t.FailNow()
+		}
+		if !assert.NoError(t, os.WriteFile(path, []byte{}, 0o644)) {
+			t.FailNow()
+		}
+	}
+
+	firstPath, err := s.BackupNow(mocks.ctx)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.FileExists(t, firstPath, "the backup just made should be kept")
+
+	mocks.clock.Add(1 * time.Hour)
+	secondPath, err := s.BackupNow(mocks.ctx)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.NoFileExists(t, firstPath)
+	assert.FileExists(t, secondPath)
+	for _, path := range migrationBackups {
+		assert.FileExists(t, path)
+	}
+}
@@ -0,0 +1,20 @@
+package db_backup
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+
+	"git.blender.org/flamenco/internal/manager/persistence"
+)
+
+// Generate mock implementations of these interfaces.
+//go:generate go run github.com/golang/mock/mockgen -destination mocks/interfaces_mock.gen.go -package mocks git.blender.org/flamenco/internal/manager/db_backup PersistenceService
+
+type PersistenceService interface {
+	Backup(ctx context.Context, path string) error
+	BackupFileExtension() string
+}
+
+// PersistenceService should be a subset of persistence.DB
+var _ PersistenceService = (*persistence.DB)(nil)
@@ -4,6 +4,7 @@ package job_compilers
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -26,6 +27,30 @@ type AuthoredJob struct {
 	Priority int
 	Status   api.JobStatus
 
+	// WorkerTagUUID is the UUID of the worker tag this job is limited to. Empty
+	// means "no tag", i.e. any worker can run this job.
+	WorkerTagUUID string
+
+	// UserUUID is the UUID of the user who submitted this job. Empty when user
+	// authentication is disabled.
+	UserUUID string
+
+	// MaxWorkers limits the number of Workers that can work on this job
+	// simultaneously. Zero means "no limit".
+	MaxWorkers int
+
+	// DependsOn contains the UUIDs of the jobs that need to be completed before
+	// this job can start.
+	DependsOn []string
+
+	// StartAfter is the moment the job is allowed to start. The zero value
+	// means it can start immediately.
+	StartAfter time.Time
+
+	// Requirements determine which Workers can run this job. They are taken
+	// from the job type.
+	Requirements WorkerRequirements
+
 	Created time.Time
 
 	Settings JobSettings
@@ -34,6 +59,16 @@ type AuthoredJob struct {
 	Tasks []AuthoredTask
 }
 
+// WorkerRequirements are the resources a Worker needs to offer in order to run
+// a job.
+type WorkerRequirements struct {
+	// CPUCores and MemoryMB are minimum values. Zero means "no requirement".
+	CPUCores int
+	MemoryMB int
+	// Capabilities need to match the Worker's capabilities exactly.
+	Capabilities map[string]string
+}
+
 type JobSettings map[string]interface{}
 type JobMetadata map[string]string
 
@@ -50,6 +85,17 @@ type AuthoredTask struct {
 
 	// Dependencies are tasks that need to be completed before this one can run.
 	Dependencies []*AuthoredTask `json:"omitempty" yaml:"omitempty"`
+
+	// Timeout determines how long the task can go without updates from its
+	// Worker, before it is considered stuck. Zero means the Manager's global
+	// task timeout is used.
+	Timeout time.Duration
+}
+
+// authoredTaskOptions are the optional arguments for `author.Task()`.
+type authoredTaskOptions struct {
+	// Timeout is a duration, like "4h" or "30s".
+	Timeout string
 }
 
 type AuthoredCommand struct {
@@ -58,7 +104,9 @@ type AuthoredCommand struct {
 }
 type AuthoredCommandParameters map[string]interface{}
 
-func (a *Author) Task(name string, taskType string) (*AuthoredTask, error) {
+// Task creates a new task. The optional options object can set the task's
+// timeout, like `author.Task("render", "blender", {timeout: "4h"})`.
+func (a *Author) Task(name string, taskType string, options ...authoredTaskOptions) (*AuthoredTask, error) {
 	name = strings.TrimSpace(name)
 	taskType = strings.TrimSpace(taskType)
 	if name == "" {
@@ -75,6 +123,21 @@ func (a *Author) Task(name string, taskType string) (*AuthoredTask, error) {
 		50, // TODO: handle default priority somehow.
 		make([]AuthoredCommand, 0),
 		make([]*AuthoredTask, 0),
+		0,
+	}
+
+	for _, opts := range options {
+		if opts.Timeout == "" {
+			continue
+		}
+		timeout, err := time.ParseDuration(opts.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("author.Task(name, type, options): invalid timeout %q: %w", opts.Timeout, err)
+		}
+		if timeout < 0 {
+			return nil, fmt.Errorf("author.Task(name, type, options): timeout %q cannot be negative", opts.Timeout)
+		}
+		at.Timeout = timeout
 	}
 	return &at, nil
 }
@@ -26,6 +26,7 @@ import (
 var ErrJobTypeUnknown = errors.New("job type unknown")
 var ErrScriptIncomplete = errors.New("job compiler script incomplete")
 var ErrJobTypeBadEtag = errors.New("job type etag does not match")
+var ErrSettingNotOverridable = errors.New("job setting cannot be changed after submission")
 
 // Service contains job compilers defined in JavaScript.
 type Service struct {
@@ -33,14 +34,19 @@ type Service struct {
 	registry    *require.Registry   // Goja module registry.
 	timeService TimeService
 
-	// mutex protects 'compilers' from race conditions.
+	// mutex protects 'compilers' and 'registry' from race conditions.
 	mutex *sync.Mutex
+
+	// scriptsState is the state of the on-disk scripts directory at the last
+	// check, used to detect changes. See Run().
+	scriptsState scriptsDirState
 }
 
 type Compiler struct {
 	jobType  string
 	program  *goja.Program // Compiled JavaScript file.
 	filename string        // The filename of that JS file.
+	err      error         // Error compiling the JS file, in which case 'program' is nil.
 }
 
 type VM struct {
@@ -52,9 +58,11 @@ type VM struct {
 // jobCompileFunc is a function that fills job.Tasks.
 type jobCompileFunc func(job *AuthoredJob) error
 
-// TimeService is a service that can tell the current time.
+// TimeService is a service that can tell the current time, and wait for time
+// to pass.
 type TimeService interface {
 	Now() time.Time
+	After(d time.Duration) <-chan time.Time
 }
 
 // Load returns a job compiler service with all JS files loaded.
@@ -70,7 +78,14 @@ func Load(ts TimeService) (*Service, error) {
 	if err := service.loadScripts(); err != nil {
 		return nil, err
 	}
+	service.scriptsState = statScriptsDir()
 
+	return &service, nil
+}
+
+// newRegistry returns a Goja module registry that can load modules from the
+// scripts directories, and has Flamenco's own modules registered.
+func newRegistry() *require.Registry {
 	staticFileLoader := func(path string) ([]byte, error) {
 		content, err := loadFileFromAnyFS(path)
 		if err == os.ErrNotExist {
@@ -82,12 +97,11 @@ func Load(ts TimeService) (*Service, error) {
 		return content, err
 	}
 
-	service.registry = require.NewRegistry(require.WithLoader(staticFileLoader))
-	service.registry.RegisterNativeModule("author", AuthorModule)
-	service.registry.RegisterNativeModule("path", PathModule)
-	service.registry.RegisterNativeModule("process", ProcessModule)
-
-	return &service, nil
+	registry := require.NewRegistry(require.WithLoader(staticFileLoader))
+	registry.RegisterNativeModule("author", AuthorModule)
+	registry.RegisterNativeModule("path", PathModule)
+	registry.RegisterNativeModule("process", ProcessModule)
+	return registry
 }
 
 func (s *Service) Compile(ctx context.Context, sj api.SubmittedJob) (*AuthoredJob, error) {
@@ -100,9 +114,74 @@ func (s *Service) Compile(ctx context.Context, sj api.SubmittedJob) (*AuthoredJo
 		return nil, err
 	}
 
+	return s.compile(vm, uuid.New(), sj)
+}
+
+// Recompile compiles an already-submitted job again, with some of its settings
+// changed. Only settings that the job type declares as `overridable` can be
+// changed. `sj` describes the job as it was submitted, and the returned job
+// keeps the given job ID.
+func (s *Service) Recompile(
+	ctx context.Context,
+	jobID string,
+	sj api.SubmittedJob,
+	changedSettings map[string]interface{},
+) (*AuthoredJob, error) {
+	vm, err := s.compilerVMForJobType(sj.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	jobType, err := vm.getJobTypeInfo()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOverridable(jobType, changedSettings); err != nil {
+		return nil, err
+	}
+
+	settings := map[string]interface{}{}
+	if sj.Settings != nil {
+		for key, value := range sj.Settings.AdditionalProperties {
+			settings[key] = value
+		}
+	}
+	for key, value := range changedSettings {
+		settings[key] = value
+	}
+	sj.Settings = &api.JobSettings{AdditionalProperties: settings}
+
+	return s.compile(vm, jobID, sj)
+}
+
+// checkOverridable returns ErrSettingNotOverridable when any of the settings
+// cannot be changed after job submission.
+func checkOverridable(jobType api.AvailableJobType, settings map[string]interface{}) error {
+	overridable := map[string]bool{}
+	for _, setting := range jobType.Settings {
+		overridable[setting.Key] = setting.Overridable != nil && *setting.Overridable
+	}
+
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !overridable[key] {
+			return fmt.Errorf("%w: %q", ErrSettingNotOverridable, key)
+		}
+	}
+	return nil
+}
+
+// compile runs the job compiler script on the submitted job, producing a job
+// with the given ID.
+func (s *Service) compile(vm *VM, jobID string, sj api.SubmittedJob) (*AuthoredJob, error) {
 	// Create an AuthoredJob from this SubmittedJob.
 	aj := AuthoredJob{
-		JobID:    uuid.New(),
+		JobID:    jobID,
 		Created:  s.timeService.Now(),
 		Name:     sj.Name,
 		JobType:  sj.Type,
@@ -112,6 +191,21 @@ func (s *Service) Compile(ctx context.Context, sj api.SubmittedJob) (*AuthoredJo
 		Settings: make(JobSettings),
 		Metadata: make(JobMetadata),
 	}
+	if sj.WorkerTag != nil {
+		aj.WorkerTagUUID = *sj.WorkerTag
+	}
+	if sj.MaxWorkers != nil {
+		if *sj.MaxWorkers < 0 {
+			return nil, fmt.Errorf("max_workers cannot be negative, got %d", *sj.MaxWorkers)
+		}
+		aj.MaxWorkers = *sj.MaxWorkers
+	}
+	if sj.DependsOn != nil {
+		aj.DependsOn = *sj.DependsOn
+	}
+	if sj.StartAfter != nil {
+		aj.StartAfter = *sj.StartAfter
+	}
 	if sj.Settings != nil {
 		for key, value := range sj.Settings.AdditionalProperties {
 			aj.Settings[key] = value
@@ -123,6 +217,12 @@ func (s *Service) Compile(ctx context.Context, sj api.SubmittedJob) (*AuthoredJo
 		}
 	}
 
+	jobType, err := vm.getJobTypeInfo()
+	if err != nil {
+		return nil, err
+	}
+	aj.Requirements = requirementsFromAPI(jobType.Requirements)
+
 	compiler, err := vm.getCompileJob()
 	if err != nil {
 		return nil, err
@@ -144,11 +244,16 @@ func (s *Service) Compile(ctx context.Context, sj api.SubmittedJob) (*AuthoredJo
 func (s *Service) ListJobTypes() api.AvailableJobTypes {
 	jobTypes := make([]api.AvailableJobType, 0)
 
-	// Protect access to s.compilers.
+	// Protect access to s.compilers. The lock is not held while running the
+	// scripts, as compilerVMForJobType() takes it as well.
 	s.mutex.Lock()
-	defer s.mutex.Unlock()
-
+	typeNames := make([]string, 0, len(s.compilers))
 	for typeName := range s.compilers {
+		typeNames = append(typeNames, typeName)
+	}
+	s.mutex.Unlock()
+
+	for _, typeName := range typeNames {
 		compiler, err := s.compilerVMForJobType(typeName)
 		if err != nil {
 			log.Warn().Err(err).Str("jobType", typeName).Msg("unable to determine job type settings")
@@ -190,10 +295,12 @@ func (vm *VM) getCompileJob() (jobCompileFunc, error) {
 		return nil, ErrScriptIncomplete
 	}
 
-	// TODO: wrap this in a nicer way.
 	return func(job *AuthoredJob) error {
 		_, err := compileJob(nil, vm.runtime.ToValue(job))
-		return err
+		if err != nil {
+			return newScriptError(vm.compiler.filename, err)
+		}
+		return nil
 	}, nil
 }
 
@@ -211,11 +318,63 @@ func (vm *VM) getJobTypeInfo() (api.AvailableJobType, error) {
 		return api.AvailableJobType{}, ErrScriptIncomplete
 	}
 
+	requirements, err := vm.getJobTypeRequirements(jtValue)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("jobType", vm.compiler.jobType).
+			Str("script", vm.compiler.filename).
+			Msg("script does not define proper JOB_TYPE requirements")
+		return api.AvailableJobType{}, ErrScriptIncomplete
+	}
+
 	ajt.Name = vm.compiler.jobType
 	ajt.Etag = vm.jobTypeEtag
+	ajt.Requirements = requirements
 	return ajt, nil
 }
 
+// getJobTypeRequirements returns the requirements of the JOB_TYPE object, or
+// nil if it has none. These are converted via JSON, so that the scripts can use
+// the same names as the API, like `memory_mb`, and free-form capabilities.
+func (vm *VM) getJobTypeRequirements(jtValue goja.Value) (*api.WorkerRequirements, error) {
+	if goja.IsUndefined(jtValue) || goja.IsNull(jtValue) {
+		return nil, nil
+	}
+	reqValue := jtValue.ToObject(vm.runtime).Get("requirements")
+	if reqValue == nil || goja.IsUndefined(reqValue) || goja.IsNull(reqValue) {
+		return nil, nil
+	}
+
+	asJSON, err := json.Marshal(reqValue.Export())
+	if err != nil {
+		return nil, err
+	}
+	var requirements api.WorkerRequirements
+	if err := json.Unmarshal(asJSON, &requirements); err != nil {
+		return nil, err
+	}
+	return &requirements, nil
+}
+
+// requirementsFromAPI converts the job type requirements to WorkerRequirements.
+func requirementsFromAPI(apiReqs *api.WorkerRequirements) WorkerRequirements {
+	requirements := WorkerRequirements{}
+	if apiReqs == nil {
+		return requirements
+	}
+	if apiReqs.CpuCores != nil {
+		requirements.CPUCores = *apiReqs.CpuCores
+	}
+	if apiReqs.MemoryMb != nil {
+		requirements.MemoryMB = *apiReqs.MemoryMb
+	}
+	if apiReqs.Capabilities != nil && len(apiReqs.Capabilities.AdditionalProperties) > 0 {
+		requirements.Capabilities = apiReqs.Capabilities.AdditionalProperties
+	}
+	return requirements
+}
+
 // getEtag gets the job type etag hash.
 func (vm *VM) getEtag() (string, error) {
 	jobTypeInfo, err := vm.getJobTypeInfo()
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/benbjohnson/clock"
+	"github.com/dop251/goja"
 	"github.com/stretchr/testify/assert"
 
 	"git.blender.org/flamenco/pkg/api"
@@ -256,6 +257,133 @@ func TestSimpleBlenderRenderOutputPathFieldReplacement(t *testing.T) {
 
 }
 
+func TestRecompile(t *testing.T) {
+	c := mockedClock(t)
+
+	s, err := Load(c)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	const jobID = "85be6b3c-3e2a-4bd4-a0a5-f9fe4be3e8c1"
+	sj := exampleSubmittedJob()
+	aj, err := s.Recompile(ctx, jobID, sj, map[string]interface{}{
+		"render_output_path": "/fixed/output/######",
+		"frames":             "1-4",
+	})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	assert.Equal(t, jobID, aj.JobID, "the job ID should be kept")
+	assert.Equal(t, "/fixed/output/######", aj.Settings["render_output_path"])
+	assert.Equal(t, "1-4", aj.Settings["frames"])
+	assert.Equal(t, sj.Settings.AdditionalProperties["blendfile"], aj.Settings["blendfile"])
+
+	// The submitted job should not have been changed.
+	assert.Equal(t, "1-10", sj.Settings.AdditionalProperties["frames"])
+
+	// Tasks should have been created to render the frames: 1-3, 4, video-encoding, and cleanup
+	if assert.Len(t, aj.Tasks, 4) {
+		assert.Equal(t, "render-1-3", aj.Tasks[0].Name)
+		assert.Equal(t, "render-4", aj.Tasks[1].Name)
+	}
+}
+
+func TestRecompileNotOverridable(t *testing.T) {
+	c := mockedClock(t)
+
+	s, err := Load(c)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	sj := exampleSubmittedJob()
+	_, err = s.Recompile(ctx, "85be6b3c-3e2a-4bd4-a0a5-f9fe4be3e8c1", sj, map[string]interface{}{
+		"frames":    "1-4",
+		"blendfile": "/some/other/file.blend",
+	})
+	assert.ErrorIs(t, err, ErrSettingNotOverridable)
+
+	_, err = s.Recompile(ctx, "85be6b3c-3e2a-4bd4-a0a5-f9fe4be3e8c1", sj, map[string]interface{}{
+		"nonexistent": "value",
+	})
+	assert.ErrorIs(t, err, ErrSettingNotOverridable)
+}
+
+func TestJobTypeRequirements(t *testing.T) {
+	c := mockedClock(t)
+
+	s, err := Load(c)
+	assert.NoError(t, err)
+
+	const script = `
+const JOB_TYPE = {
+    label: "Needs a big GPU",
+    settings: [],
+    requirements: {
+        cpu_cores: 8,
+        memory_mb: 16384,
+        capabilities: { blender_version: "3.3", gpu: "cuda" },
+    },
+};
+function compileJob(job) {
+    const task = author.Task("echo", "misc");
+    task.addCommand(author.Command("echo", {message: "hey"}));
+    job.addTask(task);
+}`
+	program, err := goja.Compile("requirements-test.js", script, true)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	s.compilers["requirements-test"] = Compiler{
+		jobType:  "requirements-test",
+		program:  program,
+		filename: "requirements-test.js",
+	}
+
+	// The requirements should be part of the job type info.
+	jobType, err := s.GetJobType("requirements-test")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	if assert.NotNil(t, jobType.Requirements) {
+		assert.Equal(t, 8, *jobType.Requirements.CpuCores)
+		assert.Equal(t, 16384, *jobType.Requirements.MemoryMb)
+		assert.Equal(t, map[string]string{"blender_version": "3.3", "gpu": "cuda"},
+			jobType.Requirements.Capabilities.AdditionalProperties)
+	}
+
+	// And they should be copied to compiled jobs.
+	sj := api.SubmittedJob{
+		Name:     "job name",
+		Type:     "requirements-test",
+		Priority: 50,
+	}
+	aj, err := s.Compile(context.Background(), sj)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	expect := WorkerRequirements{
+		CPUCores:     8,
+		MemoryMB:     16384,
+		Capabilities: map[string]string{"blender_version": "3.3", "gpu": "cuda"},
+	}
+	assert.Equal(t, expect, aj.Requirements)
+
+	// Job types without requirements should result in jobs without requirements.
+	sj.Type = "echo-sleep-test"
+	sj.Settings = &api.JobSettings{AdditionalProperties: map[string]interface{}{
+		"message": "hey",
+	}}
+	aj, err = s.Compile(context.Background(), sj)
+	if assert.NoError(t, err) {
+		assert.Equal(t, WorkerRequirements{}, aj.Requirements)
+	}
+}
+
 func TestEtag(t *testing.T) {
 	c := mockedClock(t)
 
@@ -309,3 +437,99 @@ func TestEtag(t *testing.T) {
 func ptr[T any](value T) *T {
 	return &value
 }
+
+func TestTaskTimeout(t *testing.T) {
+	c := mockedClock(t)
+
+	s, err := Load(c)
+	assert.NoError(t, err)
+
+	const script = `
+const JOB_TYPE = {
+    label: "Tasks with timeouts",
+    settings: [],
+};
+function compileJob(job) {
+    const render = author.Task("render", "blender", {timeout: "4h"});
+    render.addCommand(author.Command("echo", {message: "rendering"}));
+    job.addTask(render);
+
+    const move = author.Task("move", "file-management");
+    move.addCommand(author.Command("echo", {message: "moving"}));
+    job.addTask(move);
+}`
+	program, err := goja.Compile("timeout-test.js", script, true)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	s.compilers["timeout-test"] = Compiler{
+		jobType:  "timeout-test",
+		program:  program,
+		filename: "timeout-test.js",
+	}
+
+	sj := api.SubmittedJob{
+		Name:     "job name",
+		Type:     "timeout-test",
+		Priority: 50,
+	}
+	aj, err := s.Compile(context.Background(), sj)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	if assert.Len(t, aj.Tasks, 2) {
+		assert.Equal(t, 4*time.Hour, aj.Tasks[0].Timeout)
+		assert.Equal(t, time.Duration(0), aj.Tasks[1].Timeout, "tasks without timeout should use the global one")
+	}
+}
+
+func TestCompileScriptError(t *testing.T) {
+	c := mockedClock(t)
+
+	s, err := Load(c)
+	assert.NoError(t, err)
+
+	const script = `const JOB_TYPE = {
+    label: "Throws errors",
+    settings: [],
+};
+function compileJob(job) {
+    const task = author.Task("echo", "misc");
+    task.addCommand(author.Command("echo", {message: job.settings.message.toUpperCase()}));
+    job.addTask(task);
+}`
+	program, err := goja.Compile("error-test.js", script, true)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	s.compilers["error-test"] = Compiler{
+		jobType:  "error-test",
+		program:  program,
+		filename: "error-test.js",
+	}
+
+	sj := api.SubmittedJob{
+		Name:     "job name",
+		Type:     "error-test",
+		Priority: 50,
+	}
+	_, err = s.Compile(context.Background(), sj)
+
+	var scriptErr *ScriptError
+	if assert.ErrorAs(t, err, &scriptErr) {
+		assert.Equal(t, "error-test.js", scriptErr.Script)
+		assert.Equal(t, 7, scriptErr.Line)
+		assert.Contains(t, scriptErr.Message, "TypeError")
+		assert.Contains(t, err.Error(), "error-test.js:7:")
+	}
+}
+
+func TestTaskTimeoutInvalid(t *testing.T) {
+	a := Author{}
+
+	_, err := a.Task("render", "blender", authoredTaskOptions{Timeout: "a while"})
+	assert.Error(t, err)
+
+	_, err = a.Task("render", "blender", authoredTaskOptions{Timeout: "-5m"})
+	assert.Error(t, err)
+}
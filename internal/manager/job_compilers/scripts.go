@@ -3,13 +3,17 @@ package job_compilers
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
 	"path"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/dop251/goja"
+	"github.com/dop251/goja/parser"
 	"github.com/dop251/goja_nodejs/require"
 	"github.com/rs/zerolog/log"
 )
@@ -35,10 +39,11 @@ func (s *Service) loadScripts() error {
 			Msg("job compiler: found job compiler scripts")
 
 		// Merge the returned compilers into the big map, skipping ones that were
-		// already there.
+		// already there. Scripts that failed to compile can still be replaced by
+		// a working one from a later filesystem.
 		for name := range compilersfromFS {
-			_, found := compilers[name]
-			if found {
+			existing, found := compilers[name]
+			if found && existing.err == nil {
 				continue
 			}
 
@@ -46,16 +51,20 @@ func (s *Service) loadScripts() error {
 		}
 	}
 
-	// Assign the new set of compilers in a thread-safe way.
+	// Assign the new set of compilers in a thread-safe way. The registry is
+	// replaced as well, as it caches the modules loaded with `require()`.
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 	s.compilers = compilers
+	s.registry = newRegistry()
 
 	return nil
 }
 
 // loadScriptsFrom iterates over files in the root of the given filesystem,
-// compiles the files, and returns the "name -> compiler" mapping.
+// compiles the files, and returns the "name -> compiler" mapping. Scripts that
+// fail to compile are included, with their error, so that the error can be
+// reported when the job type is used.
 func loadScriptsFrom(filesystem fs.FS) (map[string]Compiler, error) {
 	dirEntries, err := fs.ReadDir(filesystem, ".")
 	if err != nil {
@@ -88,13 +97,18 @@ func loadScriptsFrom(filesystem fs.FS) (map[string]Compiler, error) {
 			continue
 		}
 
-		program, err := goja.Compile(filename, string(script_bytes), true)
+		jobTypeName := filenameToJobType(filename)
+		program, err := compileScript(filename, string(script_bytes))
 		if err != nil {
 			log.Error().Err(err).Str("filename", filename).Msg("failed to compile script")
+			compilers[jobTypeName] = Compiler{
+				jobType:  jobTypeName,
+				filename: filename,
+				err:      err,
+			}
 			continue
 		}
 
-		jobTypeName := filenameToJobType(filename)
 		compilers[jobTypeName] = Compiler{
 			jobType:  jobTypeName,
 			program:  program,
@@ -110,6 +124,20 @@ func loadScriptsFrom(filesystem fs.FS) (map[string]Compiler, error) {
 	return compilers, nil
 }
 
+// compileScript compiles the JavaScript source code. Parsing is done as a
+// separate step, as goja.Compile() discards the position of syntax errors.
+func compileScript(filename, source string) (*goja.Program, error) {
+	ast, err := parser.ParseFile(nil, filename, source, 0)
+	if err != nil {
+		return nil, newScriptError(filename, err)
+	}
+	program, err := goja.CompileAST(ast, true)
+	if err != nil {
+		return nil, newScriptError(filename, err)
+	}
+	return program, nil
+}
+
 func loadFileFromFS(filesystem fs.FS, path string) ([]byte, error) {
 	file, err := filesystem.Open(path)
 	if err != nil {
@@ -153,14 +181,21 @@ func newGojaVM(registry *require.Registry) *goja.Runtime {
 // compilerVMForJobType returns a Goja *Runtime that has the job compiler script
 // for the given job type loaded up.
 func (s *Service) compilerVMForJobType(jobTypeName string) (*VM, error) {
+	s.mutex.Lock()
 	program, ok := s.compilers[jobTypeName]
+	registry := s.registry
+	s.mutex.Unlock()
+
 	if !ok {
 		return nil, ErrJobTypeUnknown
 	}
+	if program.err != nil {
+		return nil, program.err
+	}
 
-	runtime := newGojaVM(s.registry)
+	runtime := newGojaVM(registry)
 	if _, err := runtime.RunProgram(program.program); err != nil {
-		return nil, err
+		return nil, newScriptError(program.filename, err)
 	}
 
 	vm := &VM{
@@ -173,3 +208,83 @@ func (s *Service) compilerVMForJobType(jobTypeName string) (*VM, error) {
 
 	return vm, nil
 }
+
+// ScriptError is returned when a job compiler script cannot be compiled, or
+// throws an exception while running. It contains the location of the error,
+// as far as it is known.
+type ScriptError struct {
+	Script  string // Filename of the script that caused the error.
+	Line    int    // Line number, or 0 if unknown.
+	Column  int    // Column number, or 0 if unknown.
+	Message string // The JavaScript error message, without its location.
+
+	err error
+}
+
+func (e *ScriptError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %s", e.Script, e.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", e.Script, e.Line, e.Column, e.Message)
+}
+
+func (e *ScriptError) Unwrap() error {
+	return e.err
+}
+
+// stackFramePosition matches the position of a JavaScript stack frame, like
+// `compileJob (simple_blender_render.js:12:5(37))`.
+var stackFramePosition = regexp.MustCompile(`([^\s()]+):(\d+):(\d+)\(\d+\)`)
+
+// newScriptError wraps errors from Goja in a ScriptError. Other errors are
+// returned as-is.
+func newScriptError(filename string, err error) error {
+	var parseErrs parser.ErrorList
+	var syntaxErr *goja.CompilerSyntaxError
+	var exception *goja.Exception
+
+	switch {
+	case errors.As(err, &parseErrs) && len(parseErrs) > 0:
+		return &ScriptError{
+			Script:  filename,
+			Line:    parseErrs[0].Position.Line,
+			Column:  parseErrs[0].Position.Column,
+			Message: parseErrs[0].Message,
+			err:     err,
+		}
+
+	case errors.As(err, &syntaxErr):
+		scriptErr := ScriptError{
+			Script:  filename,
+			Message: syntaxErr.Message,
+			err:     err,
+		}
+		if syntaxErr.File != nil {
+			position := syntaxErr.File.Position(syntaxErr.Offset)
+			scriptErr.Line = position.Line
+			scriptErr.Column = position.Column
+		}
+		return &scriptErr
+
+	case errors.As(err, &exception):
+		scriptErr := ScriptError{
+			Script:  filename,
+			Message: exception.Error(),
+			err:     err,
+		}
+		if value := exception.Value(); value != nil {
+			scriptErr.Message = value.String()
+		}
+		// The stack frames themselves are not accessible, so the position of the
+		// innermost JavaScript frame is taken from the stack trace text.
+		match := stackFramePosition.FindStringSubmatch(exception.String())
+		if match != nil {
+			scriptErr.Script = match[1]
+			scriptErr.Line, _ = strconv.Atoi(match[2])
+			scriptErr.Column, _ = strconv.Atoi(match[3])
+		}
+		return &scriptErr
+	}
+
+	return err
+}
@@ -5,16 +5,17 @@ const JOB_TYPE = {
     settings: [
         // Settings for artists to determine:
         { key: "frames", type: "string", required: true, eval: "f'{C.scene.frame_start}-{C.scene.frame_end}'",
+          overridable: true,
           description: "Frame range to render. Examples: '47', '1-30', '3, 5-10, 47-327'" },
         { key: "chunk_size", type: "int32", default: 1, description: "Number of frames to render in one Blender render task",
-          visible: "submission" },
+          visible: "submission", overridable: true },
 
         // render_output_root + add_path_components determine the value of render_output_path.
         { key: "render_output_root", type: "string", subtype: "dir_path", required: true, visible: "submission",
           description: "Base directory of where render output is stored. Will have some job-specific parts appended to it"},
         { key: "add_path_components", type: "int32", required: true, default: 0, propargs: {min: 0, max: 32}, visible: "submission",
           description: "Number of path components of the current blend file to use in the render output path"},
-        { key: "render_output_path", type: "string", subtype: "file_path", editable: false,
+        { key: "render_output_path", type: "string", subtype: "file_path", editable: false, overridable: true,
           eval: "str(Path(bpy.path.abspath(settings.render_output_root), last_n_dir_parts(settings.add_path_components), jobname, '{timestamp}', '######'))",
           description: "Final file path of where render output will be saved"},
 
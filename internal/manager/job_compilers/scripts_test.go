@@ -5,6 +5,7 @@ package job_compilers
 import (
 	"os"
 	"testing"
+	"testing/fstest"
 
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
@@ -42,6 +43,29 @@ func TestLoadScriptsFrom_embedded(t *testing.T) {
 	assert.Equal(t, expectKeys, keys(compilers))
 }
 
+func TestLoadScriptsFrom_syntax_error(t *testing.T) {
+	scriptsFS := fstest.MapFS{
+		"broken_script.js": &fstest.MapFile{
+			Data: []byte("const JOB_TYPE = {\n    label: 'Broken',\n    settings: [\n};\n"),
+		},
+	}
+	compilers, err := loadScriptsFrom(scriptsFS)
+	assert.NoError(t, err)
+
+	compiler, ok := compilers["broken-script"]
+	if !assert.True(t, ok, "scripts with errors should be included") {
+		t.FailNow()
+	}
+	assert.Nil(t, compiler.program)
+
+	var scriptErr *ScriptError
+	if assert.ErrorAs(t, compiler.err, &scriptErr) {
+		assert.Equal(t, "broken_script.js", scriptErr.Script)
+		assert.Equal(t, 4, scriptErr.Line)
+		assert.NotEmpty(t, scriptErr.Message)
+	}
+}
+
 func BenchmarkLoadScripts_fromEmbedded(b *testing.B) {
 	zerolog.SetGlobalLevel(zerolog.Disabled)
 	initEmbeddedFS()
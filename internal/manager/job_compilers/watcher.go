@@ -0,0 +1,124 @@
+package job_compilers
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"io/fs"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"git.blender.org/flamenco/pkg/api"
+)
+
+// scriptsCheckInterval determines how often the on-disk scripts directory is
+// checked for changes.
+const scriptsCheckInterval = 2 * time.Second
+
+// ChangeBroadcaster is told about the new list of job types, whenever the
+// on-disk scripts change.
+type ChangeBroadcaster interface {
+	BroadcastJobTypesUpdate(jobTypes api.AvailableJobTypes)
+}
+
+// scriptFileState is used to detect changes to a script file.
+type scriptFileState struct {
+	size    int64
+	modTime int64 // Unix time in nanoseconds.
+}
+
+// scriptsDirState maps the filename of each script to its state.
+type scriptsDirState map[string]scriptFileState
+
+// Run watches the on-disk scripts directory, and reloads the job compiler
+// scripts when anything changes. Without such a directory, it returns
+// immediately.
+//
+// Polling is used instead of filesystem notifications, as those are not
+// reliable on the network filesystems that Flamenco is often used with.
+func (s *Service) Run(ctx context.Context, broadcaster ChangeBroadcaster) {
+	if onDiskScriptsFS == nil {
+		log.Debug().Msg("job compiler: no on-disk scripts directory, not watching for changes")
+		return
+	}
+
+	log.Info().Msg("job compiler: watching on-disk scripts directory for changes")
+	defer log.Info().Msg("job compiler: shutting down scripts directory watcher")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.timeService.After(scriptsCheckInterval):
+			s.reloadIfChanged(broadcaster)
+		}
+	}
+}
+
+// reloadIfChanged reloads the scripts when the on-disk scripts directory
+// changed since the last check, and broadcasts the resulting job types.
+func (s *Service) reloadIfChanged(broadcaster ChangeBroadcaster) {
+	newState := statScriptsDir()
+	if newState.equals(s.scriptsState) {
+		return
+	}
+	s.scriptsState = newState
+
+	log.Info().Msg("job compiler: on-disk scripts changed, reloading")
+	if err := s.loadScripts(); err != nil {
+		log.Error().Err(err).Msg("job compiler: error reloading scripts")
+		return
+	}
+
+	jobTypes := s.ListJobTypes()
+	broadcaster.BroadcastJobTypesUpdate(jobTypes)
+}
+
+// statScriptsDir returns the state of the JavaScript files in the on-disk
+// scripts directory. Returns nil if there is no such directory.
+func statScriptsDir() scriptsDirState {
+	if onDiskScriptsFS == nil {
+		return nil
+	}
+
+	dirEntries, err := fs.ReadDir(onDiskScriptsFS, ".")
+	if err != nil {
+		log.Warn().Err(err).Msg("job compiler: unable to read scripts directory")
+		return nil
+	}
+
+	state := scriptsDirState{}
+	for _, dirEntry := range dirEntries {
+		if !dirEntry.Type().IsRegular() || !strings.HasSuffix(dirEntry.Name(), ".js") {
+			continue
+		}
+
+		info, err := dirEntry.Info()
+		if err != nil {
+			// The file may have been removed since reading the directory. This is
+			// picked up at the next check.
+			continue
+		}
+
+		state[dirEntry.Name()] = scriptFileState{
+			size:    info.Size(),
+			modTime: info.ModTime().UnixNano(),
+		}
+	}
+	return state
+}
+
+func (state scriptsDirState) equals(other scriptsDirState) bool {
+	if len(state) != len(other) {
+		return false
+	}
+	for filename, fileState := range state {
+		otherFileState, found := other[filename]
+		if !found || otherFileState != fileState {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,143 @@
+package job_compilers
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"git.blender.org/flamenco/pkg/api"
+)
+
+// recordingBroadcaster keeps the broadcasted job types, for inspection by the
+// test.
+type recordingBroadcaster struct {
+	updates []api.AvailableJobTypes
+}
+
+func (rb *recordingBroadcaster) BroadcastJobTypesUpdate(jobTypes api.AvailableJobTypes) {
+	rb.updates = append(rb.updates, jobTypes)
+}
+
+// channelBroadcaster sends the broadcasted job types over a channel, for use
+// with the watcher running in another goroutine.
+type channelBroadcaster struct {
+	updates chan api.AvailableJobTypes
+}
+
+func (cb *channelBroadcaster) BroadcastJobTypesUpdate(jobTypes api.AvailableJobTypes) {
+	cb.updates <- jobTypes
+}
+
+func TestReloadIfChanged(t *testing.T) {
+	scriptsDir := t.TempDir()
+	initFileLoader()
+	defer func(previous fs.FS) { onDiskScriptsFS = previous }(onDiskScriptsFS)
+	onDiskScriptsFS = os.DirFS(scriptsDir)
+
+	s, err := Load(mockedClock(t))
+	require.NoError(t, err)
+	broadcaster := recordingBroadcaster{}
+
+	// Without changes, nothing should happen.
+	s.reloadIfChanged(&broadcaster)
+	assert.Empty(t, broadcaster.updates)
+
+	// Adding a script should make its job type available.
+	script := `const JOB_TYPE = {
+    label: "Hot Reload",
+    settings: [],
+};
+function compileJob(job) {}
+`
+	scriptPath := filepath.Join(scriptsDir, "hot_reload.js")
+	require.NoError(t, os.WriteFile(scriptPath, []byte(script), 0o644))
+
+	s.reloadIfChanged(&broadcaster)
+	require.Len(t, broadcaster.updates, 1)
+	jobType, err := s.GetJobType("hot-reload")
+	require.NoError(t, err)
+	assert.Equal(t, "Hot Reload", jobType.Label)
+	assert.Contains(t, broadcaster.updates[0].JobTypes, jobType)
+
+	// Changing the script should produce a new etag.
+	changedScript := `const JOB_TYPE = {
+    label: "Hot Reloaded",
+    settings: [],
+};
+function compileJob(job) {}
+`
+	require.NoError(t, os.WriteFile(scriptPath, []byte(changedScript), 0o644))
+
+	s.reloadIfChanged(&broadcaster)
+	require.Len(t, broadcaster.updates, 2)
+	changedJobType, err := s.GetJobType("hot-reload")
+	require.NoError(t, err)
+	assert.Equal(t, "Hot Reloaded", changedJobType.Label)
+	assert.NotEqual(t, jobType.Etag, changedJobType.Etag)
+
+	// Removing the script should remove the job type.
+	require.NoError(t, os.Remove(scriptPath))
+
+	s.reloadIfChanged(&broadcaster)
+	require.Len(t, broadcaster.updates, 3)
+	_, err = s.GetJobType("hot-reload")
+	assert.ErrorIs(t, err, ErrJobTypeUnknown)
+}
+
+func TestRunChecksOnClock(t *testing.T) {
+	scriptsDir := t.TempDir()
+	initFileLoader()
+	defer func(previous fs.FS) { onDiskScriptsFS = previous }(onDiskScriptsFS)
+	onDiskScriptsFS = os.DirFS(scriptsDir)
+
+	mockedClock := clock.NewMock()
+	s, err := Load(mockedClock)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	broadcaster := channelBroadcaster{updates: make(chan api.AvailableJobTypes, 1)}
+	runDone := make(chan struct{})
+	go func() {
+		defer close(runDone)
+		s.Run(ctx, &broadcaster)
+	}()
+	defer func() {
+		cancel()
+		<-runDone
+	}()
+
+	script := `const JOB_TYPE = {
+    label: "Clocked",
+    settings: [],
+};
+function compileJob(job) {}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(scriptsDir, "clocked.js"), []byte(script), 0o644))
+
+	// The scripts directory should be checked whenever the clock says it is
+	// time, and not wait for the actual time to pass. As the watcher may not be
+	// waiting for the clock yet, keep advancing it until the update arrives.
+	timeout := time.After(1 * time.Second)
+	for {
+		mockedClock.Add(scriptsCheckInterval)
+		select {
+		case jobTypes := <-broadcaster.updates:
+			jobType, err := s.GetJobType("clocked")
+			require.NoError(t, err)
+			assert.Contains(t, jobTypes.JobTypes, jobType)
+			return
+		case <-timeout:
+			t.Fatal("the watcher did not check the scripts directory when the clock advanced")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
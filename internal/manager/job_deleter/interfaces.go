@@ -0,0 +1,53 @@
+package job_deleter
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"time"
+
+	"git.blender.org/flamenco/internal/manager/local_storage"
+	"git.blender.org/flamenco/internal/manager/persistence"
+	"git.blender.org/flamenco/internal/manager/task_state_machine"
+	"git.blender.org/flamenco/internal/manager/webupdates"
+	"git.blender.org/flamenco/pkg/api"
+)
+
+// Generate mock implementations of these interfaces.
+//go:generate go run github.com/golang/mock/mockgen -destination mocks/interfaces_mock.gen.go -package mocks git.blender.org/flamenco/internal/manager/job_deleter PersistenceService,Storage,ChangeBroadcaster,TaskStateMachine
+
+type PersistenceService interface {
+	FetchJob(ctx context.Context, jobUUID string) (*persistence.Job, error)
+	FetchJobsDependingOn(ctx context.Context, job *persistence.Job, jobStatuses ...api.JobStatus) ([]*persistence.Job, error)
+
+	RequestJobDeletion(ctx context.Context, j *persistence.Job) error
+	RequestJobMassDeletion(ctx context.Context, updatedBefore time.Time, jobStatuses ...api.JobStatus) ([]string, error)
+	FetchJobsDeletionRequested(ctx context.Context) ([]string, error)
+
+	DeleteJob(ctx context.Context, jobUUID string) error
+}
+
+// PersistenceService should be a subset of persistence.DB
+var _ PersistenceService = (*persistence.DB)(nil)
+
+type Storage interface {
+	// RemoveJobStorage removes the directory that stores job-related files.
+	RemoveJobStorage(ctx context.Context, jobUUID string) error
+}
+
+var _ Storage = (*local_storage.StorageInfo)(nil)
+
+type TaskStateMachine interface {
+	// CheckJobDependencies releases or fails a waiting job, depending on the status of its dependencies.
+	CheckJobDependencies(ctx context.Context, job *persistence.Job, reason string) error
+}
+
+// TaskStateMachine should be a subset of task_state_machine.StateMachine.
+var _ TaskStateMachine = (*task_state_machine.StateMachine)(nil)
+
+type ChangeBroadcaster interface {
+	BroadcastJobUpdate(jobUpdate api.SocketIOJobUpdate)
+}
+
+// ChangeBroadcaster should be a subset of webupdates.BiDirComms
+var _ ChangeBroadcaster = (*webupdates.BiDirComms)(nil)
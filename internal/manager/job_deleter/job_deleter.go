@@ -0,0 +1,241 @@
+// Package job_deleter removes jobs from the Manager, including their tasks and
+// the files stored in the Manager's local storage.
+//
+// Deletion is a two-step process. First a job is marked as "deletion
+// requested" in the database, after which it is queued for actual deletion.
+// This keeps the API responsive, and makes it possible to pick up pending
+// deletions after a restart of the Manager.
+package job_deleter
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/rs/zerolog/log"
+
+	"git.blender.org/flamenco/internal/manager/persistence"
+	"git.blender.org/flamenco/internal/manager/webupdates"
+	"git.blender.org/flamenco/pkg/api"
+)
+
+const (
+	// jobDeletionQueueSize determines how many job deletions can be queued in
+	// memory. Jobs that do not fit in the queue are still marked for deletion,
+	// and will be picked up by the next periodic check.
+	jobDeletionQueueSize = 100
+
+	// periodicCheckInterval determines how often the job retention is checked,
+	// and pending job deletions are re-queued.
+	periodicCheckInterval = 1 * time.Hour
+)
+
+var (
+	// massDeletionJobStatuses are the statuses of jobs that are considered
+	// 'finished' for mass deletion via the API.
+	massDeletionJobStatuses = []api.JobStatus{
+		api.JobStatusCanceled,
+		api.JobStatusCompleted,
+		api.JobStatusFailed,
+	}
+
+	// retentionJobStatuses are the statuses of jobs that are automatically
+	// deleted when they are older than the configured retention period.
+	retentionJobStatuses = []api.JobStatus{
+		api.JobStatusCanceled,
+		api.JobStatusCompleted,
+	}
+)
+
+// Service deletes jobs, and periodically purges old finished jobs.
+type Service struct {
+	clock        clock.Clock
+	persist      PersistenceService
+	storage      Storage
+	broadcaster  ChangeBroadcaster
+	stateMachine TaskStateMachine
+
+	// retention determines how long finished jobs are kept before they are
+	// deleted automatically. Zero means "forever".
+	retention time.Duration
+
+	queue chan string // UUIDs of jobs to delete.
+}
+
+// New creates a new job deletion service.
+func New(
+	clock clock.Clock,
+	persist PersistenceService,
+	storage Storage,
+	broadcaster ChangeBroadcaster,
+	stateMachine TaskStateMachine,
+	retention time.Duration,
+) *Service {
+	return &Service{
+		clock:        clock,
+		persist:      persist,
+		storage:      storage,
+		broadcaster:  broadcaster,
+		stateMachine: stateMachine,
+		retention:    retention,
+		queue:        make(chan string, jobDeletionQueueSize),
+	}
+}
+
+// QueueJobDeletion marks the job for deletion, and queues it for actual
+// deletion in the background.
+func (s *Service) QueueJobDeletion(ctx context.Context, job *persistence.Job) error {
+	logger := log.With().Str("job", job.UUID).Logger()
+	logger.Info().Msg("job deleter: queueing job for deletion")
+
+	if err := s.persist.RequestJobDeletion(ctx, job); err != nil {
+		return fmt.Errorf("marking job %s for deletion: %w", job.UUID, err)
+	}
+
+	s.queueJobUUID(job.UUID)
+	return nil
+}
+
+// QueueMassJobDeletion marks all finished jobs that were last updated before
+// the given timestamp for deletion, and queues them for actual deletion in the
+// background. Returns the UUIDs of those jobs.
+func (s *Service) QueueMassJobDeletion(ctx context.Context, finishedBefore time.Time) ([]string, error) {
+	logger := log.With().Time("finishedBefore", finishedBefore).Logger()
+
+	jobUUIDs, err := s.persist.RequestJobMassDeletion(ctx, finishedBefore, massDeletionJobStatuses...)
+	if err != nil {
+		return nil, fmt.Errorf("marking jobs for deletion: %w", err)
+	}
+
+	logger.Info().Int("numJobs", len(jobUUIDs)).Msg("job deleter: queueing jobs for deletion")
+	for _, jobUUID := range jobUUIDs {
+		s.queueJobUUID(jobUUID)
+	}
+	return jobUUIDs, nil
+}
+
+// Run processes the job deletion queue, and periodically checks for jobs that
+// should be deleted. It keeps running until the context closes.
+func (s *Service) Run(ctx context.Context) {
+	log.Info().
+		Str("retention", s.retention.String()).
+		Str("checkInterval", periodicCheckInterval.String()).
+		Msg("job deleter: starting up")
+	defer log.Info().Msg("job deleter: shutting down")
+
+	if s.retention == 0 {
+		log.Info().Msg("job deleter: no job retention period configured, will not automatically delete old jobs")
+	}
+
+	// Pick up any deletions that were still pending from a previous run.
+	s.periodicCheck(ctx)
+
+	ticker := s.clock.Ticker(periodicCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case jobUUID := <-s.queue:
+			s.deleteJob(ctx, jobUUID)
+		case <-ticker.C:
+			s.periodicCheck(ctx)
+		}
+	}
+}
+
+// queueJobUUID queues the job for deletion, without blocking.
+func (s *Service) queueJobUUID(jobUUID string) {
+	select {
+	case s.queue <- jobUUID:
+		log.Debug().Str("job", jobUUID).Msg("job deleter: job queued for deletion")
+	default:
+		log.Debug().Str("job", jobUUID).Msg("job deleter: queue is full, job will be deleted later")
+	}
+}
+
+// periodicCheck marks old jobs for deletion, and (re)queues all jobs that are
+// marked for deletion.
+func (s *Service) periodicCheck(ctx context.Context) {
+	if s.retention > 0 {
+		finishedBefore := s.clock.Now().UTC().Add(-s.retention)
+		jobUUIDs, err := s.persist.RequestJobMassDeletion(ctx, finishedBefore, retentionJobStatuses...)
+		switch {
+		case err != nil:
+			log.Error().Err(err).Msg("job deleter: unable to mark old jobs for deletion")
+		case len(jobUUIDs) > 0:
+			log.Info().
+				Int("numJobs", len(jobUUIDs)).
+				Time("finishedBefore", finishedBefore).
+				Msg("job deleter: marked old jobs for deletion")
+		}
+	}
+
+	jobUUIDs, err := s.persist.FetchJobsDeletionRequested(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("job deleter: unable to find jobs marked for deletion")
+		return
+	}
+	for _, jobUUID := range jobUUIDs {
+		s.queueJobUUID(jobUUID)
+	}
+}
+
+// deleteJob removes the job's files and then the job itself. When any of this
+// fails, the job stays marked for deletion, and deletion is retried on the
+// next periodic check.
+func (s *Service) deleteJob(ctx context.Context, jobUUID string) {
+	logger := log.With().Str("job", jobUUID).Logger()
+
+	job, err := s.persist.FetchJob(ctx, jobUUID)
+	switch {
+	case errors.Is(err, persistence.ErrJobNotFound):
+		// The job may have been queued multiple times; this is fine.
+		logger.Debug().Msg("job deleter: job was already deleted")
+		return
+	case err != nil:
+		logger.Error().Err(err).Msg("job deleter: unable to fetch job")
+		return
+	case !job.DeleteRequestedAt.Valid:
+		logger.Warn().Msg("job deleter: job was not marked for deletion, refusing to delete it")
+		return
+	}
+
+	if err := s.storage.RemoveJobStorage(ctx, jobUUID); err != nil {
+		logger.Error().Err(err).Msg("job deleter: unable to remove job files, will retry later")
+		return
+	}
+
+	// Jobs waiting for this one have to be re-checked after the deletion, as
+	// their dependency on this job will be gone.
+	dependents, err := s.persist.FetchJobsDependingOn(ctx, job, api.JobStatusWaiting)
+	if err != nil {
+		logger.Error().Err(err).Msg("job deleter: unable to fetch jobs depending on this job, will retry later")
+		return
+	}
+
+	if err := s.persist.DeleteJob(ctx, jobUUID); err != nil {
+		logger.Error().Err(err).Msg("job deleter: unable to remove job from the database, will retry later")
+		return
+	}
+
+	wasDeleted := true
+	jobUpdate := webupdates.NewJobUpdate(job)
+	jobUpdate.WasDeleted = &wasDeleted
+	s.broadcaster.BroadcastJobUpdate(jobUpdate)
+
+	logger.Info().Msg("job deleter: job deleted")
+
+	reason := fmt.Sprintf("job %s it depends on was deleted", jobUUID)
+	for _, dependent := range dependents {
+		if err := s.stateMachine.CheckJobDependencies(ctx, dependent, reason); err != nil {
+			logger.Error().Err(err).Str("dependent", dependent.UUID).
+				Msg("job deleter: unable to re-check dependencies of job depending on the deleted job")
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package job_deleter
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"git.blender.org/flamenco/internal/manager/job_deleter/mocks"
+	"git.blender.org/flamenco/internal/manager/persistence"
+	"git.blender.org/flamenco/pkg/api"
+)
+
+type JobDeleterMocks struct {
+	clock        *clock.Mock
+	persist      *mocks.MockPersistenceService
+	storage      *mocks.MockStorage
+	broadcaster  *mocks.MockChangeBroadcaster
+	stateMachine *mocks.MockTaskStateMachine
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func jobDeleterTestFixtures(t *testing.T, retention time.Duration) (*Service, func(), *JobDeleterMocks) {
+	mockCtrl := gomock.NewController(t)
+
+	mocks := &JobDeleterMocks{
+		clock:        clock.NewMock(),
+		persist:      mocks.NewMockPersistenceService(mockCtrl),
+		storage:      mocks.NewMockStorage(mockCtrl),
+		broadcaster:  mocks.NewMockChangeBroadcaster(mockCtrl),
+		stateMachine: mocks.NewMockTaskStateMachine(mockCtrl),
+	}
+
+	// Use a timezone other than UTC, as timestamps are compared against the
+	// ones in the database, which are in UTC.
+	mockedNow, err := time.Parse(time.RFC3339, "2022-09-15T11:14:41+02:00")
+	if err != nil {
+		panic(err)
+	}
+	mocks.clock.Set(mockedNow)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mocks.ctx = ctx
+	mocks.cancel = cancel
+
+	// This should be called at the end of each unit test.
+	finish := func() {
+		mocks.cancel()
+		mockCtrl.Finish()
+	}
+
+	s := New(mocks.clock, mocks.persist, mocks.storage, mocks.broadcaster, mocks.stateMachine, retention)
+	return s, finish, mocks
+}
+
+func TestQueueJobDeletion(t *testing.T) {
+	s, finish, mocks := jobDeleterTestFixtures(t, 0)
+	defer finish()
+
+	job := persistence.Job{UUID: "2f7d910f-08a6-4b0f-8ecb-b3946939ed1b"}
+	mocks.persist.EXPECT().RequestJobDeletion(mocks.ctx, &job)
+	assert.NoError(t, s.QueueJobDeletion(mocks.ctx, &job))
+
+	select {
+	case queuedUUID := <-s.queue:
+		assert.Equal(t, job.UUID, queuedUUID)
+	default:
+		t.Fatal("job should have been queued")
+	}
+
+	// Errors marking the job should not queue the job.
+	mocks.persist.EXPECT().RequestJobDeletion(mocks.ctx, &job).Return(errors.New("mocked DB failure"))
+	assert.Error(t, s.QueueJobDeletion(mocks.ctx, &job))
+	assert.Len(t, s.queue, 0)
+}
+
+func TestQueueMassJobDeletion(t *testing.T) {
+	s, finish, mocks := jobDeleterTestFixtures(t, 0)
+	defer finish()
+
+	finishedBefore := mocks.clock.Now().Add(-24 * time.Hour)
+	jobUUIDs := []string{"2f7d910f-08a6-4b0f-8ecb-b3946939ed1b", "e4c7d2d8-8b51-4a6f-9e2b-b8c0e28c6b4c"}
+	mocks.persist.EXPECT().RequestJobMassDeletion(mocks.ctx, finishedBefore,
+		api.JobStatusCanceled, api.JobStatusCompleted, api.JobStatusFailed).
+		Return(jobUUIDs, nil)
+
+	queuedUUIDs, err := s.QueueMassJobDeletion(mocks.ctx, finishedBefore)
+	assert.NoError(t, err)
+	assert.Equal(t, jobUUIDs, queuedUUIDs)
+	assert.Len(t, s.queue, 2)
+}
+
+func TestPeriodicCheck(t *testing.T) {
+	retention := 14 * 24 * time.Hour
+	s, finish, mocks := jobDeleterTestFixtures(t, retention)
+	defer finish()
+
+	jobUUIDs := []string{"2f7d910f-08a6-4b0f-8ecb-b3946939ed1b", "e4c7d2d8-8b51-4a6f-9e2b-b8c0e28c6b4c"}
+	mocks.persist.EXPECT().RequestJobMassDeletion(mocks.ctx, mocks.clock.Now().UTC().Add(-retention),
+		api.JobStatusCanceled, api.JobStatusCompleted).
+		Return(jobUUIDs[1:], nil)
+	mocks.persist.EXPECT().FetchJobsDeletionRequested(mocks.ctx).Return(jobUUIDs, nil)
+
+	s.periodicCheck(mocks.ctx)
+	assert.Len(t, s.queue, 2)
+}
+
+func TestPeriodicCheckNoRetention(t *testing.T) {
+	s, finish, mocks := jobDeleterTestFixtures(t, 0)
+	defer finish()
+
+	// Without retention, only the already-marked jobs should be queued.
+	mocks.persist.EXPECT().FetchJobsDeletionRequested(mocks.ctx).Return([]string{}, nil)
+	s.periodicCheck(mocks.ctx)
+	assert.Len(t, s.queue, 0)
+}
+
+func TestDeleteJob(t *testing.T) {
+	s, finish, mocks := jobDeleterTestFixtures(t, 0)
+	defer finish()
+
+	job := persistence.Job{
+		UUID:    "2f7d910f-08a6-4b0f-8ecb-b3946939ed1b",
+		Name:    "сцена/shot/010_03_A",
+		JobType: "simple-blender-render",
+		Status:  api.JobStatusCompleted,
+		DeleteRequestedAt: sql.NullTime{
+			Time:  mocks.clock.Now(),
+			Valid: true,
+		},
+	}
+	dependent := persistence.Job{
+		UUID:   "e6a3ad2b-4b7a-4ef6-8c3b-4a0d0f8c1e65",
+		Status: api.JobStatusWaiting,
+	}
+	wasDeleted := true
+
+	mocks.persist.EXPECT().FetchJob(mocks.ctx, job.UUID).Return(&job, nil)
+	mocks.storage.EXPECT().RemoveJobStorage(mocks.ctx, job.UUID)
+	mocks.persist.EXPECT().
+		FetchJobsDependingOn(mocks.ctx, &job, api.JobStatusWaiting).
+		Return([]*persistence.Job{&dependent}, nil)
+	mocks.persist.EXPECT().DeleteJob(mocks.ctx, job.UUID)
+	mocks.broadcaster.EXPECT().BroadcastJobUpdate(api.SocketIOJobUpdate{
+		Id:         job.UUID,
+		Name:       &job.Name,
+		Status:     job.Status,
+		Type:       job.JobType,
+		WasDeleted: &wasDeleted,
+	})
+
+	// The job waiting for the deleted one should be re-checked.
+	mocks.stateMachine.EXPECT().CheckJobDependencies(mocks.ctx, &dependent,
+		"job 2f7d910f-08a6-4b0f-8ecb-b3946939ed1b it depends on was deleted")
+
+	s.deleteJob(mocks.ctx, job.UUID)
+}
+
+func TestDeleteJobNotMarked(t *testing.T) {
+	s, finish, mocks := jobDeleterTestFixtures(t, 0)
+	defer finish()
+
+	job := persistence.Job{UUID: "2f7d910f-08a6-4b0f-8ecb-b3946939ed1b"}
+
+	// A job that's not marked for deletion should not be deleted.
+	mocks.persist.EXPECT().FetchJob(mocks.ctx, job.UUID).Return(&job, nil)
+	s.deleteJob(mocks.ctx, job.UUID)
+
+	// A job that no longer exists should not be deleted again.
+	mocks.persist.EXPECT().FetchJob(mocks.ctx, job.UUID).Return(nil, persistence.ErrJobNotFound)
+	s.deleteJob(mocks.ctx, job.UUID)
+}
+
+func TestDeleteJobStorageError(t *testing.T) {
+	s, finish, mocks := jobDeleterTestFixtures(t, 0)
+	defer finish()
+
+	job := persistence.Job{
+		UUID:              "2f7d910f-08a6-4b0f-8ecb-b3946939ed1b",
+		DeleteRequestedAt: sql.NullTime{Time: mocks.clock.Now(), Valid: true},
+	}
+
+	// When the files cannot be removed, the job should stay in the database.
+	mocks.persist.EXPECT().FetchJob(mocks.ctx, job.UUID).Return(&job, nil)
+	mocks.storage.EXPECT().RemoveJobStorage(mocks.ctx, job.UUID).Return(errors.New("permission denied"))
+	s.deleteJob(mocks.ctx, job.UUID)
+}
@@ -3,6 +3,8 @@ package local_storage
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -37,6 +39,29 @@ func (si StorageInfo) ForJob(jobUUID string) string {
 	return filepath.Join(si.rootPath, relPathForJob(jobUUID))
 }
 
+// RemoveJobStorage removes the directory that stores job-related files.
+// It is not an error when the directory does not exist.
+func (si StorageInfo) RemoveJobStorage(ctx context.Context, jobUUID string) error {
+	if jobUUID == "" {
+		return errors.New("RemoveJobStorage(): refusing to remove storage of job without UUID")
+	}
+	if si.rootPath == "" {
+		return fmt.Errorf("%+v.RemoveJobStorage(): refusing to remove job directory in empty root", si)
+	}
+
+	path := si.ForJob(jobUUID)
+	log.Ctx(ctx).Debug().Str("path", path).Msg("removing job storage directory")
+	if err := os.RemoveAll(path); err != nil {
+		return fmt.Errorf("removing job storage directory %s: %w", path, err)
+	}
+
+	// Also remove the parent directory (`job-xxxx`) when it is empty. Failure to
+	// do so is not an error, as it is very likely that other jobs still use it.
+	_ = os.Remove(filepath.Dir(path))
+
+	return nil
+}
+
 // Erase removes the entire storage directory from disk.
 func (si StorageInfo) Erase() error {
 	// A few safety measures before erasing the planet.
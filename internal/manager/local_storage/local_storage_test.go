@@ -3,6 +3,7 @@ package local_storage
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
@@ -56,3 +57,31 @@ func TestErase(t *testing.T) {
 	assert.NoError(t, si.Erase())
 	assert.NoDirExists(t, si.rootPath, "Erase() should erase the root path, and everything in it")
 }
+
+func TestRemoveJobStorage(t *testing.T) {
+	si := NewNextToExe("task-logs")
+	defer si.MustErase()
+
+	jobUUID := "08e126ef-d773-468b-8bab-19a8213cf2ff"
+	otherJobUUID := "08e17afe-b5d4-4a2a-8d1b-a2d4f7a1e5bb"
+
+	jobPath := si.ForJob(jobUUID)
+	otherJobPath := si.ForJob(otherJobUUID)
+	assert.NoError(t, os.MkdirAll(jobPath, os.ModePerm))
+	assert.NoError(t, os.MkdirAll(otherJobPath, os.ModePerm))
+	assert.NoError(t, os.WriteFile(filepath.Join(jobPath, "task-1.txt"), []byte("log"), os.ModePerm))
+
+	ctx := context.Background()
+	assert.NoError(t, si.RemoveJobStorage(ctx, jobUUID))
+	assert.NoDirExists(t, jobPath)
+	assert.DirExists(t, otherJobPath, "other jobs should not be touched")
+
+	// The now-empty parent directory should be removed as well.
+	assert.NoError(t, si.RemoveJobStorage(ctx, otherJobUUID))
+	assert.NoDirExists(t, filepath.Dir(otherJobPath))
+
+	// Removing non-existent job storage is fine.
+	assert.NoError(t, si.RemoveJobStorage(ctx, jobUUID))
+
+	assert.Error(t, si.RemoveJobStorage(ctx, ""))
+}
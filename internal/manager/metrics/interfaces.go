@@ -0,0 +1,31 @@
+package metrics
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+
+	"git.blender.org/flamenco/internal/manager/api_impl"
+	"git.blender.org/flamenco/internal/manager/persistence"
+	"git.blender.org/flamenco/pkg/api"
+)
+
+// Generate mock implementations of these interfaces.
+//go:generate go run github.com/golang/mock/mockgen -destination mocks/interfaces_mock.gen.go -package mocks git.blender.org/flamenco/internal/manager/metrics PersistenceService,Shaman
+
+type PersistenceService interface {
+	SummarizeJobStatuses(ctx context.Context) (map[api.JobStatus]int, error)
+	SummarizeTaskStatuses(ctx context.Context) (map[api.TaskStatus]int, error)
+	SummarizeWorkerStatuses(ctx context.Context) (map[api.WorkerStatus]int, error)
+}
+
+// PersistenceService should be a subset of persistence.DB
+var _ PersistenceService = (*persistence.DB)(nil)
+
+type Shaman interface {
+	IsEnabled() bool
+	FileStoreSize() (int64, error)
+}
+
+// Shaman should be a subset of api_impl.Shaman
+var _ Shaman = (api_impl.Shaman)(nil)
@@ -0,0 +1,256 @@
+// Package metrics exposes Manager statistics in the Prometheus format.
+//
+// Most metrics are gathered when they are requested, directly from the
+// database. Task updates are counted by an Echo middleware, and task scheduling
+// is timed by wrapping the persistence layer.
+package metrics
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog/log"
+
+	"git.blender.org/flamenco/internal/manager/api_impl"
+	"git.blender.org/flamenco/internal/manager/persistence"
+)
+
+const (
+	namespace = "flamenco_manager"
+
+	// collectTimeout is the maximum duration of the database queries for a
+	// single scrape of the metrics.
+	collectTimeout = 5 * time.Second
+
+	// shamanSizeCacheDuration determines how long the size of the Shaman file
+	// store is cached. Determining it requires walking the entire store, which
+	// is too expensive to do on every scrape.
+	shamanSizeCacheDuration = 5 * time.Minute
+
+	// Route of the operation that is counted. This is the path as registered
+	// with Echo, and not the actual URL.
+	routeTaskUpdate = "/api/v3/worker/task/:task_id"
+
+	// Results of scheduling a task, used as label values.
+	scheduleResultAssigned = "assigned"
+	scheduleResultNoTask   = "no_task"
+	scheduleResultError    = "error"
+)
+
+// Metrics gathers the Manager statistics, and serves them over HTTP.
+type Metrics struct {
+	clock    clock.Clock
+	persist  PersistenceService
+	shaman   Shaman
+	registry *prometheus.Registry
+
+	jobsDesc         *prometheus.Desc
+	tasksDesc        *prometheus.Desc
+	workersDesc      *prometheus.Desc
+	databaseBusyDesc *prometheus.Desc
+	shamanSizeDesc   *prometheus.Desc
+
+	scheduleTaskDuration *prometheus.HistogramVec
+	taskUpdates          *prometheus.CounterVec
+
+	shamanSizeMutex     sync.Mutex
+	shamanSize          int64
+	shamanSizeTimestamp time.Time
+}
+
+// New creates the metrics service, and registers all metrics.
+func New(clock clock.Clock, persist PersistenceService, shaman Shaman) *Metrics {
+	m := &Metrics{
+		clock:    clock,
+		persist:  persist,
+		shaman:   shaman,
+		registry: prometheus.NewRegistry(),
+
+		jobsDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "jobs"),
+			"Number of jobs per status.",
+			[]string{"status"}, nil),
+		tasksDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "tasks"),
+			"Number of tasks per status.",
+			[]string{"status"}, nil),
+		workersDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "workers"),
+			"Number of workers per status.",
+			[]string{"status"}, nil),
+		databaseBusyDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "database", "busy_total"),
+			"Number of times the database was busy, causing the request to be retried later.",
+			nil, nil),
+		shamanSizeDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "shaman", "file_store_bytes"),
+			"Total size of the files in the Shaman file store.",
+			nil, nil),
+
+		scheduleTaskDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "schedule_task_duration_seconds",
+			Help:      "Duration of the database query that finds a task for a Worker, by result.",
+			Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		}, []string{"result"}),
+		taskUpdates: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "task_updates_total",
+			Help:      "Number of task updates received from Workers, by HTTP status code of the response.",
+		}, []string{"code"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m,
+		m.scheduleTaskDuration,
+		m.taskUpdates,
+	)
+	return m
+}
+
+// RegisterHandlers registers the /metrics endpoint. Use Middleware() to also
+// count task updates.
+func (m *Metrics) RegisterHandlers(router *echo.Echo) {
+	router.GET("/metrics", echo.WrapHandler(m.Handler()))
+}
+
+// Handler returns the HTTP handler that serves the metrics.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// Middleware counts task updates.
+func (m *Metrics) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if c.Request().Method != http.MethodPost || c.Path() != routeTaskUpdate {
+			return next(c)
+		}
+
+		err := next(c)
+		m.taskUpdates.WithLabelValues(responseCode(c)).Inc()
+		return err
+	}
+}
+
+// TimeScheduleTask returns the persistence service, with its ScheduleTask()
+// function timed. Timing this, instead of the HTTP request, keeps the time a
+// Worker spends waiting for a task out of the measurement.
+func (m *Metrics) TimeScheduleTask(persist api_impl.PersistenceService) api_impl.PersistenceService {
+	return &timedPersistence{PersistenceService: persist, metrics: m}
+}
+
+type timedPersistence struct {
+	api_impl.PersistenceService
+	metrics *Metrics
+}
+
+func (tp *timedPersistence) ScheduleTask(
+	ctx context.Context, w *persistence.Worker, runningTaskUUIDs ...string,
+) (*persistence.Task, error) {
+	startTime := tp.metrics.clock.Now()
+	task, err := tp.PersistenceService.ScheduleTask(ctx, w, runningTaskUUIDs...)
+	duration := tp.metrics.clock.Since(startTime)
+
+	var result string
+	switch {
+	case err != nil:
+		result = scheduleResultError
+	case task == nil:
+		result = scheduleResultNoTask
+	default:
+		result = scheduleResultAssigned
+	}
+	tp.metrics.scheduleTaskDuration.WithLabelValues(result).Observe(duration.Seconds())
+
+	return task, err
+}
+
+func responseCode(c echo.Context) string {
+	return strconv.Itoa(c.Response().Status)
+}
+
+// Describe implements the prometheus.Collector interface.
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- m.jobsDesc
+	ch <- m.tasksDesc
+	ch <- m.workersDesc
+	ch <- m.databaseBusyDesc
+	ch <- m.shamanSizeDesc
+}
+
+// Collect implements the prometheus.Collector interface.
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	if jobStatuses, err := m.persist.SummarizeJobStatuses(ctx); err != nil {
+		log.Error().Err(err).Msg("metrics: unable to count jobs per status")
+		ch <- prometheus.NewInvalidMetric(m.jobsDesc, err)
+	} else {
+		for status, count := range jobStatuses {
+			ch <- prometheus.MustNewConstMetric(m.jobsDesc, prometheus.GaugeValue, float64(count), string(status))
+		}
+	}
+
+	if taskStatuses, err := m.persist.SummarizeTaskStatuses(ctx); err != nil {
+		log.Error().Err(err).Msg("metrics: unable to count tasks per status")
+		ch <- prometheus.NewInvalidMetric(m.tasksDesc, err)
+	} else {
+		for status, count := range taskStatuses {
+			ch <- prometheus.MustNewConstMetric(m.tasksDesc, prometheus.GaugeValue, float64(count), string(status))
+		}
+	}
+
+	if workerStatuses, err := m.persist.SummarizeWorkerStatuses(ctx); err != nil {
+		log.Error().Err(err).Msg("metrics: unable to count workers per status")
+		ch <- prometheus.NewInvalidMetric(m.workersDesc, err)
+	} else {
+		for status, count := range workerStatuses {
+			ch <- prometheus.MustNewConstMetric(m.workersDesc, prometheus.GaugeValue, float64(count), string(status))
+		}
+	}
+
+	ch <- prometheus.MustNewConstMetric(m.databaseBusyDesc, prometheus.CounterValue,
+		float64(persistence.DatabaseBusyCount()))
+
+	if m.shaman.IsEnabled() {
+		if size, err := m.shamanFileStoreSize(); err != nil {
+			log.Error().Err(err).Msg("metrics: unable to determine size of the Shaman file store")
+			ch <- prometheus.NewInvalidMetric(m.shamanSizeDesc, err)
+		} else {
+			ch <- prometheus.MustNewConstMetric(m.shamanSizeDesc, prometheus.GaugeValue, float64(size))
+		}
+	}
+}
+
+// shamanFileStoreSize returns the size of the Shaman file store. The size is
+// cached for a while, as it is expensive to determine.
+func (m *Metrics) shamanFileStoreSize() (int64, error) {
+	m.shamanSizeMutex.Lock()
+	defer m.shamanSizeMutex.Unlock()
+
+	now := m.clock.Now()
+	if !m.shamanSizeTimestamp.IsZero() && now.Sub(m.shamanSizeTimestamp) < shamanSizeCacheDuration {
+		return m.shamanSize, nil
+	}
+
+	size, err := m.shaman.FileStoreSize()
+	if err != nil {
+		return 0, err
+	}
+	m.shamanSize = size
+	m.shamanSizeTimestamp = now
+	return size, nil
+}
@@ -0,0 +1,226 @@
+package metrics
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	api_impl_mocks "git.blender.org/flamenco/internal/manager/api_impl/mocks"
+	"git.blender.org/flamenco/internal/manager/metrics/mocks"
+	"git.blender.org/flamenco/internal/manager/persistence"
+	"git.blender.org/flamenco/pkg/api"
+)
+
+type MetricsMocks struct {
+	clock   *clock.Mock
+	persist *mocks.MockPersistenceService
+	shaman  *mocks.MockShaman
+}
+
+func metricsTestFixtures(t *testing.T) (*Metrics, func(), *MetricsMocks) {
+	mockCtrl := gomock.NewController(t)
+
+	mocks := &MetricsMocks{
+		clock:   clock.NewMock(),
+		persist: mocks.NewMockPersistenceService(mockCtrl),
+		shaman:  mocks.NewMockShaman(mockCtrl),
+	}
+
+	mockedNow, err := time.Parse(time.RFC3339, "2022-09-15T11:14:41+02:00")
+	if err != nil {
+		panic(err)
+	}
+	mocks.clock.Set(mockedNow)
+
+	m := New(mocks.clock, mocks.persist, mocks.shaman)
+	return m, mockCtrl.Finish, mocks
+}
+
+func (mocks *MetricsMocks) expectSummaries() {
+	mocks.persist.EXPECT().SummarizeJobStatuses(gomock.Any()).Return(map[api.JobStatus]int{
+		api.JobStatusActive:    1,
+		api.JobStatusCompleted: 3,
+	}, nil)
+	mocks.persist.EXPECT().SummarizeTaskStatuses(gomock.Any()).Return(map[api.TaskStatus]int{
+		api.TaskStatusActive: 2,
+		api.TaskStatusQueued: 47,
+	}, nil)
+	mocks.persist.EXPECT().SummarizeWorkerStatuses(gomock.Any()).Return(map[api.WorkerStatus]int{
+		api.WorkerStatusAwake: 2,
+		api.WorkerStatusError: 1,
+	}, nil)
+}
+
+func TestCollect(t *testing.T) {
+	m, finish, mocks := metricsTestFixtures(t)
+	defer finish()
+
+	mocks.expectSummaries()
+	mocks.shaman.EXPECT().IsEnabled().Return(true)
+	mocks.shaman.EXPECT().FileStoreSize().Return(int64(1024), nil)
+
+	expect := `
+# HELP flamenco_manager_jobs Number of jobs per status.
+# TYPE flamenco_manager_jobs gauge
+flamenco_manager_jobs{status="active"} 1
+flamenco_manager_jobs{status="completed"} 3
+# HELP flamenco_manager_tasks Number of tasks per status.
+# TYPE flamenco_manager_tasks gauge
+flamenco_manager_tasks{status="active"} 2
+flamenco_manager_tasks{status="queued"} 47
+# HELP flamenco_manager_workers Number of workers per status.
+# TYPE flamenco_manager_workers gauge
+flamenco_manager_workers{status="awake"} 2
+flamenco_manager_workers{status="error"} 1
+# HELP flamenco_manager_shaman_file_store_bytes Total size of the files in the Shaman file store.
+# TYPE flamenco_manager_shaman_file_store_bytes gauge
+flamenco_manager_shaman_file_store_bytes 1024
+`
+	err := testutil.CollectAndCompare(m, strings.NewReader(expect),
+		"flamenco_manager_jobs",
+		"flamenco_manager_tasks",
+		"flamenco_manager_workers",
+		"flamenco_manager_shaman_file_store_bytes",
+	)
+	assert.NoError(t, err)
+}
+
+func TestCollectShamanDisabled(t *testing.T) {
+	m, finish, mocks := metricsTestFixtures(t)
+	defer finish()
+
+	mocks.expectSummaries()
+	mocks.shaman.EXPECT().IsEnabled().Return(false)
+
+	assert.Equal(t, 0, testutil.CollectAndCount(m, "flamenco_manager_shaman_file_store_bytes"))
+}
+
+func TestShamanFileStoreSizeCached(t *testing.T) {
+	m, finish, mocks := metricsTestFixtures(t)
+	defer finish()
+
+	mocks.shaman.EXPECT().FileStoreSize().Return(int64(1024), nil)
+	size, err := m.shamanFileStoreSize()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1024), size)
+
+	// Within the cache duration, the Shaman should not be asked again.
+	mocks.clock.Add(shamanSizeCacheDuration - time.Second)
+	size, err = m.shamanFileStoreSize()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1024), size)
+
+	// After that, the size should be refreshed.
+	mocks.clock.Add(time.Second)
+	mocks.shaman.EXPECT().FileStoreSize().Return(int64(2048), nil)
+	size, err = m.shamanFileStoreSize()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2048), size)
+
+	// Errors should not be cached.
+	mocks.clock.Add(shamanSizeCacheDuration)
+	mocks.shaman.EXPECT().FileStoreSize().Return(int64(0), errors.New("mocked error"))
+	_, err = m.shamanFileStoreSize()
+	assert.Error(t, err)
+}
+
+func TestMiddleware(t *testing.T) {
+	m, finish, mocks := metricsTestFixtures(t)
+	defer finish()
+
+	e := echo.New()
+	e.Use(m.Middleware)
+	m.RegisterHandlers(e)
+	e.POST("/api/v3/worker/task", func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	})
+	e.POST(routeTaskUpdate, func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	doRequest := func(method, path string) int {
+		req := httptest.NewRequest(method, path, nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusNoContent, doRequest(http.MethodPost, "/api/v3/worker/task"))
+	assert.Equal(t, http.StatusNoContent, doRequest(http.MethodPost, "/api/v3/worker/task/e7632d62-c3b8-4af0-9e78-01752928952c"))
+	assert.Equal(t, http.StatusNoContent, doRequest(http.MethodPost, "/api/v3/worker/task/e7632d62-c3b8-4af0-9e78-01752928952c"))
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.taskUpdates.WithLabelValues("204")))
+
+	// The metrics endpoint itself should also work.
+	mocks.expectSummaries()
+	mocks.shaman.EXPECT().IsEnabled().Return(false)
+	assert.Equal(t, http.StatusOK, doRequest(http.MethodGet, "/metrics"))
+}
+
+func TestTimeScheduleTask(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	m, finish, mocks := metricsTestFixtures(t)
+	defer finish()
+
+	persist := api_impl_mocks.NewMockPersistenceService(mockCtrl)
+	timed := m.TimeScheduleTask(persist)
+
+	ctx := context.Background()
+	worker := persistence.Worker{UUID: "ef7c6f3d-4b5c-4b47-98bb-1b6c8b0ba2f4"}
+	task := persistence.Task{UUID: "e7632d62-c3b8-4af0-9e78-01752928952c"}
+
+	persist.EXPECT().ScheduleTask(ctx, &worker, "running-task").
+		DoAndReturn(func(context.Context, *persistence.Worker, ...string) (*persistence.Task, error) {
+			mocks.clock.Add(50 * time.Millisecond)
+			return &task, nil
+		})
+	foundTask, err := timed.ScheduleTask(ctx, &worker, "running-task")
+	assert.NoError(t, err)
+	assert.Equal(t, &task, foundTask)
+
+	expect := `
+# HELP flamenco_manager_schedule_task_duration_seconds Duration of the database query that finds a task for a Worker, by result.
+# TYPE flamenco_manager_schedule_task_duration_seconds histogram
+flamenco_manager_schedule_task_duration_seconds_bucket{result="assigned",le="0.005"} 0
+flamenco_manager_schedule_task_duration_seconds_bucket{result="assigned",le="0.01"} 0
+flamenco_manager_schedule_task_duration_seconds_bucket{result="assigned",le="0.025"} 0
+flamenco_manager_schedule_task_duration_seconds_bucket{result="assigned",le="0.05"} 1
+flamenco_manager_schedule_task_duration_seconds_bucket{result="assigned",le="0.1"} 1
+flamenco_manager_schedule_task_duration_seconds_bucket{result="assigned",le="0.25"} 1
+flamenco_manager_schedule_task_duration_seconds_bucket{result="assigned",le="0.5"} 1
+flamenco_manager_schedule_task_duration_seconds_bucket{result="assigned",le="1"} 1
+flamenco_manager_schedule_task_duration_seconds_bucket{result="assigned",le="2.5"} 1
+flamenco_manager_schedule_task_duration_seconds_bucket{result="assigned",le="5"} 1
+flamenco_manager_schedule_task_duration_seconds_bucket{result="assigned",le="10"} 1
+flamenco_manager_schedule_task_duration_seconds_bucket{result="assigned",le="+Inf"} 1
+flamenco_manager_schedule_task_duration_seconds_sum{result="assigned"} 0.05
+flamenco_manager_schedule_task_duration_seconds_count{result="assigned"} 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(m.scheduleTaskDuration, strings.NewReader(expect)))
+
+	// Not finding a task, and failing to find one, should be timed separately.
+	persist.EXPECT().ScheduleTask(ctx, &worker).Return(nil, nil)
+	foundTask, err = timed.ScheduleTask(ctx, &worker)
+	assert.NoError(t, err)
+	assert.Nil(t, foundTask)
+
+	persist.EXPECT().ScheduleTask(ctx, &worker).Return(nil, errors.New("mocked error"))
+	_, err = timed.ScheduleTask(ctx, &worker)
+	assert.Error(t, err)
+
+	assert.Equal(t, 3, testutil.CollectAndCount(m.scheduleTaskDuration))
+}
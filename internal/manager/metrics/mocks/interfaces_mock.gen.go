@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: git.blender.org/flamenco/internal/manager/metrics (interfaces: PersistenceService,Shaman)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	api "git.blender.org/flamenco/pkg/api"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPersistenceService is a mock of PersistenceService interface.
+type MockPersistenceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPersistenceServiceMockRecorder
+}
+
+// MockPersistenceServiceMockRecorder is the mock recorder for MockPersistenceService.
+type MockPersistenceServiceMockRecorder struct {
+	mock *MockPersistenceService
+}
+
+// NewMockPersistenceService creates a new mock instance.
+func NewMockPersistenceService(ctrl *gomock.Controller) *MockPersistenceService {
+	mock := &MockPersistenceService{ctrl: ctrl}
+	mock.recorder = &MockPersistenceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPersistenceService) EXPECT() *MockPersistenceServiceMockRecorder {
+	return m.recorder
+}
+
+// SummarizeJobStatuses mocks base method.
+func (m *MockPersistenceService) SummarizeJobStatuses(arg0 context.Context) (map[api.JobStatus]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummarizeJobStatuses", arg0)
+	ret0, _ := ret[0].(map[api.JobStatus]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummarizeJobStatuses indicates an expected call of SummarizeJobStatuses.
+func (mr *MockPersistenceServiceMockRecorder) SummarizeJobStatuses(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummarizeJobStatuses", reflect.TypeOf((*MockPersistenceService)(nil).SummarizeJobStatuses), arg0)
+}
+
+// SummarizeTaskStatuses mocks base method.
+func (m *MockPersistenceService) SummarizeTaskStatuses(arg0 context.Context) (map[api.TaskStatus]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummarizeTaskStatuses", arg0)
+	ret0, _ := ret[0].(map[api.TaskStatus]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummarizeTaskStatuses indicates an expected call of SummarizeTaskStatuses.
+func (mr *MockPersistenceServiceMockRecorder) SummarizeTaskStatuses(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummarizeTaskStatuses", reflect.TypeOf((*MockPersistenceService)(nil).SummarizeTaskStatuses), arg0)
+}
+
+// SummarizeWorkerStatuses mocks base method.
+func (m *MockPersistenceService) SummarizeWorkerStatuses(arg0 context.Context) (map[api.WorkerStatus]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummarizeWorkerStatuses", arg0)
+	ret0, _ := ret[0].(map[api.WorkerStatus]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummarizeWorkerStatuses indicates an expected call of SummarizeWorkerStatuses.
+func (mr *MockPersistenceServiceMockRecorder) SummarizeWorkerStatuses(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummarizeWorkerStatuses", reflect.TypeOf((*MockPersistenceService)(nil).SummarizeWorkerStatuses), arg0)
+}
+
+// MockShaman is a mock of Shaman interface.
+type MockShaman struct {
+	ctrl     *gomock.Controller
+	recorder *MockShamanMockRecorder
+}
+
+// MockShamanMockRecorder is the mock recorder for MockShaman.
+type MockShamanMockRecorder struct {
+	mock *MockShaman
+}
+
+// NewMockShaman creates a new mock instance.
+func NewMockShaman(ctrl *gomock.Controller) *MockShaman {
+	mock := &MockShaman{ctrl: ctrl}
+	mock.recorder = &MockShamanMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShaman) EXPECT() *MockShamanMockRecorder {
+	return m.recorder
+}
+
+// FileStoreSize mocks base method.
+func (m *MockShaman) FileStoreSize() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FileStoreSize")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FileStoreSize indicates an expected call of FileStoreSize.
+func (mr *MockShamanMockRecorder) FileStoreSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FileStoreSize", reflect.TypeOf((*MockShaman)(nil).FileStoreSize))
+}
+
+// IsEnabled mocks base method.
+func (m *MockShaman) IsEnabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsEnabled indicates an expected call of IsEnabled.
+func (mr *MockShamanMockRecorder) IsEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEnabled", reflect.TypeOf((*MockShaman)(nil).IsEnabled))
+}
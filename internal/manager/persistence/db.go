@@ -8,18 +8,24 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
-
-	// sqlite "git.blender.org/flamenco/pkg/gorm-modernc-sqlite"
-	"github.com/glebarez/sqlite"
 )
 
 const vacuumPeriod = 1 * time.Hour
 
 // DB provides the database interface.
 type DB struct {
-	gormDB *gorm.DB
+	gormDB  *gorm.DB
+	dialect dbDialect
+	dsn     string
+
+	// migrationBackupDir is where PostgreSQL databases are backed up before
+	// migrating them. SQLite databases are backed up next to the database file.
+	migrationBackupDir string
+
+	schedulingPolicy SchedulingPolicy
 }
 
 // Model contains the common database fields for most model structs.
@@ -32,29 +38,71 @@ type Model struct {
 	UpdatedAt time.Time
 }
 
-func OpenDB(ctx context.Context, dsn string) (*DB, error) {
-	log.Info().Str("dsn", dsn).Msg("opening database")
-
-	db, err := openDB(ctx, dsn)
+// OpenDB opens the database, and migrates it to the latest schema. The
+// database is backed up before migrating; see OpenDBWithoutMigrating() for the
+// meaning of migrationBackupDir.
+func OpenDB(ctx context.Context, dsn, migrationBackupDir string) (*DB, error) {
+	db, err := OpenDBWithoutMigrating(ctx, dsn, migrationBackupDir)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := setBusyTimeout(db.gormDB, 5*time.Second); err != nil {
-		return nil, err
-	}
-
 	// Perfom some maintenance at startup.
 	db.vacuum()
 
+	if err := db.backupBeforeMigrating(ctx); err != nil {
+		return nil, fmt.Errorf("backing up database before migrating it: %w", err)
+	}
 	if err := db.migrate(); err != nil {
 		return nil, err
 	}
-	log.Debug().Msg("database automigration succesful")
+	log.Debug().Msg("database migration succesful")
+
+	return db, nil
+}
+
+// OpenDBWithoutMigrating opens the database without touching its schema. This
+// is for inspecting & rolling back migrations; everything else should use
+// OpenDB().
+//
+// PostgreSQL databases are backed up into migrationBackupDir before migrating
+// them. When it is empty, they are not backed up. SQLite databases are always
+// backed up next to the database file.
+func OpenDBWithoutMigrating(ctx context.Context, dsn, migrationBackupDir string) (*DB, error) {
+	log.Info().Str("dsn", RedactDSN(dsn)).Msg("opening database")
+
+	db, err := openDB(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+	db.migrationBackupDir = migrationBackupDir
+
+	if db.dialect == dialectSQLite {
+		if err := setBusyTimeout(db.gormDB, 5*time.Second); err != nil {
+			return nil, err
+		}
+	}
 
 	return db, nil
 }
 
+// Close closes the connection to the database.
+func (db *DB) Close() error {
+	sqlDB, err := db.gormDB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+// SetClock makes the database use the given clock, instead of the system
+// clock, for the timestamps it stores and the time-based queries it performs.
+func (db *DB) SetClock(c clock.Clock) {
+	db.gormDB.NowFunc = func() time.Time {
+		return c.Now().UTC()
+	}
+}
+
 func openDB(ctx context.Context, dsn string) (*DB, error) {
 	globalLogLevel := log.Logger.GetLevel()
 	dblogger := NewDBLogger(log.Level(globalLogLevel))
@@ -68,16 +116,35 @@ func openDB(ctx context.Context, dsn string) (*DB, error) {
 }
 
 func openDBWithConfig(dsn string, config *gorm.Config) (*DB, error) {
-	dialector := sqlite.Open(dsn)
-	gormDB, err := gorm.Open(dialector, config)
+	dialect := dialectForDSN(dsn)
+	gormDB, err := gorm.Open(dialect.gormDialector(dsn), config)
 	if err != nil {
 		return nil, err
 	}
 
+	db := DB{
+		gormDB:           gormDB,
+		dialect:          dialect,
+		dsn:              dsn,
+		schedulingPolicy: SchedulingPolicyStrict,
+	}
+
+	if dialect == dialectSQLite {
+		if err := setupSQLite(gormDB); err != nil {
+			return nil, err
+		}
+	}
+
+	return &db, nil
+}
+
+// setupSQLite configures the SQLite connection. Other databases handle
+// concurrent connections and foreign keys by themselves.
+func setupSQLite(gormDB *gorm.DB) error {
 	// Use the generic sql.DB interface to set some connection pool options.
 	sqlDB, err := gormDB.DB()
 	if err != nil {
-		return nil, err
+		return err
 	}
 	// Only allow a single database connection, to avoid SQLITE_BUSY errors.
 	// It's not certain that this'll improve the situation, but it's worth a try.
@@ -87,21 +154,17 @@ func openDBWithConfig(dsn string, config *gorm.Config) (*DB, error) {
 	// Enable foreign key checks.
 	log.Trace().Msg("enabling SQLite foreign key checks")
 	if tx := gormDB.Exec("PRAGMA foreign_keys = 1"); tx.Error != nil {
-		return nil, fmt.Errorf("enabling foreign keys: %w", tx.Error)
+		return fmt.Errorf("enabling foreign keys: %w", tx.Error)
 	}
 	var fkEnabled int
 	if tx := gormDB.Raw("PRAGMA foreign_keys").Scan(&fkEnabled); tx.Error != nil {
-		return nil, fmt.Errorf("checking whether the database has foreign key checks enabled: %w", tx.Error)
+		return fmt.Errorf("checking whether the database has foreign key checks enabled: %w", tx.Error)
 	}
 	if fkEnabled == 0 {
 		log.Error().Msg("SQLite database does not want to enable foreign keys, this may cause data loss")
 	}
 
-	db := DB{
-		gormDB: gormDB,
-	}
-
-	return &db, nil
+	return nil
 }
 
 // nowFunc returns 'now' in UTC, so that GORM-managed times (createdAt,
@@ -132,7 +195,12 @@ func (db *DB) PeriodicMaintenanceLoop(ctx context.Context) {
 }
 
 // vacuum executes the SQL "VACUUM" command, and logs any errors.
+// PostgreSQL is left alone, as its autovacuum daemon takes care of this.
 func (db *DB) vacuum() {
+	if db.dialect != dialectSQLite {
+		return
+	}
+
 	tx := db.gormDB.Exec("vacuum")
 	if tx.Error != nil {
 		log.Error().Err(tx.Error).Msg("error vacuuming database")
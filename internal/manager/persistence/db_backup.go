@@ -0,0 +1,272 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// migrationBackupSubdir is the subdirectory of the backup directory, in which
+// PostgreSQL databases are backed up before migrating them.
+const migrationBackupSubdir = "migrations"
+
+var (
+	ErrBackupExists       = errors.New("backup file already exists")
+	ErrBackupInvalid      = errors.New("not a valid Flamenco Manager database backup")
+	ErrRestoreUnsupported = errors.New("restoring backups is only supported for SQLite databases, use pg_restore for PostgreSQL")
+)
+
+// Backup writes a consistent copy of the database to the given path. The
+// database can remain in use while this happens.
+//
+// SQLite databases are copied with `VACUUM INTO`, resulting in a new SQLite
+// file. PostgreSQL databases are dumped with `pg_dump`, which has to be on the
+// $PATH, and can be restored with `pg_restore`.
+func (db *DB) Backup(ctx context.Context, path string) error {
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("%w: %s", ErrBackupExists, path)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("checking backup path %s: %w", path, err)
+	}
+
+	switch db.dialect {
+	case dialectPostgres:
+		return db.backupPostgres(ctx, path)
+	default:
+		return db.backupSQLite(ctx, path)
+	}
+}
+
+func (db *DB) backupSQLite(ctx context.Context, path string) error {
+	if tx := db.gormDB.WithContext(ctx).Exec("VACUUM INTO ?", path); tx.Error != nil {
+		return fmt.Errorf("backing up database to %s: %w", path, tx.Error)
+	}
+	return nil
+}
+
+func (db *DB) backupPostgres(ctx context.Context, path string) error {
+	// Pass the password via the environment, so that it doesn't show up in the
+	// process list.
+	dsnURL, err := url.Parse(db.dsn)
+	if err != nil {
+		return fmt.Errorf("parsing database DSN: %w", err)
+	}
+	env := os.Environ()
+	if password, ok := dsnURL.User.Password(); ok {
+		env = append(env, "PGPASSWORD="+password)
+		dsnURL.User = url.User(dsnURL.User.Username())
+	}
+
+	cmd := exec.CommandContext(ctx, "pg_dump", "--format=custom", "--file", path, dsnURL.String())
+	cmd.Env = env
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("running pg_dump: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// BackupFileExtension returns the file extension for backups of this database,
+// including the leading period.
+func (db *DB) BackupFileExtension() string {
+	switch db.dialect {
+	case dialectPostgres:
+		return ".pgdump"
+	default:
+		return ".sqlite"
+	}
+}
+
+// ValidateBackup checks that the SQLite file is a Flamenco Manager database
+// that this Manager can use, and returns its schema version. The file is
+// opened read-only, and is not migrated.
+func ValidateBackup(ctx context.Context, backupPath string) (int, error) {
+	if _, err := os.Stat(backupPath); err != nil {
+		return 0, err
+	}
+
+	db, err := OpenDBWithoutMigrating(ctx, "file:"+backupPath+"?mode=ro", "")
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrBackupInvalid, err)
+	}
+	defer db.Close()
+
+	var integrity string
+	if tx := db.gormDB.WithContext(ctx).Raw("PRAGMA integrity_check").Scan(&integrity); tx.Error != nil {
+		return 0, fmt.Errorf("%w: %v", ErrBackupInvalid, tx.Error)
+	}
+	if integrity != "ok" {
+		return 0, fmt.Errorf("%w: integrity check failed: %s", ErrBackupInvalid, integrity)
+	}
+
+	if !db.gormDB.Migrator().HasTable(&Job{}) {
+		return 0, fmt.Errorf("%w: it has no jobs table", ErrBackupInvalid)
+	}
+
+	version, err := db.readSchemaVersion()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrBackupInvalid, err)
+	}
+	if version > latestSchemaVersion() {
+		return 0, fmt.Errorf("%w: backup is at version %d, this Manager only knows up to version %d",
+			ErrSchemaTooNew, version, latestSchemaVersion())
+	}
+	return version, nil
+}
+
+// RestoreBackup replaces the SQLite database with the backup, after checking
+// that the backup is valid. The current database file is kept next to it, and
+// its new path is returned.
+//
+// The Manager should not be running while a backup is restored.
+func RestoreBackup(ctx context.Context, dsn, backupPath string) (string, error) {
+	if dialectForDSN(dsn) != dialectSQLite {
+		return "", ErrRestoreUnsupported
+	}
+	dbPath := sqliteFilename(dsn)
+	if dbPath == "" {
+		return "", fmt.Errorf("database %q is not stored in a file", dsn)
+	}
+
+	backupVersion, err := ValidateBackup(ctx, backupPath)
+	if err != nil {
+		return "", err
+	}
+	log.Info().
+		Str("backup", backupPath).
+		Int("schemaVersion", backupVersion).
+		Msg("backup is valid, restoring it")
+
+	// Copy the backup next to the database first, so that the database can be
+	// replaced by a quick rename.
+	tempPath := dbPath + "~"
+	if err := copyFile(backupPath, tempPath); err != nil {
+		return "", fmt.Errorf("copying backup: %w", err)
+	}
+
+	var previousPath string
+	if _, err := os.Stat(dbPath); err == nil {
+		ext := filepath.Ext(dbPath)
+		stem := strings.TrimSuffix(dbPath, ext)
+		timestamp := time.Now().Format("2006-01-02_150405")
+		previousPath = fmt.Sprintf("%s-before-restore-%s%s", stem, timestamp, ext)
+
+		if err := os.Rename(dbPath, previousPath); err != nil {
+			_ = os.Remove(tempPath)
+			return "", fmt.Errorf("moving current database out of the way: %w", err)
+		}
+	}
+
+	if err := os.Rename(tempPath, dbPath); err != nil {
+		return "", fmt.Errorf("moving backup into place: %w", err)
+	}
+	return previousPath, nil
+}
+
+func copyFile(sourcePath, destPath string) error {
+	source, err := os.Open(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	dest, err := os.Create(destPath)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dest, source); err != nil {
+		dest.Close()
+		return err
+	}
+	return dest.Close()
+}
+
+// backupBeforeMigrating makes a backup of the database when its schema is
+// about to be migrated. New, empty databases are not backed up.
+func (db *DB) backupBeforeMigrating(ctx context.Context) error {
+	needsMigration, err := db.NeedsMigration()
+	if err != nil || !needsMigration {
+		return err
+	}
+	if !db.gormDB.Migrator().HasTable(&Job{}) {
+		return nil
+	}
+
+	currentVersion, err := db.SchemaVersion()
+	if err != nil {
+		return err
+	}
+	return db.backupSchemaVersion(ctx, currentVersion)
+}
+
+// backupSchemaVersion backs up the database to a file named after its current
+// schema version.
+func (db *DB) backupSchemaVersion(ctx context.Context, schemaVersion int) error {
+	path := db.migrationBackupPath(schemaVersion)
+	if path == "" {
+		log.Warn().Msg("database cannot be backed up to a file, not backing it up before migrating")
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("creating backup directory: %w", err)
+	}
+
+	log.Info().
+		Int("schemaVersion", schemaVersion).
+		Str("path", path).
+		Msg("backing up database before migrating it")
+	return db.Backup(ctx, path)
+}
+
+// migrationBackupPath returns the path of the backup that is made before
+// migrating the database. Returns an empty string if the database cannot be
+// backed up to a file.
+func (db *DB) migrationBackupPath(schemaVersion int) string {
+	timestamp := db.gormDB.NowFunc().Format("2006-01-02_150405")
+
+	switch db.dialect {
+	case dialectPostgres:
+		if db.migrationBackupDir == "" {
+			return ""
+		}
+		// Keep these apart from the regular backups, so that they are not
+		// affected by their retention.
+		filename := fmt.Sprintf("flamenco-manager-v%d-%s.pgdump", schemaVersion, timestamp)
+		return filepath.Join(db.migrationBackupDir, migrationBackupSubdir, filename)
+	default:
+		filename := sqliteFilename(db.dsn)
+		if filename == "" {
+			return ""
+		}
+		ext := filepath.Ext(filename)
+		stem := strings.TrimSuffix(filename, ext)
+		return fmt.Sprintf("%s-v%d-%s%s", stem, schemaVersion, timestamp, ext)
+	}
+}
+
+// sqliteFilename returns the filename of the SQLite database, or an empty
+// string if it is an in-memory database.
+func sqliteFilename(dsn string) string {
+	filename := strings.TrimPrefix(dsn, "file:")
+	if queryIndex := strings.IndexRune(filename, '?'); queryIndex >= 0 {
+		if strings.Contains(filename[queryIndex:], "mode=memory") {
+			return ""
+		}
+		filename = filename[:queryIndex]
+	}
+	if filename == "" || filename == ":memory:" {
+		return ""
+	}
+	return filename
+}
@@ -0,0 +1,172 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackupSQLite(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 5*time.Second)
+	defer cancel()
+
+	authoredJob := createTestAuthoredJobWithTasks()
+	persistAuthoredJob(t, ctx, db, authoredJob)
+
+	backupPath := filepath.Join(t.TempDir(), "backup.sqlite")
+	if !assert.NoError(t, db.Backup(ctx, backupPath)) {
+		t.FailNow()
+	}
+
+	// Backing up to an existing file should fail.
+	assert.ErrorIs(t, db.Backup(ctx, backupPath), ErrBackupExists)
+
+	// The backup should be a complete database.
+	backup, err := OpenDBWithoutMigrating(ctx, backupPath, "")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	version, err := backup.SchemaVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, latestSchemaVersion(), version)
+
+	fetchedJob, err := backup.FetchJob(ctx, authoredJob.JobID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, fetchedJob) {
+		assert.Equal(t, authoredJob.Name, fetchedJob.Name)
+	}
+}
+
+func TestBackupBeforeMigrating(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	dbPath := filepath.Join(t.TempDir(), "flamenco-manager.sqlite")
+	db, err := OpenDB(ctx, dbPath, "")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	// A new database should not be backed up.
+	matches, err := filepath.Glob(filepath.Join(filepath.Dir(dbPath), "flamenco-manager-v*.sqlite"))
+	assert.NoError(t, err)
+	assert.Empty(t, matches)
+
+	// Pretend the database is at an older version.
+	if !assert.NoError(t, db.gormDB.Delete(&schemaVersion{}, latestSchemaVersion()).Error) {
+		t.FailNow()
+	}
+	if !assert.NoError(t, db.backupBeforeMigrating(ctx)) {
+		t.FailNow()
+	}
+
+	matches, err = filepath.Glob(filepath.Join(filepath.Dir(dbPath), "flamenco-manager-v*.sqlite"))
+	assert.NoError(t, err)
+	assert.Len(t, matches, 1)
+}
+
+func TestSQLiteFilename(t *testing.T) {
+	assert.Equal(t, "flamenco-manager.sqlite", sqliteFilename("flamenco-manager.sqlite"))
+	assert.Equal(t, "/path/to/flamenco.sqlite", sqliteFilename("file:/path/to/flamenco.sqlite?_pragma=busy_timeout(5000)"))
+	assert.Equal(t, "", sqliteFilename("file::memory:"))
+	assert.Equal(t, "", sqliteFilename("file:flamenco?mode=memory&cache=shared"))
+}
+
+func TestRestoreBackup(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "flamenco-manager.sqlite")
+	backupPath := filepath.Join(dir, "backup.sqlite")
+
+	db, err := OpenDB(ctx, dbPath, "")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	authoredJob := createTestAuthoredJobWithTasks()
+	persistAuthoredJob(t, ctx, db, authoredJob)
+	if !assert.NoError(t, db.Backup(ctx, backupPath)) {
+		t.FailNow()
+	}
+
+	// Remove the job after the backup was made, then stop using the database.
+	assert.NoError(t, db.DeleteJob(ctx, authoredJob.JobID))
+	assert.NoError(t, db.Close())
+
+	previousPath, err := RestoreBackup(ctx, dbPath, backupPath)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.FileExists(t, previousPath)
+	assert.FileExists(t, backupPath, "the backup itself should be kept")
+
+	// The job should be back.
+	db, err = OpenDB(ctx, dbPath, "")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer db.Close()
+	_, err = db.FetchJob(ctx, authoredJob.JobID)
+	assert.NoError(t, err)
+}
+
+func TestValidateBackup(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	dir := t.TempDir()
+
+	// Not a database at all.
+	notDBPath := filepath.Join(dir, "not-a-database.sqlite")
+	if !assert.NoError(t, os.WriteFile(notDBPath, []byte("just some text"), 0o644)) {
+		t.FailNow()
+	}
+	_, err := ValidateBackup(ctx, notDBPath)
+	assert.ErrorIs(t, err, ErrBackupInvalid)
+
+	// A database, but from a newer Flamenco Manager.
+	dbPath := filepath.Join(dir, "flamenco-manager.sqlite")
+	db, err := OpenDB(ctx, dbPath, "")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	version, err := ValidateBackup(ctx, dbPath)
+	assert.NoError(t, err)
+	assert.Equal(t, latestSchemaVersion(), version)
+
+	tooNew := schemaVersion{Version: latestSchemaVersion() + 1, Description: "from the future"}
+	assert.NoError(t, db.gormDB.Create(&tooNew).Error)
+	assert.NoError(t, db.Close())
+
+	_, err = ValidateBackup(ctx, dbPath)
+	assert.ErrorIs(t, err, ErrSchemaTooNew)
+
+	_, err = RestoreBackup(ctx, filepath.Join(dir, "other.sqlite"), dbPath)
+	assert.ErrorIs(t, err, ErrSchemaTooNew)
+	assert.NoFileExists(t, filepath.Join(dir, "other.sqlite"))
+}
+
+func TestMigrationBackupPathPostgres(t *testing.T) {
+	_, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	// Only the dialect matters for determining the path.
+	pgDB := *db
+	pgDB.dialect = dialectPostgres
+
+	// Without backup directory, PostgreSQL databases cannot be backed up to a file.
+	assert.Equal(t, "", pgDB.migrationBackupPath(3))
+
+	backupDir := filepath.Join("path", "to", "backups")
+	pgDB.migrationBackupDir = backupDir
+	path := pgDB.migrationBackupPath(3)
+	assert.Equal(t, filepath.Join(backupDir, "migrations"), filepath.Dir(path))
+	assert.Regexp(t, `^flamenco-manager-v3-.*\.pgdump$`, filepath.Base(path))
+}
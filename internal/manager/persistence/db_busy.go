@@ -4,17 +4,23 @@ package persistence
 import (
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/glebarez/go-sqlite"
+	"github.com/jackc/pgconn"
 	"gorm.io/gorm"
 	sqlite3 "modernc.org/sqlite/lib"
 )
 
 var (
 	// errDatabaseBusy is returned by this package when the operation could not be
-	// performed due to SQLite being busy.
+	// performed due to the database being busy.
 	errDatabaseBusy = errors.New("database busy")
+
+	// numDatabaseBusy counts the "database busy" errors, which cause the operation
+	// to be rejected & retried later. Use atomic operations to access it.
+	numDatabaseBusy uint64
 )
 
 // ErrIsDBBusy returns true when the error is a "database busy" error.
@@ -23,10 +29,35 @@ func ErrIsDBBusy(err error) bool {
 }
 
 // isDatabaseBusyError returns true when the error returned by GORM is a
-// SQLITE_BUSY error.
+// SQLITE_BUSY error, or its PostgreSQL equivalent of a transaction that could
+// not get its locks.
 func isDatabaseBusyError(err error) bool {
-	sqlErr, ok := err.(*sqlite.Error)
-	return ok && sqlErr.Code() == sqlite3.SQLITE_BUSY
+	var isBusy bool
+
+	var sqliteErr *sqlite.Error
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.As(err, &sqliteErr):
+		isBusy = sqliteErr.Code() == sqlite3.SQLITE_BUSY
+	case errors.As(err, &pgErr):
+		switch pgErr.Code {
+		case "40001", // serialization_failure
+			"40P01", // deadlock_detected
+			"55P03": // lock_not_available
+			isBusy = true
+		}
+	}
+
+	if isBusy {
+		atomic.AddUint64(&numDatabaseBusy, 1)
+	}
+	return isBusy
+}
+
+// DatabaseBusyCount returns the number of times the database was found to be
+// busy since the Manager started.
+func DatabaseBusyCount() uint64 {
+	return atomic.LoadUint64(&numDatabaseBusy)
 }
 
 // setBusyTimeout sets the SQLite busy_timeout busy handler.
@@ -3,21 +3,359 @@ package persistence
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrSchemaTooNew     = errors.New("database schema is newer than this version of Flamenco Manager supports")
+	ErrNothingToMigrate = errors.New("there is no migration to roll back")
+	ErrIrreversible     = errors.New("migration cannot be rolled back")
 )
 
+// migration is a numbered change to the database schema.
+//
+// Every change to the persistence models should get a new migration, appended
+// to the `migrations` list. Migrations use snapshots of the models, see
+// db_migration_snapshots.go. Migrations are never changed once they have been
+// released, as databases out in the wild have already been migrated with them.
+type migration struct {
+	version     int
+	description string
+	up          func(tx *gorm.DB) error
+	// down undoes the `up` function. It can be nil when the migration cannot be
+	// undone.
+	down func(tx *gorm.DB) error
+}
+
+// schemaVersion records which migration has been applied to the database, and
+// when. The database's schema version is the highest version in this table.
+type schemaVersion struct {
+	Version     int `gorm:"primaryKey;autoIncrement:false"`
+	Description string
+	AppliedAt   time.Time
+}
+
+func (schemaVersion) TableName() string {
+	return "schema_version"
+}
+
+// MigrationStatus describes a known migration, and whether it has been applied
+// to the database.
+type MigrationStatus struct {
+	Version     int
+	Description string
+	Applied     bool
+	AppliedAt   time.Time
+}
+
+var migrations = []migration{
+	{
+		version:     1,
+		description: "initial schema",
+		// Before versioned migrations were introduced, the schema was managed by
+		// GORM's auto-migration. This brings databases from that era up to date,
+		// and creates the schema for new databases.
+		up: migrateV1InitialSchema,
+	},
+	{
+		version:     2,
+		description: "worker resources and job requirements",
+		up: func(tx *gorm.DB) error {
+			worker, job, jobRequiredCapability := migrationV2Models()
+			if err := addColumns(tx, worker, "CPUCores", "MemoryMB", "Capabilities"); err != nil {
+				return err
+			}
+			if err := addColumns(tx, job, "MinCPUCores", "MinMemoryMB"); err != nil {
+				return err
+			}
+			if tx.Migrator().HasTable(jobRequiredCapability) {
+				return nil
+			}
+			return tx.Migrator().CreateTable(jobRequiredCapability)
+		},
+		down: func(tx *gorm.DB) error {
+			worker, job, jobRequiredCapability := migrationV2Models()
+			if err := tx.Migrator().DropTable(jobRequiredCapability); err != nil {
+				return err
+			}
+			if err := dropColumns(tx, job, "MinCPUCores", "MinMemoryMB"); err != nil {
+				return err
+			}
+			return dropColumns(tx, worker, "CPUCores", "MemoryMB", "Capabilities")
+		},
+	},
+	{
+		version:     3,
+		description: "task retry backoff",
+		up: func(tx *gorm.DB) error {
+			return addColumns(tx, migrationV3Models(), "RetryAfter")
+		},
+		down: func(tx *gorm.DB) error {
+			return dropColumns(tx, migrationV3Models(), "RetryAfter")
+		},
+	},
+	{
+		version:     4,
+		description: "per-task timeout",
+		up: func(tx *gorm.DB) error {
+			return addColumns(tx, migrationV4Models(), "Timeout")
+		},
+		down: func(tx *gorm.DB) error {
+			return dropColumns(tx, migrationV4Models(), "Timeout")
+		},
+	},
+	{
+		version:     5,
+		description: "task progress",
+		up: func(tx *gorm.DB) error {
+			return addColumns(tx, migrationV5Models(), "Progress")
+		},
+		down: func(tx *gorm.DB) error {
+			return dropColumns(tx, migrationV5Models(), "Progress")
+		},
+	},
+	{
+		version:     6,
+		description: "worker task slots",
+		up: func(tx *gorm.DB) error {
+			return addColumns(tx, migrationV6Models(), "TaskSlots")
+		},
+		down: func(tx *gorm.DB) error {
+			return dropColumns(tx, migrationV6Models(), "TaskSlots")
+		},
+	},
+	{
+		version:     7,
+		description: "job history",
+		up: func(tx *gorm.DB) error {
+			jobHistoryEntry := migrationV7Models()
+			if tx.Migrator().HasTable(jobHistoryEntry) {
+				return nil
+			}
+			return tx.Migrator().CreateTable(jobHistoryEntry)
+		},
+		down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(migrationV7Models())
+		},
+	},
+}
+
+// addColumns adds the columns for the given fields of the model, skipping
+// those that already exist. Databases from before versioned migrations may
+// already have these columns.
+func addColumns(tx *gorm.DB, model interface{}, fields ...string) error {
+	migrator := tx.Migrator()
+	for _, field := range fields {
+		if migrator.HasColumn(model, field) {
+			continue
+		}
+		if err := migrator.AddColumn(model, field); err != nil {
+			return fmt.Errorf("adding column for %s: %w", field, err)
+		}
+	}
+	return nil
+}
+
+// dropColumns drops the columns for the given fields of the model.
+func dropColumns(tx *gorm.DB, model interface{}, fields ...string) error {
+	migrator := tx.Migrator()
+	for _, field := range fields {
+		if !migrator.HasColumn(model, field) {
+			continue
+		}
+		if err := migrator.DropColumn(model, field); err != nil {
+			return fmt.Errorf("dropping column for %s: %w", field, err)
+		}
+	}
+	return nil
+}
+
+// latestSchemaVersion returns the schema version after all migrations have
+// been applied.
+func latestSchemaVersion() int {
+	return migrations[len(migrations)-1].version
+}
+
+// migrate applies all migrations that have not been applied yet.
 func (db *DB) migrate() error {
-	err := db.gormDB.AutoMigrate(
-		&Job{},
-		&JobBlock{},
-		&LastRendered{},
-		&SleepSchedule{},
-		&Task{},
-		&TaskFailure{},
-		&Worker{},
-	)
+	return db.migrateUp(migrations)
+}
+
+func (db *DB) migrateUp(known []migration) error {
+	currentVersion, err := db.SchemaVersion()
 	if err != nil {
-		return fmt.Errorf("failed to automigrate database: %v", err)
+		return err
 	}
-	return nil
+	if latest := known[len(known)-1].version; currentVersion > latest {
+		return fmt.Errorf("%w: database is at version %d, this Manager only knows up to version %d",
+			ErrSchemaTooNew, currentVersion, latest)
+	}
+
+	return db.withoutForeignKeys(func() error {
+		for _, m := range known {
+			if m.version <= currentVersion {
+				continue
+			}
+
+			log.Info().
+				Int("version", m.version).
+				Str("description", m.description).
+				Msg("migrating database")
+
+			err := db.gormDB.Transaction(func(tx *gorm.DB) error {
+				if err := m.up(tx); err != nil {
+					return err
+				}
+				applied := schemaVersion{
+					Version:     m.version,
+					Description: m.description,
+					AppliedAt:   tx.NowFunc(),
+				}
+				return tx.Create(&applied).Error
+			})
+			if err != nil {
+				return fmt.Errorf("migrating database to version %d: %w", m.version, err)
+			}
+		}
+		return nil
+	})
+}
+
+// MigrateDown rolls back the most recently applied migration, returning the
+// version the database was at before the rollback. The database is backed up
+// before the migration is rolled back.
+func (db *DB) MigrateDown(ctx context.Context) (int, error) {
+	return db.migrateDown(ctx, migrations)
+}
+
+func (db *DB) migrateDown(ctx context.Context, known []migration) (int, error) {
+	currentVersion, err := db.SchemaVersion()
+	if err != nil {
+		return 0, err
+	}
+	if currentVersion == 0 {
+		return 0, ErrNothingToMigrate
+	}
+
+	var toRollBack *migration
+	for i := range known {
+		if known[i].version == currentVersion {
+			toRollBack = &known[i]
+			break
+		}
+	}
+	switch {
+	case toRollBack == nil:
+		return 0, fmt.Errorf("%w: database is at unknown version %d", ErrSchemaTooNew, currentVersion)
+	case toRollBack.down == nil:
+		return 0, fmt.Errorf("%w: version %d (%s)", ErrIrreversible, toRollBack.version, toRollBack.description)
+	}
+
+	if err := db.backupSchemaVersion(ctx, currentVersion); err != nil {
+		return 0, fmt.Errorf("backing up database before rolling back migration: %w", err)
+	}
+
+	log.Info().
+		Int("version", toRollBack.version).
+		Str("description", toRollBack.description).
+		Msg("rolling back database migration")
+
+	err = db.withoutForeignKeys(func() error {
+		return db.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := toRollBack.down(tx); err != nil {
+				return err
+			}
+			return tx.Delete(&schemaVersion{}, toRollBack.version).Error
+		})
+	})
+	if err != nil {
+		return 0, fmt.Errorf("rolling back database migration %d: %w", toRollBack.version, err)
+	}
+	return currentVersion, nil
+}
+
+// SchemaVersion returns the version of the database schema. This is 0 for
+// databases that have never been migrated.
+func (db *DB) SchemaVersion() (int, error) {
+	if err := db.gormDB.AutoMigrate(&schemaVersion{}); err != nil {
+		return 0, fmt.Errorf("creating schema version table: %w", err)
+	}
+	return db.readSchemaVersion()
+}
+
+// readSchemaVersion returns the version of the database schema, without
+// creating the schema version table if it does not exist yet.
+func (db *DB) readSchemaVersion() (int, error) {
+	if !db.gormDB.Migrator().HasTable(&schemaVersion{}) {
+		return 0, nil
+	}
+
+	var version int
+	tx := db.gormDB.Model(&schemaVersion{}).Select("coalesce(max(version), 0)").Scan(&version)
+	if tx.Error != nil {
+		return 0, fmt.Errorf("fetching schema version: %w", tx.Error)
+	}
+	return version, nil
+}
+
+// MigrationStatus returns all known migrations, and whether they have been
+// applied to the database.
+func (db *DB) MigrationStatus(ctx context.Context) ([]MigrationStatus, error) {
+	if _, err := db.SchemaVersion(); err != nil {
+		return nil, err
+	}
+
+	applied := []schemaVersion{}
+	if err := db.gormDB.WithContext(ctx).Order("version").Find(&applied).Error; err != nil {
+		return nil, fmt.Errorf("fetching applied migrations: %w", err)
+	}
+	appliedAt := map[int]time.Time{}
+	for _, a := range applied {
+		appliedAt[a.Version] = a.AppliedAt
+	}
+
+	statuses := make([]MigrationStatus, len(migrations))
+	for i, m := range migrations {
+		statuses[i] = MigrationStatus{
+			Version:     m.version,
+			Description: m.description,
+		}
+		statuses[i].AppliedAt, statuses[i].Applied = appliedAt[m.version]
+	}
+	return statuses, nil
+}
+
+// NeedsMigration returns whether the database schema is older than what this
+// Manager needs.
+func (db *DB) NeedsMigration() (bool, error) {
+	currentVersion, err := db.SchemaVersion()
+	if err != nil {
+		return false, err
+	}
+	return currentVersion < latestSchemaVersion(), nil
+}
+
+// withoutForeignKeys calls the function while SQLite's foreign key checks
+// are disabled. GORM recreates SQLite tables to change their columns, and with
+// foreign key checks enabled, dropping the old table would cascade to the rows
+// of other tables that refer to it.
+func (db *DB) withoutForeignKeys(migrate func() error) error {
+	if db.dialect != dialectSQLite {
+		return migrate()
+	}
+
+	if tx := db.gormDB.Exec("PRAGMA foreign_keys = 0"); tx.Error != nil {
+		return fmt.Errorf("disabling foreign keys: %w", tx.Error)
+	}
+	migrateErr := migrate()
+	if tx := db.gormDB.Exec("PRAGMA foreign_keys = 1"); tx.Error != nil {
+		return fmt.Errorf("re-enabling foreign keys: %w", tx.Error)
+	}
+	return migrateErr
 }
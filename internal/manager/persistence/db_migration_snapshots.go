@@ -0,0 +1,114 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"database/sql"
+	"time"
+)
+
+// The functions in this file return snapshots of the models, as they were when
+// a migration was written. Migrations use these instead of the current
+// persistence models, so that they do not change when the models do.
+//
+// Just like in migrateV1InitialSchema(), the snapshot types are declared
+// inside the functions, so that they get the same names as the models. GORM
+// derives table and constraint names from those. The snapshots only contain
+// the fields that the migration touches, plus what is needed for the
+// relations. These types must never be changed.
+
+// migrationV2Models returns snapshots of the models for the "worker resources
+// and job requirements" migration.
+func migrationV2Models() (worker, job, jobRequiredCapability interface{}) {
+	type Model struct {
+		ID        uint `gorm:"primarykey"`
+		CreatedAt time.Time
+		UpdatedAt time.Time
+	}
+
+	type Worker struct {
+		Model
+		CPUCores     int    `gorm:"default:0"`
+		MemoryMB     int    `gorm:"default:0"`
+		Capabilities string `gorm:"type:jsonb"`
+	}
+
+	type Job struct {
+		Model
+		MinCPUCores int `gorm:"default:0"`
+		MinMemoryMB int `gorm:"default:0"`
+	}
+
+	type JobRequiredCapability struct {
+		Model
+		JobID uint   `gorm:"default:0;index"`
+		Job   *Job   `gorm:"foreignkey:JobID;references:ID;constraint:OnDelete:CASCADE"`
+		Name  string `gorm:"type:varchar(64);default:''"`
+		Value string `gorm:"type:varchar(255);default:''"`
+	}
+
+	return &Worker{}, &Job{}, &JobRequiredCapability{}
+}
+
+// migrationV3Models returns a snapshot of the task model for the "task retry
+// backoff" migration.
+func migrationV3Models() (task interface{}) {
+	type Task struct {
+		ID         uint `gorm:"primarykey"`
+		RetryAfter sql.NullTime
+	}
+	return &Task{}
+}
+
+// migrationV4Models returns a snapshot of the task model for the "per-task
+// timeout" migration.
+func migrationV4Models() (task interface{}) {
+	type Task struct {
+		ID      uint  `gorm:"primarykey"`
+		Timeout int64 `gorm:"default:0"`
+	}
+	return &Task{}
+}
+
+// migrationV5Models returns a snapshot of the task model for the "task
+// progress" migration.
+func migrationV5Models() (task interface{}) {
+	type Task struct {
+		ID       uint `gorm:"primarykey"`
+		Progress int  `gorm:"type:smallint;default:0"`
+	}
+	return &Task{}
+}
+
+// migrationV6Models returns a snapshot of the worker model for the "worker
+// task slots" migration.
+func migrationV6Models() (worker interface{}) {
+	type Worker struct {
+		ID        uint   `gorm:"primarykey"`
+		TaskSlots string `gorm:"type:jsonb"`
+	}
+	return &Worker{}
+}
+
+// migrationV7Models returns a snapshot of the job history model for the "job
+// history" migration.
+func migrationV7Models() (jobHistoryEntry interface{}) {
+	type Model struct {
+		ID        uint `gorm:"primarykey"`
+		CreatedAt time.Time
+		UpdatedAt time.Time
+	}
+
+	type Job struct {
+		Model
+	}
+
+	type JobHistoryEntry struct {
+		Model
+		JobID            uint   `gorm:"default:0;index"`
+		Job              *Job   `gorm:"foreignkey:JobID;references:ID;constraint:OnDelete:CASCADE"`
+		PreviousSettings string `gorm:"type:jsonb"`
+	}
+
+	return &JobHistoryEntry{}
+}
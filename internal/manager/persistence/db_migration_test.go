@@ -0,0 +1,201 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// migrationTestThing is only used to test migrations.
+type migrationTestThing struct {
+	ID   uint
+	Name string
+}
+
+// testMigrations returns the regular migrations, plus one that is only used
+// in the tests.
+func testMigrations() []migration {
+	known := append([]migration{}, migrations...)
+	return append(known, migration{
+		version:     latestSchemaVersion() + 1,
+		description: "test things",
+		up: func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&migrationTestThing{})
+		},
+		down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&migrationTestThing{})
+		},
+	})
+}
+
+func TestMigrateUpDown(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	version, err := db.SchemaVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, latestSchemaVersion(), version)
+
+	// Migrate up.
+	if !assert.NoError(t, db.migrateUp(testMigrations())) {
+		t.FailNow()
+	}
+	version, err = db.SchemaVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, latestSchemaVersion()+1, version)
+	assert.True(t, db.gormDB.Migrator().HasTable(&migrationTestThing{}))
+
+	// Migrating again should be a no-op.
+	assert.NoError(t, db.migrateUp(testMigrations()))
+
+	// Roll back.
+	rolledBack, err := db.migrateDown(ctx, testMigrations())
+	assert.NoError(t, err)
+	assert.Equal(t, latestSchemaVersion()+1, rolledBack)
+	assert.False(t, db.gormDB.Migrator().HasTable(&migrationTestThing{}))
+	version, err = db.SchemaVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, latestSchemaVersion(), version)
+
+	// Roll back all the regular migrations, except the initial schema.
+	for expectVersion := latestSchemaVersion(); expectVersion > 1; expectVersion-- {
+		rolledBack, err := db.migrateDown(ctx, migrations)
+		if !assert.NoError(t, err, "rolling back version %d", expectVersion) {
+			t.FailNow()
+		}
+		assert.Equal(t, expectVersion, rolledBack)
+	}
+
+	// The initial schema should not follow changes to the models, which are
+	// handled by the later migrations.
+	assert.NoError(t, migrateV1InitialSchema(db.gormDB))
+	assert.False(t, db.gormDB.Migrator().HasColumn(&Worker{}, "CPUCores"))
+	assert.False(t, db.gormDB.Migrator().HasTable(&JobRequiredCapability{}))
+	assert.False(t, db.gormDB.Migrator().HasColumn(&Task{}, "Progress"))
+	assert.False(t, db.gormDB.Migrator().HasColumn(&Worker{}, "TaskSlots"))
+	assert.False(t, db.gormDB.Migrator().HasTable(&JobHistoryEntry{}))
+
+	// The initial schema cannot be rolled back.
+	_, err = db.migrateDown(ctx, testMigrations())
+	assert.ErrorIs(t, err, ErrIrreversible)
+
+	// Migrating up again should bring back the latest schema.
+	assert.NoError(t, db.migrate())
+	version, err = db.SchemaVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, latestSchemaVersion(), version)
+}
+
+// TestMigrationsMatchModels checks that the migrations, which use snapshots of
+// the models, create all the tables and columns that the current models need.
+func TestMigrationsMatchModels(t *testing.T) {
+	_, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	models := []interface{}{
+		&Job{},
+		&JobBlock{},
+		&JobHistoryEntry{},
+		&JobRequiredCapability{},
+		&LastRendered{},
+		&SleepSchedule{},
+		&Task{},
+		&TaskFailure{},
+		&User{},
+		&UserSession{},
+		&WebhookDelivery{},
+		&Worker{},
+		&WorkerTag{},
+	}
+
+	migrator := db.gormDB.Migrator()
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db.gormDB}
+		if !assert.NoError(t, stmt.Parse(model)) {
+			continue
+		}
+		if !assert.True(t, migrator.HasTable(model), "table %s", stmt.Schema.Table) {
+			continue
+		}
+		for _, dbName := range stmt.Schema.DBNames {
+			assert.True(t, migrator.HasColumn(model, dbName), "column %s.%s", stmt.Schema.Table, dbName)
+		}
+	}
+}
+
+func TestMigrateSchemaTooNew(t *testing.T) {
+	_, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	if !assert.NoError(t, db.migrateUp(testMigrations())) {
+		t.FailNow()
+	}
+
+	// This Manager only knows about the regular migrations, and not the test ones.
+	err := db.migrate()
+	assert.ErrorIs(t, err, ErrSchemaTooNew)
+
+	needsMigration, err := db.NeedsMigration()
+	assert.NoError(t, err)
+	assert.False(t, needsMigration)
+}
+
+func TestMigrationStatus(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	statuses, err := db.MigrationStatus(ctx)
+	assert.NoError(t, err)
+	if !assert.Len(t, statuses, len(migrations)) {
+		t.FailNow()
+	}
+	for i, status := range statuses {
+		assert.Equal(t, migrations[i].version, status.Version)
+		assert.Equal(t, migrations[i].description, status.Description)
+		assert.True(t, status.Applied)
+		assert.False(t, status.AppliedAt.IsZero())
+	}
+}
+
+// TestMigrateKeepsReferringRows checks that recreating a table, which is what
+// GORM does to alter a column in SQLite, does not delete the rows that refer to
+// it via a foreign key.
+func TestMigrateKeepsReferringRows(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	w := createWorker(ctx, t, db)
+	schedule := SleepSchedule{
+		WorkerID:   w.ID,
+		Worker:     w,
+		IsActive:   true,
+		DaysOfWeek: "mo,tu,we",
+		StartTime:  TimeOfDay{18, 0},
+		EndTime:    TimeOfDay{9, 0},
+	}
+	if !assert.NoError(t, db.SetWorkerSleepSchedule(ctx, w.UUID, &schedule)) {
+		t.FailNow()
+	}
+
+	alterWorkers := append([]migration{}, migrations...)
+	alterWorkers = append(alterWorkers, migration{
+		version:     latestSchemaVersion() + 1,
+		description: "alter workers table",
+		up: func(tx *gorm.DB) error {
+			return tx.Migrator().AlterColumn(&Worker{}, "Name")
+		},
+	})
+	if !assert.NoError(t, db.migrateUp(alterWorkers)) {
+		t.FailNow()
+	}
+
+	fetched, err := db.FetchWorkerSleepSchedule(ctx, w.UUID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, fetched) {
+		assert.Equal(t, schedule.DaysOfWeek, fetched.DaysOfWeek)
+	}
+}
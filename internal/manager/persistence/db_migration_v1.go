@@ -0,0 +1,189 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"database/sql"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// migrateV1InitialSchema creates the schema as it was when versioned migrations
+// were introduced. The models are snapshots, instead of the current persistence
+// models, so that this migration does not change when the models do. Later
+// migrations bring the schema up to date.
+//
+// The snapshot types are declared inside the function, so that they get the
+// same names as the models. GORM derives table and constraint names from those.
+// These types must never be changed.
+func migrateV1InitialSchema(tx *gorm.DB) error {
+	type Model struct {
+		ID        uint `gorm:"primarykey"`
+		CreatedAt time.Time
+		UpdatedAt time.Time
+	}
+
+	type User struct {
+		Model
+
+		UUID         string `gorm:"type:char(36);default:'';unique;index"`
+		Name         string `gorm:"type:varchar(64);default:'';unique"`
+		PasswordHash string `gorm:"type:varchar(255);default:''"`
+		Role         string `gorm:"type:varchar(16);default:''"`
+	}
+
+	type UserSession struct {
+		Model
+
+		TokenHash string    `gorm:"type:char(64);default:'';unique;index"`
+		UserID    uint      `gorm:"default:0"`
+		User      *User     `gorm:"foreignkey:UserID;references:ID;constraint:OnDelete:CASCADE"`
+		ExpiresAt time.Time `gorm:"index"`
+	}
+
+	type Worker struct {
+		Model
+		UUID   string `gorm:"type:char(36);default:'';unique;index;default:''"`
+		Secret string `gorm:"type:varchar(255);default:''"`
+		Name   string `gorm:"type:varchar(64);default:''"`
+
+		Address    string    `gorm:"type:varchar(39);default:'';index"`
+		Platform   string    `gorm:"type:varchar(16);default:''"`
+		Software   string    `gorm:"type:varchar(32);default:''"`
+		Status     string    `gorm:"type:varchar(16);default:''"`
+		LastSeenAt time.Time `gorm:"index"`
+
+		StatusRequested   string `gorm:"type:varchar(16);default:''"`
+		LazyStatusRequest bool   `gorm:"default:false"`
+
+		SupportedTaskTypes string `gorm:"type:varchar(255);default:''"`
+	}
+
+	// The Worker model also refers to the tags, but local types cannot refer to
+	// each other. The membership table is fully defined by this side.
+	type WorkerTag struct {
+		Model
+
+		UUID        string `gorm:"type:char(36);default:'';unique;index"`
+		Name        string `gorm:"type:varchar(64);default:'';unique"`
+		Description string `gorm:"type:varchar(255);default:''"`
+
+		Workers []*Worker `gorm:"many2many:worker_tag_membership;constraint:OnDelete:CASCADE"`
+	}
+
+	type SleepSchedule struct {
+		Model
+
+		WorkerID uint    `gorm:"default:0;unique;index"`
+		Worker   *Worker `gorm:"foreignkey:WorkerID;references:ID;constraint:OnDelete:CASCADE"`
+
+		IsActive bool `gorm:"default:false;index"`
+
+		DaysOfWeek string `gorm:"default:''"`
+		StartTime  string `gorm:"default:''"`
+		EndTime    string `gorm:"default:''"`
+
+		NextCheck time.Time
+	}
+
+	type Job struct {
+		Model
+		UUID string `gorm:"type:char(36);default:'';unique;index"`
+
+		Name     string `gorm:"type:varchar(64);default:''"`
+		JobType  string `gorm:"type:varchar(32);default:''"`
+		Priority int    `gorm:"type:smallint;default:0"`
+		Status   string `gorm:"type:varchar(32);default:''"`
+		Activity string `gorm:"type:varchar(255);default:''"`
+
+		Settings string `gorm:"type:jsonb"`
+		Metadata string `gorm:"type:jsonb"`
+
+		WorkerTagID *uint
+		WorkerTag   *WorkerTag `gorm:"foreignkey:WorkerTagID;references:ID;constraint:OnDelete:SET NULL"`
+
+		UserID *uint
+		User   *User `gorm:"foreignkey:UserID;references:ID;constraint:OnDelete:SET NULL"`
+
+		MaxWorkers int `gorm:"default:0"`
+		StartAfter sql.NullTime
+
+		Dependencies []*Job `gorm:"many2many:job_dependencies;constraint:OnDelete:CASCADE"`
+
+		DeleteRequestedAt sql.NullTime `gorm:"index"`
+	}
+
+	type Task struct {
+		Model
+		UUID string `gorm:"type:char(36);default:'';unique;index"`
+
+		Name     string `gorm:"type:varchar(64);default:''"`
+		Type     string `gorm:"type:varchar(32);default:''"`
+		JobID    uint   `gorm:"default:0"`
+		Job      *Job   `gorm:"foreignkey:JobID;references:ID;constraint:OnDelete:CASCADE"`
+		Priority int    `gorm:"type:smallint;default:50"`
+		Status   string `gorm:"type:varchar(16);default:''"`
+
+		WorkerID      *uint
+		Worker        *Worker   `gorm:"foreignkey:WorkerID;references:ID;constraint:OnDelete:SET NULL"`
+		LastTouchedAt time.Time `gorm:"index"`
+
+		Dependencies []*Task `gorm:"many2many:task_dependencies;constraint:OnDelete:CASCADE"`
+
+		Commands string `gorm:"type:jsonb"`
+		Activity string `gorm:"type:varchar(255);default:''"`
+	}
+
+	type TaskFailure struct {
+		CreatedAt time.Time
+		TaskID    uint    `gorm:"primaryKey;autoIncrement:false"`
+		Task      *Task   `gorm:"foreignkey:TaskID;references:ID;constraint:OnDelete:CASCADE"`
+		WorkerID  uint    `gorm:"primaryKey;autoIncrement:false"`
+		Worker    *Worker `gorm:"foreignkey:WorkerID;references:ID;constraint:OnDelete:CASCADE"`
+	}
+
+	type JobBlock struct {
+		ID        uint
+		CreatedAt time.Time
+
+		JobID uint `gorm:"default:0;uniqueIndex:job_worker_tasktype"`
+		Job   *Job `gorm:"foreignkey:JobID;references:ID;constraint:OnDelete:CASCADE"`
+
+		WorkerID uint    `gorm:"default:0;uniqueIndex:job_worker_tasktype"`
+		Worker   *Worker `gorm:"foreignkey:WorkerID;references:ID;constraint:OnDelete:CASCADE"`
+
+		TaskType string `gorm:"uniqueIndex:job_worker_tasktype"`
+	}
+
+	type LastRendered struct {
+		Model
+		JobID uint `gorm:"default:0"`
+		Job   *Job `gorm:"foreignkey:JobID;references:ID;constraint:OnDelete:CASCADE"`
+	}
+
+	type WebhookDelivery struct {
+		Model
+
+		URL     string `gorm:"type:varchar(1024);default:''"`
+		Event   string `gorm:"type:varchar(64);default:''"`
+		Payload []byte
+
+		Attempts      int       `gorm:"type:smallint;default:0"`
+		NextAttemptAt time.Time `gorm:"index"`
+	}
+
+	return tx.AutoMigrate(
+		&Job{},
+		&JobBlock{},
+		&LastRendered{},
+		&SleepSchedule{},
+		&Task{},
+		&TaskFailure{},
+		&User{},
+		&UserSession{},
+		&WebhookDelivery{},
+		&Worker{},
+		&WorkerTag{},
+	)
+}
@@ -0,0 +1,76 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// dbDialect indicates which kind of database server is used.
+type dbDialect string
+
+const (
+	dialectSQLite   dbDialect = "sqlite"
+	dialectPostgres dbDialect = "postgres"
+)
+
+// dialectForDSN determines which database to use for the given DSN. URLs with
+// a `postgres://` or `postgresql://` scheme select PostgreSQL, anything else is
+// interpreted as an SQLite database.
+func dialectForDSN(dsn string) dbDialect {
+	lowerDSN := strings.ToLower(dsn)
+	if strings.HasPrefix(lowerDSN, "postgres://") || strings.HasPrefix(lowerDSN, "postgresql://") {
+		return dialectPostgres
+	}
+	return dialectSQLite
+}
+
+// gormDialector returns the GORM dialector to open the database with.
+func (d dbDialect) gormDialector(dsn string) gorm.Dialector {
+	switch d {
+	case dialectPostgres:
+		return postgres.Open(dsn)
+	default:
+		return sqlite.Open(dsn)
+	}
+}
+
+// jsonFieldQuery returns an SQL expression that gets the value of a top-level
+// key of a JSON column, and the argument for its placeholder.
+func (d dbDialect) jsonFieldQuery(column, key string) (string, string) {
+	switch d {
+	case dialectPostgres:
+		return column + " ->> ?", key
+	default:
+		return "json_extract(" + column + ", ?)", "$." + key
+	}
+}
+
+// likeOperator returns the operator for case-insensitive pattern matching.
+// SQLite's LIKE is already case-insensitive, PostgreSQL needs ILIKE for this.
+func (d dbDialect) likeOperator() string {
+	switch d {
+	case dialectPostgres:
+		return "ilike"
+	default:
+		return "like"
+	}
+}
+
+// RedactDSN returns the DSN with its password removed, so that it can be
+// logged.
+func RedactDSN(dsn string) string {
+	if dialectForDSN(dsn) != dialectPostgres {
+		return dsn
+	}
+	dsnURL, err := url.Parse(dsn)
+	if err != nil {
+		return "(unparsable DSN)"
+	}
+	return dsnURL.Redacted()
+}
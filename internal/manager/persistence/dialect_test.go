@@ -0,0 +1,35 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDialectForDSN(t *testing.T) {
+	assert.Equal(t, dialectSQLite, dialectForDSN("flamenco-manager.sqlite"))
+	assert.Equal(t, dialectSQLite, dialectForDSN("file::memory:"))
+	assert.Equal(t, dialectSQLite, dialectForDSN("/path/to/postgres.sqlite"))
+	assert.Equal(t, dialectPostgres, dialectForDSN("postgres://flamenco@localhost/flamenco"))
+	assert.Equal(t, dialectPostgres, dialectForDSN("PostgreSQL://flamenco:secret@db:5432/flamenco?sslmode=disable"))
+}
+
+func TestRedactDSN(t *testing.T) {
+	assert.Equal(t, "flamenco-manager.sqlite", RedactDSN("flamenco-manager.sqlite"))
+	assert.Equal(t, "postgres://flamenco@localhost/flamenco",
+		RedactDSN("postgres://flamenco@localhost/flamenco"))
+	assert.Equal(t, "postgres://flamenco:xxxxx@db:5432/flamenco?sslmode=disable",
+		RedactDSN("postgres://flamenco:hunter2@db:5432/flamenco?sslmode=disable"))
+}
+
+func TestJSONFieldQuery(t *testing.T) {
+	field, key := dialectSQLite.jsonFieldQuery("metadata", "project")
+	assert.Equal(t, "json_extract(metadata, ?)", field)
+	assert.Equal(t, "$.project", key)
+
+	field, key = dialectPostgres.jsonFieldQuery("metadata", "project")
+	assert.Equal(t, "metadata ->> ?", field)
+	assert.Equal(t, "project", key)
+}
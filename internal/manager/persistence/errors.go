@@ -12,6 +12,11 @@ var (
 	ErrJobNotFound    = PersistenceError{Message: "job not found", Err: gorm.ErrRecordNotFound}
 	ErrTaskNotFound   = PersistenceError{Message: "task not found", Err: gorm.ErrRecordNotFound}
 	ErrWorkerNotFound = PersistenceError{Message: "worker not found", Err: gorm.ErrRecordNotFound}
+
+	ErrWorkerTagNotFound = PersistenceError{Message: "worker tag not found", Err: gorm.ErrRecordNotFound}
+
+	ErrUserNotFound        = PersistenceError{Message: "user not found", Err: gorm.ErrRecordNotFound}
+	ErrUserSessionNotFound = PersistenceError{Message: "user session not found", Err: gorm.ErrRecordNotFound}
 )
 
 type PersistenceError struct {
@@ -39,6 +44,14 @@ func workerError(errorToWrap error, message string, msgArgs ...interface{}) erro
 	return wrapError(translateGormWorkerError(errorToWrap), message, msgArgs...)
 }
 
+func workerTagError(errorToWrap error, message string, msgArgs ...interface{}) error {
+	return wrapError(translateGormWorkerTagError(errorToWrap), message, msgArgs...)
+}
+
+func userError(errorToWrap error, message string, msgArgs ...interface{}) error {
+	return wrapError(translateGormUserError(errorToWrap), message, msgArgs...)
+}
+
 func wrapError(errorToWrap error, message string, format ...interface{}) error {
 	// Only format if there are arguments for formatting.
 	var formattedMsg string
@@ -80,3 +93,21 @@ func translateGormWorkerError(gormError error) error {
 	}
 	return gormError
 }
+
+// translateGormWorkerTagError translates a Gorm error to a persistence layer error.
+// This helps to keep Gorm as "implementation detail" of the persistence layer.
+func translateGormWorkerTagError(gormError error) error {
+	if errors.Is(gormError, gorm.ErrRecordNotFound) {
+		return ErrWorkerTagNotFound
+	}
+	return gormError
+}
+
+// translateGormUserError translates a Gorm error to a persistence layer error.
+// This helps to keep Gorm as "implementation detail" of the persistence layer.
+func translateGormUserError(gormError error) error {
+	if errors.Is(gormError, gorm.ErrRecordNotFound) {
+		return ErrUserNotFound
+	}
+	return gormError
+}
@@ -4,6 +4,7 @@ package persistence
 
 import (
 	"context"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
@@ -30,10 +31,59 @@ type Job struct {
 
 	Settings StringInterfaceMap `gorm:"type:jsonb"`
 	Metadata StringStringMap    `gorm:"type:jsonb"`
+
+	// WorkerTag limits this job to Workers carrying that tag. Jobs without tag
+	// can be run by any Worker.
+	WorkerTagID *uint
+	WorkerTag   *WorkerTag `gorm:"foreignkey:WorkerTagID;references:ID;constraint:OnDelete:SET NULL"`
+
+	// User is the user who submitted this job. This is only set when user
+	// authentication was enabled at the time of submission.
+	UserID *uint
+	User   *User `gorm:"foreignkey:UserID;references:ID;constraint:OnDelete:SET NULL"`
+
+	// MaxWorkers limits the number of Workers that can work on this job at the
+	// same time. Zero means "no limit".
+	MaxWorkers int `gorm:"default:0"`
+
+	// StartAfter is the moment in time the job is allowed to start. Before
+	// that, its tasks will not be scheduled.
+	StartAfter sql.NullTime
+
+	// MinCPUCores and MinMemoryMB are the resources a Worker needs to have in
+	// order to run tasks of this job. Zero means "no requirement".
+	MinCPUCores int `gorm:"default:0"`
+	MinMemoryMB int `gorm:"default:0"`
+
+	// Dependencies are jobs that need to be completed before this one can start.
+	Dependencies []*Job `gorm:"many2many:job_dependencies;constraint:OnDelete:CASCADE"`
+
+	// DeleteRequestedAt is set when the job is queued for deletion. Such jobs are
+	// no longer scheduled, and will be removed by the job deleter.
+	DeleteRequestedAt sql.NullTime `gorm:"index"`
+}
+
+// JobRequiredCapability is a capability a Worker needs to have in order to
+// run tasks of the job, like `blender_version=3.3`.
+type JobRequiredCapability struct {
+	Model
+	JobID uint   `gorm:"default:0;index"`
+	Job   *Job   `gorm:"foreignkey:JobID;references:ID;constraint:OnDelete:CASCADE"`
+	Name  string `gorm:"type:varchar(64);default:''"`
+	Value string `gorm:"type:varchar(255);default:''"`
+}
+
+// JobHistoryEntry records the settings a job had before it was edited.
+type JobHistoryEntry struct {
+	Model
+	JobID            uint               `gorm:"default:0;index"`
+	Job              *Job               `gorm:"foreignkey:JobID;references:ID;constraint:OnDelete:CASCADE"`
+	PreviousSettings StringInterfaceMap `gorm:"type:jsonb"`
 }
 
 type StringInterfaceMap map[string]interface{}
 type StringStringMap map[string]string
+type StringIntMap map[string]int
 
 type Task struct {
 	Model
@@ -56,6 +106,18 @@ type Task struct {
 
 	Commands Commands `gorm:"type:jsonb"`
 	Activity string   `gorm:"type:varchar(255);default:''"`
+
+	// Progress is the percentage of the task that has been completed, as
+	// reported by the Worker.
+	Progress int `gorm:"type:smallint;default:0"`
+
+	// Timeout determines how long the task can go without updates from its
+	// Worker. Zero means the Manager's global task timeout is used.
+	Timeout time.Duration `gorm:"default:0"`
+
+	// RetryAfter is set when the task is soft-failed. The task will not be
+	// handed to another Worker before this time.
+	RetryAfter sql.NullTime
 }
 
 type Commands []Command
@@ -98,6 +160,17 @@ func (js *StringStringMap) Scan(value interface{}) error {
 	return json.Unmarshal(b, &js)
 }
 
+func (js StringIntMap) Value() (driver.Value, error) {
+	return json.Marshal(js)
+}
+func (js *StringIntMap) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(b, &js)
+}
+
 // TaskFailure keeps track of which Worker failed which Task.
 type TaskFailure struct {
 	// Don't include the standard Gorm ID, UpdatedAt, or DeletedAt fields, as they're useless here.
@@ -122,74 +195,241 @@ func (db *DB) StoreAuthoredJob(ctx context.Context, authoredJob job_compilers.Au
 			Priority: authoredJob.Priority,
 			Settings: StringInterfaceMap(authoredJob.Settings),
 			Metadata: StringStringMap(authoredJob.Metadata),
-		}
 
-		if err := tx.Create(&dbJob).Error; err != nil {
-			return jobError(err, "storing job")
+			MaxWorkers: authoredJob.MaxWorkers,
+
+			MinCPUCores: authoredJob.Requirements.CPUCores,
+			MinMemoryMB: authoredJob.Requirements.MemoryMB,
+		}
+		if !authoredJob.StartAfter.IsZero() {
+			dbJob.StartAfter = sql.NullTime{
+				Time:  authoredJob.StartAfter.UTC(),
+				Valid: true,
+			}
 		}
 
-		uuidToTask := make(map[string]*Task)
-		for _, authoredTask := range authoredJob.Tasks {
-			var commands []Command
-			for _, authoredCommand := range authoredTask.Commands {
-				commands = append(commands, Command{
-					Name:       authoredCommand.Name,
-					Parameters: StringInterfaceMap(authoredCommand.Parameters),
-				})
+		// Find and assign the worker tag.
+		if authoredJob.WorkerTagUUID != "" {
+			dbTag, err := fetchWorkerTag(tx, authoredJob.WorkerTagUUID)
+			if err != nil {
+				return err
 			}
+			dbJob.WorkerTagID = &dbTag.ID
+			dbJob.WorkerTag = dbTag
+		}
 
-			dbTask := Task{
-				Name:     authoredTask.Name,
-				Type:     authoredTask.Type,
-				UUID:     authoredTask.UUID,
-				Job:      &dbJob,
-				Priority: authoredTask.Priority,
-				Status:   api.TaskStatusQueued,
-				Commands: commands,
-				// dependencies are stored below.
+		// Find and assign the user who submitted the job.
+		if authoredJob.UserUUID != "" {
+			dbUser, err := fetchUser(tx, authoredJob.UserUUID)
+			if err != nil {
+				return err
 			}
-			if err := tx.Create(&dbTask).Error; err != nil {
-				return taskError(err, "storing task: %v", err)
+			dbJob.UserID = &dbUser.ID
+			dbJob.User = dbUser
+		}
+
+		// Find the jobs this job depends on.
+		if len(authoredJob.DependsOn) > 0 {
+			deps, err := fetchJobsWithUUID(tx, authoredJob.DependsOn)
+			if err != nil {
+				return err
 			}
+			dbJob.Dependencies = deps
+		}
 
-			uuidToTask[authoredTask.UUID] = &dbTask
+		if err := tx.Omit("Dependencies.*").Create(&dbJob).Error; err != nil {
+			return jobError(err, "storing job")
 		}
 
-		// Store the dependencies between tasks.
-		for _, authoredTask := range authoredJob.Tasks {
-			if len(authoredTask.Dependencies) == 0 {
-				continue
+		for name, value := range authoredJob.Requirements.Capabilities {
+			capability := JobRequiredCapability{
+				Job:   &dbJob,
+				Name:  name,
+				Value: value,
+			}
+			if err := tx.Create(&capability).Error; err != nil {
+				return jobError(err, "storing required capability %q", name)
 			}
+		}
+
+		return storeAuthoredTasks(tx, &dbJob, authoredJob.Tasks, nil)
+	})
+}
+
+// storeAuthoredTasks stores the tasks of the job, and the dependencies between
+// them. Authored tasks with the same name as a task in `keepTasks` are not
+// stored; the existing task is used instead.
+func storeAuthoredTasks(
+	tx *gorm.DB,
+	dbJob *Job,
+	authoredTasks []job_compilers.AuthoredTask,
+	keepTasks map[string]*Task,
+) error {
+	uuidToTask := make(map[string]*Task)
+	for _, authoredTask := range authoredTasks {
+		if keptTask, ok := keepTasks[authoredTask.Name]; ok {
+			uuidToTask[authoredTask.UUID] = keptTask
+			continue
+		}
+
+		var commands []Command
+		for _, authoredCommand := range authoredTask.Commands {
+			commands = append(commands, Command{
+				Name:       authoredCommand.Name,
+				Parameters: StringInterfaceMap(authoredCommand.Parameters),
+			})
+		}
+
+		dbTask := Task{
+			Name:     authoredTask.Name,
+			Type:     authoredTask.Type,
+			UUID:     authoredTask.UUID,
+			Job:      dbJob,
+			Priority: authoredTask.Priority,
+			Status:   api.TaskStatusQueued,
+			Commands: commands,
+			Timeout:  authoredTask.Timeout,
+			// dependencies are stored below.
+		}
+		if err := tx.Create(&dbTask).Error; err != nil {
+			return taskError(err, "storing task: %v", err)
+		}
+
+		uuidToTask[authoredTask.UUID] = &dbTask
+	}
 
-			dbTask, ok := uuidToTask[authoredTask.UUID]
+	// Store the dependencies between tasks.
+	for _, authoredTask := range authoredTasks {
+		if len(authoredTask.Dependencies) == 0 {
+			continue
+		}
+		if _, ok := keepTasks[authoredTask.Name]; ok {
+			// Kept tasks also keep their dependencies.
+			continue
+		}
+
+		dbTask, ok := uuidToTask[authoredTask.UUID]
+		if !ok {
+			return taskError(nil, "unable to find task %q in the database, even though it was just authored", authoredTask.UUID)
+		}
+
+		deps := make([]*Task, len(authoredTask.Dependencies))
+		for i, t := range authoredTask.Dependencies {
+			depTask, ok := uuidToTask[t.UUID]
 			if !ok {
-				return taskError(nil, "unable to find task %q in the database, even though it was just authored", authoredTask.UUID)
+				return taskError(nil, "finding task with UUID %q; a task depends on a task that is not part of this job", t.UUID)
 			}
+			deps[i] = depTask
+		}
 
-			deps := make([]*Task, len(authoredTask.Dependencies))
-			for i, t := range authoredTask.Dependencies {
-				depTask, ok := uuidToTask[t.UUID]
-				if !ok {
-					return taskError(nil, "finding task with UUID %q; a task depends on a task that is not part of this job", t.UUID)
-				}
-				deps[i] = depTask
-			}
+		dbTask.Dependencies = deps
+		subQuery := tx.Model(dbTask).Updates(Task{Dependencies: deps})
+		if subQuery.Error != nil {
+			return taskError(subQuery.Error, "unable to store dependencies of task %q", authoredTask.UUID)
+		}
+	}
+
+	return nil
+}
+
+// StoreRecompiledJob replaces the tasks of an existing job with the tasks of
+// the recompiled job. Completed tasks are kept when the recompiled job has a
+// task with the same name. The job's previous settings are recorded in its
+// history.
+func (db *DB) StoreRecompiledJob(ctx context.Context, authoredJob job_compilers.AuthoredJob) error {
+	return db.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		dbJob := Job{}
+		if err := tx.First(&dbJob, "uuid = ?", authoredJob.JobID).Error; err != nil {
+			return jobError(err, "fetching job")
+		}
+
+		historyEntry := JobHistoryEntry{
+			JobID:            dbJob.ID,
+			PreviousSettings: dbJob.Settings,
+		}
+		if err := tx.Create(&historyEntry).Error; err != nil {
+			return jobError(err, "storing job history")
+		}
+
+		dbJob.Settings = StringInterfaceMap(authoredJob.Settings)
+		if err := tx.Model(&dbJob).Select("Settings").Updates(&dbJob).Error; err != nil {
+			return jobError(err, "saving job settings")
+		}
+
+		existingTasks := []*Task{}
+		if err := tx.Where("job_id = ?", dbJob.ID).Find(&existingTasks).Error; err != nil {
+			return taskError(err, "fetching tasks of job")
+		}
 
-			dbTask.Dependencies = deps
-			subQuery := tx.Model(dbTask).Updates(Task{Dependencies: deps})
-			if subQuery.Error != nil {
-				return taskError(subQuery.Error, "unable to store dependencies of task %q", authoredTask.UUID)
+		recompiledNames := map[string]bool{}
+		for _, authoredTask := range authoredJob.Tasks {
+			recompiledNames[authoredTask.Name] = true
+		}
+
+		keepTasks := map[string]*Task{}
+		deleteTaskIDs := []uint{}
+		for _, task := range existingTasks {
+			_, seen := keepTasks[task.Name]
+			if task.Status == api.TaskStatusCompleted && recompiledNames[task.Name] && !seen {
+				keepTasks[task.Name] = task
+				continue
+			}
+			deleteTaskIDs = append(deleteTaskIDs, task.ID)
+		}
+		if len(deleteTaskIDs) > 0 {
+			if err := tx.Delete(&Task{}, deleteTaskIDs).Error; err != nil {
+				return taskError(err, "deleting tasks of job")
 			}
 		}
 
-		return nil
+		return storeAuthoredTasks(tx, &dbJob, authoredJob.Tasks, keepTasks)
 	})
 }
 
+// FetchJobHistory returns the history of the job, oldest entry first.
+func (db *DB) FetchJobHistory(ctx context.Context, job *Job) ([]JobHistoryEntry, error) {
+	entries := []JobHistoryEntry{}
+	tx := db.gormDB.WithContext(ctx).
+		Where("job_id = ?", job.ID).
+		Order("id").
+		Find(&entries)
+	if tx.Error != nil {
+		return nil, jobError(tx.Error, "fetching history of job %s", job.UUID)
+	}
+	return entries, nil
+}
+
+// fetchJobsWithUUID fetches the jobs with the given UUIDs. Returns
+// ErrJobNotFound when any of the jobs cannot be found.
+func fetchJobsWithUUID(gormDB *gorm.DB, jobUUIDs []string) ([]*Job, error) {
+	jobs := make([]*Job, 0, len(jobUUIDs))
+	tx := gormDB.Where("uuid in ?", jobUUIDs).Find(&jobs)
+	if tx.Error != nil {
+		return nil, jobError(tx.Error, "fetching jobs")
+	}
+
+	if len(jobs) < len(jobUUIDs) {
+		found := map[string]bool{}
+		for _, job := range jobs {
+			found[job.UUID] = true
+		}
+		for _, jobUUID := range jobUUIDs {
+			if !found[jobUUID] {
+				return nil, jobError(ErrJobNotFound, "fetching job %s", jobUUID)
+			}
+		}
+	}
+
+	return jobs, nil
+}
+
 // FetchJob fetches a single job, without fetching its tasks.
 func (db *DB) FetchJob(ctx context.Context, jobUUID string) (*Job, error) {
 	dbJob := Job{}
-	findResult := db.gormDB.WithContext(ctx).First(&dbJob, "uuid = ?", jobUUID)
+	findResult := db.gormDB.WithContext(ctx).
+		Joins("WorkerTag").
+		Joins("User").
+		First(&dbJob, "jobs.uuid = ?", jobUUID)
 	if findResult.Error != nil {
 		return nil, jobError(findResult.Error, "fetching job")
 	}
@@ -197,6 +437,36 @@ func (db *DB) FetchJob(ctx context.Context, jobUUID string) (*Job, error) {
 	return &dbJob, nil
 }
 
+// FetchJobDependencies returns the jobs that the given job depends on.
+func (db *DB) FetchJobDependencies(ctx context.Context, job *Job) ([]*Job, error) {
+	deps := make([]*Job, 0)
+	tx := db.gormDB.WithContext(ctx).
+		Joins("inner join job_dependencies jd on jd.dependency_id = jobs.id").
+		Where("jd.job_id = ?", job.ID).
+		Order("jobs.id").
+		Find(&deps)
+	if tx.Error != nil {
+		return nil, jobError(tx.Error, "fetching dependencies of job %s", job.UUID)
+	}
+	return deps, nil
+}
+
+// FetchJobsDependingOn returns the jobs in one of the given statuses that
+// depend on the given job.
+func (db *DB) FetchJobsDependingOn(ctx context.Context, job *Job, jobStatuses ...api.JobStatus) ([]*Job, error) {
+	dependents := make([]*Job, 0)
+	tx := db.gormDB.WithContext(ctx).
+		Joins("inner join job_dependencies jd on jd.job_id = jobs.id").
+		Where("jd.dependency_id = ?", job.ID).
+		Where("jobs.status in ?", jobStatuses).
+		Order("jobs.id").
+		Find(&dependents)
+	if tx.Error != nil {
+		return nil, jobError(tx.Error, "fetching jobs depending on job %s", job.UUID)
+	}
+	return dependents, nil
+}
+
 // DeleteJob deletes a job from the database.
 // The deletion cascades to its tasks and other job-related tables.
 func (db *DB) DeleteJob(ctx context.Context, jobUUID string) error {
@@ -209,6 +479,72 @@ func (db *DB) DeleteJob(ctx context.Context, jobUUID string) error {
 	return nil
 }
 
+// RequestJobDeletion marks the job as "to be deleted". The actual deletion is
+// done by the caller, via DeleteJob().
+func (db *DB) RequestJobDeletion(ctx context.Context, j *Job) error {
+	j.DeleteRequestedAt = sql.NullTime{
+		Time:  db.gormDB.NowFunc(),
+		Valid: true,
+	}
+	tx := db.gormDB.WithContext(ctx).
+		Model(j).
+		Updates(Job{DeleteRequestedAt: j.DeleteRequestedAt})
+	if tx.Error != nil {
+		return jobError(tx.Error, "queueing job for deletion")
+	}
+	return nil
+}
+
+// RequestJobMassDeletion marks all jobs in one of the given statuses, that
+// were last updated before the given timestamp, as "to be deleted". Returns
+// the UUIDs of the marked jobs.
+//
+// As jobs do not change after they have finished, their last update time is
+// used as the time they finished.
+func (db *DB) RequestJobMassDeletion(ctx context.Context, updatedBefore time.Time, jobStatuses ...api.JobStatus) ([]string, error) {
+	var jobUUIDs []string
+
+	err := db.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		findTx := tx.Model(&Job{}).
+			Where("status in ?", jobStatuses).
+			Where("updated_at < ?", updatedBefore.UTC()).
+			Where("delete_requested_at is NULL").
+			Pluck("uuid", &jobUUIDs)
+		if findTx.Error != nil {
+			return findTx.Error
+		}
+		if len(jobUUIDs) == 0 {
+			return nil
+		}
+
+		// Use UpdateColumn() to avoid touching the `updated_at` field.
+		updateTx := tx.Model(&Job{}).
+			Where("uuid in ?", jobUUIDs).
+			UpdateColumn("delete_requested_at", db.gormDB.NowFunc())
+		return updateTx.Error
+	})
+	if err != nil {
+		return nil, jobError(err, "queueing jobs for deletion")
+	}
+	return jobUUIDs, nil
+}
+
+// FetchJobsDeletionRequested returns the UUIDs of the jobs that are queued for
+// deletion, sorted by the time their deletion was requested.
+func (db *DB) FetchJobsDeletionRequested(ctx context.Context) ([]string, error) {
+	var jobUUIDs []string
+
+	tx := db.gormDB.WithContext(ctx).
+		Model(&Job{}).
+		Where("delete_requested_at is not NULL").
+		Order("delete_requested_at").
+		Pluck("uuid", &jobUUIDs)
+	if tx.Error != nil {
+		return nil, jobError(tx.Error, "fetching jobs marked for deletion")
+	}
+	return jobUUIDs, nil
+}
+
 func (db *DB) FetchJobsInStatus(ctx context.Context, jobStatuses ...api.JobStatus) ([]*Job, error) {
 	var jobs []*Job
 
@@ -234,6 +570,28 @@ func (db *DB) SaveJobStatus(ctx context.Context, j *Job) error {
 	return nil
 }
 
+// SaveJobPriority saves the job's Priority field.
+func (db *DB) SaveJobPriority(ctx context.Context, j *Job) error {
+	tx := db.gormDB.WithContext(ctx).
+		Model(j).
+		Update("priority", j.Priority)
+	if tx.Error != nil {
+		return jobError(tx.Error, "saving job priority")
+	}
+	return nil
+}
+
+// SaveJobMaxWorkers saves the job's MaxWorkers field.
+func (db *DB) SaveJobMaxWorkers(ctx context.Context, j *Job) error {
+	tx := db.gormDB.WithContext(ctx).
+		Model(j).
+		Update("max_workers", j.MaxWorkers)
+	if tx.Error != nil {
+		return jobError(tx.Error, "saving job max workers")
+	}
+	return nil
+}
+
 func (db *DB) FetchTask(ctx context.Context, taskUUID string) (*Task, error) {
 	dbTask := Task{}
 	tx := db.gormDB.WithContext(ctx).
@@ -258,8 +616,14 @@ func (db *DB) SaveTask(ctx context.Context, t *Task) error {
 }
 
 func (db *DB) SaveTaskStatus(ctx context.Context, t *Task) error {
+	// Store the retry time in UTC, so that it can be compared to NowFunc() when
+	// scheduling tasks.
+	if t.RetryAfter.Valid {
+		t.RetryAfter.Time = t.RetryAfter.Time.UTC()
+	}
+
 	tx := db.gormDB.WithContext(ctx).
-		Select("Status").
+		Select("Status", "RetryAfter", "Progress").
 		Save(t)
 	if tx.Error != nil {
 		return taskError(tx.Error, "saving task")
@@ -277,6 +641,53 @@ func (db *DB) SaveTaskActivity(ctx context.Context, t *Task) error {
 	return nil
 }
 
+func (db *DB) SaveTaskProgress(ctx context.Context, t *Task) error {
+	if err := db.gormDB.WithContext(ctx).
+		Model(t).
+		Select("Progress").
+		Updates(Task{Progress: t.Progress}).Error; err != nil {
+		return taskError(err, "saving task progress")
+	}
+	return nil
+}
+
+// FetchJobsProgress returns the percentage of each job that has been
+// completed, indexed by job ID. Completed tasks count as 100%, active tasks
+// count with their own progress, and other tasks count as 0%.
+func (db *DB) FetchJobsProgress(ctx context.Context, jobs []*Job) (map[uint]int, error) {
+	jobIDs := make([]uint, len(jobs))
+	for i, job := range jobs {
+		jobIDs[i] = job.ID
+	}
+
+	type jobProgress struct {
+		JobID         uint
+		TotalProgress int
+		NumTasks      int
+	}
+	rows := []jobProgress{}
+	tx := db.gormDB.WithContext(ctx).
+		Model(&Task{}).
+		Select("job_id, "+
+			"sum(case when status = ? then 100 when status = ? then progress else 0 end) as total_progress, "+
+			"count(*) as num_tasks",
+			api.TaskStatusCompleted, api.TaskStatusActive).
+		Where("job_id in ?", jobIDs).
+		Group("job_id").
+		Scan(&rows)
+	if tx.Error != nil {
+		return nil, jobError(tx.Error, "fetching job progress")
+	}
+
+	progress := make(map[uint]int, len(jobs))
+	for _, row := range rows {
+		if row.NumTasks > 0 {
+			progress[row.JobID] = row.TotalProgress / row.NumTasks
+		}
+	}
+	return progress, nil
+}
+
 func (db *DB) TaskAssignToWorker(ctx context.Context, t *Task, w *Worker) error {
 	tx := db.gormDB.WithContext(ctx).
 		Model(t).
@@ -314,7 +725,7 @@ func (db *DB) FetchTasksOfWorkerInStatusOfJob(ctx context.Context, worker *Worke
 		Joins("Job").
 		Where("tasks.worker_id = ?", worker.ID).
 		Where("tasks.status = ?", taskStatus).
-		Where("job.id = ?", job.ID).
+		Where("tasks.job_id = ?", job.ID).
 		Scan(&result)
 	if tx.Error != nil {
 		return nil, taskError(tx.Error, "finding tasks of worker %s in status %q and job %s", worker.UUID, taskStatus, job.UUID)
@@ -374,6 +785,56 @@ func (db *DB) CountTasksOfJobInStatus(
 	return
 }
 
+// SummarizeJobStatuses returns the number of jobs in each status. Statuses
+// without any jobs are not included.
+func (db *DB) SummarizeJobStatuses(ctx context.Context) (map[api.JobStatus]int, error) {
+	type Result struct {
+		Status  api.JobStatus
+		NumJobs int
+	}
+	var results []Result
+
+	tx := db.gormDB.WithContext(ctx).
+		Model(&Job{}).
+		Select("status, count(*) as num_jobs").
+		Group("status").
+		Scan(&results)
+	if tx.Error != nil {
+		return nil, jobError(tx.Error, "summarizing job statuses")
+	}
+
+	summary := make(map[api.JobStatus]int, len(results))
+	for _, result := range results {
+		summary[result.Status] = result.NumJobs
+	}
+	return summary, nil
+}
+
+// SummarizeTaskStatuses returns the number of tasks in each status, over all
+// jobs. Statuses without any tasks are not included.
+func (db *DB) SummarizeTaskStatuses(ctx context.Context) (map[api.TaskStatus]int, error) {
+	type Result struct {
+		Status   api.TaskStatus
+		NumTasks int
+	}
+	var results []Result
+
+	tx := db.gormDB.WithContext(ctx).
+		Model(&Task{}).
+		Select("status, count(*) as num_tasks").
+		Group("status").
+		Scan(&results)
+	if tx.Error != nil {
+		return nil, taskError(tx.Error, "summarizing task statuses")
+	}
+
+	summary := make(map[api.TaskStatus]int, len(results))
+	for _, result := range results {
+		summary[result.Status] = result.NumTasks
+	}
+	return summary, nil
+}
+
 // FetchTaskIDsOfJob returns all tasks of the given job.
 func (db *DB) FetchTasksOfJob(ctx context.Context, job *Job) ([]*Task, error) {
 	var tasks []*Task
@@ -47,7 +47,7 @@ func (db *DB) FetchJobBlocklist(ctx context.Context, jobUUID string) ([]JobBlock
 		Joins("inner join jobs on jobs.id = job_blocks.job_id").
 		Joins("Worker").
 		Where("jobs.uuid = ?", jobUUID).
-		Order("Worker.name").
+		Order(clause.OrderByColumn{Column: clause.Column{Table: "Worker", Name: "name"}}).
 		Scan(&entries)
 	return entries, tx.Error
 }
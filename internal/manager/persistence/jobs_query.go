@@ -22,15 +22,17 @@ func (db *DB) QueryJobs(ctx context.Context, apiQ api.JobsQuery) ([]*Job, error)
 	}
 	if apiQ.Settings != nil {
 		for setting, value := range apiQ.Settings.AdditionalProperties {
-			q = q.Where("json_extract(metadata, ?) = ?", "$."+setting, value)
+			field, key := db.dialect.jsonFieldQuery("metadata", setting)
+			q = q.Where(field+" = ?", key, value)
 		}
 	}
 	if apiQ.Metadata != nil {
 		for setting, value := range apiQ.Metadata.AdditionalProperties {
+			field, key := db.dialect.jsonFieldQuery("metadata", setting)
 			if strings.ContainsRune(value, '%') {
-				q = q.Where("json_extract(metadata, ?) like ?", "$."+setting, value)
+				q = q.Where(field+" "+db.dialect.likeOperator()+" ?", key, value)
 			} else {
-				q = q.Where("json_extract(metadata, ?) = ?", "$."+setting, value)
+				q = q.Where(field+" = ?", key, value)
 			}
 		}
 	}
@@ -22,6 +22,8 @@ func TestStoreAuthoredJob(t *testing.T) {
 	defer cancel()
 
 	job := createTestAuthoredJobWithTasks()
+	job.MaxWorkers = 4
+	job.StartAfter = time.Date(2022, time.November, 3, 20, 0, 0, 0, time.FixedZone("CET", 3600))
 	err := db.StoreAuthoredJob(ctx, job)
 	assert.NoError(t, err)
 
@@ -34,6 +36,9 @@ func TestStoreAuthoredJob(t *testing.T) {
 	assert.Equal(t, job.Name, fetchedJob.Name)
 	assert.Equal(t, job.JobType, fetchedJob.JobType)
 	assert.Equal(t, job.Priority, fetchedJob.Priority)
+	assert.Equal(t, job.MaxWorkers, fetchedJob.MaxWorkers)
+	assert.True(t, fetchedJob.StartAfter.Valid)
+	assert.True(t, job.StartAfter.Equal(fetchedJob.StartAfter.Time))
 	assert.Equal(t, api.JobStatusUnderConstruction, fetchedJob.Status)
 	assert.EqualValues(t, map[string]interface{}(job.Settings), fetchedJob.Settings)
 	assert.EqualValues(t, map[string]string(job.Metadata), fetchedJob.Metadata)
@@ -56,6 +61,175 @@ func TestStoreAuthoredJob(t *testing.T) {
 	assert.Equal(t, api.TaskStatusQueued, tasks[2].Status)
 }
 
+func TestStoreAuthoredJobWithDependencies(t *testing.T) {
+	ctx, close, db, job1, authoredJob1 := jobTasksTestFixtures(t)
+	defer close()
+
+	authoredJob2 := duplicateJobAndTasks(authoredJob1)
+	job2 := persistAuthoredJob(t, ctx, db, authoredJob2)
+
+	// Create a third job that depends on the other two.
+	authoredJob3 := duplicateJobAndTasks(authoredJob1)
+	authoredJob3.DependsOn = []string{job1.UUID, job2.UUID}
+	job3 := persistAuthoredJob(t, ctx, db, authoredJob3)
+
+	deps, err := db.FetchJobDependencies(ctx, job3)
+	assert.NoError(t, err)
+	if assert.Len(t, deps, 2) {
+		assert.Equal(t, job1.UUID, deps[0].UUID)
+		assert.Equal(t, job2.UUID, deps[1].UUID)
+	}
+
+	deps, err = db.FetchJobDependencies(ctx, job1)
+	assert.NoError(t, err)
+	assert.Empty(t, deps)
+
+	// Job 3 is still under construction, so shouldn't be found when looking for waiting jobs.
+	dependents, err := db.FetchJobsDependingOn(ctx, job1, api.JobStatusWaiting)
+	assert.NoError(t, err)
+	assert.Empty(t, dependents)
+
+	job3.Status = api.JobStatusWaiting
+	assert.NoError(t, db.SaveJobStatus(ctx, job3))
+	dependents, err = db.FetchJobsDependingOn(ctx, job2, api.JobStatusWaiting)
+	assert.NoError(t, err)
+	if assert.Len(t, dependents, 1) {
+		assert.Equal(t, job3.UUID, dependents[0].UUID)
+	}
+
+	// Deleting a job should also remove it as dependency.
+	assert.NoError(t, db.DeleteJob(ctx, job1.UUID))
+	deps, err = db.FetchJobDependencies(ctx, job3)
+	assert.NoError(t, err)
+	if assert.Len(t, deps, 1) {
+		assert.Equal(t, job2.UUID, deps[0].UUID)
+	}
+}
+
+func TestStoreAuthoredJobWithNonexistentDependency(t *testing.T) {
+	ctx, close, db, job1, authoredJob1 := jobTasksTestFixtures(t)
+	defer close()
+
+	authoredJob2 := duplicateJobAndTasks(authoredJob1)
+	authoredJob2.DependsOn = []string{job1.UUID, "3c9d2ee0-2a5d-4ffa-88e1-0b1e3ac3e8f1"}
+
+	err := db.StoreAuthoredJob(ctx, authoredJob2)
+	assert.ErrorIs(t, err, ErrJobNotFound)
+
+	// The job should not have been stored.
+	_, err = db.FetchJob(ctx, authoredJob2.JobID)
+	assert.ErrorIs(t, err, ErrJobNotFound)
+}
+
+func TestStoreAuthoredJobWithUser(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	user := createTestUser(t, ctx, db, "1ab0e2e0-4d0c-4b7a-8b44-4f0e6dbb8e1d", "artist", api.UserRoleUser)
+
+	authoredJob := createTestAuthoredJobWithTasks()
+	authoredJob.UserUUID = user.UUID
+	persistAuthoredJob(t, ctx, db, authoredJob)
+
+	dbJob, err := db.FetchJob(ctx, authoredJob.JobID)
+	if assert.NoError(t, err) && assert.NotNil(t, dbJob.User) {
+		assert.Equal(t, user.ID, *dbJob.UserID)
+		assert.Equal(t, user.UUID, dbJob.User.UUID)
+		assert.Equal(t, user.Name, dbJob.User.Name)
+	}
+
+	// A job submitted by a non-existent user should be rejected.
+	authoredJob2 := duplicateJobAndTasks(authoredJob)
+	authoredJob2.UserUUID = "fa2fc9e5-0a2b-4bcd-8a3c-2bd7cd8d6a3b"
+	err = db.StoreAuthoredJob(ctx, authoredJob2)
+	assert.ErrorIs(t, err, ErrUserNotFound)
+}
+
+func TestStoreRecompiledJob(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	authJob := createTestAuthoredJobWithTasks()
+	dbJob := persistAuthoredJob(t, ctx, db, authJob)
+
+	// Complete the first task, and fail the second.
+	task1, err := db.FetchTask(ctx, authJob.Tasks[0].UUID)
+	assert.NoError(t, err)
+	task1.Status = api.TaskStatusCompleted
+	assert.NoError(t, db.SaveTask(ctx, task1))
+	task2, err := db.FetchTask(ctx, authJob.Tasks[1].UUID)
+	assert.NoError(t, err)
+	task2.Status = api.TaskStatusFailed
+	assert.NoError(t, db.SaveTask(ctx, task2))
+
+	// Recompile the job with a larger frame range. This produces new UUIDs for
+	// all tasks.
+	render1 := job_compilers.AuthoredTask{Name: "render-1-3", Type: "blender", UUID: "0f8e5ee4-8ec5-4a8c-b1a8-4cbb2b2b9b71"}
+	render2 := job_compilers.AuthoredTask{Name: "render-4-6", Type: "blender", UUID: "7e5f6d56-b7b2-4e4c-9d2c-0a5bb0c1d8b4"}
+	render3 := job_compilers.AuthoredTask{Name: "render-7-9", Type: "blender", UUID: "b4a1de9b-03f4-4d4c-8d3f-5f2a2fd0c6f1"}
+	video := job_compilers.AuthoredTask{
+		Name:         "preview-video",
+		Type:         "ffmpeg",
+		UUID:         "f16b8c7c-6a1f-4b62-a7a9-2c6b8a0b3a52",
+		Dependencies: []*job_compilers.AuthoredTask{&render1, &render2, &render3},
+	}
+	recompiled := createTestAuthoredJob(authJob.JobID, render1, render2, render3, video)
+	recompiled.Settings["frames"] = "1-9"
+	assert.NoError(t, db.StoreRecompiledJob(ctx, recompiled))
+
+	// The completed task should have been kept, the others replaced.
+	_, err = db.FetchTask(ctx, render1.UUID)
+	assert.ErrorIs(t, err, ErrTaskNotFound, "completed task should not be replaced")
+	keptTask, err := db.FetchTask(ctx, task1.UUID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, api.TaskStatusCompleted, keptTask.Status)
+	}
+	_, err = db.FetchTask(ctx, task2.UUID)
+	assert.ErrorIs(t, err, ErrTaskNotFound, "failed task should be replaced")
+
+	for _, uuid := range []string{render2.UUID, render3.UUID, video.UUID} {
+		task, err := db.FetchTask(ctx, uuid)
+		if assert.NoError(t, err) {
+			assert.Equal(t, api.TaskStatusQueued, task.Status)
+		}
+	}
+
+	var numTasks int64
+	tx := db.gormDB.Model(&Task{}).Where("job_id = ?", dbJob.ID).Count(&numTasks)
+	assert.NoError(t, tx.Error)
+	assert.Equal(t, int64(4), numTasks)
+
+	// The video task should depend on the kept task.
+	var videoTask Task
+	tx = db.gormDB.Preload("Dependencies").First(&videoTask, "uuid = ?", video.UUID)
+	assert.NoError(t, tx.Error)
+	depUUIDs := []string{}
+	for _, dep := range videoTask.Dependencies {
+		depUUIDs = append(depUUIDs, dep.UUID)
+	}
+	assert.ElementsMatch(t, []string{task1.UUID, render2.UUID, render3.UUID}, depUUIDs)
+
+	// The job should have the new settings, and the old ones in its history.
+	updatedJob, err := db.FetchJob(ctx, authJob.JobID)
+	assert.NoError(t, err)
+	assert.Equal(t, "1-9", updatedJob.Settings["frames"])
+
+	history, err := db.FetchJobHistory(ctx, updatedJob)
+	assert.NoError(t, err)
+	if assert.Len(t, history, 1) {
+		assert.EqualValues(t, map[string]interface{}(authJob.Settings), history[0].PreviousSettings)
+	}
+}
+
+func TestStoreRecompiledJobNonexistent(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	authJob := createTestAuthoredJobWithTasks()
+	err := db.StoreRecompiledJob(ctx, authJob)
+	assert.ErrorIs(t, err, ErrJobNotFound)
+}
+
 func TestDeleteJob(t *testing.T) {
 	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
 	defer cancel()
@@ -84,6 +258,102 @@ func TestDeleteJob(t *testing.T) {
 	assert.Equal(t, int64(0), numTasks, "tasks should have been deleted along with their job")
 }
 
+func TestRequestJobDeletion(t *testing.T) {
+	ctx, close, db, job1, authoredJob1 := jobTasksTestFixtures(t)
+	defer close()
+
+	// Create another job, to see it's not touched.
+	authoredJob2 := duplicateJobAndTasks(authoredJob1)
+	job2 := persistAuthoredJob(t, ctx, db, authoredJob2)
+
+	mockNow := time.Now()
+	db.gormDB.NowFunc = func() time.Time { return mockNow }
+
+	err := db.RequestJobDeletion(ctx, job1)
+	assert.NoError(t, err)
+	assert.True(t, job1.DeleteRequestedAt.Valid)
+	assert.Equal(t, job1.DeleteRequestedAt.Time, mockNow)
+
+	dbJob1, err := db.FetchJob(ctx, job1.UUID)
+	assert.NoError(t, err)
+	assert.True(t, dbJob1.DeleteRequestedAt.Valid)
+	assert.WithinDuration(t, mockNow, dbJob1.DeleteRequestedAt.Time, time.Second)
+
+	dbJob2, err := db.FetchJob(ctx, job2.UUID)
+	assert.NoError(t, err)
+	assert.False(t, dbJob2.DeleteRequestedAt.Valid)
+
+	jobUUIDs, err := db.FetchJobsDeletionRequested(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{job1.UUID}, jobUUIDs)
+}
+
+func TestRequestJobMassDeletion(t *testing.T) {
+	ctx, close, db, job1, authoredJob1 := jobTasksTestFixtures(t)
+	defer close()
+
+	now := db.gormDB.NowFunc()
+	setJobStatusAndUpdated := func(job *Job, status api.JobStatus, updatedAt time.Time) {
+		tx := db.gormDB.Model(job).UpdateColumns(Job{Status: status, Model: Model{UpdatedAt: updatedAt}})
+		if !assert.NoError(t, tx.Error) {
+			t.FailNow()
+		}
+	}
+
+	job2 := persistAuthoredJob(t, ctx, db, duplicateJobAndTasks(authoredJob1))
+	job3 := persistAuthoredJob(t, ctx, db, duplicateJobAndTasks(authoredJob1))
+	job4 := persistAuthoredJob(t, ctx, db, duplicateJobAndTasks(authoredJob1))
+
+	setJobStatusAndUpdated(job1, api.JobStatusCompleted, now.Add(-48*time.Hour)) // Old enough, right status.
+	setJobStatusAndUpdated(job2, api.JobStatusCompleted, now.Add(-1*time.Hour))  // Too new.
+	setJobStatusAndUpdated(job3, api.JobStatusPaused, now.Add(-48*time.Hour))    // Wrong status.
+	setJobStatusAndUpdated(job4, api.JobStatusCanceled, now.Add(-72*time.Hour))  // Old enough, right status.
+
+	// Only just too new. This would be found when the timezone of the threshold
+	// timestamp would not be taken into account.
+	job5 := persistAuthoredJob(t, ctx, db, duplicateJobAndTasks(authoredJob1))
+	setJobStatusAndUpdated(job5, api.JobStatusCompleted, now.Add(-22*time.Hour))
+
+	eastOfUTC := time.FixedZone("UTC+5", 5*60*60)
+	jobUUIDs, err := db.RequestJobMassDeletion(ctx, now.Add(-24*time.Hour).In(eastOfUTC),
+		api.JobStatusCompleted, api.JobStatusCanceled)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{job1.UUID, job4.UUID}, jobUUIDs)
+
+	// The jobs' update timestamp should not have been touched.
+	dbJob1, err := db.FetchJob(ctx, job1.UUID)
+	assert.NoError(t, err)
+	assert.True(t, dbJob1.DeleteRequestedAt.Valid)
+	assert.WithinDuration(t, now.Add(-48*time.Hour), dbJob1.UpdatedAt, time.Second)
+
+	// Doing the same request again should not return the same jobs again.
+	jobUUIDs, err = db.RequestJobMassDeletion(ctx, now.Add(-24*time.Hour),
+		api.JobStatusCompleted, api.JobStatusCanceled)
+	assert.NoError(t, err)
+	assert.Empty(t, jobUUIDs)
+
+	jobUUIDs, err = db.FetchJobsDeletionRequested(ctx)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{job1.UUID, job4.UUID}, jobUUIDs)
+}
+
+func TestSaveJobPriority(t *testing.T) {
+	ctx, close, db, job, _ := jobTasksTestFixtures(t)
+	defer close()
+
+	// Check setting to zero, as that's the zero-value in Go.
+	for _, prio := range []int{0, 100} {
+		job.Priority = prio
+		assert.NoError(t, db.SaveJobPriority(ctx, job))
+
+		dbJob, err := db.FetchJob(ctx, job.UUID)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		assert.Equal(t, prio, dbJob.Priority)
+	}
+}
+
 func TestJobHasTasksInStatus(t *testing.T) {
 	ctx, close, db, job, _ := jobTasksTestFixtures(t)
 	defer close()
@@ -128,6 +398,71 @@ func TestCountTasksOfJobInStatus(t *testing.T) {
 	assert.Equal(t, 3, numTotal)
 }
 
+func TestFetchJobsProgress(t *testing.T) {
+	ctx, close, db, job, authoredJob := jobTasksTestFixtures(t)
+	defer close()
+
+	// A freshly created job has not made any progress.
+	progress, err := db.FetchJobsProgress(ctx, []*Job{job})
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[uint]int{job.ID: 0}, progress)
+	}
+
+	// One completed and one half-way active task: (100 + 50 + 0) / 3 = 50.
+	task1, err := db.FetchTask(ctx, authoredJob.Tasks[0].UUID)
+	assert.NoError(t, err)
+	task1.Status = api.TaskStatusCompleted
+	assert.NoError(t, db.SaveTaskStatus(ctx, task1))
+
+	task2, err := db.FetchTask(ctx, authoredJob.Tasks[1].UUID)
+	assert.NoError(t, err)
+	task2.Status = api.TaskStatusActive
+	assert.NoError(t, db.SaveTaskStatus(ctx, task2))
+	task2.Progress = 50
+	assert.NoError(t, db.SaveTaskProgress(ctx, task2))
+
+	// Progress of a queued task should not count.
+	task3, err := db.FetchTask(ctx, authoredJob.Tasks[2].UUID)
+	assert.NoError(t, err)
+	task3.Progress = 80
+	assert.NoError(t, db.SaveTaskProgress(ctx, task3))
+
+	dbTask2, err := db.FetchTask(ctx, task2.UUID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 50, dbTask2.Progress)
+	}
+
+	progress, err = db.FetchJobsProgress(ctx, []*Job{job})
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[uint]int{job.ID: 50}, progress)
+	}
+}
+
+func TestSummarizeJobAndTaskStatuses(t *testing.T) {
+	ctx, close, db, job, authoredJob := jobTasksTestFixtures(t)
+	defer close()
+
+	// Make one task failed.
+	task, err := db.FetchTask(ctx, authoredJob.Tasks[0].UUID)
+	assert.NoError(t, err)
+	task.Status = api.TaskStatusFailed
+	assert.NoError(t, db.SaveTask(ctx, task))
+
+	jobSummary, err := db.SummarizeJobStatuses(ctx)
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[api.JobStatus]int{job.Status: 1}, jobSummary)
+	}
+
+	taskSummary, err := db.SummarizeTaskStatuses(ctx)
+	if assert.NoError(t, err) {
+		expect := map[api.TaskStatus]int{
+			api.TaskStatusQueued: 2,
+			api.TaskStatusFailed: 1,
+		}
+		assert.Equal(t, expect, taskSummary)
+	}
+}
+
 func TestFetchJobsInStatus(t *testing.T) {
 	ctx, close, db, job1, _ := jobTasksTestFixtures(t)
 	defer close()
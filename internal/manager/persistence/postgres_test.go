@@ -0,0 +1,81 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// testDatabaseEnvVar selects the database to run the tests against. Set it to
+// "postgres" to start a temporary PostgreSQL server for the tests. This uses
+// the `initdb` and `pg_ctl` programs, which should be on the $PATH.
+const testDatabaseEnvVar = "FLAMENCO_TEST_DB"
+
+func TestMain(m *testing.M) {
+	switch database := os.Getenv(testDatabaseEnvVar); database {
+	case "", "sqlite":
+		os.Exit(m.Run())
+	case "postgres":
+		os.Exit(runWithTestPostgres(m))
+	default:
+		fmt.Fprintf(os.Stderr, "unknown %s=%q, use 'sqlite' or 'postgres'\n", testDatabaseEnvVar, database)
+		os.Exit(2)
+	}
+}
+
+// runWithTestPostgres starts a PostgreSQL server, runs the tests against it,
+// and stops the server again.
+func runWithTestPostgres(m *testing.M) int {
+	dataDir, err := os.MkdirTemp("", "flamenco-test-postgres-")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "creating PostgreSQL data directory: %v\n", err)
+		return 1
+	}
+	defer os.RemoveAll(dataDir)
+
+	err = runPostgresCommand("initdb",
+		"--pgdata", dataDir,
+		"--username", "flamenco",
+		"--auth", "trust",
+		"--no-sync")
+	if err != nil {
+		return 1
+	}
+
+	// Only listen on a Unix socket in the data directory, so that this server
+	// cannot conflict with any other that may be running.
+	err = runPostgresCommand("pg_ctl", "start",
+		"--pgdata", dataDir,
+		"--log", filepath.Join(dataDir, "server.log"),
+		"--options", fmt.Sprintf("-c listen_addresses='' -k %s", dataDir),
+		"--wait")
+	if err != nil {
+		return 1
+	}
+	defer func() {
+		_ = runPostgresCommand("pg_ctl", "stop",
+			"--pgdata", dataDir,
+			"--mode", "immediate",
+			"--wait")
+	}()
+
+	testPostgresDSN = fmt.Sprintf("postgres://flamenco@/postgres?host=%s&sslmode=disable",
+		url.QueryEscape(dataDir))
+	return m.Run()
+}
+
+// runPostgresCommand runs one of the PostgreSQL programs. Its output is only
+// shown when it fails.
+func runPostgresCommand(name string, args ...string) error {
+	output, err := exec.Command(name, args...).CombinedOutput()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "running %s: %v\n%s\n", name, err, output)
+		return err
+	}
+	return nil
+}
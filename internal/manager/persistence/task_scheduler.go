@@ -4,7 +4,10 @@ package persistence
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
@@ -19,11 +22,48 @@ var (
 	// completedTaskStatuses   = []api.TaskStatus{api.TaskStatusCompleted}
 )
 
+// SchedulingPolicy determines how Workers are spread over jobs of the same priority.
+type SchedulingPolicy string
+
+const (
+	// SchedulingPolicyStrict hands out the tasks of the job that sorts first,
+	// until it has no more schedulable tasks. Only then will equal-priority jobs
+	// get a turn.
+	SchedulingPolicyStrict SchedulingPolicy = "strict"
+
+	// SchedulingPolicyFairShare spreads Workers over jobs of the same priority,
+	// by preferring the job with the fewest active tasks.
+	SchedulingPolicyFairShare SchedulingPolicy = "fair-share"
+)
+
+var ErrUnknownSchedulingPolicy = errors.New("unknown scheduling policy")
+
+// SetSchedulingPolicy determines how ScheduleTask() chooses between jobs of
+// the same priority. The empty string is interpreted as SchedulingPolicyStrict.
+func (db *DB) SetSchedulingPolicy(policy SchedulingPolicy) error {
+	switch policy {
+	case "":
+		policy = SchedulingPolicyStrict
+	case SchedulingPolicyStrict, SchedulingPolicyFairShare:
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownSchedulingPolicy, policy)
+	}
+	db.schedulingPolicy = policy
+	return nil
+}
+
 // ScheduleTask finds a task to execute by the given worker.
 // If no task is available, (nil, nil) is returned, as this is not an error situation.
 // NOTE: this does not also fetch returnedTask.Worker, but returnedTask.WorkerID is set.
-func (db *DB) ScheduleTask(ctx context.Context, w *Worker) (*Task, error) {
-	logger := log.With().Str("worker", w.UUID).Logger()
+//
+// `runningTaskUUIDs` are the tasks the Worker is still running. These are not
+// handed out again, so that a Worker with task slots can get a task for its
+// next free slot.
+func (db *DB) ScheduleTask(ctx context.Context, w *Worker, runningTaskUUIDs ...string) (*Task, error) {
+	logger := log.With().
+		Str("worker", w.UUID).
+		Str("policy", string(db.schedulingPolicy)).
+		Logger()
 	logger.Trace().Msg("finding task for worker")
 
 	// Run two queries in one transaction:
@@ -32,7 +72,7 @@ func (db *DB) ScheduleTask(ctx context.Context, w *Worker) (*Task, error) {
 	var task *Task
 	txErr := db.gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		var err error
-		task, err = findTaskForWorker(tx, w)
+		task, err = findTaskForWorker(tx, w, db.schedulingPolicy, runningTaskUUIDs)
 		if err != nil {
 			if isDatabaseBusyError(err) {
 				logger.Trace().Err(err).Msg("database busy while finding task for worker")
@@ -79,13 +119,70 @@ func (db *DB) ScheduleTask(ctx context.Context, w *Worker) (*Task, error) {
 	return task, nil
 }
 
-func findTaskForWorker(tx *gorm.DB, w *Worker) (*Task, error) {
+// NextDeferredTaskTime returns the next moment a task becomes schedulable,
+// because its job's start time or its retry time passes. Returns the zero time
+// when there are no tasks waiting for such a moment.
+func (db *DB) NextDeferredTaskTime(ctx context.Context) (time.Time, error) {
+	now := db.gormDB.NowFunc()
+
+	job := Job{}
+	tx := db.gormDB.WithContext(ctx).
+		Model(&Job{}).
+		Select("start_after").
+		Where("status in ?", schedulableJobStatuses).
+		Where("delete_requested_at is NULL").
+		Where("start_after > ?", now).
+		Order("start_after").
+		Limit(1).
+		Find(&job)
+	if tx.Error != nil {
+		return time.Time{}, jobError(tx.Error, "finding next job start time")
+	}
+
+	task := Task{}
+	tx = db.gormDB.WithContext(ctx).
+		Model(&Task{}).
+		Select("tasks.retry_after").
+		Joins("left join jobs on tasks.job_id = jobs.id").
+		Where("tasks.status = ?", api.TaskStatusSoftFailed).
+		Where("jobs.status in ?", schedulableJobStatuses).
+		Where("jobs.delete_requested_at is NULL").
+		Where("tasks.retry_after > ?", now).
+		Order("tasks.retry_after").
+		Limit(1).
+		Find(&task)
+	if tx.Error != nil {
+		return time.Time{}, taskError(tx.Error, "finding next task retry time")
+	}
+
+	switch {
+	case !job.StartAfter.Valid && !task.RetryAfter.Valid:
+		return time.Time{}, nil
+	case !task.RetryAfter.Valid:
+		return job.StartAfter.Time, nil
+	case !job.StartAfter.Valid, task.RetryAfter.Time.Before(job.StartAfter.Time):
+		return task.RetryAfter.Time, nil
+	default:
+		return job.StartAfter.Time, nil
+	}
+}
+
+func findTaskForWorker(
+	tx *gorm.DB,
+	w *Worker,
+	policy SchedulingPolicy,
+	runningTaskUUIDs []string,
+) (*Task, error) {
 	task := Task{}
 
 	// If a task is alreay active & assigned to this worker, return just that.
 	// Note that this task type could be blocklisted or no longer supported by the
 	// Worker, but since it's active that is unlikely.
-	assignedTaskResult := taskAssignedAndRunnableQuery(tx.Model(&task), w).
+	assignedTaskQuery := taskAssignedAndRunnableQuery(tx.Model(&task), w)
+	if len(runningTaskUUIDs) > 0 {
+		assignedTaskQuery = assignedTaskQuery.Where("tasks.uuid not in ?", runningTaskUUIDs)
+	}
+	assignedTaskResult := assignedTaskQuery.
 		Preload("Job").
 		Find(&task)
 	if assignedTaskResult.Error != nil {
@@ -95,6 +192,15 @@ func findTaskForWorker(tx *gorm.DB, w *Worker) (*Task, error) {
 		return &task, nil
 	}
 
+	taskTypes, err := taskTypesWithFreeSlot(tx, w)
+	if err != nil {
+		return nil, err
+	}
+	if len(taskTypes) == 0 {
+		// All the Worker's slots are taken.
+		return nil, nil
+	}
+
 	// Produce the 'current task ID' by selecting all its incomplete dependencies.
 	// This can then be used in a subquery to filter out such tasks.
 	// `tasks.id` is the task ID from the outer query.
@@ -110,22 +216,86 @@ func findTaskForWorker(tx *gorm.DB, w *Worker) (*Task, error) {
 		Where("job_blocks.worker_id = ?", w.ID).
 		Where("job_blocks.job_id = jobs.id")
 
+	// Tagged jobs are only available to workers carrying that tag. Untagged jobs
+	// are available to all workers.
+	workerTagsQuery := tx.Table("worker_tag_membership").
+		Select("worker_tag_membership.worker_tag_id").
+		Where("worker_tag_membership.worker_id = ?", w.ID)
+	workerTagFilter := tx.
+		Where("jobs.worker_tag_id is NULL").
+		Or("jobs.worker_tag_id in (?)", workerTagsQuery)
+
+	// Jobs with a maximum number of workers should only get more tasks assigned
+	// when fewer Workers than that are working on them. A Worker with task slots
+	// can run multiple tasks of the same job; once it works on the job, it can
+	// always get another task of it.
+	activeWorkerCountQuery := tx.Table("tasks as active_tasks").
+		Select("count(distinct active_tasks.worker_id)").
+		Where("active_tasks.job_id = jobs.id").
+		Where("active_tasks.status = ?", api.TaskStatusActive)
+	workerOnJobQuery := tx.Table("tasks as own_tasks").
+		Select("own_tasks.id").
+		Where("own_tasks.job_id = jobs.id").
+		Where("own_tasks.status = ?", api.TaskStatusActive).
+		Where("own_tasks.worker_id = ?", w.ID)
+	maxWorkersFilter := tx.
+		Where("jobs.max_workers = 0").
+		Or("(?) < jobs.max_workers", activeWorkerCountQuery).
+		Or("exists (?)", workerOnJobQuery)
+
+	// Jobs can require Workers to have certain resources.
+	resourcesFilter := tx.
+		Where("jobs.min_cpu_cores <= ?", w.CPUCores).
+		Where("jobs.min_memory_mb <= ?", w.MemoryMB)
+	unmetCapabilitiesQuery := unmetCapabilitiesQuery(tx, w)
+
+	// Soft-failed tasks have to wait before they can be retried.
+	retryAfterFilter := tx.
+		Where("tasks.status != ?", api.TaskStatusSoftFailed).
+		Or("tasks.retry_after is NULL").
+		Or("tasks.retry_after <= ?", tx.NowFunc())
+
+	// Jobs with a deferred start are only available after their start time.
+	startAfterFilter := tx.
+		Where("jobs.start_after is NULL").
+		Or("jobs.start_after <= ?", tx.NowFunc())
+
 	// Note that this query doesn't check for the assigned worker. Tasks that have
 	// a 'schedulable' status might have been assigned to a worker, representing
 	// the last worker to touch it -- it's not meant to indicate "ownership" of
 	// the task.
-	findTaskResult := tx.
+	findTaskQuery := tx.
 		Model(&task).
 		Joins("left join jobs on tasks.job_id = jobs.id").
 		Joins("left join task_failures TF on tasks.id = TF.task_id and TF.worker_id=?", w.ID).
 		Where("tasks.status in ?", schedulableTaskStatuses).   // Schedulable task statuses
 		Where("jobs.status in ?", schedulableJobStatuses).     // Schedulable job statuses
-		Where("tasks.type in ?", w.TaskTypes()).               // Supported task types
+		Where("jobs.delete_requested_at is NULL").             // Not being deleted
+		Where(startAfterFilter).                               // Allowed to start
+		Where("tasks.type in ?", taskTypes).                   // Supported task types with a free slot
+		Where(retryAfterFilter).                               // Not waiting to be retried
 		Where("tasks.id not in (?)", incompleteDepsQuery).     // Dependencies completed
 		Where("TF.worker_id is NULL").                         // Not failed before
 		Where("tasks.type not in (?)", blockedTaskTypesQuery). // Non-blocklisted
-		Order("jobs.priority desc").                           // Highest job priority
-		Order("tasks.priority desc").                          // Highest task priority
+		Where(workerTagFilter).                                // Untagged, or tagged for this worker
+		Where(maxWorkersFilter).                               // Not at its maximum number of workers
+		Where(resourcesFilter).                                // Worker has enough resources
+		Where("not exists (?)", unmetCapabilitiesQuery).       // Worker has the required capabilities
+		Order("jobs.priority desc")                            // Highest job priority
+
+	if policy == SchedulingPolicyFairShare {
+		// Of the jobs with the same priority, prefer the one with the fewest active
+		// tasks. As the chosen task becomes active, the next Worker will be handed
+		// a task of another job, resulting in round-robin scheduling.
+		activeTaskCount := fmt.Sprintf(
+			"(select count(*) from tasks active_tasks"+
+				" where active_tasks.job_id = jobs.id and active_tasks.status = '%s') asc",
+			api.TaskStatusActive)
+		findTaskQuery = findTaskQuery.Order(activeTaskCount)
+	}
+
+	findTaskResult := findTaskQuery.
+		Order("tasks.priority desc"). // Highest task priority
 		Limit(1).
 		Preload("Job").
 		Find(&task)
@@ -141,6 +311,69 @@ func findTaskForWorker(tx *gorm.DB, w *Worker) (*Task, error) {
 	return &task, nil
 }
 
+// taskTypesWithFreeSlot returns the Worker's supported task types for which it
+// has a free task slot. Workers without task slots get all their task types, as
+// they only ask for a task when they are idle.
+func taskTypesWithFreeSlot(tx *gorm.DB, w *Worker) ([]string, error) {
+	if len(w.TaskSlots) == 0 {
+		return w.TaskTypes(), nil
+	}
+
+	type typeCount struct {
+		Type  string
+		Count int
+	}
+	activeCounts := []typeCount{}
+	result := tx.Model(&Task{}).
+		Select("tasks.type as type, count(*) as count").
+		Where("tasks.worker_id = ?", w.ID).
+		Where("tasks.status = ?", api.TaskStatusActive).
+		Group("tasks.type").
+		Scan(&activeCounts)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	numActive := map[string]int{}
+	for _, c := range activeCounts {
+		numActive[c.Type] = c.Count
+	}
+
+	freeTypes := []string{}
+	for _, taskType := range w.TaskTypes() {
+		slots, ok := w.TaskSlots[taskType]
+		if !ok {
+			slots = 1
+		}
+		if numActive[taskType] < slots {
+			freeTypes = append(freeTypes, taskType)
+		}
+	}
+	return freeTypes, nil
+}
+
+// unmetCapabilitiesQuery returns a subquery that finds the capabilities
+// required by the job (`jobs.id` from the outer query) that the Worker does not
+// have.
+func unmetCapabilitiesQuery(tx *gorm.DB, w *Worker) *gorm.DB {
+	query := tx.Model(&JobRequiredCapability{}).
+		Select("job_required_capabilities.id").
+		Where("job_required_capabilities.job_id = jobs.id")
+
+	// Sort the capabilities, to get the same SQL for every query.
+	names := make([]string, 0, len(w.Capabilities))
+	for name := range w.Capabilities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		query = query.Where(
+			"not (job_required_capabilities.name = ? and job_required_capabilities.value = ?)",
+			name, w.Capabilities[name])
+	}
+	return query
+}
+
 func assignTaskToWorker(tx *gorm.DB, w *Worker, t *Task) error {
 	return tx.Model(t).
 		Select("WorkerID", "LastTouchedAt").
@@ -4,9 +4,11 @@ package persistence
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/stretchr/testify/assert"
 
 	"git.blender.org/flamenco/internal/manager/job_compilers"
@@ -161,6 +163,435 @@ func TestTwoJobsThreeTasks(t *testing.T) {
 	assert.Equal(t, att2_3.Name, task.Name, "the 3rd task of the 2nd job should have been chosen")
 }
 
+func TestJobPriorityChanged(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	w := linuxWorker(t, db)
+
+	att1 := authorTestTask("1.1 task", "blender")
+	atj1 := authorTestJob("1295757b-e668-4c49-8b89-f73db8270e42", "simple-blender-render", att1)
+	att2 := authorTestTask("2.1 task", "blender")
+	atj2 := authorTestJob("7180617b-da70-411c-8b38-b972ab2bae8d", "simple-blender-render", att2)
+	atj2.Priority = 100
+
+	job1 := constructTestJob(ctx, t, db, atj1)
+	constructTestJob(ctx, t, db, atj2)
+
+	// Bump the priority of the first job over that of the second one.
+	job1.Priority = 150
+	if !assert.NoError(t, db.SaveJobPriority(ctx, job1)) {
+		t.FailNow()
+	}
+
+	task, err := db.ScheduleTask(ctx, &w)
+	assert.NoError(t, err)
+	if task == nil {
+		t.Fatal("task is nil")
+	}
+	assert.Equal(t, job1.ID, task.JobID)
+	assert.Equal(t, att1.Name, task.Name, "the task of the re-prioritised job should have been chosen")
+}
+
+func TestSchedulingPolicyStrict(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	job1, job2 := constructEqualPriorityJobs(ctx, t, db)
+	assert.NoError(t, db.SetSchedulingPolicy(SchedulingPolicyStrict))
+
+	// Both workers should get a task of the same job.
+	linux := linuxWorker(t, db)
+	task1 := scheduleAndActivate(ctx, t, db, &linux)
+	windows := windowsWorker(t, db)
+	task2 := scheduleAndActivate(ctx, t, db, &windows)
+
+	assert.Equal(t, task1.JobID, task2.JobID)
+	assert.Contains(t, []uint{job1.ID, job2.ID}, task1.JobID)
+}
+
+func TestSchedulingPolicyFairShare(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	job1, job2 := constructEqualPriorityJobs(ctx, t, db)
+	assert.NoError(t, db.SetSchedulingPolicy(SchedulingPolicyFairShare))
+
+	// The workers should be spread over the two jobs.
+	linux := linuxWorker(t, db)
+	task1 := scheduleAndActivate(ctx, t, db, &linux)
+	windows := windowsWorker(t, db)
+	task2 := scheduleAndActivate(ctx, t, db, &windows)
+
+	assert.ElementsMatch(t, []uint{job1.ID, job2.ID}, []uint{task1.JobID, task2.JobID})
+}
+
+func TestSchedulingPolicyFairShareRespectsJobPriority(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	job1, job2 := constructEqualPriorityJobs(ctx, t, db)
+	job2.Priority = 75
+	if !assert.NoError(t, db.SaveJobPriority(ctx, job2)) {
+		t.FailNow()
+	}
+	assert.NoError(t, db.SetSchedulingPolicy(SchedulingPolicyFairShare))
+
+	// Fair-share only applies to jobs of the same priority.
+	linux := linuxWorker(t, db)
+	task1 := scheduleAndActivate(ctx, t, db, &linux)
+	windows := windowsWorker(t, db)
+	task2 := scheduleAndActivate(ctx, t, db, &windows)
+
+	assert.Equal(t, job2.ID, task1.JobID)
+	assert.Equal(t, job2.ID, task2.JobID)
+	assert.NotEqual(t, job1.ID, task2.JobID)
+}
+
+func TestSetSchedulingPolicy(t *testing.T) {
+	_, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	assert.Equal(t, SchedulingPolicyStrict, db.schedulingPolicy)
+
+	assert.NoError(t, db.SetSchedulingPolicy(SchedulingPolicyFairShare))
+	assert.Equal(t, SchedulingPolicyFairShare, db.schedulingPolicy)
+
+	assert.NoError(t, db.SetSchedulingPolicy(""))
+	assert.Equal(t, SchedulingPolicyStrict, db.schedulingPolicy)
+
+	err := db.SetSchedulingPolicy("whoever-shouts-loudest")
+	assert.ErrorIs(t, err, ErrUnknownSchedulingPolicy)
+	assert.Equal(t, SchedulingPolicyStrict, db.schedulingPolicy)
+}
+
+func TestJobMaxWorkers(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	job1, job2 := constructEqualPriorityJobs(ctx, t, db)
+	job1.Priority = 75
+	job1.MaxWorkers = 1
+	if !assert.NoError(t, db.SaveJobPriority(ctx, job1)) {
+		t.FailNow()
+	}
+	if !assert.NoError(t, db.SaveJobMaxWorkers(ctx, job1)) {
+		t.FailNow()
+	}
+
+	// The first worker should get a task of the high-priority job.
+	linux := linuxWorker(t, db)
+	task1 := scheduleAndActivate(ctx, t, db, &linux)
+	assert.Equal(t, job1.ID, task1.JobID)
+
+	// The high-priority job is at its maximum number of workers, so the second
+	// worker should get a task of the other job.
+	windows := windowsWorker(t, db)
+	task2 := scheduleAndActivate(ctx, t, db, &windows)
+	assert.Equal(t, job2.ID, task2.JobID)
+
+	// Lifting the limit should make the job available again.
+	job1.MaxWorkers = 0
+	if !assert.NoError(t, db.SaveJobMaxWorkers(ctx, job1)) {
+		t.FailNow()
+	}
+	otherWorker := linuxWorker(t, db, func(w *Worker) {
+		w.UUID = "8d6bfbdf-9d6e-4b9a-a90e-f7c5ce44f1d6"
+		w.Name = "Another Linux"
+	})
+	task3 := scheduleAndActivate(ctx, t, db, &otherWorker)
+	assert.Equal(t, job1.ID, task3.JobID)
+}
+
+func TestJobMaxWorkersWithTaskSlots(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	atj := authorTestJob(
+		"1295757b-e668-4c49-8b89-f73db8270e42",
+		"simple-blender-render",
+		authorTestTask("1 render", "blender"),
+		authorTestTask("2 render", "blender"),
+		authorTestTask("3 render", "blender"),
+		authorTestTask("4 render", "blender"))
+	atj.MaxWorkers = 2
+	job := constructTestJob(ctx, t, db, atj)
+
+	// A Worker with two slots uses two tasks, but only one of the job's Workers.
+	twoSlots := linuxWorker(t, db, func(w *Worker) {
+		w.TaskSlots = StringIntMap{"blender": 2}
+	})
+	task1 := scheduleAndActivate(ctx, t, db, &twoSlots)
+	assert.Equal(t, job.ID, task1.JobID)
+	task2, err := db.ScheduleTask(ctx, &twoSlots, task1.UUID)
+	if !assert.NoError(t, err) || !assert.NotNil(t, task2) {
+		t.FailNow()
+	}
+	assert.Equal(t, job.ID, task2.JobID)
+	setTaskStatus(t, db, task2.UUID, api.TaskStatusActive)
+
+	// There is still room for a second Worker.
+	windows := windowsWorker(t, db)
+	task3 := scheduleAndActivate(ctx, t, db, &windows)
+	assert.Equal(t, job.ID, task3.JobID)
+
+	// A third Worker should not get a task.
+	otherWorker := linuxWorker(t, db, func(w *Worker) {
+		w.UUID = "8d6bfbdf-9d6e-4b9a-a90e-f7c5ce44f1d6"
+		w.Name = "Another Linux"
+	})
+	task4, err := db.ScheduleTask(ctx, &otherWorker)
+	assert.NoError(t, err)
+	assert.Nil(t, task4, "the job is at its maximum number of Workers")
+}
+
+func TestJobStartAfter(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	mockedClock := clock.NewMock()
+	mockedNow, err := time.Parse(time.RFC3339, "2022-11-03T18:00:00+01:00")
+	if err != nil {
+		panic(err)
+	}
+	mockedClock.Set(mockedNow)
+	db.SetClock(mockedClock)
+
+	w := linuxWorker(t, db)
+
+	// Construct a job that is only allowed to start at 20:00.
+	att1 := authorTestTask("1.1 task", "blender")
+	atj1 := authorTestJob("1295757b-e668-4c49-8b89-f73db8270e42", "simple-blender-render", att1)
+	atj1.StartAfter = mockedNow.Add(2 * time.Hour)
+	job := constructTestJob(ctx, t, db, atj1)
+
+	task, err := db.ScheduleTask(ctx, &w)
+	assert.NoError(t, err)
+	assert.Nil(t, task, "job should not be scheduled before its start time")
+
+	mockedClock.Add(2 * time.Hour)
+
+	task, err = db.ScheduleTask(ctx, &w)
+	assert.NoError(t, err)
+	if task == nil {
+		t.Fatal("task is nil")
+	}
+	assert.Equal(t, job.ID, task.JobID)
+}
+
+func TestJobRequiredResources(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	att1 := authorTestTask("1.1 task", "blender")
+	atj1 := authorTestJob("1295757b-e668-4c49-8b89-f73db8270e42", "simple-blender-render", att1)
+	atj1.Requirements = job_compilers.WorkerRequirements{
+		CPUCores: 8,
+		MemoryMB: 16384,
+	}
+	job := constructTestJob(ctx, t, db, atj1)
+	assert.Equal(t, 8, job.MinCPUCores)
+	assert.Equal(t, 16384, job.MinMemoryMB)
+
+	// Workers that did not report their resources should not get the job.
+	w := linuxWorker(t, db)
+	task, err := db.ScheduleTask(ctx, &w)
+	assert.NoError(t, err)
+	assert.Nil(t, task)
+
+	w.CPUCores = 16
+	w.MemoryMB = 8192
+	saveWorker(t, db, &w)
+	task, err = db.ScheduleTask(ctx, &w)
+	assert.NoError(t, err)
+	assert.Nil(t, task, "worker has too little memory")
+
+	w.CPUCores = 8
+	w.MemoryMB = 32768
+	saveWorker(t, db, &w)
+	task, err = db.ScheduleTask(ctx, &w)
+	assert.NoError(t, err)
+	if task == nil {
+		t.Fatal("task is nil")
+	}
+	assert.Equal(t, job.ID, task.JobID)
+}
+
+func TestJobRequiredCapabilities(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	att1 := authorTestTask("1.1 task", "blender")
+	atj1 := authorTestJob("1295757b-e668-4c49-8b89-f73db8270e42", "simple-blender-render", att1)
+	atj1.Requirements.Capabilities = map[string]string{
+		"blender_version": "3.3",
+		"gpu":             "cuda",
+	}
+	job := constructTestJob(ctx, t, db, atj1)
+
+	// Workers without capabilities should not get the job.
+	w := linuxWorker(t, db)
+	task, err := db.ScheduleTask(ctx, &w)
+	assert.NoError(t, err)
+	assert.Nil(t, task)
+
+	// Having only some of the capabilities is not enough.
+	w.Capabilities = StringStringMap{"blender_version": "3.3"}
+	saveWorker(t, db, &w)
+	task, err = db.ScheduleTask(ctx, &w)
+	assert.NoError(t, err)
+	assert.Nil(t, task)
+
+	// The values have to match.
+	w.Capabilities = StringStringMap{"blender_version": "3.4", "gpu": "cuda"}
+	saveWorker(t, db, &w)
+	task, err = db.ScheduleTask(ctx, &w)
+	assert.NoError(t, err)
+	assert.Nil(t, task)
+
+	// Extra capabilities are fine.
+	w.Capabilities = StringStringMap{"blender_version": "3.3", "gpu": "cuda", "os": "ubuntu"}
+	saveWorker(t, db, &w)
+	task, err = db.ScheduleTask(ctx, &w)
+	assert.NoError(t, err)
+	if task == nil {
+		t.Fatal("task is nil")
+	}
+	assert.Equal(t, job.ID, task.JobID)
+}
+
+func TestJobWithoutRequirements(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	att1 := authorTestTask("1.1 task", "blender")
+	atj1 := authorTestJob("1295757b-e668-4c49-8b89-f73db8270e42", "simple-blender-render", att1)
+	job := constructTestJob(ctx, t, db, atj1)
+
+	// Resources and capabilities of the worker should not matter.
+	w := linuxWorker(t, db, func(w *Worker) {
+		w.CPUCores = 4
+		w.MemoryMB = 4096
+		w.Capabilities = StringStringMap{"gpu": "cuda"}
+	})
+	task, err := db.ScheduleTask(ctx, &w)
+	assert.NoError(t, err)
+	if task == nil {
+		t.Fatal("task is nil")
+	}
+	assert.Equal(t, job.ID, task.JobID)
+}
+
+func TestSoftFailedTaskRetryAfter(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	mockedClock := clock.NewMock()
+	mockedNow, err := time.Parse(time.RFC3339, "2022-11-03T18:00:00+01:00")
+	if err != nil {
+		panic(err)
+	}
+	mockedClock.Set(mockedNow)
+	db.SetClock(mockedClock)
+
+	w := linuxWorker(t, db)
+
+	att1 := authorTestTask("1.1 task", "blender")
+	atj1 := authorTestJob("1295757b-e668-4c49-8b89-f73db8270e42", "simple-blender-render", att1)
+	constructTestJob(ctx, t, db, atj1)
+
+	// Soft-fail the task, and let it wait for a minute.
+	task, err := db.FetchTask(ctx, att1.UUID)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	task.Status = api.TaskStatusSoftFailed
+	task.RetryAfter = sql.NullTime{Time: mockedNow.Add(1 * time.Minute), Valid: true}
+	if !assert.NoError(t, db.SaveTaskStatus(ctx, task)) {
+		t.FailNow()
+	}
+
+	scheduled, err := db.ScheduleTask(ctx, &w)
+	assert.NoError(t, err)
+	assert.Nil(t, scheduled, "task should not be scheduled before its retry time")
+
+	mockedClock.Add(1 * time.Minute)
+
+	scheduled, err = db.ScheduleTask(ctx, &w)
+	assert.NoError(t, err)
+	if scheduled == nil {
+		t.Fatal("task is nil")
+	}
+	assert.Equal(t, task.ID, scheduled.ID)
+
+	// The retry time should be ignored once the task is queued again.
+	task.Status = api.TaskStatusQueued
+	task.RetryAfter = sql.NullTime{Time: mockedNow.Add(1 * time.Hour), Valid: true}
+	if !assert.NoError(t, db.SaveTaskStatus(ctx, task)) {
+		t.FailNow()
+	}
+	scheduled, err = db.ScheduleTask(ctx, &w)
+	assert.NoError(t, err)
+	assert.NotNil(t, scheduled, "queued task should be scheduled regardless of its retry time")
+}
+
+func TestNextDeferredTaskTime(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	mockedClock := clock.NewMock()
+	mockedNow, err := time.Parse(time.RFC3339, "2022-11-03T18:00:00+01:00")
+	if err != nil {
+		panic(err)
+	}
+	mockedClock.Set(mockedNow)
+	db.SetClock(mockedClock)
+
+	next, err := db.NextDeferredTaskTime(ctx)
+	assert.NoError(t, err)
+	assert.True(t, next.IsZero(), "without jobs there is nothing deferred")
+
+	// Construct a job that is only allowed to start at 20:00.
+	att1 := authorTestTask("1.1 task", "blender")
+	atj1 := authorTestJob("1295757b-e668-4c49-8b89-f73db8270e42", "simple-blender-render", att1)
+	atj1.StartAfter = mockedNow.Add(2 * time.Hour)
+	constructTestJob(ctx, t, db, atj1)
+
+	next, err = db.NextDeferredTaskTime(ctx)
+	assert.NoError(t, err)
+	assert.True(t, next.Equal(atj1.StartAfter), "expected %s, got %s", atj1.StartAfter, next)
+
+	// A soft-failed task that is retried earlier should come first.
+	att2 := authorTestTask("2.1 task", "blender")
+	atj2 := authorTestJob("7d7e8ea6-d6f5-4d4a-8bd0-5f79e4d4c1f4", "simple-blender-render", att2)
+	constructTestJob(ctx, t, db, atj2)
+	task, err := db.FetchTask(ctx, att2.UUID)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	retryAfter := mockedNow.Add(1 * time.Minute)
+	task.Status = api.TaskStatusSoftFailed
+	task.RetryAfter = sql.NullTime{Time: retryAfter, Valid: true}
+	if !assert.NoError(t, db.SaveTaskStatus(ctx, task)) {
+		t.FailNow()
+	}
+
+	next, err = db.NextDeferredTaskTime(ctx)
+	assert.NoError(t, err)
+	assert.True(t, next.Equal(retryAfter), "expected %s, got %s", retryAfter, next)
+
+	// Moments that have passed are no longer deferred.
+	mockedClock.Add(1 * time.Minute)
+	next, err = db.NextDeferredTaskTime(ctx)
+	assert.NoError(t, err)
+	assert.True(t, next.Equal(atj1.StartAfter), "expected %s, got %s", atj1.StartAfter, next)
+
+	mockedClock.Add(2 * time.Hour)
+	next, err = db.NextDeferredTaskTime(ctx)
+	assert.NoError(t, err)
+	assert.True(t, next.IsZero(), "expected zero time, got %s", next)
+}
+
 func TestSomeButNotAllDependenciesCompleted(t *testing.T) {
 	// There was a bug in the task scheduler query, where it would schedule a task
 	// if any of its dependencies was completed (instead of all dependencies).
@@ -223,6 +654,99 @@ func TestAlreadyAssigned(t *testing.T) {
 	assert.Equal(t, att3.Name, task.Name, "the already-assigned task should have been chosen")
 }
 
+func TestTaskSlots(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	w := linuxWorker(t, db, func(w *Worker) {
+		w.TaskSlots = StringIntMap{"blender": 2}
+	})
+
+	att1 := authorTestTask("1 render", "blender")
+	att2 := authorTestTask("2 render", "blender")
+	att3 := authorTestTask("3 render", "blender")
+	att4 := authorTestTask("4 video", "ffmpeg")
+	att4.Priority = 10
+	atj := authorTestJob(
+		"1295757b-e668-4c49-8b89-f73db8270e42",
+		"simple-blender-render",
+		att1, att2, att3, att4)
+	constructTestJob(ctx, t, db, atj)
+
+	// Schedule a task, and mark it as active like the API implementation does.
+	scheduleActive := func(running ...string) *Task {
+		task, err := db.ScheduleTask(ctx, &w, running...)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		if task == nil {
+			return nil
+		}
+		task.Status = api.TaskStatusActive
+		if !assert.NoError(t, db.SaveTaskStatus(ctx, task)) {
+			t.FailNow()
+		}
+		return task
+	}
+
+	task1 := scheduleActive()
+	if !assert.NotNil(t, task1) {
+		t.FailNow()
+	}
+	assert.Equal(t, att1.Name, task1.Name)
+
+	// Without telling which tasks are running, the active task is handed out again.
+	again, err := db.ScheduleTask(ctx, &w)
+	assert.NoError(t, err)
+	if assert.NotNil(t, again) {
+		assert.Equal(t, task1.UUID, again.UUID)
+	}
+
+	task2 := scheduleActive(task1.UUID)
+	if !assert.NotNil(t, task2) {
+		t.FailNow()
+	}
+	assert.Equal(t, att2.Name, task2.Name, "the second blender slot should be used")
+
+	// The 'blender' slots are full, and 'ffmpeg' gets one slot by default.
+	task3 := scheduleActive(task1.UUID, task2.UUID)
+	if !assert.NotNil(t, task3) {
+		t.FailNow()
+	}
+	assert.Equal(t, att4.Name, task3.Name)
+
+	task4 := scheduleActive(task1.UUID, task2.UUID, task3.UUID)
+	assert.Nil(t, task4, "all slots should be taken")
+}
+
+func TestTaskSlotsWithoutSlots(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	// Workers without task slots get a task regardless of their active tasks.
+	w := linuxWorker(t, db)
+
+	att1 := authorTestTask("1 render", "blender")
+	att2 := authorTestTask("2 render", "blender")
+	atj := authorTestJob(
+		"1295757b-e668-4c49-8b89-f73db8270e42",
+		"simple-blender-render",
+		att1, att2)
+	constructTestJob(ctx, t, db, atj)
+
+	dbTask1, err := db.FetchTask(ctx, att1.UUID)
+	assert.NoError(t, err)
+	dbTask1.WorkerID = &w.ID
+	dbTask1.Status = api.TaskStatusActive
+	assert.NoError(t, db.SaveTask(ctx, dbTask1))
+
+	task, err := db.ScheduleTask(ctx, &w, att1.UUID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, task) {
+		assert.Equal(t, att2.Name, task.Name)
+	}
+}
+
 func TestAssignedToOtherWorker(t *testing.T) {
 	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
 	defer cancel()
@@ -316,6 +840,111 @@ func TestBlocklisted(t *testing.T) {
 	assert.Equal(t, att2.Name, task.Name, "the second task should have been chosen")
 }
 
+func TestJobDeletionRequested(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	w := linuxWorker(t, db)
+
+	authTask := authorTestTask("the task", "blender")
+	atj := authorTestJob("b6a1d859-122f-4791-8b78-b943329a9989", "simple-blender-render", authTask)
+	job := constructTestJob(ctx, t, db, atj)
+
+	assert.NoError(t, db.RequestJobDeletion(ctx, job))
+
+	task, err := db.ScheduleTask(ctx, &w)
+	assert.NoError(t, err)
+	assert.Nil(t, task, "jobs that are queued for deletion should not be scheduled")
+}
+
+func TestWorkerTagJobWithTag(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	// Create worker tags:
+	tag1 := WorkerTag{UUID: "f0157623-4b14-4801-bee2-271dddab6309", Name: "Tag 1"}
+	tag2 := WorkerTag{UUID: "2f71dba1-cf92-4752-8386-f5926affabd5", Name: "Tag 2"}
+	assert.NoError(t, db.CreateWorkerTag(ctx, &tag1))
+	assert.NoError(t, db.CreateWorkerTag(ctx, &tag2))
+
+	// Create a worker in tag1:
+	workerC := linuxWorker(t, db)
+	assert.NoError(t, db.WorkerSetTags(ctx, &workerC, []string{tag1.UUID}))
+
+	// Create a worker without tag:
+	workerNC := linuxWorker(t, db, func(w *Worker) {
+		w.UUID = "c53f8f68-4149-4790-991c-ba73a326551e"
+	})
+
+	{ // Test job with different tag:
+		authTask := authorTestTask("the task", "blender")
+		job := authorTestJob("499cf0f8-e83d-4cb1-837a-df94789d07db", "simple-blender-render", authTask)
+		job.WorkerTagUUID = tag2.UUID
+		constructTestJob(ctx, t, db, job)
+
+		task, err := db.ScheduleTask(ctx, &workerC)
+		assert.NoError(t, err)
+		assert.Nil(t, task, "job with different tag should not be scheduled")
+	}
+
+	{ // Test job with matching tag:
+		authTask := authorTestTask("the task", "blender")
+		job := authorTestJob("5d4c2321-0bb7-4c13-a9dd-32a2c0cd156e", "simple-blender-render", authTask)
+		job.WorkerTagUUID = tag1.UUID
+		constructTestJob(ctx, t, db, job)
+
+		task, err := db.ScheduleTask(ctx, &workerC)
+		assert.NoError(t, err)
+		if task == nil {
+			t.Fatal("job with matching tag should be scheduled")
+		}
+		assert.Equal(t, authTask.UUID, task.UUID)
+
+		task, err = db.ScheduleTask(ctx, &workerNC)
+		assert.NoError(t, err)
+		assert.Nil(t, task, "workers without tag should not be scheduled for tagged job")
+	}
+}
+
+func TestWorkerTagJobWithoutTag(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, schedulerTestTimeout)
+	defer cancel()
+
+	// Create worker tag:
+	tag1 := WorkerTag{UUID: "f0157623-4b14-4801-bee2-271dddab6309", Name: "Tag 1"}
+	assert.NoError(t, db.CreateWorkerTag(ctx, &tag1))
+
+	// Create a worker in tag1:
+	workerC := linuxWorker(t, db)
+	assert.NoError(t, db.WorkerSetTags(ctx, &workerC, []string{tag1.UUID}))
+
+	// Create a worker without tag:
+	workerNC := linuxWorker(t, db, func(w *Worker) {
+		w.UUID = "c53f8f68-4149-4790-991c-ba73a326551e"
+	})
+
+	// Test tag-less job:
+	authTask := authorTestTask("the task", "blender")
+	job := authorTestJob("b6a1d859-122f-4791-8b78-b943329a9989", "simple-blender-render", authTask)
+	constructTestJob(ctx, t, db, job)
+
+	task, err := db.ScheduleTask(ctx, &workerC)
+	assert.NoError(t, err)
+	if task == nil {
+		t.Fatal("task is nil")
+	}
+	assert.Equal(t, authTask.UUID, task.UUID, "tagged workers should be able to run untagged jobs")
+
+	// Requeue the task, so it becomes available again.
+	setTaskStatus(t, db, authTask.UUID, api.TaskStatusQueued)
+	task, err = db.ScheduleTask(ctx, &workerNC)
+	assert.NoError(t, err)
+	if task == nil {
+		t.Fatal("task is nil")
+	}
+	assert.Equal(t, authTask.UUID, task.UUID, "untagged workers should be able to run untagged jobs")
+}
+
 // To test: blocklists
 
 // To test: variable replacement
@@ -383,7 +1012,40 @@ func setTaskStatus(t *testing.T, db *DB, taskUUID string, status api.TaskStatus)
 	}
 }
 
-func linuxWorker(t *testing.T, db *DB) Worker {
+// constructEqualPriorityJobs constructs two jobs of the same priority, each
+// with two independent tasks.
+func constructEqualPriorityJobs(ctx context.Context, t *testing.T, db *DB) (*Job, *Job) {
+	atj1 := authorTestJob(
+		"1295757b-e668-4c49-8b89-f73db8270e42",
+		"simple-blender-render",
+		authorTestTask("1.1 task", "blender"),
+		authorTestTask("1.2 task", "blender"))
+	atj2 := authorTestJob(
+		"7180617b-da70-411c-8b38-b972ab2bae8d",
+		"simple-blender-render",
+		authorTestTask("2.1 task", "blender"),
+		authorTestTask("2.2 task", "blender"))
+
+	job1 := constructTestJob(ctx, t, db, atj1)
+	job2 := constructTestJob(ctx, t, db, atj2)
+	return job1, job2
+}
+
+// scheduleAndActivate schedules a task for the worker, and marks it as active,
+// like the API implementation does after scheduling.
+func scheduleAndActivate(ctx context.Context, t *testing.T, db *DB, w *Worker) *Task {
+	task, err := db.ScheduleTask(ctx, w)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	if task == nil {
+		t.Fatal("task is nil")
+	}
+	setTaskStatus(t, db, task.UUID, api.TaskStatusActive)
+	return task
+}
+
+func linuxWorker(t *testing.T, db *DB, updaters ...func(worker *Worker)) Worker {
 	w := Worker{
 		UUID:               "b13b8322-3e96-41c3-940a-3d581008a5f8",
 		Name:               "Linux",
@@ -392,6 +1054,10 @@ func linuxWorker(t *testing.T, db *DB) Worker {
 		SupportedTaskTypes: "blender,ffmpeg,file-management,misc",
 	}
 
+	for _, updater := range updaters {
+		updater(&w)
+	}
+
 	err := db.gormDB.Save(&w).Error
 	if err != nil {
 		t.Logf("cannot save Linux worker: %v", err)
@@ -401,6 +1067,12 @@ func linuxWorker(t *testing.T, db *DB) Worker {
 	return w
 }
 
+func saveWorker(t *testing.T, db *DB, w *Worker) {
+	if !assert.NoError(t, db.SaveWorker(context.Background(), w)) {
+		t.FailNow()
+	}
+}
+
 func windowsWorker(t *testing.T, db *DB) Worker {
 	w := Worker{
 		UUID:               "4f6ee45e-c8fc-4c31-bf5c-922f2415deb1",
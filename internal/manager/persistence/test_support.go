@@ -20,7 +20,15 @@ import (
 // resulting database.
 const TestDSN = "file::memory:"
 
+// testPostgresDSN is set when the tests should run against PostgreSQL instead
+// of SQLite. See TestMain().
+var testPostgresDSN string
+
 func CreateTestDB(t *testing.T) (db *DB, closer func()) {
+	if testPostgresDSN != "" {
+		return createTestPostgresDB(t)
+	}
+
 	// Delete the SQLite file if it exists on disk.
 	if _, err := os.Stat(TestDSN); err == nil {
 		if err := os.Remove(TestDSN); err != nil {
@@ -64,6 +72,45 @@ func CreateTestDB(t *testing.T) (db *DB, closer func()) {
 	return db, closer
 }
 
+// createTestPostgresDB connects to the PostgreSQL test database, and replaces
+// whatever the previous test left behind with an empty schema.
+func createTestPostgresDB(t *testing.T) (db *DB, closer func()) {
+	dblogger := NewDBLogger(log.Level(zerolog.TraceLevel).Output(os.Stdout))
+	config := gorm.Config{
+		Logger:  dblogger,
+		NowFunc: nowFunc,
+	}
+
+	db, err := openDBWithConfig(testPostgresDSN, &config)
+	if err != nil {
+		t.Fatalf("opening DB: %v", err)
+	}
+
+	if err := db.gormDB.Exec("DROP SCHEMA IF EXISTS public CASCADE").Error; err != nil {
+		t.Fatalf("dropping DB schema: %v", err)
+	}
+	if err := db.gormDB.Exec("CREATE SCHEMA public").Error; err != nil {
+		t.Fatalf("creating DB schema: %v", err)
+	}
+
+	err = db.migrate()
+	if err != nil {
+		t.Fatalf("migrating DB: %v", err)
+	}
+
+	closer = func() {
+		sqlDB, err := db.gormDB.DB()
+		if err != nil {
+			t.Fatalf("getting DB connection pool: %v", err)
+		}
+		if err := sqlDB.Close(); err != nil {
+			t.Fatalf("closing DB: %v", err)
+		}
+	}
+
+	return db, closer
+}
+
 // persistenceTestFixtures creates a test database and returns it and a context.
 // Tests should call the returned cancel function when they're done.
 func persistenceTestFixtures(t *testing.T, testContextTimeout time.Duration) (context.Context, context.CancelFunc, *DB) {
@@ -22,20 +22,40 @@ var workerStatusNoTimeout = []api.WorkerStatus{
 // FetchTimedOutTasks returns a slice of tasks that have timed out.
 //
 // In order to time out, a task must be in status `active` and not touched by a
-// Worker since `untouchedSince`.
+// Worker for longer than its timeout. Tasks without their own timeout use
+// `defaultTimeout`; when that is zero, only tasks with their own timeout can
+// time out.
 //
 // The returned tasks also have their `Job` and `Worker` fields set.
-func (db *DB) FetchTimedOutTasks(ctx context.Context, untouchedSince time.Time) ([]*Task, error) {
-	result := []*Task{}
+func (db *DB) FetchTimedOutTasks(ctx context.Context, now time.Time, defaultTimeout time.Duration) ([]*Task, error) {
+	// Tasks with their own timeout are filtered below, as SQLite and PostgreSQL
+	// have different ways of doing time arithmetic. There are at most as many
+	// active tasks as there are task slots on all Workers combined, so this
+	// doesn't cost much.
+	timeoutFilter := db.gormDB.Where("tasks.timeout > 0")
+	if defaultTimeout > 0 {
+		untouchedSince := now.Add(-defaultTimeout)
+		timeoutFilter = timeoutFilter.Or("tasks.timeout = 0 AND tasks.last_touched_at <= ?", untouchedSince)
+	}
+
+	candidates := []*Task{}
 	tx := db.gormDB.WithContext(ctx).
 		Model(&Task{}).
 		Joins("Job").
 		Joins("Worker").
 		Where("tasks.status = ?", api.TaskStatusActive).
-		Where("tasks.last_touched_at <= ?", untouchedSince).
-		Scan(&result)
+		Where(timeoutFilter).
+		Scan(&candidates)
 	if tx.Error != nil {
-		return nil, taskError(tx.Error, "finding timed out tasks (untouched since %s)", untouchedSince.String())
+		return nil, taskError(tx.Error, "finding timed out tasks (default timeout %s)", defaultTimeout)
+	}
+
+	result := []*Task{}
+	for _, task := range candidates {
+		if task.Timeout > 0 && task.LastTouchedAt.After(now.Add(-task.Timeout)) {
+			continue
+		}
+		result = append(result, task)
 	}
 	return result, nil
 }
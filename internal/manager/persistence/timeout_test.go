@@ -20,7 +20,8 @@ func TestFetchTimedOutTasks(t *testing.T) {
 	}
 
 	now := db.gormDB.NowFunc()
-	deadline := now.Add(-5 * time.Minute)
+	const timeout = 5 * time.Minute
+	deadline := now.Add(-timeout)
 
 	// Mark the task as last touched before the deadline, i.e. old enough for a timeout.
 	task := tasks[0]
@@ -31,7 +32,7 @@ func TestFetchTimedOutTasks(t *testing.T) {
 	assert.NoError(t, db.TaskAssignToWorker(ctx, task, w))
 
 	// The task should still not be returned, as it's not in 'active' state.
-	timedout, err := db.FetchTimedOutTasks(ctx, deadline)
+	timedout, err := db.FetchTimedOutTasks(ctx, now, timeout)
 	assert.NoError(t, err)
 	assert.Empty(t, timedout)
 
@@ -40,7 +41,7 @@ func TestFetchTimedOutTasks(t *testing.T) {
 	assert.NoError(t, db.SaveTask(ctx, task))
 
 	// Now it should time out:
-	timedout, err = db.FetchTimedOutTasks(ctx, deadline)
+	timedout, err = db.FetchTimedOutTasks(ctx, now, timeout)
 	assert.NoError(t, err)
 	if assert.Len(t, timedout, 1) {
 		// Other fields will be different, like the 'UpdatedAt' field -- this just
@@ -51,6 +52,49 @@ func TestFetchTimedOutTasks(t *testing.T) {
 	}
 }
 
+func TestFetchTimedOutTasksPerTaskTimeout(t *testing.T) {
+	ctx, close, db, job, _ := jobTasksTestFixtures(t)
+	defer close()
+
+	tasks, err := db.FetchTasksOfJob(ctx, job)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	now := db.gormDB.NowFunc()
+
+	// This task is untouched for an hour, but is allowed to take four.
+	longTask := tasks[0]
+	longTask.Status = api.TaskStatusActive
+	longTask.Timeout = 4 * time.Hour
+	longTask.LastTouchedAt = now.Add(-1 * time.Hour)
+	assert.NoError(t, db.SaveTask(ctx, longTask))
+
+	// This task is untouched for a minute, but should take only 30 seconds.
+	shortTask := tasks[1]
+	shortTask.Status = api.TaskStatusActive
+	shortTask.Timeout = 30 * time.Second
+	shortTask.LastTouchedAt = now.Add(-1 * time.Minute)
+	assert.NoError(t, db.SaveTask(ctx, shortTask))
+
+	timedout, err := db.FetchTimedOutTasks(ctx, now, 5*time.Minute)
+	assert.NoError(t, err)
+	if assert.Len(t, timedout, 1) {
+		assert.Equal(t, shortTask.UUID, timedout[0].UUID)
+	}
+
+	// Without global timeout, tasks with their own timeout should still time out.
+	timedout, err = db.FetchTimedOutTasks(ctx, now, 0)
+	assert.NoError(t, err)
+	if assert.Len(t, timedout, 1) {
+		assert.Equal(t, shortTask.UUID, timedout[0].UUID)
+	}
+
+	// Five hours later, both should have timed out.
+	timedout, err = db.FetchTimedOutTasks(ctx, now.Add(5*time.Hour), 5*time.Minute)
+	assert.NoError(t, err)
+	assert.Len(t, timedout, 2)
+}
+
 func TestFetchTimedOutWorkers(t *testing.T) {
 	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
 	defer cancel()
@@ -0,0 +1,154 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"git.blender.org/flamenco/pkg/api"
+)
+
+// User is an account that can log in to the Manager.
+type User struct {
+	Model
+
+	UUID         string       `gorm:"type:char(36);default:'';unique;index"`
+	Name         string       `gorm:"type:varchar(64);default:'';unique"`
+	PasswordHash string       `gorm:"type:varchar(255);default:''"`
+	Role         api.UserRole `gorm:"type:varchar(16);default:''"`
+}
+
+// IsAdmin returns whether this user has the admin role.
+func (u *User) IsAdmin() bool {
+	return u.Role == api.UserRoleAdmin
+}
+
+// UserSession is created when a user logs in. Only the hash of the session
+// token is stored, so that the tokens cannot be obtained from the database.
+type UserSession struct {
+	Model
+
+	TokenHash string    `gorm:"type:char(64);default:'';unique;index"`
+	UserID    uint      `gorm:"default:0"`
+	User      *User     `gorm:"foreignkey:UserID;references:ID;constraint:OnDelete:CASCADE"`
+	ExpiresAt time.Time `gorm:"index"`
+}
+
+func (db *DB) CreateUser(ctx context.Context, user *User) error {
+	if err := db.gormDB.WithContext(ctx).Create(user).Error; err != nil {
+		return userError(err, "creating new user")
+	}
+	return nil
+}
+
+func (db *DB) FetchUser(ctx context.Context, uuid string) (*User, error) {
+	return fetchUser(db.gormDB.WithContext(ctx), uuid)
+}
+
+// fetchUser fetches the user using the given database instance.
+func fetchUser(gormDB *gorm.DB, uuid string) (*User, error) {
+	user := User{}
+	tx := gormDB.First(&user, "uuid = ?", uuid)
+	if tx.Error != nil {
+		return nil, userError(tx.Error, "fetching user")
+	}
+	return &user, nil
+}
+
+// FetchUserByName fetches the user with the given name.
+func (db *DB) FetchUserByName(ctx context.Context, name string) (*User, error) {
+	user := User{}
+	tx := db.gormDB.WithContext(ctx).First(&user, "name = ?", name)
+	if tx.Error != nil {
+		return nil, userError(tx.Error, "fetching user %q", name)
+	}
+	return &user, nil
+}
+
+func (db *DB) FetchUsers(ctx context.Context) ([]*User, error) {
+	users := make([]*User, 0)
+	tx := db.gormDB.WithContext(ctx).Model(&User{}).Order("name").Scan(&users)
+	if tx.Error != nil {
+		return nil, userError(tx.Error, "fetching all users")
+	}
+	return users, nil
+}
+
+// CountUsers returns the number of user accounts.
+func (db *DB) CountUsers(ctx context.Context) (int, error) {
+	var numUsers int64
+	tx := db.gormDB.WithContext(ctx).Model(&User{}).Count(&numUsers)
+	if tx.Error != nil {
+		return 0, userError(tx.Error, "counting users")
+	}
+	return int(numUsers), nil
+}
+
+// DeleteUser deletes the user and their sessions. Jobs submitted by this user
+// are kept, but no longer have an owner.
+func (db *DB) DeleteUser(ctx context.Context, uuid string) error {
+	tx := db.gormDB.WithContext(ctx).
+		Where("uuid = ?", uuid).
+		Delete(&User{})
+	if tx.Error != nil {
+		return userError(tx.Error, "deleting user")
+	}
+	if tx.RowsAffected == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+func (db *DB) CreateUserSession(ctx context.Context, session *UserSession) error {
+	session.ExpiresAt = session.ExpiresAt.UTC()
+	if err := db.gormDB.WithContext(ctx).Create(session).Error; err != nil {
+		return fmt.Errorf("creating user session: %w", err)
+	}
+	return nil
+}
+
+// FetchUserSession returns the non-expired session with the given token hash,
+// including its user.
+func (db *DB) FetchUserSession(ctx context.Context, tokenHash string) (*UserSession, error) {
+	session := UserSession{}
+	tx := db.gormDB.WithContext(ctx).
+		Joins("User").
+		Where("user_sessions.token_hash = ?", tokenHash).
+		Where("user_sessions.expires_at > ?", db.gormDB.NowFunc()).
+		First(&session)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, ErrUserSessionNotFound
+	}
+	if tx.Error != nil {
+		return nil, fmt.Errorf("fetching user session: %w", tx.Error)
+	}
+	return &session, nil
+}
+
+// DeleteUserSession removes the session with the given token hash, logging
+// out the user. It is not an error when the session does not exist.
+func (db *DB) DeleteUserSession(ctx context.Context, tokenHash string) error {
+	tx := db.gormDB.WithContext(ctx).
+		Where("token_hash = ?", tokenHash).
+		Delete(&UserSession{})
+	if tx.Error != nil {
+		return fmt.Errorf("deleting user session: %w", tx.Error)
+	}
+	return nil
+}
+
+// DeleteExpiredUserSessions removes all sessions that have expired.
+func (db *DB) DeleteExpiredUserSessions(ctx context.Context) error {
+	tx := db.gormDB.WithContext(ctx).
+		Where("expires_at <= ?", db.gormDB.NowFunc()).
+		Delete(&UserSession{})
+	if tx.Error != nil {
+		return fmt.Errorf("deleting expired user sessions: %w", tx.Error)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"git.blender.org/flamenco/pkg/api"
+)
+
+func createTestUser(t *testing.T, ctx context.Context, db *DB, uuid, name string, role api.UserRole) *User {
+	user := User{
+		UUID:         uuid,
+		Name:         name,
+		PasswordHash: "not-really-a-hash",
+		Role:         role,
+	}
+	if !assert.NoError(t, db.CreateUser(ctx, &user)) {
+		t.FailNow()
+	}
+	return &user
+}
+
+func TestCreateFetchUser(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	numUsers, err := db.CountUsers(ctx)
+	assert.NoError(t, err)
+	assert.Zero(t, numUsers)
+
+	user := createTestUser(t, ctx, db, "1ab0e2e0-4d0c-4b7a-8b44-4f0e6dbb8e1d", "artist", api.UserRoleUser)
+	assert.False(t, user.IsAdmin())
+
+	fetched, err := db.FetchUser(ctx, user.UUID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, user.ID, fetched.ID)
+		assert.Equal(t, user.Name, fetched.Name)
+		assert.Equal(t, user.PasswordHash, fetched.PasswordHash)
+		assert.Equal(t, user.Role, fetched.Role)
+	}
+
+	fetched, err = db.FetchUserByName(ctx, "artist")
+	if assert.NoError(t, err) {
+		assert.Equal(t, user.UUID, fetched.UUID)
+	}
+
+	_, err = db.FetchUser(ctx, "fa2fc9e5-0a2b-4bcd-8a3c-2bd7cd8d6a3b")
+	assert.ErrorIs(t, err, ErrUserNotFound)
+	_, err = db.FetchUserByName(ctx, "nobody")
+	assert.ErrorIs(t, err, ErrUserNotFound)
+
+	// User names should be unique.
+	duplicate := User{UUID: "fa2fc9e5-0a2b-4bcd-8a3c-2bd7cd8d6a3b", Name: "artist"}
+	assert.Error(t, db.CreateUser(ctx, &duplicate))
+
+	numUsers, err = db.CountUsers(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, numUsers)
+}
+
+func TestFetchDeleteUsers(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	zebra := createTestUser(t, ctx, db, "1ab0e2e0-4d0c-4b7a-8b44-4f0e6dbb8e1d", "zebra", api.UserRoleUser)
+	admin := createTestUser(t, ctx, db, "fa2fc9e5-0a2b-4bcd-8a3c-2bd7cd8d6a3b", "admin", api.UserRoleAdmin)
+	assert.True(t, admin.IsAdmin())
+
+	users, err := db.FetchUsers(ctx)
+	if assert.NoError(t, err) && assert.Len(t, users, 2) {
+		// Users should be sorted by name.
+		assert.Equal(t, admin.UUID, users[0].UUID)
+		assert.Equal(t, zebra.UUID, users[1].UUID)
+	}
+
+	assert.NoError(t, db.DeleteUser(ctx, zebra.UUID))
+	assert.ErrorIs(t, db.DeleteUser(ctx, zebra.UUID), ErrUserNotFound)
+
+	users, err = db.FetchUsers(ctx)
+	if assert.NoError(t, err) && assert.Len(t, users, 1) {
+		assert.Equal(t, admin.UUID, users[0].UUID)
+	}
+}
+
+func TestUserSessions(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	user := createTestUser(t, ctx, db, "1ab0e2e0-4d0c-4b7a-8b44-4f0e6dbb8e1d", "artist", api.UserRoleUser)
+	now := db.gormDB.NowFunc()
+
+	validSession := UserSession{
+		TokenHash: "valid-token-hash",
+		UserID:    user.ID,
+		ExpiresAt: now.Add(time.Hour),
+	}
+	expiredSession := UserSession{
+		TokenHash: "expired-token-hash",
+		UserID:    user.ID,
+		ExpiresAt: now.Add(-time.Hour),
+	}
+	assert.NoError(t, db.CreateUserSession(ctx, &validSession))
+	assert.NoError(t, db.CreateUserSession(ctx, &expiredSession))
+
+	session, err := db.FetchUserSession(ctx, "valid-token-hash")
+	if assert.NoError(t, err) && assert.NotNil(t, session.User) {
+		assert.Equal(t, user.UUID, session.User.UUID)
+	}
+
+	_, err = db.FetchUserSession(ctx, "expired-token-hash")
+	assert.ErrorIs(t, err, ErrUserSessionNotFound)
+	_, err = db.FetchUserSession(ctx, "unknown-token-hash")
+	assert.ErrorIs(t, err, ErrUserSessionNotFound)
+
+	// Cleaning up expired sessions should keep the valid one.
+	assert.NoError(t, db.DeleteExpiredUserSessions(ctx))
+	var numSessions int64
+	db.gormDB.Model(&UserSession{}).Count(&numSessions)
+	assert.Equal(t, int64(1), numSessions)
+
+	// Logging out.
+	assert.NoError(t, db.DeleteUserSession(ctx, "valid-token-hash"))
+	_, err = db.FetchUserSession(ctx, "valid-token-hash")
+	assert.ErrorIs(t, err, ErrUserSessionNotFound)
+}
+
+func TestDeleteUserKeepsJobs(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	user := createTestUser(t, ctx, db, "1ab0e2e0-4d0c-4b7a-8b44-4f0e6dbb8e1d", "artist", api.UserRoleUser)
+	session := UserSession{TokenHash: "token-hash", UserID: user.ID, ExpiresAt: db.gormDB.NowFunc().Add(time.Hour)}
+	assert.NoError(t, db.CreateUserSession(ctx, &session))
+
+	authoredJob := createTestAuthoredJobWithTasks()
+	authoredJob.UserUUID = user.UUID
+	persistAuthoredJob(t, ctx, db, authoredJob)
+
+	assert.NoError(t, db.DeleteUser(ctx, user.UUID))
+
+	dbJob, err := db.FetchJob(ctx, authoredJob.JobID)
+	if assert.NoError(t, err, "jobs should survive deletion of their user") {
+		assert.Nil(t, dbJob.UserID)
+		assert.Nil(t, dbJob.User)
+	}
+
+	_, err = db.FetchUserSession(ctx, "token-hash")
+	assert.ErrorIs(t, err, ErrUserSessionNotFound, "sessions should be deleted with their user")
+}
@@ -0,0 +1,74 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WebhookDelivery is an entry in the webhook outbox. It stores a notification
+// that still has to be delivered, so that it survives a restart of the Manager.
+type WebhookDelivery struct {
+	Model
+
+	URL     string `gorm:"type:varchar(1024);default:''"`
+	Event   string `gorm:"type:varchar(64);default:''"`
+	Payload []byte
+
+	// Attempts is the number of failed delivery attempts so far.
+	Attempts      int       `gorm:"type:smallint;default:0"`
+	NextAttemptAt time.Time `gorm:"index"`
+}
+
+// QueueWebhookDelivery stores the delivery in the outbox.
+func (db *DB) QueueWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) error {
+	// Timestamps are compared as text, so they should all be in the same timezone.
+	delivery.NextAttemptAt = delivery.NextAttemptAt.UTC()
+	if err := db.gormDB.WithContext(ctx).Create(delivery).Error; err != nil {
+		return fmt.Errorf("queueing webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// FetchWebhookDeliveriesDue returns at most `limit` deliveries that should be
+// attempted at or before the given time, oldest first.
+func (db *DB) FetchWebhookDeliveriesDue(ctx context.Context, now time.Time, limit int) ([]*WebhookDelivery, error) {
+	deliveries := make([]*WebhookDelivery, 0)
+	tx := db.gormDB.WithContext(ctx).
+		Model(&WebhookDelivery{}).
+		Where("next_attempt_at <= ?", now.UTC()).
+		Order("next_attempt_at").
+		Order("id").
+		Limit(limit).
+		Scan(&deliveries)
+	if tx.Error != nil {
+		return nil, fmt.Errorf("fetching webhook deliveries: %w", tx.Error)
+	}
+	return deliveries, nil
+}
+
+// SaveWebhookDelivery saves the attempt count and next attempt timestamp.
+func (db *DB) SaveWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) error {
+	tx := db.gormDB.WithContext(ctx).
+		Model(delivery).
+		Select("attempts", "next_attempt_at").
+		Updates(WebhookDelivery{
+			Attempts:      delivery.Attempts,
+			NextAttemptAt: delivery.NextAttemptAt.UTC(),
+		})
+	if tx.Error != nil {
+		return fmt.Errorf("saving webhook delivery %d: %w", delivery.ID, tx.Error)
+	}
+	return nil
+}
+
+// DeleteWebhookDelivery removes the delivery from the outbox.
+func (db *DB) DeleteWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) error {
+	tx := db.gormDB.WithContext(ctx).Delete(delivery)
+	if tx.Error != nil {
+		return fmt.Errorf("deleting webhook delivery %d: %w", delivery.ID, tx.Error)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookDeliveries(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	now := db.gormDB.NowFunc()
+	delivery1 := WebhookDelivery{
+		URL:           "http://localhost/hook",
+		Event:         "job-update",
+		Payload:       []byte(`{"id": "1"}`),
+		NextAttemptAt: now,
+	}
+	delivery2 := WebhookDelivery{
+		URL:           "http://localhost/hook",
+		Event:         "worker-update",
+		Payload:       []byte(`{"id": "2"}`),
+		NextAttemptAt: now.Add(1 * time.Minute),
+	}
+	if !assert.NoError(t, db.QueueWebhookDelivery(ctx, &delivery1)) {
+		t.FailNow()
+	}
+	if !assert.NoError(t, db.QueueWebhookDelivery(ctx, &delivery2)) {
+		t.FailNow()
+	}
+
+	// Only the first delivery should be due.
+	due, err := db.FetchWebhookDeliveriesDue(ctx, now, 10)
+	if !assert.NoError(t, err) || !assert.Len(t, due, 1) {
+		t.FailNow()
+	}
+	assert.Equal(t, delivery1.ID, due[0].ID)
+	assert.Equal(t, delivery1.URL, due[0].URL)
+	assert.Equal(t, delivery1.Event, due[0].Event)
+	assert.Equal(t, delivery1.Payload, due[0].Payload)
+
+	// Postpone the first delivery, after which both are due later.
+	due[0].Attempts = 1
+	due[0].NextAttemptAt = now.Add(2 * time.Minute)
+	if !assert.NoError(t, db.SaveWebhookDelivery(ctx, due[0])) {
+		t.FailNow()
+	}
+
+	due, err = db.FetchWebhookDeliveriesDue(ctx, now.Add(5*time.Minute), 10)
+	if !assert.NoError(t, err) || !assert.Len(t, due, 2) {
+		t.FailNow()
+	}
+	assert.Equal(t, delivery2.ID, due[0].ID, "deliveries should be sorted by next attempt time")
+	assert.Equal(t, delivery1.ID, due[1].ID)
+	assert.Equal(t, 1, due[1].Attempts)
+
+	// Test the limit.
+	due, err = db.FetchWebhookDeliveriesDue(ctx, now.Add(5*time.Minute), 1)
+	if assert.NoError(t, err) {
+		assert.Len(t, due, 1)
+	}
+
+	// Delete the deliveries.
+	assert.NoError(t, db.DeleteWebhookDelivery(ctx, &delivery1))
+	assert.NoError(t, db.DeleteWebhookDelivery(ctx, &delivery2))
+	due, err = db.FetchWebhookDeliveriesDue(ctx, now.Add(5*time.Minute), 10)
+	if assert.NoError(t, err) {
+		assert.Empty(t, due)
+	}
+}
+
+func TestWebhookDeliveriesNonUTC(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	// A timestamp east of UTC should still be due at the same moment in time.
+	now := db.gormDB.NowFunc()
+	eastOfUTC := time.FixedZone("UTC+5", 5*60*60)
+	delivery := WebhookDelivery{
+		URL:           "http://localhost/hook",
+		Event:         "job-update",
+		Payload:       []byte(`{"id": "1"}`),
+		NextAttemptAt: now.In(eastOfUTC),
+	}
+	if !assert.NoError(t, db.QueueWebhookDelivery(ctx, &delivery)) {
+		t.FailNow()
+	}
+
+	due, err := db.FetchWebhookDeliveriesDue(ctx, now, 10)
+	if assert.NoError(t, err) && assert.Len(t, due, 1) {
+		assert.Equal(t, delivery.ID, due[0].ID)
+	}
+
+	// The same should hold for postponed deliveries.
+	delivery.NextAttemptAt = now.Add(1 * time.Minute).In(eastOfUTC)
+	if !assert.NoError(t, db.SaveWebhookDelivery(ctx, &delivery)) {
+		t.FailNow()
+	}
+	due, err = db.FetchWebhookDeliveriesDue(ctx, now, 10)
+	if assert.NoError(t, err) {
+		assert.Empty(t, due)
+	}
+	due, err = db.FetchWebhookDeliveriesDue(ctx, now.Add(1*time.Minute).In(eastOfUTC), 10)
+	if assert.NoError(t, err) {
+		assert.Len(t, due, 1)
+	}
+}
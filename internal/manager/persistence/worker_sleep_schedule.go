@@ -108,11 +108,18 @@ func (db *DB) FetchSleepSchedulesToCheck(ctx context.Context) ([]*SleepSchedule,
 		Str("timeout", now.String()).
 		Msg("fetching sleep schedules that need checking")
 
+	// SQLite allows storing an empty string in a timestamp column, so that has
+	// to be handled too. Other databases can not even compare against that.
+	nextCheckFilter := "next_check <= ? or next_check is NULL"
+	if db.dialect == dialectSQLite {
+		nextCheckFilter += " or next_check = ''"
+	}
+
 	schedules := []*SleepSchedule{}
 	tx := db.gormDB.WithContext(ctx).
 		Model(&SleepSchedule{}).
 		Where("is_active = ?", true).
-		Where("next_check <= ? or next_check is NULL or next_check = ''", now).
+		Where(nextCheckFilter, now).
 		Scan(&schedules)
 	if tx.Error != nil {
 		return nil, tx.Error
@@ -0,0 +1,121 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// WorkerTag groups Workers. Jobs can be limited to a tag, in which case only
+// Workers carrying that tag will get tasks of that job.
+type WorkerTag struct {
+	Model
+
+	UUID        string `gorm:"type:char(36);default:'';unique;index"`
+	Name        string `gorm:"type:varchar(64);default:'';unique"`
+	Description string `gorm:"type:varchar(255);default:''"`
+
+	Workers []*Worker `gorm:"many2many:worker_tag_membership;constraint:OnDelete:CASCADE"`
+}
+
+func (db *DB) CreateWorkerTag(ctx context.Context, tag *WorkerTag) error {
+	if err := db.gormDB.WithContext(ctx).Create(tag).Error; err != nil {
+		return fmt.Errorf("creating new worker tag: %w", err)
+	}
+	return nil
+}
+
+func (db *DB) FetchWorkerTag(ctx context.Context, uuid string) (*WorkerTag, error) {
+	return fetchWorkerTag(db.gormDB.WithContext(ctx), uuid)
+}
+
+// fetchWorkerTag fetches the worker tag using the given database instance.
+func fetchWorkerTag(gormDB *gorm.DB, uuid string) (*WorkerTag, error) {
+	w := WorkerTag{}
+	tx := gormDB.First(&w, "uuid = ?", uuid)
+	if tx.Error != nil {
+		return nil, workerTagError(tx.Error, "fetching worker tag")
+	}
+	return &w, nil
+}
+
+func (db *DB) SaveWorkerTag(ctx context.Context, tag *WorkerTag) error {
+	if err := db.gormDB.WithContext(ctx).Save(tag).Error; err != nil {
+		return workerTagError(err, "saving worker tag")
+	}
+	return nil
+}
+
+// DeleteWorkerTag deletes the given tag, after unassigning all workers from it.
+// Jobs that were limited to this tag become untagged, and can be run by any
+// worker.
+func (db *DB) DeleteWorkerTag(ctx context.Context, uuid string) error {
+	tx := db.gormDB.WithContext(ctx).
+		Where("uuid = ?", uuid).
+		Delete(&WorkerTag{})
+	if tx.Error != nil {
+		return workerTagError(tx.Error, "deleting worker tag")
+	}
+	if tx.RowsAffected == 0 {
+		return ErrWorkerTagNotFound
+	}
+	return nil
+}
+
+func (db *DB) FetchWorkerTags(ctx context.Context) ([]*WorkerTag, error) {
+	tags := make([]*WorkerTag, 0)
+	tx := db.gormDB.WithContext(ctx).Model(&WorkerTag{}).Order("name").Scan(&tags)
+	if tx.Error != nil {
+		return nil, workerTagError(tx.Error, "fetching all worker tags")
+	}
+	return tags, nil
+}
+
+// FetchTagsOfWorker returns the tags the worker is assigned to.
+func (db *DB) FetchTagsOfWorker(ctx context.Context, workerUUID string) ([]*WorkerTag, error) {
+	tags := make([]*WorkerTag, 0)
+	tx := db.gormDB.WithContext(ctx).
+		Model(&WorkerTag{}).
+		Joins("inner join worker_tag_membership wtm on wtm.worker_tag_id = worker_tags.id").
+		Joins("inner join workers on workers.id = wtm.worker_id").
+		Where("workers.uuid = ?", workerUUID).
+		Order("worker_tags.name").
+		Scan(&tags)
+	if tx.Error != nil {
+		return nil, workerTagError(tx.Error, "fetching tags of worker %s", workerUUID)
+	}
+	return tags, nil
+}
+
+func (db *DB) fetchWorkerTagsWithUUID(ctx context.Context, tagUUIDs []string) ([]*WorkerTag, error) {
+	tags := make([]*WorkerTag, 0)
+	tx := db.gormDB.WithContext(ctx).
+		Model(&WorkerTag{}).
+		Where("uuid in ?", tagUUIDs).
+		Scan(&tags)
+	if tx.Error != nil {
+		return nil, workerTagError(tx.Error, "fetching all worker tags")
+	}
+	return tags, nil
+}
+
+// WorkerSetTags replaces the tags of the worker with the given ones.
+// Tag UUIDs that do not correspond to an existing tag are ignored.
+func (db *DB) WorkerSetTags(ctx context.Context, worker *Worker, tagUUIDs []string) error {
+	tags, err := db.fetchWorkerTagsWithUUID(ctx, tagUUIDs)
+	if err != nil {
+		return workerTagError(err, "fetching worker tags")
+	}
+
+	err = db.gormDB.WithContext(ctx).
+		Model(worker).
+		Association("Tags").
+		Replace(tags)
+	if err != nil {
+		return workerTagError(err, "updating worker tags")
+	}
+	return nil
+}
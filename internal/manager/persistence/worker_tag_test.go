@@ -0,0 +1,187 @@
+package persistence
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateFetchWorkerTag(t *testing.T) {
+	// New tag creation is already done in the workerTagTestFixtures() call.
+	f := workerTagTestFixtures(t, 1*time.Second)
+	defer f.done()
+
+	// Test fetching non-existent tag
+	fetchedTag, err := f.db.FetchWorkerTag(f.ctx, "7ee21bc8-ff1a-42d2-a6b6-cc4b529b189f")
+	assert.ErrorIs(t, err, ErrWorkerTagNotFound)
+	assert.Nil(t, fetchedTag)
+
+	// Test existing tag
+	fetchedTag, err = f.db.FetchWorkerTag(f.ctx, f.tag.UUID)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, f.tag.UUID, fetchedTag.UUID)
+	assert.Equal(t, f.tag.Name, fetchedTag.Name)
+	assert.Equal(t, f.tag.Description, fetchedTag.Description)
+	assert.Zero(t, fetchedTag.Workers)
+}
+
+func TestFetchDeleteWorkerTags(t *testing.T) {
+	f := workerTagTestFixtures(t, 1*time.Second)
+	defer f.done()
+
+	secondTag := WorkerTag{
+		UUID:        "4477ec21-4d35-4b3d-9ae6-63dbb4f96ff9",
+		Name:        "arbeiderstag",
+		Description: "Worker tag in Dutch",
+	}
+	if !assert.NoError(t, f.db.CreateWorkerTag(f.ctx, &secondTag)) {
+		t.FailNow()
+	}
+
+	allTags, err := f.db.FetchWorkerTags(f.ctx)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	if assert.Len(t, allTags, 2) {
+		// Tags should be sorted by name.
+		assert.Equal(t, f.tag.UUID, allTags[0].UUID)
+		assert.Equal(t, secondTag.UUID, allTags[1].UUID)
+	}
+
+	// Test deleting the 2nd tag.
+	if !assert.NoError(t, f.db.DeleteWorkerTag(f.ctx, secondTag.UUID)) {
+		t.FailNow()
+	}
+
+	allTags, err = f.db.FetchWorkerTags(f.ctx)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	if assert.Len(t, allTags, 1) {
+		assert.Equal(t, f.tag.UUID, allTags[0].UUID)
+	}
+
+	// Test deleting a non-existent tag.
+	err = f.db.DeleteWorkerTag(f.ctx, secondTag.UUID)
+	assert.ErrorIs(t, err, ErrWorkerTagNotFound)
+}
+
+func TestAssignUnassignWorkerTags(t *testing.T) {
+	f := workerTagTestFixtures(t, 1*time.Second)
+	defer f.done()
+
+	secondTag := WorkerTag{
+		UUID: "4477ec21-4d35-4b3d-9ae6-63dbb4f96ff9",
+		Name: "arbeiderstag",
+	}
+	if !assert.NoError(t, f.db.CreateWorkerTag(f.ctx, &secondTag)) {
+		t.FailNow()
+	}
+
+	w := linuxWorker(t, f.db)
+
+	assertTags := func(msgLabel string, tagUUIDs ...string) {
+		fetchedTags, err := f.db.FetchTagsOfWorker(f.ctx, w.UUID)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+
+		fetchedUUIDs := []string{}
+		for _, tag := range fetchedTags {
+			fetchedUUIDs = append(fetchedUUIDs, tag.UUID)
+		}
+		assert.ElementsMatch(t, tagUUIDs, fetchedUUIDs, msgLabel)
+	}
+
+	// Assign to both tags.
+	if !assert.NoError(t, f.db.WorkerSetTags(f.ctx, &w, []string{f.tag.UUID, secondTag.UUID})) {
+		t.FailNow()
+	}
+	assertTags("both tags assigned", f.tag.UUID, secondTag.UUID)
+
+	// Replace with just the second tag.
+	if !assert.NoError(t, f.db.WorkerSetTags(f.ctx, &w, []string{secondTag.UUID})) {
+		t.FailNow()
+	}
+	assertTags("second tag only", secondTag.UUID)
+
+	// Deleting a tag should unassign the worker.
+	if !assert.NoError(t, f.db.DeleteWorkerTag(f.ctx, secondTag.UUID)) {
+		t.FailNow()
+	}
+	assertTags("after tag deletion")
+}
+
+func TestDeleteWorkerTagWithJob(t *testing.T) {
+	f := workerTagTestFixtures(t, 1*time.Second)
+	defer f.done()
+
+	authTask := authorTestTask("the task", "blender")
+	authoredJob := authorTestJob("eebd3fa2-c4c6-4d33-b78a-c58da5d7a4e0", "simple-blender-render", authTask)
+	authoredJob.WorkerTagUUID = f.tag.UUID
+	job := constructTestJob(f.ctx, t, f.db, authoredJob)
+	if assert.NotNil(t, job.WorkerTag) {
+		assert.Equal(t, f.tag.UUID, job.WorkerTag.UUID)
+	}
+
+	// Deleting the tag should make the job untagged.
+	if !assert.NoError(t, f.db.DeleteWorkerTag(f.ctx, f.tag.UUID)) {
+		t.FailNow()
+	}
+
+	dbJob, err := f.db.FetchJob(f.ctx, job.UUID)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Nil(t, dbJob.WorkerTagID)
+	assert.Nil(t, dbJob.WorkerTag)
+}
+
+func TestStoreAuthoredJobUnknownWorkerTag(t *testing.T) {
+	f := workerTagTestFixtures(t, 1*time.Second)
+	defer f.done()
+
+	authTask := authorTestTask("the task", "blender")
+	authoredJob := authorTestJob("eebd3fa2-c4c6-4d33-b78a-c58da5d7a4e0", "simple-blender-render", authTask)
+	authoredJob.WorkerTagUUID = "c26a1c74-2f2a-4a6b-b5e9-e3d0c11b25c7"
+
+	err := f.db.StoreAuthoredJob(f.ctx, authoredJob)
+	assert.ErrorIs(t, err, ErrWorkerTagNotFound)
+
+	_, err = f.db.FetchJob(f.ctx, authoredJob.JobID)
+	assert.ErrorIs(t, err, ErrJobNotFound, "the job should not have been stored")
+}
+
+type workerTagFixtures struct {
+	ctx  context.Context
+	done func()
+	db   *DB
+	tag  *WorkerTag
+}
+
+func workerTagTestFixtures(t *testing.T, testContextTimeout time.Duration) workerTagFixtures {
+	ctx, cancel, db := persistenceTestFixtures(t, testContextTimeout)
+
+	tag := WorkerTag{
+		UUID:        "dcc5e1e1-a50e-4c4d-9d1c-c2a42b3b1ff1",
+		Name:        "GPU-EEVEE",
+		Description: "Workers that can render EEVEE on their GPU",
+	}
+	if err := db.CreateWorkerTag(ctx, &tag); err != nil {
+		cancel()
+		t.Fatalf("creating worker tag: %v", err)
+	}
+
+	return workerTagFixtures{
+		ctx:  ctx,
+		done: cancel,
+		db:   db,
+		tag:  &tag,
+	}
+}
@@ -24,9 +24,22 @@ type Worker struct {
 	LastSeenAt time.Time        `gorm:"index"` // Should contain UTC timestamps.
 
 	StatusRequested   api.WorkerStatus `gorm:"type:varchar(16);default:''"`
-	LazyStatusRequest bool             `gorm:"type:smallint;default:0"`
+	LazyStatusRequest bool             `gorm:"default:false"`
 
 	SupportedTaskTypes string `gorm:"type:varchar(255);default:''"` // comma-separated list of task types.
+
+	// Resources offered by the Worker, as it reported when signing on. Zero
+	// means "unknown".
+	CPUCores     int             `gorm:"default:0"`
+	MemoryMB     int             `gorm:"default:0"`
+	Capabilities StringStringMap `gorm:"type:jsonb"`
+
+	// TaskSlots is the number of tasks of each type the Worker can run at the
+	// same time. Task types not mentioned get one slot. When empty, the Worker
+	// runs one task at a time, regardless of its type.
+	TaskSlots StringIntMap `gorm:"type:jsonb"`
+
+	Tags []*WorkerTag `gorm:"many2many:worker_tag_membership;constraint:OnDelete:CASCADE"`
 }
 
 func (w *Worker) Identifier() string {
@@ -80,6 +93,31 @@ func (db *DB) FetchWorkers(ctx context.Context) ([]*Worker, error) {
 	return workers, nil
 }
 
+// SummarizeWorkerStatuses returns the number of workers in each status.
+// Statuses without any workers are not included.
+func (db *DB) SummarizeWorkerStatuses(ctx context.Context) (map[api.WorkerStatus]int, error) {
+	type Result struct {
+		Status     api.WorkerStatus
+		NumWorkers int
+	}
+	var results []Result
+
+	tx := db.gormDB.WithContext(ctx).
+		Model(&Worker{}).
+		Select("status, count(*) as num_workers").
+		Group("status").
+		Scan(&results)
+	if tx.Error != nil {
+		return nil, workerError(tx.Error, "summarizing worker statuses")
+	}
+
+	summary := make(map[api.WorkerStatus]int, len(results))
+	for _, result := range results {
+		summary[result.Status] = result.NumWorkers
+	}
+	return summary, nil
+}
+
 // FetchWorkerTask returns the most recent task assigned to the given Worker.
 func (db *DB) FetchWorkerTask(ctx context.Context, worker *Worker) (*Task, error) {
 	task := Task{}
@@ -252,3 +252,30 @@ func TestFetchWorkers(t *testing.T) {
 		assert.Equal(t, windowsWorker.UUID, workers[1].UUID)
 	}
 }
+
+func TestSummarizeWorkerStatuses(t *testing.T) {
+	ctx, cancel, db := persistenceTestFixtures(t, 1*time.Second)
+	defer cancel()
+
+	// No workers.
+	summary, err := db.SummarizeWorkerStatuses(ctx)
+	if assert.NoError(t, err) {
+		assert.Empty(t, summary)
+	}
+
+	linuxWorker(t, db)
+	windowsWorker(t, db)
+	linuxWorker(t, db, func(w *Worker) {
+		w.UUID = uuid.New()
+		w.Status = api.WorkerStatusOffline
+	})
+
+	summary, err = db.SummarizeWorkerStatuses(ctx)
+	if assert.NoError(t, err) {
+		expect := map[api.WorkerStatus]int{
+			api.WorkerStatusAwake:   2,
+			api.WorkerStatusOffline: 1,
+		}
+		assert.Equal(t, expect, summary)
+	}
+}
@@ -0,0 +1,228 @@
+// Package schedule_notifier wakes up Workers that are waiting for a task, when
+// something happens that may make a task available to them.
+//
+// It listens to the same updates that are sent to the web interface. Queued
+// jobs and tasks can be assigned to Workers, and completed tasks can unblock
+// the tasks that depend on them. Tasks that are deferred, because their job
+// has a start time or because they are waiting to be retried, become available
+// at a known moment; a timer wakes up a Worker at that moment.
+//
+// To avoid waking up all waiting Workers when only one task became available,
+// most notifications wake up only the Worker that has been waiting longest. A
+// Worker that was woken up this way passes the wake-up on after it looked for a
+// task. When it got a task there may be more available, and when it didn't the
+// task may be meant for another Worker. The wake-up is only passed on to Workers
+// that started waiting before the notification, as the others have already
+// looked for a task after it happened.
+package schedule_notifier
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+
+	"git.blender.org/flamenco/pkg/api"
+)
+
+type Notifier struct {
+	clock clock.Clock
+
+	mutex sync.Mutex
+
+	// waiters is ordered by the time they started waiting, oldest first.
+	waiters []*waiter
+
+	// generation counts the notifications that wake up one Worker.
+	generation uint64
+
+	// timer wakes up a waiter at timerAt, the next moment a deferred task
+	// becomes available. It is nil when there is no such moment known.
+	timer   *clock.Timer
+	timerAt time.Time
+}
+
+type waiter struct {
+	workerUUID string
+	wakeup     chan struct{}
+
+	// seen is the generation at the time the Worker started waiting. As it looks
+	// for a task after that, it doesn't need to be woken up for notifications of
+	// that generation or older.
+	seen uint64
+
+	// wokenAlone is set when this waiter was woken up without waking up the
+	// others, and thus should pass the wake-up on.
+	wokenAlone bool
+}
+
+func New(clock clock.Clock) *Notifier {
+	return &Notifier{
+		clock: clock,
+	}
+}
+
+// Wait registers the Worker as waiting for a task. The returned channel is
+// closed when the Worker is woken up. The returned function must always be
+// called when the Worker is done waiting. After a wake-up, call it only after
+// looking for a task again (and after calling Wait again, if the Worker keeps
+// waiting). When the Worker was woken up on its own, this passes the wake-up on
+// to the next waiting Worker that hasn't seen the notification yet.
+//
+// Call Wait before looking for a task, otherwise a task that becomes available
+// while looking could be missed.
+func (n *Notifier) Wait(workerUUID string) (<-chan struct{}, func()) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	w := &waiter{
+		workerUUID: workerUUID,
+		wakeup:     make(chan struct{}),
+		seen:       n.generation,
+	}
+	n.waiters = append(n.waiters, w)
+
+	stopWaiting := func() {
+		n.mutex.Lock()
+		defer n.mutex.Unlock()
+
+		if n.removeWaiter(w) {
+			return
+		}
+		if w.wokenAlone {
+			w.wokenAlone = false
+			n.wakeNext()
+		}
+	}
+	return w.wakeup, stopWaiting
+}
+
+// Notify wakes up all waiting Workers.
+func (n *Notifier) Notify() {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	for _, w := range n.waiters {
+		close(w.wakeup)
+	}
+	n.waiters = nil
+}
+
+// NotifyOne wakes up the Worker that has been waiting the longest.
+func (n *Notifier) NotifyOne() {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.notifyOne()
+}
+
+// NotifyAt wakes up the Worker that has been waiting the longest at the given
+// time. Only the earliest of such times is remembered, so this should be
+// called again after the timer fired.
+func (n *Notifier) NotifyAt(t time.Time) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if n.timer != nil {
+		if !t.Before(n.timerAt) {
+			return
+		}
+		n.timer.Stop()
+	}
+
+	n.timerAt = t
+	n.timer = n.clock.AfterFunc(t.Sub(n.clock.Now()), func() {
+		n.mutex.Lock()
+		defer n.mutex.Unlock()
+
+		// This timer may have been replaced just before firing.
+		if n.timerAt.Equal(t) {
+			n.timer = nil
+			n.timerAt = time.Time{}
+		}
+		n.notifyOne()
+	})
+}
+
+// JobUpdate notifies when a job has been queued. This wakes up all Workers, as
+// the job can have many tasks.
+func (n *Notifier) JobUpdate(jobUpdate api.SocketIOJobUpdate) {
+	if jobUpdate.Status != api.JobStatusQueued {
+		return
+	}
+	if jobUpdate.PreviousStatus != nil && *jobUpdate.PreviousStatus == jobUpdate.Status {
+		return
+	}
+	n.Notify()
+}
+
+// TaskUpdate notifies when a task has been queued, or when it has been
+// completed and thus its dependent tasks may be runnable. This wakes up one
+// Worker, which passes the wake-up on when it got a task.
+func (n *Notifier) TaskUpdate(taskUpdate api.SocketIOTaskUpdate) {
+	switch taskUpdate.Status {
+	case api.TaskStatusQueued, api.TaskStatusCompleted:
+	default:
+		return
+	}
+	if taskUpdate.PreviousStatus != nil && *taskUpdate.PreviousStatus == taskUpdate.Status {
+		return
+	}
+	n.NotifyOne()
+}
+
+// WorkerUpdate wakes up the Worker when it should change its status, so that
+// it hears about this as soon as possible.
+func (n *Notifier) WorkerUpdate(workerUpdate api.SocketIOWorkerUpdate) {
+	if workerUpdate.StatusChange == nil {
+		return
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	remaining := n.waiters[:0]
+	for _, w := range n.waiters {
+		if w.workerUUID == workerUpdate.Id {
+			close(w.wakeup)
+			continue
+		}
+		remaining = append(remaining, w)
+	}
+	n.waiters = remaining
+}
+
+// notifyOne starts a new generation of notifications, and wakes up the Worker
+// that has been waiting the longest. The mutex must be locked by the caller.
+func (n *Notifier) notifyOne() {
+	n.generation++
+	n.wakeNext()
+}
+
+// wakeNext wakes up the Worker that has been waiting the longest, and hasn't
+// seen the current generation of notifications yet. The mutex must be locked
+// by the caller.
+func (n *Notifier) wakeNext() {
+	for idx, w := range n.waiters {
+		if w.seen >= n.generation {
+			continue
+		}
+		n.waiters = append(n.waiters[:idx], n.waiters[idx+1:]...)
+		w.wokenAlone = true
+		close(w.wakeup)
+		return
+	}
+}
+
+// removeWaiter removes the waiter, and returns whether it was still waiting.
+// The mutex must be locked by the caller.
+func (n *Notifier) removeWaiter(toRemove *waiter) bool {
+	for idx, w := range n.waiters {
+		if w == toRemove {
+			n.waiters = append(n.waiters[:idx], n.waiters[idx+1:]...)
+			return true
+		}
+	}
+	return false
+}
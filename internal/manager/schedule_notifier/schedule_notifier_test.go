@@ -0,0 +1,201 @@
+package schedule_notifier
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+
+	"git.blender.org/flamenco/pkg/api"
+)
+
+const (
+	workerUUID1 = "e7632d62-c3b8-4af0-9e78-01752928952c"
+	workerUUID2 = "8d6bfbdf-9d6e-4b9a-a90e-f7c5ce44f1d6"
+)
+
+func TestNotify(t *testing.T) {
+	n := New(clock.NewMock())
+
+	waitChan1, _ := n.Wait(workerUUID1)
+	waitChan2, _ := n.Wait(workerUUID2)
+	assertNotNotified(t, waitChan1)
+
+	n.Notify()
+	assertNotified(t, waitChan1)
+	assertNotified(t, waitChan2)
+
+	// After a notification, the next wait should block again.
+	waitChan3, _ := n.Wait(workerUUID1)
+	assertNotNotified(t, waitChan3)
+}
+
+func TestNotifyOne(t *testing.T) {
+	n := New(clock.NewMock())
+
+	waitChan1, stopWaiting1 := n.Wait(workerUUID1)
+	waitChan2, _ := n.Wait(workerUUID2)
+
+	// The longest-waiting Worker should be woken up first.
+	n.NotifyOne()
+	assertNotified(t, waitChan1)
+	assertNotNotified(t, waitChan2)
+
+	// When it doesn't act on the wake-up, it should be passed on.
+	stopWaiting1()
+	assertNotified(t, waitChan2)
+}
+
+func TestNotifyOnePassedOn(t *testing.T) {
+	n := New(clock.NewMock())
+
+	waitChan1, stopWaiting1 := n.Wait(workerUUID1)
+	waitChan2, stopWaiting2 := n.Wait(workerUUID2)
+
+	n.NotifyOne()
+	assertNotified(t, waitChan1)
+	assertNotNotified(t, waitChan2)
+
+	// The first Worker looks for a task, doesn't find one it can run, and waits
+	// again. Its wake-up should go to the next Worker.
+	waitChan1, _ = n.Wait(workerUUID1)
+	stopWaiting1()
+	assertNotNotified(t, waitChan1)
+	assertNotified(t, waitChan2)
+
+	// When every Worker has looked for a task after the notification, the
+	// wake-up should not be passed on any further.
+	waitChan2, _ = n.Wait(workerUUID2)
+	stopWaiting2()
+	assertNotNotified(t, waitChan1)
+	assertNotNotified(t, waitChan2)
+
+	// The next notification should wake up the Workers again.
+	n.NotifyOne()
+	assertNotified(t, waitChan1)
+	assertNotNotified(t, waitChan2)
+}
+
+func TestStopWaiting(t *testing.T) {
+	n := New(clock.NewMock())
+
+	_, stopWaiting1 := n.Wait(workerUUID1)
+	waitChan2, _ := n.Wait(workerUUID2)
+
+	// A Worker that stopped waiting should not swallow the next wake-up.
+	stopWaiting1()
+	assertNotNotified(t, waitChan2)
+	n.NotifyOne()
+	assertNotified(t, waitChan2)
+}
+
+func TestNotifyAt(t *testing.T) {
+	mockedClock := clock.NewMock()
+	n := New(mockedClock)
+	waitChan1, _ := n.Wait(workerUUID1)
+	waitChan2, _ := n.Wait(workerUUID2)
+
+	now := mockedClock.Now()
+	n.NotifyAt(now.Add(time.Hour))
+	n.NotifyAt(now.Add(time.Minute))
+	n.NotifyAt(now.Add(2 * time.Minute))
+
+	mockedClock.Add(59 * time.Second)
+	assertNotNotified(t, waitChan1)
+
+	// Only the earliest time should be remembered.
+	mockedClock.Add(time.Second)
+	assertNotified(t, waitChan1)
+	assertNotNotified(t, waitChan2)
+
+	mockedClock.Add(2 * time.Hour)
+	assertNotNotified(t, waitChan2)
+}
+
+func TestJobUpdate(t *testing.T) {
+	n := New(clock.NewMock())
+	waitChan, _ := n.Wait(workerUUID1)
+
+	// Changes to other statuses should not notify.
+	prevStatus := api.JobStatusQueued
+	n.JobUpdate(api.SocketIOJobUpdate{Status: api.JobStatusActive, PreviousStatus: &prevStatus})
+	assertNotNotified(t, waitChan)
+
+	// Updates without status change should not notify.
+	n.JobUpdate(api.SocketIOJobUpdate{Status: api.JobStatusQueued, PreviousStatus: &prevStatus})
+	assertNotNotified(t, waitChan)
+
+	// New jobs are queued without previous status.
+	n.JobUpdate(api.SocketIOJobUpdate{Status: api.JobStatusQueued})
+	assertNotified(t, waitChan)
+
+	// Queued jobs should wake up all Workers.
+	waitChan1, _ := n.Wait(workerUUID1)
+	waitChan2, _ := n.Wait(workerUUID2)
+	prevStatus = api.JobStatusPaused
+	n.JobUpdate(api.SocketIOJobUpdate{Status: api.JobStatusQueued, PreviousStatus: &prevStatus})
+	assertNotified(t, waitChan1)
+	assertNotified(t, waitChan2)
+}
+
+func TestTaskUpdate(t *testing.T) {
+	n := New(clock.NewMock())
+	waitChan1, _ := n.Wait(workerUUID1)
+	waitChan2, _ := n.Wait(workerUUID2)
+
+	prevStatus := api.TaskStatusQueued
+	n.TaskUpdate(api.SocketIOTaskUpdate{Status: api.TaskStatusActive, PreviousStatus: &prevStatus})
+	assertNotNotified(t, waitChan1)
+
+	// Progress updates of active tasks should not notify.
+	n.TaskUpdate(api.SocketIOTaskUpdate{Status: api.TaskStatusActive})
+	assertNotNotified(t, waitChan1)
+
+	// A single task should wake up a single Worker.
+	prevStatus = api.TaskStatusActive
+	n.TaskUpdate(api.SocketIOTaskUpdate{Status: api.TaskStatusCompleted, PreviousStatus: &prevStatus})
+	assertNotified(t, waitChan1)
+	assertNotNotified(t, waitChan2)
+
+	prevStatus = api.TaskStatusFailed
+	n.TaskUpdate(api.SocketIOTaskUpdate{Status: api.TaskStatusQueued, PreviousStatus: &prevStatus})
+	assertNotified(t, waitChan2)
+}
+
+func TestWorkerUpdate(t *testing.T) {
+	n := New(clock.NewMock())
+	waitChan1, _ := n.Wait(workerUUID1)
+	waitChan2, _ := n.Wait(workerUUID2)
+
+	n.WorkerUpdate(api.SocketIOWorkerUpdate{Id: workerUUID1, Status: api.WorkerStatusAwake})
+	assertNotNotified(t, waitChan1)
+
+	// Only the Worker that should change status should be woken up.
+	n.WorkerUpdate(api.SocketIOWorkerUpdate{
+		Id:           workerUUID1,
+		Status:       api.WorkerStatusAwake,
+		StatusChange: &api.WorkerStatusChangeRequest{Status: api.WorkerStatusAsleep},
+	})
+	assertNotified(t, waitChan1)
+	assertNotNotified(t, waitChan2)
+}
+
+func assertNotified(t *testing.T, waitChan <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-waitChan:
+	default:
+		t.Error("expected notification")
+	}
+}
+
+func assertNotNotified(t *testing.T, waitChan <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-waitChan:
+		t.Error("unexpected notification")
+	default:
+	}
+}
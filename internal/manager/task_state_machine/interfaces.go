@@ -34,8 +34,15 @@ type PersistenceService interface {
 		statusesToUpdate []api.TaskStatus, taskStatus api.TaskStatus, activity string) error
 
 	FetchJobsInStatus(ctx context.Context, jobStatuses ...api.JobStatus) ([]*persistence.Job, error)
+	FetchJobDependencies(ctx context.Context, job *persistence.Job) ([]*persistence.Job, error)
+	FetchJobsDependingOn(ctx context.Context, job *persistence.Job, jobStatuses ...api.JobStatus) ([]*persistence.Job, error)
 	FetchTasksOfWorkerInStatus(context.Context, *persistence.Worker, api.TaskStatus) ([]*persistence.Task, error)
 	FetchTasksOfWorkerInStatusOfJob(context.Context, *persistence.Worker, api.TaskStatus, *persistence.Job) ([]*persistence.Task, error)
+	FetchTaskFailureList(context.Context, *persistence.Task) ([]*persistence.Worker, error)
+
+	// FetchJobsProgress returns the percentage of each job that has been
+	// completed, indexed by job ID.
+	FetchJobsProgress(ctx context.Context, jobs []*persistence.Job) (map[uint]int, error)
 }
 
 // PersistenceService should be a subset of persistence.DB
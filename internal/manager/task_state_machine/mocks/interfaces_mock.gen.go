@@ -58,6 +58,41 @@ func (mr *MockPersistenceServiceMockRecorder) CountTasksOfJobInStatus(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTasksOfJobInStatus", reflect.TypeOf((*MockPersistenceService)(nil).CountTasksOfJobInStatus), varargs...)
 }
 
+// FetchJobDependencies mocks base method.
+func (m *MockPersistenceService) FetchJobDependencies(arg0 context.Context, arg1 *persistence.Job) ([]*persistence.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchJobDependencies", arg0, arg1)
+	ret0, _ := ret[0].([]*persistence.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobDependencies indicates an expected call of FetchJobDependencies.
+func (mr *MockPersistenceServiceMockRecorder) FetchJobDependencies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobDependencies", reflect.TypeOf((*MockPersistenceService)(nil).FetchJobDependencies), arg0, arg1)
+}
+
+// FetchJobsDependingOn mocks base method.
+func (m *MockPersistenceService) FetchJobsDependingOn(arg0 context.Context, arg1 *persistence.Job, arg2 ...api.JobStatus) ([]*persistence.Job, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchJobsDependingOn", varargs...)
+	ret0, _ := ret[0].([]*persistence.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobsDependingOn indicates an expected call of FetchJobsDependingOn.
+func (mr *MockPersistenceServiceMockRecorder) FetchJobsDependingOn(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobsDependingOn", reflect.TypeOf((*MockPersistenceService)(nil).FetchJobsDependingOn), varargs...)
+}
+
 // FetchJobsInStatus mocks base method.
 func (m *MockPersistenceService) FetchJobsInStatus(arg0 context.Context, arg1 ...api.JobStatus) ([]*persistence.Job, error) {
 	m.ctrl.T.Helper()
@@ -78,6 +113,36 @@ func (mr *MockPersistenceServiceMockRecorder) FetchJobsInStatus(arg0 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobsInStatus", reflect.TypeOf((*MockPersistenceService)(nil).FetchJobsInStatus), varargs...)
 }
 
+// FetchJobsProgress mocks base method.
+func (m *MockPersistenceService) FetchJobsProgress(arg0 context.Context, arg1 []*persistence.Job) (map[uint]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchJobsProgress", arg0, arg1)
+	ret0, _ := ret[0].(map[uint]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobsProgress indicates an expected call of FetchJobsProgress.
+func (mr *MockPersistenceServiceMockRecorder) FetchJobsProgress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobsProgress", reflect.TypeOf((*MockPersistenceService)(nil).FetchJobsProgress), arg0, arg1)
+}
+
+// FetchTaskFailureList mocks base method.
+func (m *MockPersistenceService) FetchTaskFailureList(arg0 context.Context, arg1 *persistence.Task) ([]*persistence.Worker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTaskFailureList", arg0, arg1)
+	ret0, _ := ret[0].([]*persistence.Worker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchTaskFailureList indicates an expected call of FetchTaskFailureList.
+func (mr *MockPersistenceServiceMockRecorder) FetchTaskFailureList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaskFailureList", reflect.TypeOf((*MockPersistenceService)(nil).FetchTaskFailureList), arg0, arg1)
+}
+
 // FetchTasksOfWorkerInStatus mocks base method.
 func (m *MockPersistenceService) FetchTasksOfWorkerInStatus(arg0 context.Context, arg1 *persistence.Worker, arg2 api.TaskStatus) ([]*persistence.Task, error) {
 	m.ctrl.T.Helper()
@@ -4,11 +4,16 @@ package task_state_machine
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"math"
+	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 
+	"git.blender.org/flamenco/internal/manager/config"
 	"git.blender.org/flamenco/internal/manager/persistence"
 	"git.blender.org/flamenco/internal/manager/webupdates"
 	"git.blender.org/flamenco/pkg/api"
@@ -20,16 +25,26 @@ const taskFailJobPercentage = 10 // Integer from 0 to 100.
 
 // StateMachine handles task and job status changes.
 type StateMachine struct {
-	persist     PersistenceService
-	broadcaster ChangeBroadcaster
-	logStorage  LogStorage
+	clock        clock.Clock
+	persist      PersistenceService
+	broadcaster  ChangeBroadcaster
+	logStorage   LogStorage
+	retryBackoff config.TaskRetryBackoff
 }
 
-func NewStateMachine(persist PersistenceService, broadcaster ChangeBroadcaster, logStorage LogStorage) *StateMachine {
+func NewStateMachine(
+	clock clock.Clock,
+	persist PersistenceService,
+	broadcaster ChangeBroadcaster,
+	logStorage LogStorage,
+	retryBackoff config.TaskRetryBackoff,
+) *StateMachine {
 	return &StateMachine{
-		persist:     persist,
-		broadcaster: broadcaster,
-		logStorage:  logStorage,
+		clock:        clock,
+		persist:      persist,
+		broadcaster:  broadcaster,
+		logStorage:   logStorage,
+		retryBackoff: retryBackoff,
 	}
 }
 
@@ -41,6 +56,7 @@ func (sm *StateMachine) TaskStatusChange(
 	newTaskStatus api.TaskStatus,
 ) error {
 	oldTaskStatus := task.Status
+	oldJobStatus := task.Job.Status
 
 	if err := sm.taskStatusChangeOnly(ctx, task, newTaskStatus); err != nil {
 		return err
@@ -49,9 +65,30 @@ func (sm *StateMachine) TaskStatusChange(
 	if err := sm.updateJobAfterTaskStatusChange(ctx, task, oldTaskStatus); err != nil {
 		return fmt.Errorf("updating job after task status change: %w", err)
 	}
+
+	// The job's progress depends on the status of its tasks. Job status changes
+	// already include the progress in their broadcast.
+	if oldTaskStatus != newTaskStatus && task.Job.Status == oldJobStatus {
+		jobUpdate := webupdates.NewJobUpdate(task.Job)
+		jobUpdate.Progress = sm.jobProgress(ctx, task.Job)
+		sm.broadcaster.BroadcastJobUpdate(jobUpdate)
+	}
 	return nil
 }
 
+// jobProgress returns the percentage of the job that has been completed, or nil
+// when that cannot be determined. Failing to get the progress should not block
+// status changes, so errors are only logged.
+func (sm *StateMachine) jobProgress(ctx context.Context, job *persistence.Job) *int {
+	jobsProgress, err := sm.persist.FetchJobsProgress(ctx, []*persistence.Job{job})
+	if err != nil {
+		log.Warn().Err(err).Str("job", job.UUID).Msg("unable to fetch job progress")
+		return nil
+	}
+	progress := jobsProgress[job.ID]
+	return &progress
+}
+
 // taskStatusChangeOnly updates the task's status to the new one, but does not "ripple" the change to the job.
 // `task` is expected to still have its original status, and have a filled `Job` pointer.
 func (sm *StateMachine) taskStatusChangeOnly(
@@ -67,6 +104,7 @@ func (sm *StateMachine) taskStatusChangeOnly(
 
 	oldTaskStatus := task.Status
 	task.Status = newTaskStatus
+	updateTaskProgress(task, oldTaskStatus)
 
 	logger := log.With().
 		Str("task", task.UUID).
@@ -76,6 +114,11 @@ func (sm *StateMachine) taskStatusChangeOnly(
 		Logger()
 	logger.Debug().Msg("task state changed")
 
+	retryDelay, err := sm.setTaskRetryAfter(ctx, task)
+	if err != nil {
+		return err
+	}
+
 	if err := sm.persist.SaveTaskStatus(ctx, task); err != nil {
 		return fmt.Errorf("saving task to database: %w", err)
 	}
@@ -86,6 +129,11 @@ func (sm *StateMachine) taskStatusChangeOnly(
 		_ = sm.logStorage.WriteTimestamped(logger, job.UUID, task.UUID,
 			fmt.Sprintf("task changed status %s -> %s", oldTaskStatus, newTaskStatus))
 	}
+	if retryDelay > 0 {
+		_ = sm.logStorage.WriteTimestamped(logger, job.UUID, task.UUID,
+			fmt.Sprintf("task will be retried after %s, at %s",
+				retryDelay, task.RetryAfter.Time.Format(time.RFC3339)))
+	}
 
 	// Broadcast this change to the SocketIO clients.
 	taskUpdate := webupdates.NewTaskUpdate(task)
@@ -95,6 +143,57 @@ func (sm *StateMachine) taskStatusChangeOnly(
 	return nil
 }
 
+// updateTaskProgress resets the task's progress when it (re)starts, and
+// fills it up when the task completes.
+func updateTaskProgress(task *persistence.Task, oldTaskStatus api.TaskStatus) {
+	switch {
+	case task.Status == api.TaskStatusCompleted:
+		task.Progress = 100
+	case task.Status == api.TaskStatusQueued,
+		task.Status == api.TaskStatusActive && oldTaskStatus != api.TaskStatusActive:
+		task.Progress = 0
+	}
+}
+
+// setTaskRetryAfter determines when a soft-failed task can be retried, and
+// clears the retry time for tasks in other statuses. Every failure doubles the
+// time the task has to wait, up to the configured maximum. Returns the delay
+// before the task will be retried, or 0 if it can be retried immediately.
+func (sm *StateMachine) setTaskRetryAfter(ctx context.Context, task *persistence.Task) (time.Duration, error) {
+	task.RetryAfter = sql.NullTime{}
+	if task.Status != api.TaskStatusSoftFailed || sm.retryBackoff.Initial <= 0 {
+		return 0, nil
+	}
+
+	failedWorkers, err := sm.persist.FetchTaskFailureList(ctx, task)
+	if err != nil {
+		return 0, fmt.Errorf("fetching workers that failed task %s: %w", task.UUID, err)
+	}
+
+	delay := retryDelay(sm.retryBackoff, len(failedWorkers))
+	task.RetryAfter = sql.NullTime{
+		Time:  sm.clock.Now().Add(delay),
+		Valid: true,
+	}
+	return delay, nil
+}
+
+// retryDelay returns how long a task has to wait before it is retried, after
+// it has failed `numFailures` times.
+func retryDelay(backoff config.TaskRetryBackoff, numFailures int) time.Duration {
+	delay := backoff.Initial
+	for i := 1; i < numFailures && delay < math.MaxInt64/2; i++ {
+		delay *= 2
+		if backoff.Max > 0 && delay >= backoff.Max {
+			return backoff.Max
+		}
+	}
+	if backoff.Max > 0 && delay > backoff.Max {
+		return backoff.Max
+	}
+	return delay
+}
+
 // updateJobAfterTaskStatusChange updates the job status based on the status of
 // this task and other tasks in the job.
 func (sm *StateMachine) updateJobAfterTaskStatusChange(
@@ -334,8 +433,14 @@ func (sm *StateMachine) jobStatusSet(ctx context.Context,
 	jobUpdate := webupdates.NewJobUpdate(job)
 	jobUpdate.PreviousStatus = &oldJobStatus
 	jobUpdate.RefreshTasks = result.massTaskUpdate
+	jobUpdate.Progress = sm.jobProgress(ctx, job)
 	sm.broadcaster.BroadcastJobUpdate(jobUpdate)
 
+	// Jobs waiting for this one may be able to continue now.
+	if err := sm.updateDependentJobs(ctx, logger, job); err != nil {
+		return "", fmt.Errorf("updating jobs depending on this job: %w", err)
+	}
+
 	return result.followingJobStatus, nil
 }
 
@@ -396,6 +501,13 @@ func (sm *StateMachine) updateTasksAfterJobStatusChange(
 			massTaskUpdate:     true,
 		}, err
 
+	case api.JobStatusWaiting:
+		// The tasks stay queued, but won't be scheduled until the job is queued.
+		jobStatus, err := sm.checkJobDependencies(ctx, logger, job)
+		return tasksUpdateResult{
+			followingJobStatus: jobStatus,
+		}, err
+
 	default:
 		logger.Warn().Msg("unknown job status change, ignoring")
 		return tasksUpdateResult{}, nil
@@ -508,11 +620,108 @@ func (sm *StateMachine) checkTaskCompletion(
 	return api.JobStatusCompleted, nil
 }
 
+// checkJobDependencies returns "queued" as next job status when all the jobs
+// this job depends on are completed, and "failed" when any of them failed or
+// got canceled.
+//
+// Returns the new job status, if this status transition should be followed by
+// another one.
+func (sm *StateMachine) checkJobDependencies(
+	ctx context.Context, logger zerolog.Logger, job *persistence.Job,
+) (api.JobStatus, error) {
+	deps, err := sm.persist.FetchJobDependencies(ctx, job)
+	if err != nil {
+		return "", fmt.Errorf("fetching dependencies of job %s: %w", job.UUID, err)
+	}
+
+	numCompleted := 0
+	for _, dep := range deps {
+		switch dep.Status {
+		case api.JobStatusCompleted:
+			numCompleted++
+		case api.JobStatusFailed, api.JobStatusCanceled:
+			logger.Info().
+				Str("dependency", dep.UUID).
+				Str("dependencyStatus", string(dep.Status)).
+				Msg("job depends on a job that will not complete, failing job")
+			return api.JobStatusFailed, nil
+		}
+	}
+
+	if numCompleted < len(deps) {
+		logger.Debug().
+			Int("numDepsCompleted", numCompleted).
+			Int("numDepsTotal", len(deps)).
+			Msg("not all dependencies of job are completed")
+		return "", nil
+	}
+
+	logger.Info().Msg("job has all dependencies completed, transition job to 'queued'")
+	return api.JobStatusQueued, nil
+}
+
+// updateDependentJobs re-checks the dependencies of the jobs waiting for this
+// one, after this job reached a final status.
+func (sm *StateMachine) updateDependentJobs(
+	ctx context.Context, logger zerolog.Logger, job *persistence.Job,
+) error {
+	switch job.Status {
+	case api.JobStatusCompleted, api.JobStatusFailed, api.JobStatusCanceled:
+	default:
+		return nil
+	}
+
+	dependents, err := sm.persist.FetchJobsDependingOn(ctx, job, api.JobStatusWaiting)
+	if err != nil {
+		return err
+	}
+
+	for _, dependent := range dependents {
+		depLogger := logger.With().Str("dependent", dependent.UUID).Logger()
+		newJobStatus, err := sm.checkJobDependencies(ctx, depLogger, dependent)
+		if err != nil {
+			return err
+		}
+		if newJobStatus == "" {
+			continue
+		}
+
+		reason := fmt.Sprintf("job %s it depends on went to status %q", job.UUID, job.Status)
+		if err := sm.JobStatusChange(ctx, dependent, newJobStatus, reason); err != nil {
+			return fmt.Errorf("updating job %s: %w", dependent.UUID, err)
+		}
+	}
+	return nil
+}
+
+// CheckJobDependencies re-checks the dependencies of a waiting job, and
+// releases or fails it when its dependencies allow. This is necessary when one
+// of its dependencies was deleted, as then no status change of that job will
+// trigger this check.
+func (sm *StateMachine) CheckJobDependencies(ctx context.Context, job *persistence.Job, reason string) error {
+	if job.Status != api.JobStatusWaiting {
+		return nil
+	}
+
+	logger := log.With().Str("job", job.UUID).Logger()
+	newJobStatus, err := sm.checkJobDependencies(ctx, logger, job)
+	if err != nil {
+		return err
+	}
+	if newJobStatus == "" {
+		return nil
+	}
+	return sm.JobStatusChange(ctx, job, newJobStatus, reason)
+}
+
 // CheckStuck finds jobs that are 'stuck' in their current status. This is meant
 // to run at startup of Flamenco Manager, and checks to see if there are any
 // jobs in a status that a human will not be able to fix otherwise.
 func (sm *StateMachine) CheckStuck(ctx context.Context) {
-	stuckJobs, err := sm.persist.FetchJobsInStatus(ctx, api.JobStatusCancelRequested, api.JobStatusRequeueing)
+	// Waiting jobs are included, to release those whose dependencies got
+	// deleted or finished while their status changes weren't handled.
+	stuckJobs, err := sm.persist.FetchJobsInStatus(ctx,
+		api.JobStatusCancelRequested, api.JobStatusRequeueing, api.JobStatusWaiting)
 	if err != nil {
 		log.Error().Err(err).Msg("unable to fetch stuck jobs")
 		return
@@ -7,16 +7,20 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"git.blender.org/flamenco/internal/manager/config"
 	"git.blender.org/flamenco/internal/manager/persistence"
 	"git.blender.org/flamenco/internal/manager/task_state_machine/mocks"
 	"git.blender.org/flamenco/pkg/api"
 )
 
 type StateMachineMocks struct {
+	clock       *clock.Mock
 	persist     *mocks.MockPersistenceService
 	broadcaster *mocks.MockChangeBroadcaster
 	logStorage  *mocks.MockLogStorage
@@ -40,6 +44,63 @@ func TestTaskStatusChangeQueuedToActive(t *testing.T) {
 	assert.NoError(t, sm.TaskStatusChange(ctx, task, api.TaskStatusActive))
 }
 
+func TestTaskStatusChangeSoftFailedRetryBackoff(t *testing.T) {
+	mockCtrl, ctx, sm, mocks := taskStateMachineTestFixtures(t)
+	defer mockCtrl.Finish()
+
+	sm.retryBackoff = config.TaskRetryBackoff{Initial: 1 * time.Minute, Max: 15 * time.Minute}
+	mockedNow, err := time.Parse(time.RFC3339, "2022-11-03T18:00:00+01:00")
+	if err != nil {
+		panic(err)
+	}
+	mocks.clock.Set(mockedNow)
+
+	// Second failure of this task: T: active > soft-failed --> J: active > active
+	task := taskWithStatus(api.JobStatusActive, api.TaskStatusActive)
+	failedWorkers := []*persistence.Worker{{UUID: "worker-1"}, {UUID: "worker-2"}}
+	mocks.persist.EXPECT().FetchTaskFailureList(ctx, task).Return(failedWorkers, nil)
+	mocks.persist.EXPECT().
+		SaveTaskStatus(gomock.Any(), task).
+		DoAndReturn(func(ctx context.Context, savedTask *persistence.Task) error {
+			assert.Equal(t, api.TaskStatusSoftFailed, savedTask.Status)
+			assert.True(t, savedTask.RetryAfter.Valid)
+			assert.Equal(t, mockedNow.Add(2*time.Minute), savedTask.RetryAfter.Time)
+			return nil
+		})
+	mocks.expectWriteTaskLogTimestamped(t, task, "task changed status active -> soft-failed")
+	mocks.expectWriteTaskLogTimestamped(t, task, "task will be retried after 2m0s, at 2022-11-03T18:02:00+01:00")
+	mocks.expectBroadcastTaskChange(task, api.TaskStatusActive, api.TaskStatusSoftFailed)
+	mocks.expectBroadcastJobProgress(task.Job, 0)
+	assert.NoError(t, sm.TaskStatusChange(ctx, task, api.TaskStatusSoftFailed))
+
+	// Re-queueing the task should clear its retry time: T: soft-failed > queued --> J: active > active
+	mocks.persist.EXPECT().
+		SaveTaskStatus(gomock.Any(), task).
+		DoAndReturn(func(ctx context.Context, savedTask *persistence.Task) error {
+			assert.Equal(t, api.TaskStatusQueued, savedTask.Status)
+			assert.False(t, savedTask.RetryAfter.Valid)
+			return nil
+		})
+	mocks.expectWriteTaskLogTimestamped(t, task, "task changed status soft-failed -> queued")
+	mocks.expectBroadcastTaskChange(task, api.TaskStatusSoftFailed, api.TaskStatusQueued)
+	mocks.expectBroadcastJobProgress(task.Job, 0)
+	assert.NoError(t, sm.TaskStatusChange(ctx, task, api.TaskStatusQueued))
+}
+
+func TestRetryDelay(t *testing.T) {
+	backoff := config.TaskRetryBackoff{Initial: 1 * time.Minute, Max: 15 * time.Minute}
+	assert.Equal(t, 1*time.Minute, retryDelay(backoff, 0))
+	assert.Equal(t, 1*time.Minute, retryDelay(backoff, 1))
+	assert.Equal(t, 2*time.Minute, retryDelay(backoff, 2))
+	assert.Equal(t, 8*time.Minute, retryDelay(backoff, 4))
+	assert.Equal(t, 15*time.Minute, retryDelay(backoff, 5))
+	assert.Equal(t, 15*time.Minute, retryDelay(backoff, 1000))
+
+	// Without maximum, the delay just keeps doubling.
+	backoff.Max = 0
+	assert.Equal(t, 16*time.Minute, retryDelay(backoff, 5))
+}
+
 func TestTaskStatusChangeSaveTaskAfterJobChangeFailure(t *testing.T) {
 	mockCtrl, ctx, sm, mocks := taskStateMachineTestFixtures(t)
 	defer mockCtrl.Finish()
@@ -75,12 +136,14 @@ func TestTaskStatusChangeActiveToCompleted(t *testing.T) {
 	mocks.expectWriteTaskLogTimestamped(t, task, "task changed status active -> completed")
 	mocks.expectBroadcastTaskChange(task, api.TaskStatusActive, api.TaskStatusCompleted)
 	mocks.persist.EXPECT().CountTasksOfJobInStatus(ctx, task.Job, api.TaskStatusCompleted).Return(1, 3, nil) // 1 of 3 complete.
+	mocks.expectBroadcastJobProgress(task.Job, 33)
 	assert.NoError(t, sm.TaskStatusChange(ctx, task, api.TaskStatusCompleted))
 
 	// Second task hickup: T: active > soft-failed --> J: active > active
 	mocks.expectSaveTaskWithStatus(t, task2, api.TaskStatusSoftFailed)
 	mocks.expectWriteTaskLogTimestamped(t, task2, "task changed status active -> soft-failed")
 	mocks.expectBroadcastTaskChange(task2, api.TaskStatusActive, api.TaskStatusSoftFailed)
+	mocks.expectBroadcastJobProgress(task.Job, 33)
 	assert.NoError(t, sm.TaskStatusChange(ctx, task2, api.TaskStatusSoftFailed))
 
 	// Second task completing: T: soft-failed > completed --> J: active > active
@@ -88,6 +151,7 @@ func TestTaskStatusChangeActiveToCompleted(t *testing.T) {
 	mocks.expectWriteTaskLogTimestamped(t, task2, "task changed status soft-failed -> completed")
 	mocks.expectBroadcastTaskChange(task2, api.TaskStatusSoftFailed, api.TaskStatusCompleted)
 	mocks.persist.EXPECT().CountTasksOfJobInStatus(ctx, task.Job, api.TaskStatusCompleted).Return(2, 3, nil) // 2 of 3 complete.
+	mocks.expectBroadcastJobProgress(task.Job, 66)
 	assert.NoError(t, sm.TaskStatusChange(ctx, task2, api.TaskStatusCompleted))
 
 	// Third task completing: T: active > completed --> J: active > completed
@@ -97,6 +161,7 @@ func TestTaskStatusChangeActiveToCompleted(t *testing.T) {
 	mocks.persist.EXPECT().CountTasksOfJobInStatus(ctx, task.Job, api.TaskStatusCompleted).Return(3, 3, nil) // 3 of 3 complete.
 	mocks.expectSaveJobWithStatus(t, task.Job, api.JobStatusCompleted)
 	mocks.expectBroadcastJobChange(task.Job, api.JobStatusActive, api.JobStatusCompleted)
+	mocks.expectFetchJobsDependingOn(task.Job)
 
 	assert.NoError(t, sm.TaskStatusChange(ctx, task3, api.TaskStatusCompleted))
 }
@@ -130,6 +195,7 @@ func TestTaskStatusChangeActiveToFailedFailJob(t *testing.T) {
 	mocks.expectSaveJobWithStatus(t, task1.Job, api.JobStatusFailed)
 	// The resulting cancellation of the other tasks should be communicated as mass-task-update in the job update broadcast.
 	mocks.expectBroadcastJobChangeWithTaskRefresh(task1.Job, api.JobStatusActive, api.JobStatusFailed)
+	mocks.expectFetchJobsDependingOn(task1.Job)
 
 	mocks.persist.EXPECT().CountTasksOfJobInStatus(ctx, task1.Job, api.TaskStatusFailed).Return(10, 100, nil) // 10 out of 100 failed.
 
@@ -186,6 +252,7 @@ func TestTaskStatusChangeCancelSingleTask(t *testing.T) {
 	mocks.persist.EXPECT().CountTasksOfJobInStatus(ctx, job,
 		api.TaskStatusActive, api.TaskStatusQueued, api.TaskStatusSoftFailed).
 		Return(1, 2, nil)
+	mocks.expectBroadcastJobProgress(job, 0)
 	assert.NoError(t, sm.TaskStatusChange(ctx, task, api.TaskStatusCanceled))
 
 	// T2: queued > cancelled --> J: cancel-requested > canceled
@@ -197,6 +264,7 @@ func TestTaskStatusChangeCancelSingleTask(t *testing.T) {
 		Return(0, 2, nil)
 	mocks.expectSaveJobWithStatus(t, job, api.JobStatusCanceled)
 	mocks.expectBroadcastJobChange(task.Job, api.JobStatusCancelRequested, api.JobStatusCanceled)
+	mocks.expectFetchJobsDependingOn(task.Job)
 
 	assert.NoError(t, sm.TaskStatusChange(ctx, task2, api.TaskStatusCanceled))
 }
@@ -219,6 +287,7 @@ func TestTaskStatusChangeCancelSingleTaskWithOtherFailed(t *testing.T) {
 		Return(0, 3, nil)
 	mocks.expectSaveJobWithStatus(t, job, api.JobStatusCanceled)
 	mocks.expectBroadcastJobChange(task1.Job, api.JobStatusCancelRequested, api.JobStatusCanceled)
+	mocks.expectFetchJobsDependingOn(task1.Job)
 
 	// The paused task just stays paused, so don't expectBroadcastTaskChange(task3).
 
@@ -234,6 +303,7 @@ func TestTaskStatusChangeUnknownStatus(t *testing.T) {
 	mocks.expectSaveTaskWithStatus(t, task, api.TaskStatus("borked"))
 	mocks.expectWriteTaskLogTimestamped(t, task, "task changed status queued -> borked")
 	mocks.expectBroadcastTaskChange(task, api.TaskStatusQueued, api.TaskStatus("borked"))
+	mocks.expectBroadcastJobProgress(task.Job, 0)
 
 	assert.NoError(t, sm.TaskStatusChange(ctx, task, api.TaskStatus("borked")))
 }
@@ -331,10 +401,108 @@ func TestJobCancelWithSomeCompletedTasks(t *testing.T) {
 
 	mocks.expectBroadcastJobChangeWithTaskRefresh(job, api.JobStatusActive, api.JobStatusCancelRequested)
 	mocks.expectBroadcastJobChange(job, api.JobStatusCancelRequested, api.JobStatusCanceled)
+	mocks.expectFetchJobsDependingOn(job)
 
 	assert.NoError(t, sm.JobStatusChange(ctx, job, api.JobStatusCancelRequested, "someone wrote a unittest"))
 }
 
+func TestJobWaitingForDependencies(t *testing.T) {
+	mockCtrl, ctx, sm, mocks := taskStateMachineTestFixtures(t)
+	defer mockCtrl.Finish()
+
+	task := taskWithStatus(api.JobStatusUnderConstruction, api.TaskStatusQueued)
+	job := task.Job
+	upstream := &persistence.Job{UUID: "9a39b5f6-a2b0-4ed7-9f9b-81a7a5fc2d8b", Status: api.JobStatusActive}
+
+	// J: under-construction > waiting, as the upstream job is still running.
+	mocks.persist.EXPECT().FetchJobDependencies(ctx, job).Return([]*persistence.Job{upstream}, nil)
+	mocks.expectSaveJobWithStatus(t, job, api.JobStatusWaiting)
+	mocks.expectBroadcastJobChange(job, api.JobStatusUnderConstruction, api.JobStatusWaiting)
+
+	assert.NoError(t, sm.JobStatusChange(ctx, job, api.JobStatusWaiting, "waiting for other jobs"))
+	assert.Equal(t, api.JobStatusWaiting, job.Status)
+}
+
+func TestJobDependencyCompleted(t *testing.T) {
+	mockCtrl, ctx, sm, mocks := taskStateMachineTestFixtures(t)
+	defer mockCtrl.Finish()
+
+	task := taskWithStatus(api.JobStatusActive, api.TaskStatusActive)
+	upstream := task.Job
+	dependent := &persistence.Job{UUID: "9a39b5f6-a2b0-4ed7-9f9b-81a7a5fc2d8b", Status: api.JobStatusWaiting}
+
+	// T: active > completed --> J: active > completed --> dependent: waiting > queued
+	mocks.expectSaveTaskWithStatus(t, task, api.TaskStatusCompleted)
+	mocks.expectWriteTaskLogTimestamped(t, task, "task changed status active -> completed")
+	mocks.expectBroadcastTaskChange(task, api.TaskStatusActive, api.TaskStatusCompleted)
+	mocks.persist.EXPECT().CountTasksOfJobInStatus(ctx, upstream, api.TaskStatusCompleted).Return(1, 1, nil)
+	mocks.expectSaveJobWithStatus(t, upstream, api.JobStatusCompleted)
+	mocks.expectBroadcastJobChange(upstream, api.JobStatusActive, api.JobStatusCompleted)
+	mocks.expectFetchJobsDependingOn(upstream, dependent)
+
+	mocks.persist.EXPECT().FetchJobDependencies(ctx, dependent).Return([]*persistence.Job{upstream}, nil)
+	mocks.expectSaveJobWithStatus(t, dependent, api.JobStatusQueued)
+	mocks.persist.EXPECT().CountTasksOfJobInStatus(ctx, dependent, api.TaskStatusCompleted).Return(0, 3, nil)
+	mocks.expectBroadcastJobChangeWithTaskRefresh(dependent, api.JobStatusWaiting, api.JobStatusQueued)
+
+	assert.NoError(t, sm.TaskStatusChange(ctx, task, api.TaskStatusCompleted))
+	assert.Equal(t, api.JobStatusQueued, dependent.Status)
+}
+
+func TestJobDependencyCanceled(t *testing.T) {
+	mockCtrl, ctx, sm, mocks := taskStateMachineTestFixtures(t)
+	defer mockCtrl.Finish()
+
+	upstream := taskWithStatus(api.JobStatusCancelRequested, api.TaskStatusCanceled).Job
+	dependent := &persistence.Job{UUID: "9a39b5f6-a2b0-4ed7-9f9b-81a7a5fc2d8b", Status: api.JobStatusWaiting}
+
+	// J: cancel-requested > canceled --> dependent: waiting > failed
+	mocks.expectSaveJobWithStatus(t, upstream, api.JobStatusCanceled)
+	mocks.expectBroadcastJobChange(upstream, api.JobStatusCancelRequested, api.JobStatusCanceled)
+	mocks.expectFetchJobsDependingOn(upstream, dependent)
+
+	mocks.persist.EXPECT().FetchJobDependencies(ctx, dependent).Return([]*persistence.Job{upstream}, nil)
+	mocks.expectSaveJobWithStatus(t, dependent, api.JobStatusFailed)
+	mocks.persist.EXPECT().UpdateJobsTaskStatusesConditional(ctx, dependent,
+		[]api.TaskStatus{
+			api.TaskStatusActive,
+			api.TaskStatusQueued,
+			api.TaskStatusSoftFailed,
+		},
+		api.TaskStatusCanceled,
+		"Manager cancelled this task because the job got status \"failed\".",
+	)
+	mocks.expectBroadcastJobChangeWithTaskRefresh(dependent, api.JobStatusWaiting, api.JobStatusFailed)
+	mocks.expectFetchJobsDependingOn(dependent)
+
+	assert.NoError(t, sm.JobStatusChange(ctx, upstream, api.JobStatusCanceled, "someone wrote a unittest"))
+	assert.Equal(t, api.JobStatusFailed, dependent.Status)
+}
+
+func TestCheckJobDependencies(t *testing.T) {
+	mockCtrl, ctx, sm, mocks := taskStateMachineTestFixtures(t)
+	defer mockCtrl.Finish()
+
+	job := &persistence.Job{UUID: "9a39b5f6-a2b0-4ed7-9f9b-81a7a5fc2d8b", Status: api.JobStatusWaiting}
+
+	// Still waiting for another upstream job.
+	upstream := &persistence.Job{UUID: "2d5b9e3c-6f7e-4a43-9c5b-0a7c2f1b6d4e", Status: api.JobStatusActive}
+	mocks.persist.EXPECT().FetchJobDependencies(ctx, job).Return([]*persistence.Job{upstream}, nil)
+	assert.NoError(t, sm.CheckJobDependencies(ctx, job, "upstream job was deleted"))
+	assert.Equal(t, api.JobStatusWaiting, job.Status)
+
+	// The only upstream job was deleted, so this job can be queued.
+	mocks.persist.EXPECT().FetchJobDependencies(ctx, job).Return([]*persistence.Job{}, nil)
+	mocks.expectSaveJobWithStatus(t, job, api.JobStatusQueued)
+	mocks.persist.EXPECT().CountTasksOfJobInStatus(ctx, job, api.TaskStatusCompleted).Return(0, 3, nil)
+	mocks.expectBroadcastJobChangeWithTaskRefresh(job, api.JobStatusWaiting, api.JobStatusQueued)
+	assert.NoError(t, sm.CheckJobDependencies(ctx, job, "upstream job was deleted"))
+	assert.Equal(t, api.JobStatusQueued, job.Status)
+
+	// Jobs that are no longer waiting should be left alone.
+	assert.NoError(t, sm.CheckJobDependencies(ctx, job, "upstream job was deleted"))
+}
+
 func TestCheckStuck(t *testing.T) {
 	mockCtrl, ctx, sm, mocks := taskStateMachineTestFixtures(t)
 	defer mockCtrl.Finish()
@@ -345,7 +513,7 @@ func TestCheckStuck(t *testing.T) {
 	job := task1.Job
 	job.Status = api.JobStatusRequeueing
 
-	mocks.persist.EXPECT().FetchJobsInStatus(ctx, api.JobStatusCancelRequested, api.JobStatusRequeueing).
+	mocks.persist.EXPECT().FetchJobsInStatus(ctx, api.JobStatusCancelRequested, api.JobStatusRequeueing, api.JobStatusWaiting).
 		Return([]*persistence.Job{job}, nil)
 	mocks.persist.EXPECT().CountTasksOfJobInStatus(ctx, job, api.TaskStatusCompleted).Return(1, 3, nil)
 
@@ -372,11 +540,15 @@ func TestCheckStuck(t *testing.T) {
 
 func mockedTaskStateMachine(mockCtrl *gomock.Controller) (*StateMachine, *StateMachineMocks) {
 	mocks := StateMachineMocks{
+		clock:       clock.NewMock(),
 		persist:     mocks.NewMockPersistenceService(mockCtrl),
 		broadcaster: mocks.NewMockChangeBroadcaster(mockCtrl),
 		logStorage:  mocks.NewMockLogStorage(mockCtrl),
 	}
-	sm := NewStateMachine(mocks.persist, mocks.broadcaster, mocks.logStorage)
+	// Retry soft-failed tasks immediately, so that only the tests for the
+	// backoff have to deal with it.
+	retryBackoff := config.TaskRetryBackoff{}
+	sm := NewStateMachine(mocks.clock, mocks.persist, mocks.broadcaster, mocks.logStorage, retryBackoff)
 	return sm, &mocks
 }
 
@@ -415,6 +587,17 @@ func (m *StateMachineMocks) expectSaveJobWithStatus(
 		})
 }
 
+// expectFetchJobsDependingOn expects a query for the jobs waiting for the given
+// job, returning the given dependent jobs.
+func (m *StateMachineMocks) expectFetchJobsDependingOn(
+	job *persistence.Job,
+	dependents ...*persistence.Job,
+) *gomock.Call {
+	return m.persist.EXPECT().
+		FetchJobsDependingOn(gomock.Any(), job, api.JobStatusWaiting).
+		Return(dependents, nil)
+}
+
 func (m *StateMachineMocks) expectBroadcastJobChange(
 	job *persistence.Job,
 	fromStatus, toStatus api.JobStatus,
@@ -426,6 +609,7 @@ func (m *StateMachineMocks) expectBroadcastJobChange(
 		RefreshTasks:   false,
 		Status:         toStatus,
 		Updated:        job.UpdatedAt,
+		Progress:       m.expectFetchJobProgress(job, 0),
 	}
 	return m.broadcaster.EXPECT().BroadcastJobUpdate(expectUpdate)
 }
@@ -441,14 +625,46 @@ func (m *StateMachineMocks) expectBroadcastJobChangeWithTaskRefresh(
 		RefreshTasks:   true,
 		Status:         toStatus,
 		Updated:        job.UpdatedAt,
+		Progress:       m.expectFetchJobProgress(job, 0),
 	}
 	return m.broadcaster.EXPECT().BroadcastJobUpdate(expectUpdate)
 }
 
+// expectBroadcastJobProgress expects a job update for a task status change
+// that did not change the job's status.
+func (m *StateMachineMocks) expectBroadcastJobProgress(
+	job *persistence.Job,
+	progress int,
+) *gomock.Call {
+	expectUpdate := api.SocketIOJobUpdate{
+		Id:       job.UUID,
+		Name:     &job.Name,
+		Status:   job.Status,
+		Updated:  job.UpdatedAt,
+		Progress: m.expectFetchJobProgress(job, progress),
+	}
+	return m.broadcaster.EXPECT().BroadcastJobUpdate(expectUpdate)
+}
+
+func (m *StateMachineMocks) expectFetchJobProgress(job *persistence.Job, progress int) *int {
+	m.persist.EXPECT().
+		FetchJobsProgress(gomock.Any(), []*persistence.Job{job}).
+		Return(map[uint]int{job.ID: progress}, nil)
+	return &progress
+}
+
 func (m *StateMachineMocks) expectBroadcastTaskChange(
 	task *persistence.Task,
 	fromStatus, toStatus api.TaskStatus,
 ) *gomock.Call {
+	expectProgress := task.Progress
+	switch toStatus {
+	case api.TaskStatusCompleted:
+		expectProgress = 100
+	case api.TaskStatusQueued, api.TaskStatusActive:
+		expectProgress = 0
+	}
+
 	expectUpdate := api.SocketIOTaskUpdate{
 		Id:             task.UUID,
 		JobId:          task.Job.UUID,
@@ -456,6 +672,7 @@ func (m *StateMachineMocks) expectBroadcastTaskChange(
 		Updated:        task.UpdatedAt,
 		PreviousStatus: &fromStatus,
 		Status:         toStatus,
+		Progress:       &expectProgress,
 	}
 	return m.broadcaster.EXPECT().BroadcastTaskUpdate(expectUpdate)
 }
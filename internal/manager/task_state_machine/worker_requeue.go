@@ -10,7 +10,8 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-// RequeueActiveTasksOfWorker re-queues all active tasks (should be max one) of this worker.
+// RequeueActiveTasksOfWorker re-queues all active tasks of this worker. There
+// can be more than one when the worker has task slots.
 //
 // `reason`: a string that can be appended to text like "Task requeued because "
 func (sm *StateMachine) RequeueActiveTasksOfWorker(
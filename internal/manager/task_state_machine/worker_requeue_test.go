@@ -45,6 +45,8 @@ func TestRequeueActiveTasksOfWorker(t *testing.T) {
 	mocks.logStorage.EXPECT().WriteTimestamped(gomock.Any(), task1.Job.UUID, task1.UUID, logMsg2)
 	mocks.logStorage.EXPECT().WriteTimestamped(gomock.Any(), task2.Job.UUID, task2.UUID, logMsg2)
 
+	// Requeued tasks start over, so their progress is reset.
+	zeroProgress := 0
 	mocks.broadcaster.EXPECT().BroadcastTaskUpdate(api.SocketIOTaskUpdate{
 		Activity:       logMsg2,
 		Id:             task1.UUID,
@@ -53,6 +55,7 @@ func TestRequeueActiveTasksOfWorker(t *testing.T) {
 		PreviousStatus: &task1PrevStatus,
 		Status:         api.TaskStatusQueued,
 		Updated:        task1.UpdatedAt,
+		Progress:       &zeroProgress,
 	})
 
 	mocks.broadcaster.EXPECT().BroadcastTaskUpdate(api.SocketIOTaskUpdate{
@@ -63,8 +66,13 @@ func TestRequeueActiveTasksOfWorker(t *testing.T) {
 		PreviousStatus: &task2PrevStatus,
 		Status:         api.TaskStatusQueued,
 		Updated:        task2.UpdatedAt,
+		Progress:       &zeroProgress,
 	})
 
+	// Requeueing the tasks resets the job's progress.
+	mocks.expectBroadcastJobProgress(task1.Job, 0)
+	mocks.expectBroadcastJobProgress(task1.Job, 0)
+
 	err := sm.RequeueActiveTasksOfWorker(ctx, &worker, "worker had to test")
 	assert.NoError(t, err)
 }
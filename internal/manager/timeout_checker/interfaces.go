@@ -17,7 +17,7 @@ import (
 //go:generate go run github.com/golang/mock/mockgen -destination mocks/interfaces_mock.gen.go -package mocks git.blender.org/flamenco/internal/manager/timeout_checker PersistenceService,TaskStateMachine,LogStorage,ChangeBroadcaster
 
 type PersistenceService interface {
-	FetchTimedOutTasks(ctx context.Context, untouchedSince time.Time) ([]*persistence.Task, error)
+	FetchTimedOutTasks(ctx context.Context, now time.Time, defaultTimeout time.Duration) ([]*persistence.Task, error)
 	FetchTimedOutWorkers(ctx context.Context, lastSeenBefore time.Time) ([]*persistence.Worker, error)
 	SaveWorker(ctx context.Context, w *persistence.Worker) error
 }
@@ -39,18 +39,18 @@ func (m *MockPersistenceService) EXPECT() *MockPersistenceServiceMockRecorder {
 }
 
 // FetchTimedOutTasks mocks base method.
-func (m *MockPersistenceService) FetchTimedOutTasks(arg0 context.Context, arg1 time.Time) ([]*persistence.Task, error) {
+func (m *MockPersistenceService) FetchTimedOutTasks(arg0 context.Context, arg1 time.Time, arg2 time.Duration) ([]*persistence.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchTimedOutTasks", arg0, arg1)
+	ret := m.ctrl.Call(m, "FetchTimedOutTasks", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]*persistence.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FetchTimedOutTasks indicates an expected call of FetchTimedOutTasks.
-func (mr *MockPersistenceServiceMockRecorder) FetchTimedOutTasks(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockPersistenceServiceMockRecorder) FetchTimedOutTasks(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTimedOutTasks", reflect.TypeOf((*MockPersistenceService)(nil).FetchTimedOutTasks), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTimedOutTasks", reflect.TypeOf((*MockPersistenceService)(nil).FetchTimedOutTasks), arg0, arg1, arg2)
 }
 
 // FetchTimedOutWorkers mocks base method.
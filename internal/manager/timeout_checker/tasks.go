@@ -15,13 +15,13 @@ import (
 )
 
 func (ttc *TimeoutChecker) checkTasks(ctx context.Context) {
-	timeoutThreshold := ttc.clock.Now().UTC().Add(-ttc.taskTimeout)
+	now := ttc.clock.Now().UTC()
 	logger := log.With().
-		Time("threshold", timeoutThreshold.Local()).
+		Str("defaultTimeout", ttc.taskTimeout.String()).
 		Logger()
-	logger.Trace().Msg("TimeoutChecker: finding active tasks that have not been touched since threshold")
+	logger.Trace().Msg("TimeoutChecker: finding active tasks that have not been touched for longer than their timeout")
 
-	tasks, err := ttc.persist.FetchTimedOutTasks(ctx, timeoutThreshold)
+	tasks, err := ttc.persist.FetchTimedOutTasks(ctx, now, ttc.taskTimeout)
 	if err != nil {
 		log.Error().Err(err).Msg("TimeoutChecker: error fetching timed-out tasks from database")
 		return
@@ -33,7 +33,7 @@ func (ttc *TimeoutChecker) checkTasks(ctx context.Context) {
 	}
 	logger.Debug().
 		Int("numTasks", len(tasks)).
-		Msg("TimeoutChecker: failing all active tasks that have not been touched since threshold")
+		Msg("TimeoutChecker: failing all active tasks that have not been touched for longer than their timeout")
 
 	for _, task := range tasks {
 		ttc.timeoutTask(ctx, task)
@@ -23,12 +23,12 @@ func TestTimeoutCheckerTiming(t *testing.T) {
 	ttc, finish, mocks := timeoutCheckerTestFixtures(t)
 	defer finish()
 
-	// Determine the deadlines relative to the initial clock value.
+	// Determine the check times relative to the initial clock value.
 	initialTime := mocks.clock.Now().UTC()
-	deadlines := []time.Time{
-		initialTime.Add(-taskTimeout + timeoutInitialSleep + 0*timeoutCheckInterval),
-		initialTime.Add(-taskTimeout + timeoutInitialSleep + 1*timeoutCheckInterval),
-		initialTime.Add(-taskTimeout + timeoutInitialSleep + 2*timeoutCheckInterval),
+	checkTimes := []time.Time{
+		initialTime.Add(timeoutInitialSleep + 0*timeoutCheckInterval),
+		initialTime.Add(timeoutInitialSleep + 1*timeoutCheckInterval),
+		initialTime.Add(timeoutInitialSleep + 2*timeoutCheckInterval),
 	}
 
 	mocks.run(ttc)
@@ -42,22 +42,22 @@ func TestTimeoutCheckerTiming(t *testing.T) {
 
 	// Expect three fetches, one after the initial sleep time, and two a regular interval later.
 	fetchTimes := make([]time.Time, 0)
-	firstCall := mocks.persist.EXPECT().FetchTimedOutTasks(mocks.ctx, deadlines[0]).
-		DoAndReturn(func(ctx context.Context, deadline time.Time) ([]*persistence.Task, error) {
+	firstCall := mocks.persist.EXPECT().FetchTimedOutTasks(mocks.ctx, checkTimes[0], taskTimeout).
+		DoAndReturn(func(ctx context.Context, now time.Time, defaultTimeout time.Duration) ([]*persistence.Task, error) {
 			fetchTimes = append(fetchTimes, mocks.clock.Now().UTC())
 			return []*persistence.Task{}, nil
 		})
 
-	secondCall := mocks.persist.EXPECT().FetchTimedOutTasks(mocks.ctx, deadlines[1]).
-		DoAndReturn(func(ctx context.Context, deadline time.Time) ([]*persistence.Task, error) {
+	secondCall := mocks.persist.EXPECT().FetchTimedOutTasks(mocks.ctx, checkTimes[1], taskTimeout).
+		DoAndReturn(func(ctx context.Context, now time.Time, defaultTimeout time.Duration) ([]*persistence.Task, error) {
 			fetchTimes = append(fetchTimes, mocks.clock.Now().UTC())
 			// Return a database error. This shouldn't break the check loop.
 			return []*persistence.Task{}, errors.New("testing what errors do")
 		}).
 		After(firstCall)
 
-	thirdCall := mocks.persist.EXPECT().FetchTimedOutTasks(mocks.ctx, deadlines[2]).
-		DoAndReturn(func(ctx context.Context, deadline time.Time) ([]*persistence.Task, error) {
+	thirdCall := mocks.persist.EXPECT().FetchTimedOutTasks(mocks.ctx, checkTimes[2], taskTimeout).
+		DoAndReturn(func(ctx context.Context, now time.Time, defaultTimeout time.Duration) ([]*persistence.Task, error) {
 			fetchTimes = append(fetchTimes, mocks.clock.Now().UTC())
 			return []*persistence.Task{}, nil
 		}).
@@ -67,10 +67,10 @@ func TestTimeoutCheckerTiming(t *testing.T) {
 	// more sensible error messages than the mocking framework would give (which
 	// would just abort the test saying the call doesn't match the above three
 	// expectations).
-	mocks.persist.EXPECT().FetchTimedOutTasks(mocks.ctx, gomock.Any()).
-		DoAndReturn(func(ctx context.Context, deadline time.Time) ([]*persistence.Task, error) {
+	mocks.persist.EXPECT().FetchTimedOutTasks(mocks.ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, now time.Time, defaultTimeout time.Duration) ([]*persistence.Task, error) {
 			fetchTimes = append(fetchTimes, mocks.clock.Now().UTC())
-			assert.Failf(t, "extra call to FetchTimedOutTasks", "deadline=%s", deadline.String())
+			assert.Failf(t, "extra call to FetchTimedOutTasks", "now=%s", now.String())
 			return []*persistence.Task{}, nil
 		}).
 		After(thirdCall).
@@ -136,7 +136,7 @@ func TestTaskTimeout(t *testing.T) {
 
 	mocks.persist.EXPECT().FetchTimedOutWorkers(mocks.ctx, gomock.Any()).AnyTimes().Return(nil, nil)
 
-	mocks.persist.EXPECT().FetchTimedOutTasks(mocks.ctx, gomock.Any()).
+	mocks.persist.EXPECT().FetchTimedOutTasks(mocks.ctx, gomock.Any(), taskTimeout).
 		Return([]*persistence.Task{&taskUnassigned, &taskUnknownWorker, &taskAssigned}, nil)
 
 	mocks.taskStateMachine.EXPECT().TaskStatusChange(mocks.ctx, &taskUnassigned, api.TaskStatusFailed)
@@ -0,0 +1,27 @@
+package webhooks
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"time"
+
+	"git.blender.org/flamenco/internal/manager/persistence"
+	"git.blender.org/flamenco/internal/manager/webupdates"
+)
+
+// Generate mock implementations of these interfaces.
+//go:generate go run github.com/golang/mock/mockgen -destination mocks/interfaces_mock.gen.go -package mocks git.blender.org/flamenco/internal/manager/webhooks PersistenceService
+
+type PersistenceService interface {
+	QueueWebhookDelivery(ctx context.Context, delivery *persistence.WebhookDelivery) error
+	FetchWebhookDeliveriesDue(ctx context.Context, now time.Time, limit int) ([]*persistence.WebhookDelivery, error)
+	SaveWebhookDelivery(ctx context.Context, delivery *persistence.WebhookDelivery) error
+	DeleteWebhookDelivery(ctx context.Context, delivery *persistence.WebhookDelivery) error
+}
+
+// PersistenceService should be a subset of persistence.DB
+var _ PersistenceService = (*persistence.DB)(nil)
+
+// Service should be usable as listener for web updates.
+var _ webupdates.UpdateListener = (*Service)(nil)
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: git.blender.org/flamenco/internal/manager/webhooks (interfaces: PersistenceService)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	persistence "git.blender.org/flamenco/internal/manager/persistence"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPersistenceService is a mock of PersistenceService interface.
+type MockPersistenceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPersistenceServiceMockRecorder
+}
+
+// MockPersistenceServiceMockRecorder is the mock recorder for MockPersistenceService.
+type MockPersistenceServiceMockRecorder struct {
+	mock *MockPersistenceService
+}
+
+// NewMockPersistenceService creates a new mock instance.
+func NewMockPersistenceService(ctrl *gomock.Controller) *MockPersistenceService {
+	mock := &MockPersistenceService{ctrl: ctrl}
+	mock.recorder = &MockPersistenceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPersistenceService) EXPECT() *MockPersistenceServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteWebhookDelivery mocks base method.
+func (m *MockPersistenceService) DeleteWebhookDelivery(arg0 context.Context, arg1 *persistence.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookDelivery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhookDelivery indicates an expected call of DeleteWebhookDelivery.
+func (mr *MockPersistenceServiceMockRecorder) DeleteWebhookDelivery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookDelivery", reflect.TypeOf((*MockPersistenceService)(nil).DeleteWebhookDelivery), arg0, arg1)
+}
+
+// FetchWebhookDeliveriesDue mocks base method.
+func (m *MockPersistenceService) FetchWebhookDeliveriesDue(arg0 context.Context, arg1 time.Time, arg2 int) ([]*persistence.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchWebhookDeliveriesDue", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*persistence.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchWebhookDeliveriesDue indicates an expected call of FetchWebhookDeliveriesDue.
+func (mr *MockPersistenceServiceMockRecorder) FetchWebhookDeliveriesDue(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchWebhookDeliveriesDue", reflect.TypeOf((*MockPersistenceService)(nil).FetchWebhookDeliveriesDue), arg0, arg1, arg2)
+}
+
+// QueueWebhookDelivery mocks base method.
+func (m *MockPersistenceService) QueueWebhookDelivery(arg0 context.Context, arg1 *persistence.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueWebhookDelivery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QueueWebhookDelivery indicates an expected call of QueueWebhookDelivery.
+func (mr *MockPersistenceServiceMockRecorder) QueueWebhookDelivery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueWebhookDelivery", reflect.TypeOf((*MockPersistenceService)(nil).QueueWebhookDelivery), arg0, arg1)
+}
+
+// SaveWebhookDelivery mocks base method.
+func (m *MockPersistenceService) SaveWebhookDelivery(arg0 context.Context, arg1 *persistence.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveWebhookDelivery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveWebhookDelivery indicates an expected call of SaveWebhookDelivery.
+func (mr *MockPersistenceServiceMockRecorder) SaveWebhookDelivery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWebhookDelivery", reflect.TypeOf((*MockPersistenceService)(nil).SaveWebhookDelivery), arg0, arg1)
+}
@@ -0,0 +1,308 @@
+// Package webhooks sends job and worker status changes to external HTTP
+// endpoints.
+//
+// Notifications are first queued in memory, so that the job and worker update
+// listeners return quickly. The delivery loop then stores them in an outbox in
+// the database, and removes them from there once they have been delivered. Failed deliveries are retried
+// with an exponential backoff. Because of the outbox, notifications that
+// haven't been delivered yet survive a restart of the Manager.
+package webhooks
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/rs/zerolog/log"
+
+	"git.blender.org/flamenco/internal/manager/config"
+	"git.blender.org/flamenco/internal/manager/persistence"
+	"git.blender.org/flamenco/pkg/api"
+)
+
+const (
+	// EventJobUpdate and EventWorkerUpdate are sent in the X-Flamenco-Event
+	// header, to indicate the type of payload.
+	EventJobUpdate    = "job-update"
+	EventWorkerUpdate = "worker-update"
+
+	// eventHeader is the HTTP header that contains the event type.
+	eventHeader = "X-Flamenco-Event"
+
+	// maxDeliveryAttempts determines how often a delivery is attempted before it
+	// is dropped from the outbox.
+	maxDeliveryAttempts = 10
+
+	// After a failed delivery, the next attempt is postponed. This delay doubles
+	// with each failure, up to retryBackoffMax.
+	retryBackoffBase = 10 * time.Second
+	retryBackoffMax  = 1 * time.Hour
+
+	// periodicCheckInterval determines how often the outbox is checked for
+	// deliveries that should be retried.
+	periodicCheckInterval = 10 * time.Second
+
+	// deliveryBatchSize is the maximum number of deliveries handled in one go.
+	deliveryBatchSize = 100
+
+	// queueSize determines how many notifications can be queued in memory,
+	// before they are stored in the outbox. Notifications that do not fit are
+	// dropped.
+	queueSize = 100
+
+	httpTimeout  = 10 * time.Second
+	queueTimeout = 5 * time.Second
+)
+
+// Service queues and delivers webhook notifications.
+type Service struct {
+	clock      clock.Clock
+	persist    PersistenceService
+	hooks      []config.Webhook
+	httpClient *http.Client
+
+	// pending contains the deliveries that still have to be stored in the outbox.
+	pending chan persistence.WebhookDelivery
+}
+
+// New creates a new webhook service. It only sends notifications for the given
+// hooks; when there are none, it does nothing.
+func New(clock clock.Clock, persist PersistenceService, hooks []config.Webhook) *Service {
+	return &Service{
+		clock:      clock,
+		persist:    persist,
+		hooks:      hooks,
+		httpClient: &http.Client{Timeout: httpTimeout},
+		pending:    make(chan persistence.WebhookDelivery, queueSize),
+	}
+}
+
+// JobUpdate queues notifications for the job update, if it represents a status
+// change that one of the webhooks is interested in.
+func (s *Service) JobUpdate(jobUpdate api.SocketIOJobUpdate) {
+	if jobUpdate.PreviousStatus == nil || *jobUpdate.PreviousStatus == jobUpdate.Status {
+		return
+	}
+	status := string(jobUpdate.Status)
+	s.queue(EventJobUpdate, jobUpdate, func(hook config.Webhook) []string { return hook.JobStatuses }, status)
+}
+
+// WorkerUpdate queues notifications for the worker update, if it represents a
+// status change that one of the webhooks is interested in.
+func (s *Service) WorkerUpdate(workerUpdate api.SocketIOWorkerUpdate) {
+	if workerUpdate.PreviousStatus == nil || *workerUpdate.PreviousStatus == workerUpdate.Status {
+		return
+	}
+	status := string(workerUpdate.Status)
+	s.queue(EventWorkerUpdate, workerUpdate, func(hook config.Webhook) []string { return hook.WorkerStatuses }, status)
+}
+
+// queue queues a delivery for each hook that is interested in the given
+// status. It does not wait for the database, as it is called from the job and
+// worker update listeners, which should return quickly.
+func (s *Service) queue(event string, update interface{}, hookStatuses func(config.Webhook) []string, status string) {
+	logger := log.With().Str("event", event).Str("status", status).Logger()
+
+	var payload []byte
+	for _, hook := range s.hooks {
+		if !contains(hookStatuses(hook), status) {
+			continue
+		}
+
+		if payload == nil {
+			var err error
+			payload, err = json.Marshal(update)
+			if err != nil {
+				logger.Error().Err(err).Msg("webhooks: unable to convert update to JSON")
+				return
+			}
+		}
+
+		delivery := persistence.WebhookDelivery{
+			URL:           hook.URL,
+			Event:         event,
+			Payload:       payload,
+			NextAttemptAt: s.clock.Now().UTC(),
+		}
+
+		select {
+		case s.pending <- delivery:
+		default:
+			logger.Error().Str("url", hook.URL).Msg("webhooks: queue is full, dropping notification")
+		}
+	}
+}
+
+// storeQueued stores the given delivery in the outbox, together with any other
+// deliveries that are waiting in the queue.
+func (s *Service) storeQueued(delivery persistence.WebhookDelivery) {
+	for {
+		s.store(delivery)
+
+		select {
+		case delivery = <-s.pending:
+		default:
+			return
+		}
+	}
+}
+
+// store stores a single delivery in the outbox.
+func (s *Service) store(delivery persistence.WebhookDelivery) {
+	// Use a background context, so that queued notifications are still stored
+	// when the Manager shuts down. They are then delivered after a restart.
+	ctx, cancel := context.WithTimeout(context.Background(), queueTimeout)
+	defer cancel()
+
+	if err := s.persist.QueueWebhookDelivery(ctx, &delivery); err != nil {
+		log.Error().Err(err).
+			Str("event", delivery.Event).
+			Str("url", delivery.URL).
+			Msg("webhooks: unable to store notification in the outbox")
+	}
+}
+
+// Run delivers queued notifications. It keeps running until the context closes.
+func (s *Service) Run(ctx context.Context) {
+	if len(s.hooks) == 0 {
+		log.Debug().Msg("webhooks: no webhooks configured")
+	} else {
+		log.Info().Int("numWebhooks", len(s.hooks)).Msg("webhooks: starting up")
+	}
+	defer log.Debug().Msg("webhooks: shutting down")
+
+	// Deliver anything that was left in the outbox from a previous run.
+	s.deliverDue(ctx)
+
+	ticker := s.clock.Ticker(periodicCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Don't lose the notifications that haven't been stored yet.
+			select {
+			case delivery := <-s.pending:
+				s.storeQueued(delivery)
+			default:
+			}
+			return
+		case delivery := <-s.pending:
+			s.storeQueued(delivery)
+			s.deliverDue(ctx)
+		case <-ticker.C:
+			s.deliverDue(ctx)
+		}
+	}
+}
+
+// deliverDue attempts to deliver all notifications that are due.
+func (s *Service) deliverDue(ctx context.Context) {
+	for {
+		deliveries, err := s.persist.FetchWebhookDeliveriesDue(ctx, s.clock.Now(), deliveryBatchSize)
+		if err != nil {
+			log.Error().Err(err).Msg("webhooks: unable to fetch queued notifications")
+			return
+		}
+
+		for _, delivery := range deliveries {
+			if ctx.Err() != nil {
+				return
+			}
+			s.deliver(ctx, delivery)
+		}
+
+		if len(deliveries) < deliveryBatchSize {
+			return
+		}
+	}
+}
+
+// deliver sends the notification to its webhook. It is removed from the outbox
+// when successful, or postponed when not.
+func (s *Service) deliver(ctx context.Context, delivery *persistence.WebhookDelivery) {
+	logger := log.With().
+		Str("url", delivery.URL).
+		Str("event", delivery.Event).
+		Int("attempt", delivery.Attempts+1).
+		Logger()
+
+	err := s.post(ctx, delivery)
+	if err == nil {
+		logger.Debug().Msg("webhooks: notification delivered")
+		if err := s.persist.DeleteWebhookDelivery(ctx, delivery); err != nil {
+			logger.Error().Err(err).Msg("webhooks: unable to remove delivered notification from the outbox")
+		}
+		return
+	}
+	if ctx.Err() != nil {
+		// Shutting down; don't count this as a failed attempt.
+		return
+	}
+
+	delivery.Attempts++
+	if delivery.Attempts >= maxDeliveryAttempts {
+		logger.Error().Err(err).Msg("webhooks: unable to deliver notification, giving up")
+		if err := s.persist.DeleteWebhookDelivery(ctx, delivery); err != nil {
+			logger.Error().Err(err).Msg("webhooks: unable to remove notification from the outbox")
+		}
+		return
+	}
+
+	delivery.NextAttemptAt = s.clock.Now().UTC().Add(retryBackoff(delivery.Attempts))
+	logger.Warn().Err(err).
+		Time("nextAttempt", delivery.NextAttemptAt).
+		Msg("webhooks: unable to deliver notification, will retry later")
+	if err := s.persist.SaveWebhookDelivery(ctx, delivery); err != nil {
+		logger.Error().Err(err).Msg("webhooks: unable to postpone notification")
+	}
+}
+
+// post performs the actual HTTP request.
+func (s *Service) post(ctx context.Context, delivery *persistence.WebhookDelivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return fmt.Errorf("creating HTTP request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(eventHeader, delivery.Event)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected HTTP status %s", resp.Status)
+	}
+	return nil
+}
+
+// retryBackoff returns the delay before the next attempt, after the given
+// number of failed attempts.
+func retryBackoff(failedAttempts int) time.Duration {
+	backoff := retryBackoffBase
+	for i := 1; i < failedAttempts; i++ {
+		backoff *= 2
+		if backoff >= retryBackoffMax {
+			return retryBackoffMax
+		}
+	}
+	return backoff
+}
+
+func contains(haystack []string, needle string) bool {
+	for _, value := range haystack {
+		if value == needle {
+			return true
+		}
+	}
+	return false
+}
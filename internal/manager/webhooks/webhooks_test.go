@@ -0,0 +1,297 @@
+package webhooks
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"git.blender.org/flamenco/internal/manager/config"
+	"git.blender.org/flamenco/internal/manager/persistence"
+	"git.blender.org/flamenco/internal/manager/webhooks/mocks"
+	"git.blender.org/flamenco/pkg/api"
+)
+
+type WebhooksMocks struct {
+	clock   *clock.Mock
+	persist *mocks.MockPersistenceService
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// receivedRequest is what the test HTTP server received.
+type receivedRequest struct {
+	event   string
+	payload []byte
+}
+
+func webhooksTestFixtures(t *testing.T, hooks []config.Webhook) (*Service, func(), *WebhooksMocks) {
+	mockCtrl := gomock.NewController(t)
+
+	mocks := &WebhooksMocks{
+		clock:   clock.NewMock(),
+		persist: mocks.NewMockPersistenceService(mockCtrl),
+	}
+
+	// Use a timezone other than UTC, as the timestamps stored in the database
+	// have to be in UTC.
+	mockedNow, err := time.Parse(time.RFC3339, "2022-09-15T11:14:41+02:00")
+	if err != nil {
+		panic(err)
+	}
+	mocks.clock.Set(mockedNow)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mocks.ctx = ctx
+	mocks.cancel = cancel
+
+	// This should be called at the end of each unit test.
+	finish := func() {
+		mocks.cancel()
+		mockCtrl.Finish()
+	}
+
+	s := New(mocks.clock, mocks.persist, hooks)
+	return s, finish, mocks
+}
+
+// testServer starts an HTTP server that responds with the given status code,
+// and sends the requests it receives to the returned channel.
+func testServer(t *testing.T, statusCode int) (*httptest.Server, <-chan receivedRequest) {
+	received := make(chan receivedRequest, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		received <- receivedRequest{event: r.Header.Get(eventHeader), payload: body}
+		w.WriteHeader(statusCode)
+	}))
+	return server, received
+}
+
+func TestJobUpdateQueued(t *testing.T) {
+	hooks := []config.Webhook{
+		{URL: "http://localhost/finished", JobStatuses: []string{"completed", "failed"}},
+		{URL: "http://localhost/workers", WorkerStatuses: []string{"error"}},
+	}
+	s, finish, mocks := webhooksTestFixtures(t, hooks)
+	defer finish()
+
+	prevStatus := api.JobStatusActive
+	jobUpdate := api.SocketIOJobUpdate{
+		Id:             "2f7d910f-08a6-4b0f-8ecb-b3946939ed1b",
+		Status:         api.JobStatusCompleted,
+		PreviousStatus: &prevStatus,
+	}
+	expectPayload, err := json.Marshal(jobUpdate)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	// The database should not be touched here, as this is called from the update
+	// listener. That's checked by not expecting any calls on the mocks.
+	s.JobUpdate(jobUpdate)
+
+	if !assert.Len(t, s.pending, 1) {
+		t.FailNow()
+	}
+	assert.Equal(t, persistence.WebhookDelivery{
+		URL:           "http://localhost/finished",
+		Event:         EventJobUpdate,
+		Payload:       expectPayload,
+		NextAttemptAt: mocks.clock.Now().UTC(),
+	}, <-s.pending)
+}
+
+func TestJobUpdateFiltered(t *testing.T) {
+	hooks := []config.Webhook{
+		{URL: "http://localhost/finished", JobStatuses: []string{"completed", "failed"}},
+	}
+	s, finish, _ := webhooksTestFixtures(t, hooks)
+	defer finish()
+
+	// No status change.
+	s.JobUpdate(api.SocketIOJobUpdate{Status: api.JobStatusCompleted})
+	prevStatus := api.JobStatusCompleted
+	s.JobUpdate(api.SocketIOJobUpdate{Status: api.JobStatusCompleted, PreviousStatus: &prevStatus})
+
+	// Status change, but not one the webhook is interested in.
+	prevStatus = api.JobStatusQueued
+	s.JobUpdate(api.SocketIOJobUpdate{Status: api.JobStatusActive, PreviousStatus: &prevStatus})
+
+	// Worker status change, but the webhook only wants job updates.
+	prevWorkerStatus := api.WorkerStatusAwake
+	s.WorkerUpdate(api.SocketIOWorkerUpdate{Status: api.WorkerStatusError, PreviousStatus: &prevWorkerStatus})
+
+	assert.Len(t, s.pending, 0, "nothing should have been queued")
+}
+
+func TestWorkerUpdateQueued(t *testing.T) {
+	hooks := []config.Webhook{
+		{URL: "http://localhost/workers", WorkerStatuses: []string{"error"}},
+	}
+	s, finish, mocks := webhooksTestFixtures(t, hooks)
+	defer finish()
+
+	prevStatus := api.WorkerStatusAwake
+	workerUpdate := api.SocketIOWorkerUpdate{
+		Id:             "e7632d62-c3b8-4af0-9e78-01752928952c",
+		Name:           "workie",
+		Status:         api.WorkerStatusError,
+		PreviousStatus: &prevStatus,
+	}
+	expectPayload, err := json.Marshal(workerUpdate)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	s.WorkerUpdate(workerUpdate)
+
+	if !assert.Len(t, s.pending, 1) {
+		t.FailNow()
+	}
+	assert.Equal(t, persistence.WebhookDelivery{
+		URL:           "http://localhost/workers",
+		Event:         EventWorkerUpdate,
+		Payload:       expectPayload,
+		NextAttemptAt: mocks.clock.Now().UTC(),
+	}, <-s.pending)
+}
+
+func TestQueueFull(t *testing.T) {
+	hooks := []config.Webhook{
+		{URL: "http://localhost/workers", WorkerStatuses: []string{"error"}},
+	}
+	s, finish, _ := webhooksTestFixtures(t, hooks)
+	defer finish()
+
+	prevStatus := api.WorkerStatusAwake
+	workerUpdate := api.SocketIOWorkerUpdate{
+		Id:             "e7632d62-c3b8-4af0-9e78-01752928952c",
+		Status:         api.WorkerStatusError,
+		PreviousStatus: &prevStatus,
+	}
+
+	// A full queue should drop the notification, and not block.
+	for i := 0; i < queueSize+1; i++ {
+		s.WorkerUpdate(workerUpdate)
+	}
+	assert.Len(t, s.pending, queueSize)
+}
+
+func TestStoreQueued(t *testing.T) {
+	s, finish, mocks := webhooksTestFixtures(t, nil)
+	defer finish()
+
+	delivery1 := persistence.WebhookDelivery{URL: "http://localhost/first", Event: EventJobUpdate}
+	delivery2 := persistence.WebhookDelivery{URL: "http://localhost/second", Event: EventWorkerUpdate}
+	s.pending <- delivery2
+
+	// Both the given delivery and the one waiting in the queue should be stored,
+	// even when the first one cannot be.
+	gomock.InOrder(
+		mocks.persist.EXPECT().QueueWebhookDelivery(gomock.Any(), &delivery1).Return(errors.New("mocked error")),
+		mocks.persist.EXPECT().QueueWebhookDelivery(gomock.Any(), &delivery2),
+	)
+	s.storeQueued(delivery1)
+	assert.Len(t, s.pending, 0)
+}
+
+func TestDeliverSuccess(t *testing.T) {
+	s, finish, mocks := webhooksTestFixtures(t, nil)
+	defer finish()
+
+	server, received := testServer(t, http.StatusNoContent)
+	defer server.Close()
+
+	delivery := persistence.WebhookDelivery{
+		Model:   persistence.Model{ID: 47},
+		URL:     server.URL,
+		Event:   EventJobUpdate,
+		Payload: []byte(`{"id": "2f7d910f-08a6-4b0f-8ecb-b3946939ed1b"}`),
+	}
+
+	mocks.persist.EXPECT().
+		FetchWebhookDeliveriesDue(mocks.ctx, mocks.clock.Now(), deliveryBatchSize).
+		Return([]*persistence.WebhookDelivery{&delivery}, nil)
+	mocks.persist.EXPECT().DeleteWebhookDelivery(mocks.ctx, &delivery)
+
+	s.deliverDue(mocks.ctx)
+
+	select {
+	case request := <-received:
+		assert.Equal(t, EventJobUpdate, request.event)
+		assert.Equal(t, delivery.Payload, request.payload)
+	default:
+		t.Fatal("the webhook should have been called")
+	}
+}
+
+func TestDeliverFailure(t *testing.T) {
+	s, finish, mocks := webhooksTestFixtures(t, nil)
+	defer finish()
+
+	server, received := testServer(t, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	delivery := persistence.WebhookDelivery{
+		Model:    persistence.Model{ID: 47},
+		URL:      server.URL,
+		Event:    EventWorkerUpdate,
+		Payload:  []byte(`{}`),
+		Attempts: 2,
+	}
+
+	mocks.persist.EXPECT().
+		FetchWebhookDeliveriesDue(mocks.ctx, mocks.clock.Now(), deliveryBatchSize).
+		Return([]*persistence.WebhookDelivery{&delivery}, nil)
+	mocks.persist.EXPECT().SaveWebhookDelivery(mocks.ctx, &delivery)
+
+	s.deliverDue(mocks.ctx)
+
+	assert.Len(t, received, 1)
+	assert.Equal(t, 3, delivery.Attempts)
+	assert.Equal(t, mocks.clock.Now().UTC().Add(40*time.Second), delivery.NextAttemptAt)
+}
+
+func TestDeliverGiveUp(t *testing.T) {
+	s, finish, mocks := webhooksTestFixtures(t, nil)
+	defer finish()
+
+	server, _ := testServer(t, http.StatusInternalServerError)
+	defer server.Close()
+
+	delivery := persistence.WebhookDelivery{
+		Model:    persistence.Model{ID: 47},
+		URL:      server.URL,
+		Event:    EventJobUpdate,
+		Payload:  []byte(`{}`),
+		Attempts: maxDeliveryAttempts - 1,
+	}
+
+	mocks.persist.EXPECT().
+		FetchWebhookDeliveriesDue(mocks.ctx, mocks.clock.Now(), deliveryBatchSize).
+		Return([]*persistence.WebhookDelivery{&delivery}, nil)
+	mocks.persist.EXPECT().DeleteWebhookDelivery(mocks.ctx, &delivery)
+
+	s.deliverDue(mocks.ctx)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	assert.Equal(t, 10*time.Second, retryBackoff(1))
+	assert.Equal(t, 20*time.Second, retryBackoff(2))
+	assert.Equal(t, 80*time.Second, retryBackoff(4))
+	assert.Equal(t, retryBackoffMax, retryBackoff(20))
+}
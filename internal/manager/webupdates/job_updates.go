@@ -38,6 +38,7 @@ func NewTaskUpdate(task *persistence.Task) api.SocketIOTaskUpdate {
 		Updated:  task.UpdatedAt,
 		Status:   task.Status,
 		Activity: task.Activity,
+		Progress: &task.Progress,
 	}
 	return taskUpdate
 }
@@ -63,6 +64,7 @@ func NewTaskLogUpdate(taskUUID string, logchunk string) api.SocketIOTaskLogUpdat
 func (b *BiDirComms) BroadcastJobUpdate(jobUpdate api.SocketIOJobUpdate) {
 	log.Debug().Interface("jobUpdate", jobUpdate).Msg("socketIO: broadcasting job update")
 	b.BroadcastTo(SocketIORoomJobs, SIOEventJobUpdate, jobUpdate)
+	b.notifyListeners(func(listener UpdateListener) { listener.JobUpdate(jobUpdate) })
 }
 
 // BroadcastNewJob sends a "new job" notification to clients.
@@ -76,6 +78,7 @@ func (b *BiDirComms) BroadcastNewJob(jobUpdate api.SocketIOJobUpdate) {
 
 	log.Debug().Interface("jobUpdate", jobUpdate).Msg("socketIO: broadcasting new job")
 	b.BroadcastTo(SocketIORoomJobs, SIOEventJobUpdate, jobUpdate)
+	b.notifyListeners(func(listener UpdateListener) { listener.JobUpdate(jobUpdate) })
 }
 
 // BroadcastTaskUpdate sends the task update to clients.
@@ -83,6 +86,11 @@ func (b *BiDirComms) BroadcastTaskUpdate(taskUpdate api.SocketIOTaskUpdate) {
 	log.Debug().Interface("taskUpdate", taskUpdate).Msg("socketIO: broadcasting task update")
 	room := roomForJob(taskUpdate.JobId)
 	b.BroadcastTo(room, SIOEventTaskUpdate, taskUpdate)
+	b.notifyListeners(func(listener UpdateListener) {
+		if taskListener, ok := listener.(TaskUpdateListener); ok {
+			taskListener.TaskUpdate(taskUpdate)
+		}
+	})
 }
 
 // BroadcastLastRenderedImage sends the 'last-rendered' update to clients.
@@ -105,3 +113,10 @@ func (b *BiDirComms) BroadcastTaskLogUpdate(taskLogUpdate api.SocketIOTaskLogUpd
 		Msg("socketIO: broadcasting task log")
 	b.BroadcastTo(room, SIOEventTaskLogUpdate, taskLogUpdate)
 }
+
+// BroadcastJobTypesUpdate sends the new list of job types to clients. This is
+// done when the job compiler scripts have been reloaded.
+func (b *BiDirComms) BroadcastJobTypesUpdate(jobTypes api.AvailableJobTypes) {
+	log.Debug().Int("numJobTypes", len(jobTypes.JobTypes)).Msg("socketIO: broadcasting job types update")
+	b.BroadcastTo(SocketIORoomJobs, SIOEventJobTypesUpdate, jobTypes)
+}
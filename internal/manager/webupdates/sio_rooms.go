@@ -36,6 +36,7 @@ const (
 	SIOEventChatMessageRcv     SocketIOEventType = "/chat"          // clients send chat messages here
 	SIOEventChatMessageSend    SocketIOEventType = "/message"       // chat messages are broadcasted here
 	SIOEventJobUpdate          SocketIOEventType = "/jobs"          // sends api.SocketIOJobUpdate
+	SIOEventJobTypesUpdate     SocketIOEventType = "/jobtypes"      // sends api.AvailableJobTypes
 	SIOEventLastRenderedUpdate SocketIOEventType = "/last-rendered" // sends api.SocketIOLastRenderedUpdate
 	SIOEventTaskUpdate         SocketIOEventType = "/task"          // sends api.SocketIOTaskUpdate
 	SIOEventTaskLogUpdate      SocketIOEventType = "/tasklog"       // sends api.SocketIOTaskLogUpdate
@@ -3,15 +3,37 @@
 package webupdates
 
 import (
+	"sync"
+
 	gosocketio "github.com/graarh/golang-socketio"
 	"github.com/graarh/golang-socketio/transport"
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+
+	"git.blender.org/flamenco/pkg/api"
 )
 
 type BiDirComms struct {
 	sockserv *gosocketio.Server
+
+	listenersMutex sync.RWMutex
+	listeners      []UpdateListener
+}
+
+// UpdateListener receives job and worker updates in addition to the SocketIO
+// clients. This allows sending those updates over other channels, like
+// webhooks. Implementations should return quickly, as they are called
+// synchronously from the broadcast functions.
+type UpdateListener interface {
+	JobUpdate(jobUpdate api.SocketIOJobUpdate)
+	WorkerUpdate(workerUpdate api.SocketIOWorkerUpdate)
+}
+
+// TaskUpdateListener can be implemented by an UpdateListener to also receive
+// task updates.
+type TaskUpdateListener interface {
+	TaskUpdate(taskUpdate api.SocketIOTaskUpdate)
 }
 
 type Message struct {
@@ -27,6 +49,22 @@ func New() *BiDirComms {
 	return &bdc
 }
 
+// AddListener registers a listener for job and worker updates.
+func (b *BiDirComms) AddListener(listener UpdateListener) {
+	b.listenersMutex.Lock()
+	defer b.listenersMutex.Unlock()
+	b.listeners = append(b.listeners, listener)
+}
+
+// notifyListeners calls the given function for each registered listener.
+func (b *BiDirComms) notifyListeners(notify func(listener UpdateListener)) {
+	b.listenersMutex.RLock()
+	defer b.listenersMutex.RUnlock()
+	for _, listener := range b.listeners {
+		notify(listener)
+	}
+}
+
 func (b *BiDirComms) RegisterHandlers(router *echo.Echo) {
 	router.Any("/socket.io/", echo.WrapHandler(b.sockserv))
 }
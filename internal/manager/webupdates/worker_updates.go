@@ -39,6 +39,7 @@ func NewWorkerUpdate(worker *persistence.Worker) api.SocketIOWorkerUpdate {
 func (b *BiDirComms) BroadcastWorkerUpdate(workerUpdate api.SocketIOWorkerUpdate) {
 	log.Debug().Interface("workerUpdate", workerUpdate).Msg("socketIO: broadcasting worker update")
 	b.BroadcastTo(SocketIORoomWorkers, SIOEventWorkerUpdate, workerUpdate)
+	b.notifyListeners(func(listener UpdateListener) { listener.WorkerUpdate(workerUpdate) })
 }
 
 // BroadcastNewWorker sends a "new worker" notification to clients.
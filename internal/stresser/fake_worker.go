@@ -56,7 +56,7 @@ func fetchTask(ctx context.Context, client worker.FlamencoClient) *api.AssignedT
 		}
 
 		log.Debug().Msg("fetching tasks")
-		resp, err := client.ScheduleTaskWithResponse(ctx)
+		resp, err := client.ScheduleTaskWithResponse(ctx, &api.ScheduleTaskParams{})
 		if err != nil {
 			log.Error().Err(err).Msg("error obtaining task")
 			wait = durationFetchFailed
@@ -25,7 +25,9 @@ func NewCLIRunner() *CLIRunner {
 }
 
 func (cli *CLIRunner) CommandContext(ctx context.Context, name string, arg ...string) *exec.Cmd {
-	return exec.CommandContext(ctx, name, arg...)
+	execCmd := exec.CommandContext(ctx, name, arg...)
+	setProcessGroup(execCmd)
+	return execCmd
 }
 
 // RunWithTextOutput runs a command and sends its output line-by-line to the
@@ -53,6 +55,22 @@ func (cli *CLIRunner) RunWithTextOutput(
 	blenderPID := execCmd.Process.Pid
 	logger = logger.With().Int("pid", blenderPID).Logger()
 
+	// exec.CommandContext() only kills the process itself when the context is
+	// done. Kill its subprocesses too, as those could otherwise keep running,
+	// and keep the output pipe open.
+	processDone := make(chan struct{})
+	defer close(processDone)
+	go func() {
+		select {
+		case <-processDone:
+		case <-ctx.Done():
+			logger.Warn().Err(ctx.Err()).Msg("killing command and its subprocesses")
+			if err := killProcessGroup(execCmd); err != nil {
+				logger.Error().Err(err).Msg("error killing command and its subprocesses")
+			}
+		}
+	}()
+
 	reader := bufio.NewReaderSize(outPipe, StdoutBufferSize)
 
 	// returnErr determines which error is returned to the caller. More important
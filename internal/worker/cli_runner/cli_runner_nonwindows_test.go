@@ -0,0 +1,51 @@
+//go:build !windows
+
+package cli_runner
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+type discardingLogChunker struct{}
+
+func (discardingLogChunker) Flush(ctx context.Context) error                      { return nil }
+func (discardingLogChunker) Append(ctx context.Context, logLines ...string) error { return nil }
+
+func TestRunWithTextOutputKillsSubprocesses(t *testing.T) {
+	cli := NewCLIRunner()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The backgrounded subprocess keeps the output pipe open. Without killing it
+	// as well, RunWithTextOutput() would wait for it to finish.
+	execCmd := cli.CommandContext(ctx, "sh", "-c", "sleep 30 & echo started; sleep 30")
+
+	lines := make(chan string, 1)
+	runDone := make(chan error)
+	go func() {
+		runDone <- cli.RunWithTextOutput(ctx, zerolog.Nop(), execCmd, discardingLogChunker{}, lines)
+	}()
+
+	select {
+	case line := <-lines:
+		assert.Equal(t, "started", line)
+	case <-time.After(10 * time.Second):
+		t.Fatal("command did not start")
+	}
+	cancel()
+
+	select {
+	case err := <-runDone:
+		assert.Error(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("command and its subprocesses were not killed")
+	}
+}
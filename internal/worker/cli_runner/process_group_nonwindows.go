@@ -0,0 +1,25 @@
+//go:build !windows
+
+package cli_runner
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup makes the command run in its own process group, so that it
+// can be killed together with any subprocesses it starts.
+func setProcessGroup(execCmd *exec.Cmd) {
+	if execCmd.SysProcAttr == nil {
+		execCmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	execCmd.SysProcAttr.Setpgid = true
+}
+
+// killProcessGroup kills the command's process and all of its subprocesses.
+func killProcessGroup(execCmd *exec.Cmd) error {
+	// A negative PID signals the entire process group.
+	return syscall.Kill(-execCmd.Process.Pid, syscall.SIGKILL)
+}
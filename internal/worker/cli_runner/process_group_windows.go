@@ -0,0 +1,26 @@
+//go:build windows
+
+package cli_runner
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"os/exec"
+	"strconv"
+	"syscall"
+)
+
+// setProcessGroup makes the command run in its own process group, so that it
+// can be killed together with any subprocesses it starts.
+func setProcessGroup(execCmd *exec.Cmd) {
+	if execCmd.SysProcAttr == nil {
+		execCmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	execCmd.SysProcAttr.CreationFlags |= syscall.CREATE_NEW_PROCESS_GROUP
+}
+
+// killProcessGroup kills the command's process and all of its subprocesses.
+func killProcessGroup(execCmd *exec.Cmd) error {
+	pid := strconv.Itoa(execCmd.Process.Pid)
+	return exec.Command("taskkill", "/F", "/T", "/PID", pid).Run()
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"os/exec"
 	"regexp"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/google/shlex"
@@ -19,7 +21,11 @@ import (
 	"git.blender.org/flamenco/pkg/crosspath"
 )
 
-var regexpFileSaved = regexp.MustCompile("Saved: '(.*)'")
+var (
+	regexpFileSaved     = regexp.MustCompile("Saved: '(.*)'")
+	regexpSampleCount   = regexp.MustCompile(`Sample (\d+)/(\d+)`)
+	regexpRenderedTiles = regexp.MustCompile(`Rendered (\d+)/(\d+) Tiles`)
+)
 
 type BlenderParameters struct {
 	exe        string   // Expansion of `{blender}`: executable path + its CLI parameters defined by the Manager.
@@ -40,6 +46,7 @@ func (ce *CommandExecutor) cmdBlenderRender(ctx context.Context, logger zerolog.
 
 	logChunker := NewLogChunker(taskID, ce.listener, ce.timeService)
 	lineChannel := make(chan string)
+	progress := newBlenderProgress(execCmd.Args)
 
 	// Process the output of Blender.
 	wg := sync.WaitGroup{}
@@ -47,7 +54,7 @@ func (ce *CommandExecutor) cmdBlenderRender(ctx context.Context, logger zerolog.
 	go func() {
 		defer wg.Done()
 		for line := range lineChannel {
-			ce.processLineBlender(ctx, logger, taskID, line)
+			ce.processLineBlender(ctx, logger, taskID, progress, line)
 		}
 	}()
 
@@ -163,10 +170,22 @@ func cmdBlenderRenderParams(logger zerolog.Logger, cmd api.Command) (BlenderPara
 	return parameters, nil
 }
 
-func (ce *CommandExecutor) processLineBlender(ctx context.Context, logger zerolog.Logger, taskID string, line string) {
+func (ce *CommandExecutor) processLineBlender(
+	ctx context.Context,
+	logger zerolog.Logger,
+	taskID string,
+	progress *blenderProgress,
+	line string,
+) {
 	// TODO: check for "Warning: Unable to open" and other indicators of missing
 	// files. Flamenco v2 updated the task.Activity field for such situations.
 
+	if percentage, changed := progress.processLine(line); changed {
+		if err := ce.listener.ProgressProduced(ctx, taskID, percentage); err != nil {
+			logger.Warn().Err(err).Msg("error submitting task progress to listener")
+		}
+	}
+
 	match := regexpFileSaved.FindStringSubmatch(line)
 	if len(match) < 2 {
 		return
@@ -181,3 +200,114 @@ func (ce *CommandExecutor) processLineBlender(ctx context.Context, logger zerolo
 		logger.Warn().Err(err).Msg("error submitting produced output to listener")
 	}
 }
+
+// blenderProgress tracks the render progress of a Blender process, based on
+// the lines it logs.
+type blenderProgress struct {
+	numFrames     int     // Number of frames to render, or 0 if unknown.
+	framesSaved   int     // Number of frames that have been rendered & saved.
+	frameProgress float64 // Progress of the current frame, in range [0, 1].
+	lastReported  int     // Last reported percentage, or -1 if nothing was reported yet.
+}
+
+func newBlenderProgress(cliArgs []string) *blenderProgress {
+	return &blenderProgress{
+		numFrames:    countRenderFrames(cliArgs),
+		lastReported: -1,
+	}
+}
+
+// processLine updates the progress from a line of Blender output. Returns the
+// progress percentage, and whether it changed since the last call.
+func (bp *blenderProgress) processLine(line string) (int, bool) {
+	switch {
+	case regexpFileSaved.MatchString(line):
+		bp.framesSaved++
+		bp.frameProgress = 0
+		if bp.numFrames == 0 {
+			// Without knowing the number of frames, the best that can be done is
+			// to report on the progress of the current frame.
+			return bp.lastReported, false
+		}
+	default:
+		done, total, ok := matchProgressFraction(line)
+		if !ok {
+			return bp.lastReported, false
+		}
+		bp.frameProgress = float64(done) / float64(total)
+	}
+
+	percentage := bp.percentage()
+	if percentage == bp.lastReported {
+		return percentage, false
+	}
+	bp.lastReported = percentage
+	return percentage, true
+}
+
+func (bp *blenderProgress) percentage() int {
+	var fraction float64
+	if bp.numFrames == 0 {
+		fraction = bp.frameProgress
+	} else {
+		fraction = (float64(bp.framesSaved) + bp.frameProgress) / float64(bp.numFrames)
+	}
+
+	percentage := int(fraction * 100)
+	switch {
+	case percentage < 0:
+		return 0
+	case percentage > 100:
+		return 100
+	default:
+		return percentage
+	}
+}
+
+// matchProgressFraction finds "Sample 64/128" (Cycles) or "Rendered 3/12 Tiles"
+// (older versions of Blender) in a line of Blender output.
+func matchProgressFraction(line string) (done, total int, ok bool) {
+	match := regexpSampleCount.FindStringSubmatch(line)
+	if match == nil {
+		match = regexpRenderedTiles.FindStringSubmatch(line)
+	}
+	if match == nil {
+		return 0, 0, false
+	}
+
+	done, errDone := strconv.Atoi(match[1])
+	total, errTotal := strconv.Atoi(match[2])
+	if errDone != nil || errTotal != nil || total <= 0 {
+		return 0, 0, false
+	}
+	return done, total, true
+}
+
+// countRenderFrames returns the number of frames rendered by the
+// `--render-frame` or `-f` CLI argument, or 0 if it cannot be determined.
+//
+// Frames can be given as "3", "1..10", or a comma-separated list of those.
+// Blender's relative frame numbers, like "+3", are not supported.
+func countRenderFrames(cliArgs []string) int {
+	for idx := 0; idx < len(cliArgs)-1; idx++ {
+		if cliArgs[idx] != "--render-frame" && cliArgs[idx] != "-f" {
+			continue
+		}
+
+		numFrames := 0
+		for _, part := range strings.Split(cliArgs[idx+1], ",") {
+			start, end, isRange := strings.Cut(part, "..")
+			if !isRange {
+				end = start
+			}
+			startFrame, errStart := strconv.ParseUint(strings.TrimSpace(start), 10, 31)
+			endFrame, errEnd := strconv.ParseUint(strings.TrimSpace(end), 10, 31)
+			if errStart != nil || errEnd != nil || endFrame < startFrame {
+				return 0
+			}
+			numFrames += int(endFrame-startFrame) + 1
+		}
+		return numFrames
+	}
+	return 0
+}
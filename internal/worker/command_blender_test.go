@@ -77,10 +77,53 @@ func TestProcessLineBlender(t *testing.T) {
 	ce, mocks := testCommandExecutor(t, mockCtrl)
 	taskID := "c194ea21-1fda-46f6-bc9a-34bd302cfb19"
 
+	progress := newBlenderProgress([]string{"--render-frame", "1..2"})
+
 	// This shouldn't call anything on the mocks.
-	ce.processLineBlender(ctx, log.Logger, taskID, "starting Blender")
+	ce.processLineBlender(ctx, log.Logger, taskID, progress, "starting Blender")
+
+	// This should be recognised as progress.
+	mocks.listener.EXPECT().ProgressProduced(ctx, taskID, 25)
+	ce.processLineBlender(ctx, log.Logger, taskID, progress,
+		"Fra:1 Mem:83.14M (Peak 84.29M) | Time:00:01.23 | Remaining:00:01.20 | Mem:6.84M, Peak:6.84M | Scene, ViewLayer | Sample 64/128")
 
-	// This should be recognised as produced output.
+	// The same percentage should not be reported again.
+	ce.processLineBlender(ctx, log.Logger, taskID, progress,
+		"Fra:1 Mem:83.14M (Peak 84.29M) | Time:00:01.24 | Remaining:00:01.20 | Mem:6.84M, Peak:6.84M | Scene, ViewLayer | Sample 65/128")
+
+	// This should be recognised as produced output, and finish the first frame.
+	mocks.listener.EXPECT().ProgressProduced(ctx, taskID, 50)
 	mocks.listener.EXPECT().OutputProduced(ctx, taskID, "/path/to/file.exr")
-	ce.processLineBlender(ctx, log.Logger, taskID, "Saved: '/path/to/file.exr'")
+	ce.processLineBlender(ctx, log.Logger, taskID, progress, "Saved: '/path/to/file.exr'")
+}
+
+func TestBlenderProgress(t *testing.T) {
+	// Tiles, without knowing the number of frames.
+	progress := newBlenderProgress([]string{"--background", "file.blend"})
+	percentage, changed := progress.processLine("Fra:1 Mem:12.00M | Rendered 3/12 Tiles")
+	assert.True(t, changed)
+	assert.Equal(t, 25, percentage)
+	_, changed = progress.processLine("Saved: '/path/to/0001.png'")
+	assert.False(t, changed)
+	percentage, changed = progress.processLine("Fra:2 Mem:12.00M | Rendered 6/12 Tiles")
+	assert.True(t, changed)
+	assert.Equal(t, 50, percentage)
+
+	// Samples of the 2nd of 4 frames.
+	progress = newBlenderProgress([]string{"--render-frame", "1,5..7"})
+	progress.processLine("Saved: '/path/to/0001.png'")
+	percentage, changed = progress.processLine("Fra:5 | Scene, ViewLayer | Sample 32/64")
+	assert.True(t, changed)
+	assert.Equal(t, 37, percentage)
+}
+
+func TestCountRenderFrames(t *testing.T) {
+	assert.Equal(t, 0, countRenderFrames([]string{}))
+	assert.Equal(t, 0, countRenderFrames([]string{"--render-anim"}))
+	assert.Equal(t, 0, countRenderFrames([]string{"--render-frame"}))
+	assert.Equal(t, 0, countRenderFrames([]string{"--render-frame", "+3"}))
+	assert.Equal(t, 0, countRenderFrames([]string{"--render-frame", "10..1"}))
+	assert.Equal(t, 1, countRenderFrames([]string{"-f", "47"}))
+	assert.Equal(t, 10, countRenderFrames([]string{"--render-output", "/frames", "--render-frame", "1..10"}))
+	assert.Equal(t, 5, countRenderFrames([]string{"--render-frame", "1,3,5..7"}))
 }
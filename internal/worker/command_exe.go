@@ -21,6 +21,19 @@ type CommandExecutor struct {
 	listener    CommandListener
 	timeService TimeService
 
+	// execAllowlist contains the executables the "exec" command is allowed to
+	// run. When empty, the "exec" command cannot run anything.
+	execAllowlist []string
+
+	// execEnvAllowlist contains the environment variables the "exec" command is
+	// allowed to set.
+	execEnvAllowlist []string
+
+	// deleteRoot is the directory in which the "delete-path" command is allowed
+	// to delete things. When empty, the "delete-path" command cannot delete
+	// anything.
+	deleteRoot string
+
 	// registry maps a command name to a function that runs that command.
 	registry map[string]commandCallable
 }
@@ -39,6 +52,8 @@ type CommandListener interface {
 	LogProduced(ctx context.Context, taskID string, logLines ...string) error
 	// OutputProduced tells the Manager there has been some output (most commonly a rendered frame or video).
 	OutputProduced(ctx context.Context, taskID string, outputLocation string) error
+	// ProgressProduced tells the Manager how far along the task is, as percentage.
+	ProgressProduced(ctx context.Context, taskID string, progress int) error
 }
 
 // TimeService is a service that operates on time.
@@ -66,11 +81,25 @@ type CommandLineRunner interface {
 // without actually executing anything.
 var ErrNoExecCmd = errors.New("no exec.Cmd could be created")
 
-func NewCommandExecutor(cli CommandLineRunner, listener CommandListener, timeService TimeService) *CommandExecutor {
+// ErrMaxDurationExceeded means that a command was aborted because it ran for
+// longer than its `max_duration` parameter allows.
+var ErrMaxDurationExceeded = errors.New("command exceeded its maximum duration")
+
+func NewCommandExecutor(
+	cli CommandLineRunner,
+	listener CommandListener,
+	timeService TimeService,
+	execAllowlist []string,
+	execEnvAllowlist []string,
+	deleteRoot string,
+) *CommandExecutor {
 	ce := &CommandExecutor{
-		cli:         cli,
-		listener:    listener,
-		timeService: timeService,
+		cli:              cli,
+		listener:         listener,
+		timeService:      timeService,
+		execAllowlist:    execAllowlist,
+		execEnvAllowlist: execEnvAllowlist,
+		deleteRoot:       deleteRoot,
 	}
 
 	// Registry of supported commands. Having this as a map (instead of a big
@@ -89,6 +118,13 @@ func NewCommandExecutor(cli CommandLineRunner, listener CommandListener, timeSer
 
 		// file-management
 		"move-directory": ce.cmdMoveDirectory,
+		"copy-file":      ce.cmdCopyFile,
+		"copy-directory": ce.cmdCopyDirectory,
+		"delete-path":    ce.cmdDeletePath,
+		"mkdir":          ce.cmdMkdir,
+
+		// generic
+		"exec": ce.cmdExec,
 	}
 
 	return ce
@@ -103,7 +139,79 @@ func (ce *CommandExecutor) Run(ctx context.Context, taskID string, cmd api.Comma
 		return fmt.Errorf("unknown command: %q", cmd.Name)
 	}
 
-	return runner(ctx, logger, taskID, cmd)
+	maxDuration, err := cmdMaxDuration(cmd)
+	if err != nil {
+		return err
+	}
+	if maxDuration == 0 {
+		return runner(ctx, logger, taskID, cmd)
+	}
+	return ce.runWithMaxDuration(ctx, logger, taskID, cmd, runner, maxDuration)
+}
+
+// runWithMaxDuration runs the command, and cancels it when it takes longer
+// than `maxDuration`. Canceling the context kills any subprocess the command
+// started.
+func (ce *CommandExecutor) runWithMaxDuration(
+	ctx context.Context,
+	logger zerolog.Logger,
+	taskID string,
+	cmd api.Command,
+	runner commandCallable,
+	maxDuration time.Duration,
+) error {
+	cmdCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	timedOut := make(chan struct{})
+	go func() {
+		select {
+		case <-cmdCtx.Done():
+		case <-ce.timeService.After(maxDuration):
+			close(timedOut)
+			cancel()
+		}
+	}()
+
+	runErr := runner(cmdCtx, logger, taskID, cmd)
+	if runErr == nil || ctx.Err() != nil {
+		// Shutting down the Worker should not be reported as exceeding the
+		// maximum duration.
+		return runErr
+	}
+
+	select {
+	case <-timedOut:
+		logger.Warn().
+			Str("maxDuration", maxDuration.String()).
+			AnErr("cause", runErr).
+			Msg("command aborted, it ran for longer than its maximum duration")
+		return fmt.Errorf("%w: %s was aborted after running for %v", ErrMaxDurationExceeded, cmd.Name, maxDuration)
+	default:
+		return runErr
+	}
+}
+
+// cmdMaxDuration returns the command's `max_duration` parameter, or 0 if the
+// command has no maximum duration.
+func cmdMaxDuration(cmd api.Command) (time.Duration, error) {
+	setting, found := cmd.Parameters["max_duration"]
+	if !found {
+		return 0, nil
+	}
+
+	durationStr, ok := setting.(string)
+	if !ok {
+		return 0, NewParameterInvalidError("max_duration", cmd, "bad type %T, expecting a duration like \"4h\"", setting)
+	}
+	maxDuration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return 0, NewParameterInvalidError("max_duration", cmd, "%v", err)
+	}
+	if maxDuration < 0 {
+		return 0, NewParameterInvalidError("max_duration", cmd, "cannot be negative")
+	}
+	return maxDuration, nil
 }
 
 // cmdParameterAsStrings converts an array parameter ([]interface{}) to a []string slice.
@@ -137,6 +245,34 @@ func cmdParameterAsStrings(cmd api.Command, key string) ([]string, bool) {
 	return strSlice, true
 }
 
+// cmdParameterAsStringMap converts an object parameter (map[string]interface{})
+// to a map[string]string. A missing parameter is ok and returned as empty map.
+func cmdParameterAsStringMap(cmd api.Command, key string) (map[string]string, bool) {
+	parameter, found := cmd.Parameters[key]
+	if !found {
+		return map[string]string{}, true
+	}
+
+	if asStrMap, ok := parameter.(map[string]string); ok {
+		return asStrMap, true
+	}
+
+	interfMap, ok := parameter.(map[string]interface{})
+	if !ok {
+		return map[string]string{}, false
+	}
+
+	strMap := make(map[string]string, len(interfMap))
+	for mapKey, value := range interfMap {
+		strValue, ok := value.(string)
+		if !ok {
+			return map[string]string{}, false
+		}
+		strMap[mapKey] = strValue
+	}
+	return strMap, true
+}
+
 // cmdParameter retrieves a single parameter of a certain type.
 func cmdParameter[T any](cmd api.Command, key string) (T, bool) {
 	setting, found := cmd.Parameters[key]
@@ -3,7 +3,9 @@ package worker
 // SPDX-License-Identifier: GPL-3.0-or-later
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/benbjohnson/clock"
 	"github.com/golang/mock/gomock"
@@ -24,7 +26,7 @@ func testCommandExecutor(t *testing.T, mockCtrl *gomock.Controller) (*CommandExe
 	listener := mocks.NewMockCommandListener(mockCtrl)
 	clock := mockedClock(t)
 
-	ce := NewCommandExecutor(cli, listener, clock)
+	ce := NewCommandExecutor(cli, listener, clock, nil, nil, "")
 	mocks := CommandExecutorMocks{
 		cli:      cli,
 		listener: listener,
@@ -70,3 +72,71 @@ func TestCmdSettingAsStrings(t *testing.T) {
 		}
 	}
 }
+
+func TestCommandMaxDuration(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ce, mocks := testCommandExecutor(t, mockCtrl)
+
+	ctx := context.Background()
+	taskID := "90e9d656-e201-4ef0-b6b0-c80684fafa27"
+	cmd := api.Command{
+		Name: "sleep",
+		Parameters: map[string]interface{}{
+			"duration_in_seconds": 47,
+			"max_duration":        "10s",
+		},
+	}
+
+	timeBefore := mocks.clock.Now()
+
+	// Run the test in a goroutine, as we also need to actually increase the
+	// mocked clock at the same time.
+	runDone := make(chan struct{})
+	var err error
+	go func() {
+		err = ce.Run(ctx, taskID, cmd)
+		close(runDone)
+	}()
+
+	timeStepSize := 1 * time.Second
+loop:
+	for {
+		select {
+		case <-runDone:
+			break loop
+		default:
+			mocks.clock.Add(timeStepSize)
+		}
+	}
+
+	assert.ErrorIs(t, err, ErrMaxDurationExceeded)
+	assert.NotErrorIs(t, err, context.Canceled, "exceeding the maximum duration should not look like a shutdown")
+	timeAfter := mocks.clock.Now()
+	assert.WithinDuration(t, timeBefore.Add(10*time.Second), timeAfter, timeStepSize)
+}
+
+func TestCommandMaxDurationInvalid(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ce, _ := testCommandExecutor(t, mockCtrl)
+
+	ctx := context.Background()
+	taskID := "90e9d656-e201-4ef0-b6b0-c80684fafa27"
+
+	for _, maxDuration := range []interface{}{"forever", "-5m", 47} {
+		cmd := api.Command{
+			Name: "echo",
+			Parameters: map[string]interface{}{
+				"message":      "hey",
+				"max_duration": maxDuration,
+			},
+		}
+
+		err := ce.Run(ctx, taskID, cmd)
+		var paramErr ParameterInvalidError
+		if assert.ErrorAs(t, err, &paramErr, "max_duration=%v", maxDuration) {
+			assert.Equal(t, "max_duration", paramErr.Parameter)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package worker
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+/* This file contains the generic "exec" command. */
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+
+	"github.com/rs/zerolog"
+
+	"git.blender.org/flamenco/pkg/api"
+	"git.blender.org/flamenco/pkg/crosspath"
+)
+
+var (
+	// ErrExecNotAllowed means that the "exec" command was asked to run an
+	// executable that is not on the Worker's allowlist.
+	ErrExecNotAllowed = errors.New("executable is not on this Worker's exec allowlist")
+
+	// ErrExecEnvNotAllowed means that the "exec" command was asked to set an
+	// environment variable that is not on the Worker's allowlist. Variables like
+	// LD_PRELOAD or PYTHONPATH would otherwise allow running arbitrary code
+	// inside an allowed executable.
+	ErrExecEnvNotAllowed = errors.New("environment variable is not on this Worker's exec environment allowlist")
+)
+
+type ExecParameters struct {
+	exe  string            // Executable to run.
+	args []string          // CLI arguments for the executable.
+	env  map[string]string // Environment variables, in addition to the Worker's own environment.
+	cwd  string            // Working directory. Empty means the Worker's working directory.
+}
+
+// cmdExec runs an arbitrary executable, as long as it is on the allowlist.
+func (ce *CommandExecutor) cmdExec(ctx context.Context, logger zerolog.Logger, taskID string, cmd api.Command) error {
+	cmdCtx, cmdCtxCancel := context.WithCancel(ctx)
+	defer cmdCtxCancel()
+
+	execCmd, err := ce.cmdExecCommand(cmdCtx, logger, taskID, cmd)
+	if err != nil {
+		return err
+	}
+
+	logChunker := NewLogChunker(taskID, ce.listener, ce.timeService)
+	subprocessErr := ce.cli.RunWithTextOutput(ctx, logger, execCmd, logChunker, nil)
+
+	if subprocessErr != nil {
+		logger.Error().Err(subprocessErr).
+			Int("exitCode", execCmd.ProcessState.ExitCode()).
+			Msg("command exited abnormally")
+		return subprocessErr
+	}
+
+	logger.Info().Msg("command exited succesfully")
+	return nil
+}
+
+func (ce *CommandExecutor) cmdExecCommand(
+	ctx context.Context,
+	logger zerolog.Logger,
+	taskID string,
+	cmd api.Command,
+) (*exec.Cmd, error) {
+	parameters, err := cmdExecParams(logger, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ce.isExecAllowed(parameters.exe) {
+		logger.Warn().Str("exe", parameters.exe).Msg("refusing to run executable that is not on the exec allowlist")
+		return nil, fmt.Errorf("%w: %q", ErrExecNotAllowed, parameters.exe)
+	}
+	for _, name := range parameters.envNames() {
+		if !ce.isExecEnvAllowed(name) {
+			logger.Warn().Str("env", name).Msg("refusing to set environment variable that is not on the exec environment allowlist")
+			return nil, fmt.Errorf("%w: %q", ErrExecEnvNotAllowed, name)
+		}
+	}
+
+	execCmd := ce.cli.CommandContext(ctx, parameters.exe, parameters.args...)
+	if execCmd == nil {
+		logger.Error().Msg("unable to create command executor")
+		return nil, ErrNoExecCmd
+	}
+
+	execCmd.Dir = parameters.cwd
+	if len(parameters.env) > 0 {
+		execCmd.Env = append(os.Environ(), parameters.envList()...)
+	}
+
+	logger.Info().
+		Str("execCmd", execCmd.String()).
+		Str("cwd", parameters.cwd).
+		Strs("env", parameters.envList()).
+		Msg("going to execute command")
+
+	if err := ce.listener.LogProduced(ctx, taskID, fmt.Sprintf("going to run: %s %q", parameters.exe, parameters.args)); err != nil {
+		return nil, err
+	}
+
+	return execCmd, nil
+}
+
+// isExecAllowed checks the executable against the allowlist. Entries without
+// directory only allow that executable to be found on $PATH, and entries with
+// a directory only allow that exact path.
+func (ce *CommandExecutor) isExecAllowed(exe string) bool {
+	isBareName := crosspath.Dir(exe) == "."
+	for _, allowed := range ce.execAllowlist {
+		if isBareName {
+			if exe == allowed {
+				return true
+			}
+			continue
+		}
+		if filepath.Clean(exe) == filepath.Clean(allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// isExecEnvAllowed checks the environment variable name against the allowlist.
+func (ce *CommandExecutor) isExecEnvAllowed(name string) bool {
+	for _, allowed := range ce.execEnvAllowlist {
+		if name == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func cmdExecParams(logger zerolog.Logger, cmd api.Command) (ExecParameters, error) {
+	var (
+		parameters ExecParameters
+		ok         bool
+	)
+
+	if parameters.exe, ok = cmdParameter[string](cmd, "exe"); !ok || parameters.exe == "" {
+		logger.Warn().Interface("command", cmd).Msg("missing 'exe' parameter")
+		return parameters, NewParameterMissingError("exe", cmd)
+	}
+	if parameters.args, ok = cmdParameterAsStrings(cmd, "args"); !ok {
+		logger.Warn().Interface("command", cmd).Msg("invalid 'args' parameter")
+		return parameters, NewParameterInvalidError("args", cmd, "cannot convert to list of strings")
+	}
+	if parameters.env, ok = cmdParameterAsStringMap(cmd, "env"); !ok {
+		logger.Warn().Interface("command", cmd).Msg("invalid 'env' parameter")
+		return parameters, NewParameterInvalidError("env", cmd, "cannot convert to mapping of strings")
+	}
+	if _, found := cmd.Parameters["cwd"]; found {
+		if parameters.cwd, ok = cmdParameter[string](cmd, "cwd"); !ok {
+			logger.Warn().Interface("command", cmd).Msg("invalid 'cwd' parameter")
+			return parameters, NewParameterInvalidError("cwd", cmd, "expecting a string")
+		}
+	}
+
+	return parameters, nil
+}
+
+// envList returns the environment variables as sorted "KEY=value" strings.
+func (p ExecParameters) envList() []string {
+	env := make([]string, 0, len(p.env))
+	for key, value := range p.env {
+		env = append(env, key+"="+value)
+	}
+	sort.Strings(env)
+	return env
+}
+
+// envNames returns the names of the environment variables, sorted.
+func (p ExecParameters) envNames() []string {
+	names := make([]string, 0, len(p.env))
+	for name := range p.env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
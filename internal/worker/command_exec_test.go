@@ -0,0 +1,152 @@
+package worker
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"git.blender.org/flamenco/pkg/api"
+)
+
+func TestCmdExecCliArgs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ce, mocks := testCommandExecutor(t, mockCtrl)
+	ce.execAllowlist = []string{"/opt/tools/denoiser"}
+	ce.execEnvAllowlist = []string{"OIDN_VERBOSE", "CUDA_VISIBLE_DEVICES"}
+
+	taskID := "1d54c6fe-1242-4c8f-bd63-5a09e358d7b6"
+	cmd := api.Command{
+		Name: "exec",
+		Parameters: map[string]interface{}{
+			"exe":  "/opt/tools/denoiser",
+			"args": []interface{}{"--input", "frame 0001.exr"},
+			"env":  map[string]interface{}{"OIDN_VERBOSE": "2", "CUDA_VISIBLE_DEVICES": "0"},
+			"cwd":  "/render/shot-47",
+		},
+	}
+
+	ctx := context.Background()
+	execCmd := exec.Command("/opt/tools/denoiser", "--input", "frame 0001.exr")
+	mocks.cli.EXPECT().CommandContext(gomock.Any(), "/opt/tools/denoiser", "--input", "frame 0001.exr").Return(execCmd)
+	mocks.listener.EXPECT().LogProduced(gomock.Any(), taskID,
+		`going to run: /opt/tools/denoiser ["--input" "frame 0001.exr"]`)
+
+	returnedCmd, err := ce.cmdExecCommand(ctx, zerolog.Nop(), taskID, cmd)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, "/render/shot-47", returnedCmd.Dir)
+	assert.Subset(t, returnedCmd.Env, []string{"CUDA_VISIBLE_DEVICES=0", "OIDN_VERBOSE=2"})
+}
+
+func TestCmdExecNotAllowed(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ce, _ := testCommandExecutor(t, mockCtrl)
+	ce.execAllowlist = []string{"denoiser", "/opt/tools/usd-export"}
+
+	taskID := "1d54c6fe-1242-4c8f-bd63-5a09e358d7b6"
+	notAllowed := []string{
+		"rm",
+		"/tmp/denoiser",              // Only allowed as bare name, i.e. from $PATH.
+		"/opt/tools/usd-export-evil", // Only the exact path is allowed.
+		"/opt/tools/../../bin/sh",
+	}
+
+	for _, exe := range notAllowed {
+		cmd := api.Command{
+			Name:       "exec",
+			Parameters: map[string]interface{}{"exe": exe},
+		}
+		// No calls to the mocks are expected.
+		err := ce.cmdExec(context.Background(), zerolog.Nop(), taskID, cmd)
+		assert.ErrorIs(t, err, ErrExecNotAllowed, "exe=%q", exe)
+	}
+}
+
+func TestCmdExecEnvNotAllowed(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ce, _ := testCommandExecutor(t, mockCtrl)
+	ce.execAllowlist = []string{"ffmpeg"}
+	ce.execEnvAllowlist = []string{"OIDN_VERBOSE"}
+
+	taskID := "1d54c6fe-1242-4c8f-bd63-5a09e358d7b6"
+	notAllowed := []string{
+		"LD_PRELOAD",
+		"LD_LIBRARY_PATH",
+		"DYLD_INSERT_LIBRARIES",
+		"PYTHONPATH",
+		"PYTHONSTARTUP",
+		"BASH_ENV",
+		"oidn_verbose", // Only the exact name is allowed.
+	}
+
+	for _, name := range notAllowed {
+		cmd := api.Command{
+			Name: "exec",
+			Parameters: map[string]interface{}{
+				"exe": "ffmpeg",
+				"env": map[string]interface{}{"OIDN_VERBOSE": "1", name: "/tmp/evil"},
+			},
+		}
+		// No calls to the mocks are expected.
+		err := ce.cmdExec(context.Background(), zerolog.Nop(), taskID, cmd)
+		assert.ErrorIs(t, err, ErrExecEnvNotAllowed, "env=%q", name)
+	}
+
+	// Without allowlist, no environment variables can be set at all.
+	ce.execEnvAllowlist = nil
+	cmd := api.Command{
+		Name: "exec",
+		Parameters: map[string]interface{}{
+			"exe": "ffmpeg",
+			"env": map[string]interface{}{"OIDN_VERBOSE": "1"},
+		},
+	}
+	err := ce.cmdExec(context.Background(), zerolog.Nop(), taskID, cmd)
+	assert.ErrorIs(t, err, ErrExecEnvNotAllowed)
+}
+
+func TestCmdExecEmptyAllowlist(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ce, _ := testCommandExecutor(t, mockCtrl)
+
+	cmd := api.Command{
+		Name:       "exec",
+		Parameters: map[string]interface{}{"exe": "echo"},
+	}
+	err := ce.Run(context.Background(), "1d54c6fe-1242-4c8f-bd63-5a09e358d7b6", cmd)
+	assert.ErrorIs(t, err, ErrExecNotAllowed)
+}
+
+func TestCmdExecInvalidParameters(t *testing.T) {
+	logger := zerolog.Nop()
+
+	_, err := cmdExecParams(logger, api.Command{Name: "exec", Parameters: map[string]interface{}{}})
+	var missingErr ParameterMissingError
+	if assert.ErrorAs(t, err, &missingErr) {
+		assert.Equal(t, "exe", missingErr.Parameter)
+	}
+
+	_, err = cmdExecParams(logger, api.Command{Name: "exec", Parameters: map[string]interface{}{
+		"exe": "denoiser",
+		"env": map[string]interface{}{"THREADS": 4},
+	}})
+	var invalidErr ParameterInvalidError
+	if assert.ErrorAs(t, err, &invalidErr) {
+		assert.Equal(t, "env", invalidErr.Parameter)
+	}
+}
@@ -8,11 +8,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -48,27 +50,8 @@ func (ce *CommandExecutor) cmdMoveDirectory(ctx context.Context, logger zerolog.
 		return NewParameterInvalidError("src", cmd, "path does not exist")
 	}
 
-	if fileExists(dest) {
-		backup, err := timestampedPath(dest)
-		if err != nil {
-			logger.Error().Err(err).Str("path", dest).Msg("unable to determine timestamp of directory")
-			return err
-		}
-
-		if fileExists(backup) {
-			logger.Debug().Str("backup", backup).Msg("backup destination also exists, finding one that does not")
-			backup, err = uniquePath(backup)
-			if err != nil {
-				return err
-			}
-		}
-
-		logger.Info().
-			Str("toBackup", backup).
-			Msg("dest directory exists, moving to backup")
-		if err := ce.moveAndLog(ctx, taskID, cmd.Name, dest, backup); err != nil {
-			return err
-		}
+	if err := ce.moveToBackup(ctx, logger, taskID, cmd.Name, dest); err != nil {
+		return err
 	}
 
 	// self._log.info("Moving %s to %s", src, dest)
@@ -80,6 +63,193 @@ func (ce *CommandExecutor) cmdMoveDirectory(ctx context.Context, logger zerolog.
 	return ce.moveAndLog(ctx, taskID, cmd.Name, src, dest)
 }
 
+// cmdCopyFile executes the "copy-file" command.
+// It copies file 'src' to 'dest'; if 'dest' already exists, it's moved to 'dest-{timestamp}'.
+func (ce *CommandExecutor) cmdCopyFile(ctx context.Context, logger zerolog.Logger, taskID string, cmd api.Command) error {
+	src, dest, err := cmdSrcDestParams(logger, cmd)
+	if err != nil {
+		return err
+	}
+	logger = logger.With().
+		Str("src", src).
+		Str("dest", dest).
+		Logger()
+
+	stat, err := os.Stat(src)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return ce.sourceDoesNotExist(ctx, logger, taskID, cmd, src)
+	case err != nil:
+		return fmt.Errorf("%s: inspecting %q: %w", cmd.Name, src, err)
+	case !stat.Mode().IsRegular():
+		return NewParameterInvalidError("src", cmd, "not a regular file")
+	}
+
+	if err := ce.moveToBackup(ctx, logger, taskID, cmd.Name, dest); err != nil {
+		return err
+	}
+
+	logger.Info().Msg("copying file")
+	return ce.logAndDo(ctx, taskID,
+		fmt.Sprintf("%s: copying %q to %q", cmd.Name, src, dest),
+		func() error {
+			if err := os.MkdirAll(filepath.Dir(dest), fs.ModePerm); err != nil {
+				return err
+			}
+			return copyFile(src, dest)
+		})
+}
+
+// cmdCopyDirectory executes the "copy-directory" command.
+// It copies directory 'src' to 'dest'; if 'dest' already exists, it's moved to 'dest-{timestamp}'.
+func (ce *CommandExecutor) cmdCopyDirectory(ctx context.Context, logger zerolog.Logger, taskID string, cmd api.Command) error {
+	src, dest, err := cmdSrcDestParams(logger, cmd)
+	if err != nil {
+		return err
+	}
+	logger = logger.With().
+		Str("src", src).
+		Str("dest", dest).
+		Logger()
+
+	stat, err := os.Stat(src)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return ce.sourceDoesNotExist(ctx, logger, taskID, cmd, src)
+	case err != nil:
+		return fmt.Errorf("%s: inspecting %q: %w", cmd.Name, src, err)
+	case !stat.IsDir():
+		return NewParameterInvalidError("src", cmd, "not a directory")
+	}
+
+	if err := ce.moveToBackup(ctx, logger, taskID, cmd.Name, dest); err != nil {
+		return err
+	}
+
+	logger.Info().Msg("copying directory")
+	return ce.logAndDo(ctx, taskID,
+		fmt.Sprintf("%s: copying %q to %q", cmd.Name, src, dest),
+		func() error { return copyDirectory(src, dest) })
+}
+
+// cmdDeletePath executes the "delete-path" command.
+// It deletes file or directory 'path', which has to be inside the Worker's
+// configured delete root. Deleting a path that does not exist is not an error.
+func (ce *CommandExecutor) cmdDeletePath(ctx context.Context, logger zerolog.Logger, taskID string, cmd api.Command) error {
+	path, ok := cmdParameter[string](cmd, "path")
+	if !ok || path == "" {
+		logger.Warn().Interface("command", cmd).Msg("missing 'path' parameter")
+		return NewParameterMissingError("path", cmd)
+	}
+	logger = logger.With().Str("path", path).Logger()
+
+	if err := checkInsideRoot(ce.deleteRoot, path); err != nil {
+		logger.Warn().Err(err).Str("deleteRoot", ce.deleteRoot).Msg("refusing to delete path")
+		return NewParameterInvalidError("path", cmd, "%v", err)
+	}
+
+	if !fileExists(path) {
+		logger.Info().Msg("path does not exist, not deleting anything")
+		msg := fmt.Sprintf("%s: path %q does not exist, not deleting anything", cmd.Name, path)
+		return ce.listener.LogProduced(ctx, taskID, msg)
+	}
+
+	logger.Info().Msg("deleting path")
+	return ce.logAndDo(ctx, taskID,
+		fmt.Sprintf("%s: deleting %q", cmd.Name, path),
+		func() error { return os.RemoveAll(path) })
+}
+
+// cmdMkdir executes the "mkdir" command.
+// It creates directory 'path', including any missing parent directories.
+func (ce *CommandExecutor) cmdMkdir(ctx context.Context, logger zerolog.Logger, taskID string, cmd api.Command) error {
+	path, ok := cmdParameter[string](cmd, "path")
+	if !ok || path == "" {
+		logger.Warn().Interface("command", cmd).Msg("missing 'path' parameter")
+		return NewParameterMissingError("path", cmd)
+	}
+	logger = logger.With().Str("path", path).Logger()
+
+	if stat, err := os.Stat(path); err == nil && !stat.IsDir() {
+		return NewParameterInvalidError("path", cmd, "exists, but is not a directory")
+	}
+
+	logger.Info().Msg("creating directory")
+	return ce.logAndDo(ctx, taskID,
+		fmt.Sprintf("%s: creating directory %q", cmd.Name, path),
+		func() error { return os.MkdirAll(path, fs.ModePerm) })
+}
+
+// cmdSrcDestParams returns the 'src' and 'dest' parameters of the command.
+func cmdSrcDestParams(logger zerolog.Logger, cmd api.Command) (src, dest string, err error) {
+	var ok bool
+	if src, ok = cmdParameter[string](cmd, "src"); !ok || src == "" {
+		logger.Warn().Interface("command", cmd).Msg("missing 'src' parameter")
+		return "", "", NewParameterMissingError("src", cmd)
+	}
+	if dest, ok = cmdParameter[string](cmd, "dest"); !ok || dest == "" {
+		logger.Warn().Interface("command", cmd).Msg("missing 'dest' parameter")
+		return "", "", NewParameterMissingError("dest", cmd)
+	}
+	return src, dest, nil
+}
+
+// sourceDoesNotExist logs that the source path does not exist, and returns
+// the error for the 'src' parameter.
+func (ce *CommandExecutor) sourceDoesNotExist(
+	ctx context.Context, logger zerolog.Logger, taskID string, cmd api.Command, src string,
+) error {
+	logger.Warn().Msg("source path does not exist, not copying anything")
+	msg := fmt.Sprintf("%s: source path %q does not exist, not copying anything", cmd.Name, src)
+	if err := ce.listener.LogProduced(ctx, taskID, msg); err != nil {
+		return err
+	}
+	return NewParameterInvalidError("src", cmd, "path does not exist")
+}
+
+// moveToBackup moves `path` out of the way, to `path-{timestamp}`, if it exists.
+func (ce *CommandExecutor) moveToBackup(ctx context.Context, logger zerolog.Logger, taskID, cmdName, path string) error {
+	if !fileExists(path) {
+		return nil
+	}
+
+	backup, err := timestampedPath(path)
+	if err != nil {
+		logger.Error().Err(err).Str("path", path).Msg("unable to determine timestamp of path")
+		return err
+	}
+
+	if fileExists(backup) {
+		logger.Debug().Str("backup", backup).Msg("backup destination also exists, finding one that does not")
+		backup, err = uniquePath(backup)
+		if err != nil {
+			return err
+		}
+	}
+
+	logger.Info().
+		Str("toBackup", backup).
+		Msg("dest path exists, moving to backup")
+	return ce.moveAndLog(ctx, taskID, cmdName, path, backup)
+}
+
+// logAndDo logs the message, then runs the function. If that fails, the error
+// is logged as well.
+func (ce *CommandExecutor) logAndDo(ctx context.Context, taskID, msg string, do func() error) error {
+	if err := ce.listener.LogProduced(ctx, taskID, msg); err != nil {
+		return err
+	}
+
+	if err := do(); err != nil {
+		msg := fmt.Sprintf("%s failed: %v", msg, err)
+		if err := ce.listener.LogProduced(ctx, taskID, msg); err != nil {
+			return err
+		}
+		return err
+	}
+	return nil
+}
+
 // moveAndLog renames a file/directory from `src` to `dest`, and logs the moveAndLog.
 // The other parameters are just for logging.
 func (ce *CommandExecutor) moveAndLog(ctx context.Context, taskID, cmdName, src, dest string) error {
@@ -151,3 +321,115 @@ func uniquePath(path string) (string, error) {
 
 	return fmt.Sprintf("%s-%03d", path, maxSuffix+1), nil
 }
+
+// checkInsideRoot returns an error when `path` is not inside directory `root`.
+// The root itself is not considered to be inside the root. Symlinks are
+// resolved, so that they cannot be used to point outside the root.
+func checkInsideRoot(root, path string) error {
+	if root == "" {
+		return errors.New("no delete root is configured on this Worker")
+	}
+
+	absRoot, err := resolvedAbsPath(root)
+	if err != nil {
+		return fmt.Errorf("resolving delete root %q: %w", root, err)
+	}
+	absPath, err := resolvedAbsPath(path)
+	if err != nil {
+		return fmt.Errorf("resolving %q: %w", path, err)
+	}
+
+	relPath, err := filepath.Rel(absRoot, absPath)
+	if err != nil {
+		return fmt.Errorf("%q is not inside the delete root %q", path, root)
+	}
+	if relPath == "." || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("%q is not inside the delete root %q", path, root)
+	}
+	return nil
+}
+
+// resolvedAbsPath returns the absolute path, with symlinks resolved. When the
+// path does not exist, symlinks are resolved for its parent directory.
+func resolvedAbsPath(path string) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	resolved, err := filepath.EvalSymlinks(absPath)
+	switch {
+	case err == nil:
+		return resolved, nil
+	case !errors.Is(err, fs.ErrNotExist):
+		return "", err
+	}
+
+	parent, base := filepath.Split(absPath)
+	if parent == absPath || base == "" {
+		return absPath, nil
+	}
+	resolvedParent, err := resolvedAbsPath(filepath.Clean(parent))
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(resolvedParent, base), nil
+}
+
+// copyFile copies the file contents and permissions from `src` to `dest`.
+func copyFile(src, dest string) error {
+	source, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	stat, err := source.Stat()
+	if err != nil {
+		return err
+	}
+
+	target, err := os.OpenFile(dest, os.O_CREATE|os.O_EXCL|os.O_WRONLY, stat.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(target, source); err != nil {
+		target.Close()
+		return err
+	}
+	if err := target.Close(); err != nil {
+		return err
+	}
+	return os.Chtimes(dest, stat.ModTime(), stat.ModTime())
+}
+
+// copyDirectory recursively copies directory `src` to `dest`. Symlinks are
+// copied as symlinks.
+func copyDirectory(src, dest string) error {
+	return filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dest, relPath)
+
+		switch {
+		case entry.IsDir():
+			return os.MkdirAll(target, fs.ModePerm)
+		case entry.Type()&fs.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case entry.Type().IsRegular():
+			return copyFile(path, target)
+		default:
+			return fmt.Errorf("cannot copy %q, it is not a regular file, directory, or symlink", path)
+		}
+	})
+}
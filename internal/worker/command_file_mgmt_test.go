@@ -278,3 +278,189 @@ func fileCreateEmpty(filename string) {
 	}
 	file.Close()
 }
+
+func TestCmdCopyFile(t *testing.T) {
+	f := newCmdMoveDirectoryFixture(t)
+	defer f.finish(t)
+
+	ensureDirExists("render")
+	fileCreateContents("render/frame.exr", "frame contents")
+
+	f.mocks.listener.EXPECT().LogProduced(gomock.Any(), taskID,
+		"copy-file: copying \"render/frame.exr\" to \"comp/input/frame.exr\"")
+	err := f.runCommand("copy-file", map[string]interface{}{
+		"src":  "render/frame.exr",
+		"dest": "comp/input/frame.exr",
+	})
+	assert.NoError(t, err)
+
+	assert.FileExists(t, "render/frame.exr", "the source file should still exist")
+	assertFileContents(t, "comp/input/frame.exr", "frame contents")
+}
+
+func TestCmdCopyFileExistingDest(t *testing.T) {
+	f := newCmdMoveDirectoryFixture(t)
+	defer f.finish(t)
+
+	mtime, err := time.Parse(time.RFC3339, "2006-01-02T15:04:05-07:00")
+	assert.NoError(t, err)
+
+	fileCreateContents("new.txt", "new contents")
+	fileCreateContents("old.txt", "old contents")
+	if err := os.Chtimes("old.txt", mtime, mtime); err != nil {
+		t.Fatalf("changing file time: %v", err)
+	}
+
+	// This cannot be a hard-coded string, as the test would fail in other timezones.
+	backupPath := "old.txt-2006-01-02_" + mtime.Local().Format("150405")
+
+	f.mocks.listener.EXPECT().LogProduced(gomock.Any(), taskID,
+		fmt.Sprintf("copy-file: moving \"old.txt\" to %q", backupPath))
+	f.mocks.listener.EXPECT().LogProduced(gomock.Any(), taskID,
+		"copy-file: copying \"new.txt\" to \"old.txt\"")
+	err = f.runCommand("copy-file", map[string]interface{}{
+		"src":  "new.txt",
+		"dest": "old.txt",
+	})
+	assert.NoError(t, err)
+
+	assertFileContents(t, "old.txt", "new contents")
+	assertFileContents(t, backupPath, "old contents")
+}
+
+func TestCmdCopyFileNonExistentSource(t *testing.T) {
+	f := newCmdMoveDirectoryFixture(t)
+	defer f.finish(t)
+
+	f.mocks.listener.EXPECT().LogProduced(gomock.Any(), taskID,
+		"copy-file: source path \"nothere.txt\" does not exist, not copying anything")
+	err := f.runCommand("copy-file", map[string]interface{}{
+		"src":  "nothere.txt",
+		"dest": "dest.txt",
+	})
+	var paramErr ParameterInvalidError
+	if assert.ErrorAs(t, err, &paramErr) {
+		assert.Equal(t, "src", paramErr.Parameter)
+	}
+	assert.NoFileExists(t, "dest.txt")
+}
+
+func TestCmdCopyDirectory(t *testing.T) {
+	f := newCmdMoveDirectoryFixture(t)
+	defer f.finish(t)
+
+	ensureDirExists(filepath.Join(sourcePath, "subdir"))
+	fileCreateContents(filepath.Join(sourcePath, "file.txt"), "file")
+	fileCreateContents(filepath.Join(sourcePath, "subdir", "subfile.txt"), "subfile")
+
+	f.mocks.listener.EXPECT().LogProduced(gomock.Any(), taskID,
+		"copy-directory: copying \"render/output/here__intermediate\" to \"render/output/here\"")
+	err := f.runCommand("copy-directory", map[string]interface{}{
+		"src":  sourcePath,
+		"dest": destPath,
+	})
+	assert.NoError(t, err)
+
+	assertFileContents(t, filepath.Join(sourcePath, "file.txt"), "file")
+	assertFileContents(t, filepath.Join(destPath, "file.txt"), "file")
+	assertFileContents(t, filepath.Join(destPath, "subdir", "subfile.txt"), "subfile")
+}
+
+func TestCmdDeletePath(t *testing.T) {
+	f := newCmdMoveDirectoryFixture(t)
+	defer f.finish(t)
+	f.ce.deleteRoot = "render"
+
+	ensureDirExists(sourcePath)
+	fileCreateEmpty(filepath.Join(sourcePath, "testfile.txt"))
+	fileCreateEmpty("outside.txt")
+
+	f.mocks.listener.EXPECT().LogProduced(gomock.Any(), taskID,
+		"delete-path: deleting \"render/output/here__intermediate\"")
+	assert.NoError(t, f.runCommand("delete-path", map[string]interface{}{"path": sourcePath}))
+	assert.NoDirExists(t, sourcePath)
+
+	// Deleting something that doesn't exist is fine.
+	f.mocks.listener.EXPECT().LogProduced(gomock.Any(), taskID,
+		"delete-path: path \"render/output/here__intermediate\" does not exist, not deleting anything")
+	assert.NoError(t, f.runCommand("delete-path", map[string]interface{}{"path": sourcePath}))
+
+	// Paths outside the root, and the root itself, should not be deleted.
+	for _, path := range []string{"outside.txt", "render/../outside.txt", "render", "."} {
+		err := f.runCommand("delete-path", map[string]interface{}{"path": path})
+		var paramErr ParameterInvalidError
+		if assert.ErrorAs(t, err, &paramErr, "path=%q", path) {
+			assert.Equal(t, "path", paramErr.Parameter)
+		}
+	}
+	assert.FileExists(t, "outside.txt")
+	assert.DirExists(t, "render")
+}
+
+func TestCmdDeletePathSymlinkOutsideRoot(t *testing.T) {
+	f := newCmdMoveDirectoryFixture(t)
+	defer f.finish(t)
+	f.ce.deleteRoot = "render"
+
+	ensureDirExists("render")
+	ensureDirExists("precious")
+	fileCreateEmpty("precious/file.txt")
+	if err := os.Symlink(filepath.Join(f.temppath, "precious"), "render/link"); err != nil {
+		t.Skipf("unable to create symlink: %v", err)
+	}
+
+	err := f.runCommand("delete-path", map[string]interface{}{"path": "render/link/file.txt"})
+	assert.ErrorAs(t, err, &ParameterInvalidError{})
+	assert.FileExists(t, "precious/file.txt")
+}
+
+func TestCmdDeletePathNoRoot(t *testing.T) {
+	f := newCmdMoveDirectoryFixture(t)
+	defer f.finish(t)
+
+	fileCreateEmpty("file.txt")
+	err := f.runCommand("delete-path", map[string]interface{}{"path": "file.txt"})
+	assert.ErrorAs(t, err, &ParameterInvalidError{})
+	assert.FileExists(t, "file.txt")
+}
+
+func TestCmdMkdir(t *testing.T) {
+	f := newCmdMoveDirectoryFixture(t)
+	defer f.finish(t)
+
+	f.mocks.listener.EXPECT().LogProduced(gomock.Any(), taskID,
+		"mkdir: creating directory \"render/output/here\"")
+	assert.NoError(t, f.runCommand("mkdir", map[string]interface{}{"path": destPath}))
+	assert.DirExists(t, destPath)
+
+	// Creating an existing directory is fine.
+	f.mocks.listener.EXPECT().LogProduced(gomock.Any(), taskID,
+		"mkdir: creating directory \"render/output/here\"")
+	assert.NoError(t, f.runCommand("mkdir", map[string]interface{}{"path": destPath}))
+
+	// A file is in the way.
+	fileCreateEmpty("file.txt")
+	err := f.runCommand("mkdir", map[string]interface{}{"path": "file.txt"})
+	assert.ErrorAs(t, err, &ParameterInvalidError{})
+}
+
+func (f cmdMoveDirFixture) runCommand(name string, parameters map[string]interface{}) error {
+	cmd := api.Command{
+		Name:       name,
+		Parameters: parameters,
+	}
+	return f.ce.Run(f.ctx, taskID, cmd)
+}
+
+func fileCreateContents(filename, contents string) {
+	if err := os.WriteFile(filename, []byte(contents), 0666); err != nil {
+		panic(err.Error())
+	}
+}
+
+func assertFileContents(t *testing.T, filename, expectContents string) {
+	contents, err := os.ReadFile(filename)
+	if assert.NoError(t, err) {
+		assert.Equal(t, expectContents, string(contents), "contents of %s", filename)
+	}
+}
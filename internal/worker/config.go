@@ -43,6 +43,37 @@ type WorkerConfig struct {
 	ManagerURL string `yaml:"-"`
 
 	TaskTypes []string `yaml:"task_types"`
+
+	// Resources offered by this Worker. Jobs can require certain resources, and
+	// will only be run by Workers that offer them.
+	Resources WorkerResources `yaml:"resources,omitempty"`
+
+	// ExecAllowlist contains the executables that jobs can run with the "exec"
+	// command. Names without directory are searched for on $PATH; paths only
+	// allow that specific executable.
+	ExecAllowlist []string `yaml:"exec_allowlist,omitempty"`
+
+	// ExecEnvAllowlist contains the environment variables that jobs can set for
+	// the "exec" command. Other variables are refused, as variables like
+	// LD_PRELOAD could make an allowed executable run arbitrary code.
+	ExecEnvAllowlist []string `yaml:"exec_env_allowlist,omitempty"`
+
+	// DeleteRoot is the directory in which the "delete-path" command can delete
+	// files and directories. When empty, nothing can be deleted.
+	DeleteRoot string `yaml:"delete_root,omitempty"`
+
+	// TaskSlots is the number of tasks of each type this Worker runs at the
+	// same time. Task types that are not mentioned get one slot each. When
+	// empty, the Worker runs one task at a time.
+	TaskSlots map[string]int `yaml:"task_slots,omitempty"`
+}
+
+// WorkerResources describes the hardware and software of the Worker. CPU cores
+// and memory are detected automatically when they are not configured.
+type WorkerResources struct {
+	CPUCores     int               `yaml:"cpu_cores,omitempty"`
+	MemoryMB     int               `yaml:"memory_mb,omitempty"`
+	Capabilities map[string]string `yaml:"capabilities,omitempty"`
 }
 
 type WorkerCredentials struct {
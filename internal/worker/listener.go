@@ -102,6 +102,13 @@ func (l *Listener) OutputProduced(ctx context.Context, taskID string, outputLoca
 	return nil
 }
 
+// ProgressProduced tells the Manager how far along the task is, as percentage.
+func (l *Listener) ProgressProduced(ctx context.Context, taskID string, progress int) error {
+	return l.sendTaskUpdate(ctx, taskID, api.TaskUpdateJSONRequestBody{
+		Progress: &progress,
+	})
+}
+
 func (l *Listener) sendTaskUpdate(ctx context.Context, taskID string, update api.TaskUpdateJSONRequestBody) error {
 	if ctx.Err() != nil {
 		return ctx.Err()
@@ -36,6 +36,26 @@ func (m *MockFlamencoClient) EXPECT() *MockFlamencoClientMockRecorder {
 	return m.recorder
 }
 
+// BackupDatabaseWithResponse mocks base method.
+func (m *MockFlamencoClient) BackupDatabaseWithResponse(arg0 context.Context, arg1 ...api.RequestEditorFn) (*api.BackupDatabaseResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BackupDatabaseWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.BackupDatabaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupDatabaseWithResponse indicates an expected call of BackupDatabaseWithResponse.
+func (mr *MockFlamencoClientMockRecorder) BackupDatabaseWithResponse(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupDatabaseWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).BackupDatabaseWithResponse), varargs...)
+}
+
 // CheckBlenderExePathWithBodyWithResponse mocks base method.
 func (m *MockFlamencoClient) CheckBlenderExePathWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...api.RequestEditorFn) (*api.CheckBlenderExePathResponse, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +136,246 @@ func (mr *MockFlamencoClientMockRecorder) CheckSharedStoragePathWithResponse(arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSharedStoragePathWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).CheckSharedStoragePathWithResponse), varargs...)
 }
 
+// CreateUserWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) CreateUserWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...api.RequestEditorFn) (*api.CreateUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateUserWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.CreateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserWithBodyWithResponse indicates an expected call of CreateUserWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) CreateUserWithBodyWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).CreateUserWithBodyWithResponse), varargs...)
+}
+
+// CreateUserWithResponse mocks base method.
+func (m *MockFlamencoClient) CreateUserWithResponse(arg0 context.Context, arg1 api.CreateUserJSONRequestBody, arg2 ...api.RequestEditorFn) (*api.CreateUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateUserWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.CreateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserWithResponse indicates an expected call of CreateUserWithResponse.
+func (mr *MockFlamencoClientMockRecorder) CreateUserWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).CreateUserWithResponse), varargs...)
+}
+
+// CreateWorkerTagWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) CreateWorkerTagWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...api.RequestEditorFn) (*api.CreateWorkerTagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWorkerTagWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.CreateWorkerTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkerTagWithBodyWithResponse indicates an expected call of CreateWorkerTagWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) CreateWorkerTagWithBodyWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkerTagWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).CreateWorkerTagWithBodyWithResponse), varargs...)
+}
+
+// CreateWorkerTagWithResponse mocks base method.
+func (m *MockFlamencoClient) CreateWorkerTagWithResponse(arg0 context.Context, arg1 api.CreateWorkerTagJSONRequestBody, arg2 ...api.RequestEditorFn) (*api.CreateWorkerTagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWorkerTagWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.CreateWorkerTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkerTagWithResponse indicates an expected call of CreateWorkerTagWithResponse.
+func (mr *MockFlamencoClientMockRecorder) CreateWorkerTagWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkerTagWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).CreateWorkerTagWithResponse), varargs...)
+}
+
+// DeleteJobMassWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) DeleteJobMassWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...api.RequestEditorFn) (*api.DeleteJobMassResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteJobMassWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.DeleteJobMassResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteJobMassWithBodyWithResponse indicates an expected call of DeleteJobMassWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) DeleteJobMassWithBodyWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJobMassWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).DeleteJobMassWithBodyWithResponse), varargs...)
+}
+
+// DeleteJobMassWithResponse mocks base method.
+func (m *MockFlamencoClient) DeleteJobMassWithResponse(arg0 context.Context, arg1 api.DeleteJobMassJSONRequestBody, arg2 ...api.RequestEditorFn) (*api.DeleteJobMassResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteJobMassWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.DeleteJobMassResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteJobMassWithResponse indicates an expected call of DeleteJobMassWithResponse.
+func (mr *MockFlamencoClientMockRecorder) DeleteJobMassWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJobMassWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).DeleteJobMassWithResponse), varargs...)
+}
+
+// DeleteJobWithResponse mocks base method.
+func (m *MockFlamencoClient) DeleteJobWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.DeleteJobResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteJobWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.DeleteJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteJobWithResponse indicates an expected call of DeleteJobWithResponse.
+func (mr *MockFlamencoClientMockRecorder) DeleteJobWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJobWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).DeleteJobWithResponse), varargs...)
+}
+
+// DeleteUserWithResponse mocks base method.
+func (m *MockFlamencoClient) DeleteUserWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.DeleteUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteUserWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.DeleteUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserWithResponse indicates an expected call of DeleteUserWithResponse.
+func (mr *MockFlamencoClientMockRecorder) DeleteUserWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).DeleteUserWithResponse), varargs...)
+}
+
+// DeleteWorkerTagWithResponse mocks base method.
+func (m *MockFlamencoClient) DeleteWorkerTagWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.DeleteWorkerTagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWorkerTagWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.DeleteWorkerTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWorkerTagWithResponse indicates an expected call of DeleteWorkerTagWithResponse.
+func (mr *MockFlamencoClientMockRecorder) DeleteWorkerTagWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkerTagWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).DeleteWorkerTagWithResponse), varargs...)
+}
+
+// EditJobWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) EditJobWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...api.RequestEditorFn) (*api.EditJobResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EditJobWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.EditJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditJobWithBodyWithResponse indicates an expected call of EditJobWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) EditJobWithBodyWithResponse(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditJobWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).EditJobWithBodyWithResponse), varargs...)
+}
+
+// EditJobWithResponse mocks base method.
+func (m *MockFlamencoClient) EditJobWithResponse(arg0 context.Context, arg1 string, arg2 api.EditJobJSONRequestBody, arg3 ...api.RequestEditorFn) (*api.EditJobResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EditJobWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.EditJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditJobWithResponse indicates an expected call of EditJobWithResponse.
+func (mr *MockFlamencoClientMockRecorder) EditJobWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditJobWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).EditJobWithResponse), varargs...)
+}
+
+// FetchCurrentUserWithResponse mocks base method.
+func (m *MockFlamencoClient) FetchCurrentUserWithResponse(arg0 context.Context, arg1 ...api.RequestEditorFn) (*api.FetchCurrentUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchCurrentUserWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.FetchCurrentUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchCurrentUserWithResponse indicates an expected call of FetchCurrentUserWithResponse.
+func (mr *MockFlamencoClientMockRecorder) FetchCurrentUserWithResponse(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchCurrentUserWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchCurrentUserWithResponse), varargs...)
+}
+
 // FetchGlobalLastRenderedInfoWithResponse mocks base method.
 func (m *MockFlamencoClient) FetchGlobalLastRenderedInfoWithResponse(arg0 context.Context, arg1 ...api.RequestEditorFn) (*api.FetchGlobalLastRenderedInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +416,26 @@ func (mr *MockFlamencoClientMockRecorder) FetchJobBlocklistWithResponse(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobBlocklistWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchJobBlocklistWithResponse), varargs...)
 }
 
+// FetchJobHistoryWithResponse mocks base method.
+func (m *MockFlamencoClient) FetchJobHistoryWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.FetchJobHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchJobHistoryWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.FetchJobHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchJobHistoryWithResponse indicates an expected call of FetchJobHistoryWithResponse.
+func (mr *MockFlamencoClientMockRecorder) FetchJobHistoryWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchJobHistoryWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchJobHistoryWithResponse), varargs...)
+}
+
 // FetchJobLastRenderedInfoWithResponse mocks base method.
 func (m *MockFlamencoClient) FetchJobLastRenderedInfoWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.FetchJobLastRenderedInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -276,6 +556,26 @@ func (mr *MockFlamencoClientMockRecorder) FetchTaskWithResponse(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaskWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchTaskWithResponse), varargs...)
 }
 
+// FetchUsersWithResponse mocks base method.
+func (m *MockFlamencoClient) FetchUsersWithResponse(arg0 context.Context, arg1 ...api.RequestEditorFn) (*api.FetchUsersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchUsersWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.FetchUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchUsersWithResponse indicates an expected call of FetchUsersWithResponse.
+func (mr *MockFlamencoClientMockRecorder) FetchUsersWithResponse(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUsersWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchUsersWithResponse), varargs...)
+}
+
 // FetchWorkerSleepScheduleWithResponse mocks base method.
 func (m *MockFlamencoClient) FetchWorkerSleepScheduleWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.FetchWorkerSleepScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -296,6 +596,46 @@ func (mr *MockFlamencoClientMockRecorder) FetchWorkerSleepScheduleWithResponse(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchWorkerSleepScheduleWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchWorkerSleepScheduleWithResponse), varargs...)
 }
 
+// FetchWorkerTagWithResponse mocks base method.
+func (m *MockFlamencoClient) FetchWorkerTagWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.FetchWorkerTagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchWorkerTagWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.FetchWorkerTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchWorkerTagWithResponse indicates an expected call of FetchWorkerTagWithResponse.
+func (mr *MockFlamencoClientMockRecorder) FetchWorkerTagWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchWorkerTagWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchWorkerTagWithResponse), varargs...)
+}
+
+// FetchWorkerTagsWithResponse mocks base method.
+func (m *MockFlamencoClient) FetchWorkerTagsWithResponse(arg0 context.Context, arg1 ...api.RequestEditorFn) (*api.FetchWorkerTagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchWorkerTagsWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.FetchWorkerTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchWorkerTagsWithResponse indicates an expected call of FetchWorkerTagsWithResponse.
+func (mr *MockFlamencoClientMockRecorder) FetchWorkerTagsWithResponse(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchWorkerTagsWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).FetchWorkerTagsWithResponse), varargs...)
+}
+
 // FetchWorkerWithResponse mocks base method.
 func (m *MockFlamencoClient) FetchWorkerWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.FetchWorkerResponse, error) {
 	m.ctrl.T.Helper()
@@ -476,6 +816,66 @@ func (mr *MockFlamencoClientMockRecorder) GetVersionWithResponse(arg0 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersionWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).GetVersionWithResponse), varargs...)
 }
 
+// LoginUserWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) LoginUserWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...api.RequestEditorFn) (*api.LoginUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LoginUserWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.LoginUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginUserWithBodyWithResponse indicates an expected call of LoginUserWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) LoginUserWithBodyWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginUserWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).LoginUserWithBodyWithResponse), varargs...)
+}
+
+// LoginUserWithResponse mocks base method.
+func (m *MockFlamencoClient) LoginUserWithResponse(arg0 context.Context, arg1 api.LoginUserJSONRequestBody, arg2 ...api.RequestEditorFn) (*api.LoginUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LoginUserWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.LoginUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginUserWithResponse indicates an expected call of LoginUserWithResponse.
+func (mr *MockFlamencoClientMockRecorder) LoginUserWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginUserWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).LoginUserWithResponse), varargs...)
+}
+
+// LogoutUserWithResponse mocks base method.
+func (m *MockFlamencoClient) LogoutUserWithResponse(arg0 context.Context, arg1 ...api.RequestEditorFn) (*api.LogoutUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LogoutUserWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.LogoutUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogoutUserWithResponse indicates an expected call of LogoutUserWithResponse.
+func (mr *MockFlamencoClientMockRecorder) LogoutUserWithResponse(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogoutUserWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).LogoutUserWithResponse), varargs...)
+}
+
 // MayWorkerRunWithResponse mocks base method.
 func (m *MockFlamencoClient) MayWorkerRunWithResponse(arg0 context.Context, arg1 string, arg2 ...api.RequestEditorFn) (*api.MayWorkerRunResponse, error) {
 	m.ctrl.T.Helper()
@@ -697,10 +1097,10 @@ func (mr *MockFlamencoClientMockRecorder) SaveSetupAssistantConfigWithResponse(a
 }
 
 // ScheduleTaskWithResponse mocks base method.
-func (m *MockFlamencoClient) ScheduleTaskWithResponse(arg0 context.Context, arg1 ...api.RequestEditorFn) (*api.ScheduleTaskResponse, error) {
+func (m *MockFlamencoClient) ScheduleTaskWithResponse(arg0 context.Context, arg1 *api.ScheduleTaskParams, arg2 ...api.RequestEditorFn) (*api.ScheduleTaskResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0}
-	for _, a := range arg1 {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ScheduleTaskWithResponse", varargs...)
@@ -710,12 +1110,92 @@ func (m *MockFlamencoClient) ScheduleTaskWithResponse(arg0 context.Context, arg1
 }
 
 // ScheduleTaskWithResponse indicates an expected call of ScheduleTaskWithResponse.
-func (mr *MockFlamencoClientMockRecorder) ScheduleTaskWithResponse(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+func (mr *MockFlamencoClientMockRecorder) ScheduleTaskWithResponse(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0}, arg1...)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleTaskWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).ScheduleTaskWithResponse), varargs...)
 }
 
+// SetJobMaxWorkersWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) SetJobMaxWorkersWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...api.RequestEditorFn) (*api.SetJobMaxWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetJobMaxWorkersWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.SetJobMaxWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetJobMaxWorkersWithBodyWithResponse indicates an expected call of SetJobMaxWorkersWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) SetJobMaxWorkersWithBodyWithResponse(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetJobMaxWorkersWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).SetJobMaxWorkersWithBodyWithResponse), varargs...)
+}
+
+// SetJobMaxWorkersWithResponse mocks base method.
+func (m *MockFlamencoClient) SetJobMaxWorkersWithResponse(arg0 context.Context, arg1 string, arg2 api.SetJobMaxWorkersJSONRequestBody, arg3 ...api.RequestEditorFn) (*api.SetJobMaxWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetJobMaxWorkersWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.SetJobMaxWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetJobMaxWorkersWithResponse indicates an expected call of SetJobMaxWorkersWithResponse.
+func (mr *MockFlamencoClientMockRecorder) SetJobMaxWorkersWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetJobMaxWorkersWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).SetJobMaxWorkersWithResponse), varargs...)
+}
+
+// SetJobPriorityWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) SetJobPriorityWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...api.RequestEditorFn) (*api.SetJobPriorityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetJobPriorityWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.SetJobPriorityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetJobPriorityWithBodyWithResponse indicates an expected call of SetJobPriorityWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) SetJobPriorityWithBodyWithResponse(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetJobPriorityWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).SetJobPriorityWithBodyWithResponse), varargs...)
+}
+
+// SetJobPriorityWithResponse mocks base method.
+func (m *MockFlamencoClient) SetJobPriorityWithResponse(arg0 context.Context, arg1 string, arg2 api.SetJobPriorityJSONRequestBody, arg3 ...api.RequestEditorFn) (*api.SetJobPriorityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetJobPriorityWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.SetJobPriorityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetJobPriorityWithResponse indicates an expected call of SetJobPriorityWithResponse.
+func (mr *MockFlamencoClientMockRecorder) SetJobPriorityWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetJobPriorityWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).SetJobPriorityWithResponse), varargs...)
+}
+
 // SetJobStatusWithBodyWithResponse mocks base method.
 func (m *MockFlamencoClient) SetJobStatusWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...api.RequestEditorFn) (*api.SetJobStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -836,6 +1316,46 @@ func (mr *MockFlamencoClientMockRecorder) SetWorkerSleepScheduleWithResponse(arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkerSleepScheduleWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).SetWorkerSleepScheduleWithResponse), varargs...)
 }
 
+// SetWorkerTagsWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) SetWorkerTagsWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...api.RequestEditorFn) (*api.SetWorkerTagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetWorkerTagsWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.SetWorkerTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWorkerTagsWithBodyWithResponse indicates an expected call of SetWorkerTagsWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) SetWorkerTagsWithBodyWithResponse(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkerTagsWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).SetWorkerTagsWithBodyWithResponse), varargs...)
+}
+
+// SetWorkerTagsWithResponse mocks base method.
+func (m *MockFlamencoClient) SetWorkerTagsWithResponse(arg0 context.Context, arg1 string, arg2 api.SetWorkerTagsJSONRequestBody, arg3 ...api.RequestEditorFn) (*api.SetWorkerTagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetWorkerTagsWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.SetWorkerTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWorkerTagsWithResponse indicates an expected call of SetWorkerTagsWithResponse.
+func (mr *MockFlamencoClientMockRecorder) SetWorkerTagsWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkerTagsWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).SetWorkerTagsWithResponse), varargs...)
+}
+
 // ShamanCheckoutRequirementsWithBodyWithResponse mocks base method.
 func (m *MockFlamencoClient) ShamanCheckoutRequirementsWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...api.RequestEditorFn) (*api.ShamanCheckoutRequirementsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1016,6 +1536,46 @@ func (mr *MockFlamencoClientMockRecorder) SignOnWithResponse(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignOnWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).SignOnWithResponse), varargs...)
 }
 
+// SubmitJobDryRunWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) SubmitJobDryRunWithBodyWithResponse(arg0 context.Context, arg1 *api.SubmitJobDryRunParams, arg2 string, arg3 io.Reader, arg4 ...api.RequestEditorFn) (*api.SubmitJobDryRunResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubmitJobDryRunWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.SubmitJobDryRunResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitJobDryRunWithBodyWithResponse indicates an expected call of SubmitJobDryRunWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) SubmitJobDryRunWithBodyWithResponse(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitJobDryRunWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).SubmitJobDryRunWithBodyWithResponse), varargs...)
+}
+
+// SubmitJobDryRunWithResponse mocks base method.
+func (m *MockFlamencoClient) SubmitJobDryRunWithResponse(arg0 context.Context, arg1 *api.SubmitJobDryRunParams, arg2 api.SubmitJobDryRunJSONRequestBody, arg3 ...api.RequestEditorFn) (*api.SubmitJobDryRunResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubmitJobDryRunWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.SubmitJobDryRunResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitJobDryRunWithResponse indicates an expected call of SubmitJobDryRunWithResponse.
+func (mr *MockFlamencoClientMockRecorder) SubmitJobDryRunWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitJobDryRunWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).SubmitJobDryRunWithResponse), varargs...)
+}
+
 // SubmitJobWithBodyWithResponse mocks base method.
 func (m *MockFlamencoClient) SubmitJobWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...api.RequestEditorFn) (*api.SubmitJobResponse, error) {
 	m.ctrl.T.Helper()
@@ -1116,6 +1676,86 @@ func (mr *MockFlamencoClientMockRecorder) TaskUpdateWithResponse(arg0, arg1, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskUpdateWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).TaskUpdateWithResponse), varargs...)
 }
 
+// UpdateWorkerTagWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) UpdateWorkerTagWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...api.RequestEditorFn) (*api.UpdateWorkerTagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkerTagWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.UpdateWorkerTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkerTagWithBodyWithResponse indicates an expected call of UpdateWorkerTagWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) UpdateWorkerTagWithBodyWithResponse(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerTagWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).UpdateWorkerTagWithBodyWithResponse), varargs...)
+}
+
+// UpdateWorkerTagWithResponse mocks base method.
+func (m *MockFlamencoClient) UpdateWorkerTagWithResponse(arg0 context.Context, arg1 string, arg2 api.UpdateWorkerTagJSONRequestBody, arg3 ...api.RequestEditorFn) (*api.UpdateWorkerTagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkerTagWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.UpdateWorkerTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkerTagWithResponse indicates an expected call of UpdateWorkerTagWithResponse.
+func (mr *MockFlamencoClientMockRecorder) UpdateWorkerTagWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerTagWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).UpdateWorkerTagWithResponse), varargs...)
+}
+
+// ValidateJobTypeWithBodyWithResponse mocks base method.
+func (m *MockFlamencoClient) ValidateJobTypeWithBodyWithResponse(arg0 context.Context, arg1, arg2 string, arg3 io.Reader, arg4 ...api.RequestEditorFn) (*api.ValidateJobTypeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateJobTypeWithBodyWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.ValidateJobTypeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateJobTypeWithBodyWithResponse indicates an expected call of ValidateJobTypeWithBodyWithResponse.
+func (mr *MockFlamencoClientMockRecorder) ValidateJobTypeWithBodyWithResponse(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateJobTypeWithBodyWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).ValidateJobTypeWithBodyWithResponse), varargs...)
+}
+
+// ValidateJobTypeWithResponse mocks base method.
+func (m *MockFlamencoClient) ValidateJobTypeWithResponse(arg0 context.Context, arg1 string, arg2 api.ValidateJobTypeJSONRequestBody, arg3 ...api.RequestEditorFn) (*api.ValidateJobTypeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateJobTypeWithResponse", varargs...)
+	ret0, _ := ret[0].(*api.ValidateJobTypeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateJobTypeWithResponse indicates an expected call of ValidateJobTypeWithResponse.
+func (mr *MockFlamencoClientMockRecorder) ValidateJobTypeWithResponse(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateJobTypeWithResponse", reflect.TypeOf((*MockFlamencoClient)(nil).ValidateJobTypeWithResponse), varargs...)
+}
+
 // WorkerStateChangedWithBodyWithResponse mocks base method.
 func (m *MockFlamencoClient) WorkerStateChangedWithBodyWithResponse(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 ...api.RequestEditorFn) (*api.WorkerStateChangedResponse, error) {
 	m.ctrl.T.Helper()
@@ -49,6 +49,9 @@ func RegisterOrSignOn(ctx context.Context, configWrangler WorkerConfigWithCreden
 		log.Fatal().Msg("no Manager configured")
 	}
 
+	// Detect the resources once, instead of on every sign-on attempt.
+	cfg.Resources = detectResources(cfg.Resources)
+
 	// Load credentials
 	creds, err := configWrangler.WorkerCredentials()
 	if err == nil {
@@ -154,12 +157,22 @@ func signOn(ctx context.Context, cfg WorkerConfig, client FlamencoClient) (api.W
 		Name:               workerName(),
 		SupportedTaskTypes: cfg.TaskTypes,
 		SoftwareVersion:    appinfo.ExtendedVersion(),
+		Resources:          cfg.Resources.toAPI(),
+	}
+	if len(cfg.TaskSlots) > 0 {
+		req.TaskSlots = &api.WorkerSignOn_TaskSlots{
+			AdditionalProperties: cfg.TaskSlots,
+		}
 	}
 
 	logger.Info().
 		Str("name", req.Name).
 		Str("softwareVersion", req.SoftwareVersion).
 		Interface("taskTypes", req.SupportedTaskTypes).
+		Int("cpuCores", cfg.Resources.CPUCores).
+		Int("memoryMB", cfg.Resources.MemoryMB).
+		Interface("capabilities", cfg.Resources.Capabilities).
+		Interface("taskSlots", cfg.TaskSlots).
 		Msg("signing on at Manager")
 
 	resp, err := client.SignOnWithResponse(ctx, req)
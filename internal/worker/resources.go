@@ -0,0 +1,47 @@
+package worker
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"runtime"
+
+	"github.com/rs/zerolog/log"
+
+	"git.blender.org/flamenco/pkg/api"
+)
+
+// detectResources returns the configured resources, with the CPU cores and
+// memory detected from the system when they are not configured.
+func detectResources(configured WorkerResources) WorkerResources {
+	resources := configured
+
+	if resources.CPUCores <= 0 {
+		resources.CPUCores = runtime.NumCPU()
+	}
+
+	if resources.MemoryMB <= 0 {
+		memoryMB, err := detectMemoryMB()
+		if err != nil {
+			log.Warn().Err(err).Msg("unable to detect the amount of memory, configure it in resources.memory_mb")
+		}
+		resources.MemoryMB = memoryMB
+	}
+
+	return resources
+}
+
+// toAPI converts the resources to the type used in the API.
+func (r WorkerResources) toAPI() *api.WorkerResources {
+	cpuCores := r.CPUCores
+	memoryMB := r.MemoryMB
+	resources := api.WorkerResources{
+		CpuCores: &cpuCores,
+		MemoryMb: &memoryMB,
+	}
+	if len(r.Capabilities) > 0 {
+		resources.Capabilities = &api.WorkerResources_Capabilities{
+			AdditionalProperties: r.Capabilities,
+		}
+	}
+	return &resources
+}
@@ -0,0 +1,52 @@
+//go:build linux
+
+package worker
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var errMemTotalNotFound = errors.New("MemTotal not found")
+
+// detectMemoryMB returns the total amount of RAM in megabytes.
+func detectMemoryMB() (int, error) {
+	file, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	return parseMeminfo(file)
+}
+
+// parseMeminfo returns the total amount of RAM in megabytes, from the contents
+// of /proc/meminfo.
+func parseMeminfo(meminfo io.Reader) (int, error) {
+	scanner := bufio.NewScanner(meminfo)
+	for scanner.Scan() {
+		// The line looks like "MemTotal:       16314924 kB".
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 || fields[0] != "MemTotal:" {
+			continue
+		}
+		if fields[2] != "kB" {
+			return 0, fmt.Errorf("unexpected unit %q for MemTotal", fields[2])
+		}
+		kilobytes, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return 0, fmt.Errorf("parsing MemTotal: %w", err)
+		}
+		return kilobytes / 1024, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, errMemTotalNotFound
+}
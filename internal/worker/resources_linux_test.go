@@ -0,0 +1,39 @@
+//go:build linux
+
+package worker
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMeminfo(t *testing.T) {
+	meminfo := `MemTotal:       16314924 kB
+MemFree:         1264012 kB
+MemAvailable:    9327268 kB
+`
+	memoryMB, err := parseMeminfo(strings.NewReader(meminfo))
+	assert.NoError(t, err)
+	assert.Equal(t, 15932, memoryMB)
+
+	_, err = parseMeminfo(strings.NewReader("MemFree: 1264012 kB\n"))
+	assert.ErrorIs(t, err, errMemTotalNotFound)
+}
+
+func TestDetectResources(t *testing.T) {
+	// Configured values should not be overwritten.
+	configured := WorkerResources{
+		CPUCores:     3,
+		MemoryMB:     47,
+		Capabilities: map[string]string{"blender_version": "3.3"},
+	}
+	assert.Equal(t, configured, detectResources(configured))
+
+	detected := detectResources(WorkerResources{})
+	assert.Positive(t, detected.CPUCores)
+	assert.Positive(t, detected.MemoryMB)
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+
+package worker
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import "errors"
+
+// detectMemoryMB is only implemented for Linux. On other platforms the memory
+// has to be configured.
+func detectMemoryMB() (int, error) {
+	return 0, errors.New("memory detection is only supported on Linux")
+}
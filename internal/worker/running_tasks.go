@@ -0,0 +1,62 @@
+package worker
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"sort"
+	"sync"
+)
+
+// runningTasks keeps track of the tasks that are running at the same time, on
+// a Worker with task slots.
+type runningTasks struct {
+	mutex sync.Mutex
+	uuids map[string]struct{}
+	wg    sync.WaitGroup
+}
+
+func newRunningTasks() *runningTasks {
+	return &runningTasks{
+		uuids: map[string]struct{}{},
+	}
+}
+
+// start marks the task as running. Every call must be followed by a call to
+// done() with the same task UUID.
+func (rt *runningTasks) start(taskUUID string) {
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+
+	rt.uuids[taskUUID] = struct{}{}
+	rt.wg.Add(1)
+}
+
+// done marks the task as no longer running.
+func (rt *runningTasks) done(taskUUID string) {
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+
+	if _, ok := rt.uuids[taskUUID]; !ok {
+		return
+	}
+	delete(rt.uuids, taskUUID)
+	rt.wg.Done()
+}
+
+// list returns the UUIDs of the running tasks, sorted for predictability.
+func (rt *runningTasks) list() []string {
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+
+	uuids := make([]string, 0, len(rt.uuids))
+	for uuid := range rt.uuids {
+		uuids = append(uuids, uuid)
+	}
+	sort.Strings(uuids)
+	return uuids
+}
+
+// wait blocks until all running tasks are done.
+func (rt *runningTasks) wait() {
+	rt.wg.Wait()
+}
@@ -0,0 +1,43 @@
+package worker
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunningTasks(t *testing.T) {
+	rt := newRunningTasks()
+	assert.Empty(t, rt.list())
+
+	rt.start("task-b")
+	rt.start("task-a")
+	assert.Equal(t, []string{"task-a", "task-b"}, rt.list())
+
+	waitDone := make(chan struct{})
+	go func() {
+		rt.wait()
+		close(waitDone)
+	}()
+
+	rt.done("task-b")
+	rt.done("task-b") // Should be ignored.
+	assert.Equal(t, []string{"task-a"}, rt.list())
+
+	select {
+	case <-waitDone:
+		t.Fatal("wait() should block while a task is running")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	rt.done("task-a")
+	select {
+	case <-waitDone:
+	case <-time.After(time.Second):
+		t.Fatal("wait() should return when all tasks are done")
+	}
+	assert.Empty(t, rt.list())
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/rs/zerolog/log"
 
+	"git.blender.org/flamenco/internal/appinfo"
 	"git.blender.org/flamenco/pkg/api"
 )
 
@@ -20,6 +21,10 @@ const (
 	durationFetchFailed  = 10 * time.Second // ... if fetching failed somehow.
 	durationTaskComplete = 2 * time.Second  // ... when a task was completed.
 
+	// How long the Manager should wait for a task to become available, when it
+	// supports this. Without support, the Worker falls back to polling.
+	durationLongPoll = 25 * time.Second
+
 	mayKeepRunningPeriod = 10 * time.Second
 )
 
@@ -66,46 +71,111 @@ func (w *Worker) runStateAwake(ctx context.Context) {
 	defer w.doneWg.Done()
 	defer log.Debug().Msg("stopping state 'awake'")
 
+	features := w.managerFeatures(ctx)
+	longPoll := features[appinfo.FeatureTaskLongPoll]
+
+	// Only run tasks in parallel when the Manager knows how to hand them out.
+	// Older Managers would keep handing out the task that is already running.
+	var running *runningTasks
+	if len(w.taskSlots) > 0 {
+		if features[appinfo.FeatureTaskSlots] {
+			log.Info().Interface("taskSlots", w.taskSlots).Msg("running multiple tasks at the same time")
+			running = newRunningTasks()
+			defer running.wait()
+		} else {
+			log.Warn().Msg("Manager does not support task slots, running one task at a time")
+		}
+	}
+
 	for {
-		task := w.fetchTask(ctx)
+		task := w.fetchTask(ctx, longPoll, running)
 		if task == nil {
 			return
 		}
 
-		// The task runner's listener will be responsible for sending results back
-		// to the Manager. This code only needs to fetch a task and run it.
-		err := w.runTask(ctx, *task)
-		if err != nil {
-			var abortError taskRunAborted
-			if errors.As(err, &abortError) {
-				log.Warn().
-					Str("task", task.Uuid).
-					Str("reason", err.Error()).
-					Msg("task aborted by request of Manager")
-			} else if errors.Is(err, context.Canceled) {
-				log.Warn().Interface("task", *task).Msg("task aborted due to context being closed")
-			} else {
-				log.Warn().Err(err).Interface("task", *task).Msg("error executing task")
-			}
+		if running == nil {
+			w.executeTask(ctx, *task)
+			continue
 		}
 
-		// Do some rate limiting. This is mostly useful while developing.
-		select {
-		case <-ctx.Done():
-			return
-		case <-time.After(durationTaskComplete):
+		running.start(task.Uuid)
+		go func(task api.AssignedTask) {
+			defer running.done(task.Uuid)
+			w.executeTask(ctx, task)
+		}(*task)
+	}
+}
+
+// executeTask runs the task, and logs the reason when it didn't complete.
+func (w *Worker) executeTask(ctx context.Context, task api.AssignedTask) {
+	// The task runner's listener will be responsible for sending results back
+	// to the Manager. This code only needs to run the task.
+	err := w.runTask(ctx, task)
+	if err != nil {
+		var abortError taskRunAborted
+		if errors.As(err, &abortError) {
+			log.Warn().
+				Str("task", task.Uuid).
+				Str("reason", err.Error()).
+				Msg("task aborted by request of Manager")
+		} else if errors.Is(err, context.Canceled) {
+			log.Warn().Interface("task", task).Msg("task aborted due to context being closed")
+		} else {
+			log.Warn().Err(err).Interface("task", task).Msg("error executing task")
 		}
 	}
+
+	// Do some rate limiting. This is mostly useful while developing.
+	select {
+	case <-ctx.Done():
+	case <-time.After(durationTaskComplete):
+	}
+}
+
+// managerFeatures returns the optional features supported by the Manager.
+// When the Manager cannot be queried, no features are assumed to be supported.
+func (w *Worker) managerFeatures(ctx context.Context) map[string]bool {
+	features := map[string]bool{}
+
+	resp, err := w.client.GetVersionWithResponse(ctx)
+	switch {
+	case err != nil:
+		log.Warn().Err(err).Msg("unable to get Manager version, assuming it has no optional features")
+		return features
+	case resp.JSON200 == nil:
+		log.Warn().Int("code", resp.StatusCode()).Msg("unable to get Manager version, assuming it has no optional features")
+		return features
+	case resp.JSON200.Features == nil:
+		return features
+	}
+
+	for _, feature := range *resp.JSON200.Features {
+		features[feature] = true
+	}
+	log.Debug().Strs("features", *resp.JSON200.Features).Msg("Manager features")
+	return features
 }
 
 // fetchTasks periodically tries to fetch a task from the Manager, returning it when obtained.
 // Returns nil when a task could not be obtained and the period loop was cancelled.
-func (w *Worker) fetchTask(ctx context.Context) *api.AssignedTask {
+//
+// With `longPoll`, the Manager is asked to wait for a task to become
+// available, and the Worker can ask again as soon as the Manager responds.
+//
+// `running` is nil when tasks are run one at a time. Otherwise the Manager is
+// told which tasks are still running, so that it can hand out a task for a
+// free task slot.
+func (w *Worker) fetchTask(ctx context.Context, longPoll bool, running *runningTasks) *api.AssignedTask {
 	logger := w.loggerWithStatus()
 
 	// Initially don't wait at all.
 	var wait time.Duration
 
+	params := api.ScheduleTaskParams{}
+	if longPoll {
+		params.Wait = ptr(int(durationLongPoll / time.Second))
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -117,8 +187,13 @@ func (w *Worker) fetchTask(ctx context.Context) *api.AssignedTask {
 		case <-time.After(wait):
 		}
 
+		if running != nil {
+			runningUUIDs := running.list()
+			params.Running = &runningUUIDs
+		}
+
 		logger.Debug().Msg("fetching tasks")
-		resp, err := w.client.ScheduleTaskWithResponse(ctx)
+		resp, err := w.client.ScheduleTaskWithResponse(ctx, &params)
 		if err != nil {
 			log.Error().Err(err).Msg("error obtaining task")
 			wait = durationFetchFailed
@@ -134,6 +209,11 @@ func (w *Worker) fetchTask(ctx context.Context) *api.AssignedTask {
 			log.Info().
 				Str("requestedStatus", string(resp.JSON423.StatusRequested)).
 				Msg("Manager requests status change")
+			if running != nil {
+				// Tasks will be aborted by the Manager when the status change
+				// shouldn't wait for them.
+				running.wait()
+			}
 			w.changeState(ctx, resp.JSON423.StatusRequested)
 			return nil
 		case resp.JSON403 != nil:
@@ -144,9 +224,14 @@ func (w *Worker) fetchTask(ctx context.Context) *api.AssignedTask {
 			wait = durationFetchFailed
 		case resp.StatusCode() == http.StatusNoContent:
 			log.Debug().Msg("no task available")
-			// TODO: implement gradual back-off, to avoid too frequent checks when the
-			// farm is idle.
-			wait = durationNoTask
+			if longPoll {
+				// The Manager already waited for a task to become available.
+				wait = 0
+			} else {
+				// TODO: implement gradual back-off, to avoid too frequent checks when the
+				// farm is idle.
+				wait = durationNoTask
+			}
 		default:
 			log.Warn().
 				Int("code", resp.StatusCode()).
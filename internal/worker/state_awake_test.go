@@ -0,0 +1,49 @@
+package worker
+
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"git.blender.org/flamenco/internal/appinfo"
+	"git.blender.org/flamenco/internal/worker/mocks"
+	"git.blender.org/flamenco/pkg/api"
+)
+
+func TestManagerFeatures(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ctx := context.Background()
+	client := mocks.NewMockFlamencoClient(mockCtrl)
+	w := Worker{client: client}
+
+	versionResponse := func(features *[]string) *api.GetVersionResponse {
+		return &api.GetVersionResponse{
+			JSON200: &api.FlamencoVersion{Name: "Flamenco", Version: "3.1", Features: features},
+		}
+	}
+
+	// Older Managers do not advertise any features.
+	client.EXPECT().GetVersionWithResponse(ctx).Return(versionResponse(nil), nil)
+	assert.Empty(t, w.managerFeatures(ctx))
+
+	client.EXPECT().GetVersionWithResponse(ctx).Return(versionResponse(&[]string{"something-else"}), nil)
+	features := w.managerFeatures(ctx)
+	assert.False(t, features[appinfo.FeatureTaskLongPoll])
+
+	client.EXPECT().GetVersionWithResponse(ctx).Return(
+		versionResponse(&[]string{appinfo.FeatureTaskLongPoll, appinfo.FeatureTaskSlots}), nil)
+	features = w.managerFeatures(ctx)
+	assert.True(t, features[appinfo.FeatureTaskLongPoll])
+	assert.True(t, features[appinfo.FeatureTaskSlots])
+
+	// Errors should fall back to polling, without any other features.
+	client.EXPECT().GetVersionWithResponse(ctx).Return(nil, errors.New("connection refused"))
+	assert.Empty(t, w.managerFeatures(ctx))
+}
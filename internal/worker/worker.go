@@ -23,6 +23,10 @@ type Worker struct {
 	stateMutex    *sync.Mutex
 
 	taskRunner TaskRunner
+
+	// taskSlots is the number of tasks per task type that can run at the same
+	// time. When empty, tasks are run one at a time.
+	taskSlots map[string]int
 }
 
 type StateStarter func(context.Context)
@@ -35,6 +39,7 @@ type TaskRunner interface {
 func NewWorker(
 	flamenco FlamencoClient,
 	taskRunner TaskRunner,
+	taskSlots map[string]int,
 ) *Worker {
 
 	worker := &Worker{
@@ -48,6 +53,7 @@ func NewWorker(
 		stateMutex:    new(sync.Mutex),
 
 		taskRunner: taskRunner,
+		taskSlots:  taskSlots,
 	}
 	worker.setupStateMachine()
 	return worker
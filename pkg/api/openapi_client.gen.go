@@ -108,6 +108,9 @@ type ClientInterface interface {
 
 	CheckSharedStoragePath(ctx context.Context, body CheckSharedStoragePathJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// BackupDatabase request
+	BackupDatabase(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetConfigurationFile request
 	GetConfigurationFile(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -124,9 +127,19 @@ type ClientInterface interface {
 
 	SubmitJob(ctx context.Context, body SubmitJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SubmitJobDryRun request with any body
+	SubmitJobDryRunWithBody(ctx context.Context, params *SubmitJobDryRunParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SubmitJobDryRun(ctx context.Context, params *SubmitJobDryRunParams, body SubmitJobDryRunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FetchGlobalLastRenderedInfo request
 	FetchGlobalLastRenderedInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteJobMass request with any body
+	DeleteJobMassWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeleteJobMass(ctx context.Context, body DeleteJobMassJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// QueryJobs request with any body
 	QueryJobsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -135,9 +148,17 @@ type ClientInterface interface {
 	// GetJobType request
 	GetJobType(ctx context.Context, typeName string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ValidateJobType request with any body
+	ValidateJobTypeWithBody(ctx context.Context, typeName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ValidateJobType(ctx context.Context, typeName string, body ValidateJobTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetJobTypes request
 	GetJobTypes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteJob request
+	DeleteJob(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FetchJob request
 	FetchJob(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -149,9 +170,27 @@ type ClientInterface interface {
 	// FetchJobBlocklist request
 	FetchJobBlocklist(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// EditJob request with any body
+	EditJobWithBody(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EditJob(ctx context.Context, jobId string, body EditJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FetchJobHistory request
+	FetchJobHistory(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FetchJobLastRenderedInfo request
 	FetchJobLastRenderedInfo(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SetJobMaxWorkers request with any body
+	SetJobMaxWorkersWithBody(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetJobMaxWorkers(ctx context.Context, jobId string, body SetJobMaxWorkersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetJobPriority request with any body
+	SetJobPriorityWithBody(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetJobPriority(ctx context.Context, jobId string, body SetJobPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SetJobStatus request with any body
 	SetJobStatusWithBody(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -190,9 +229,50 @@ type ClientInterface interface {
 
 	SetTaskStatus(ctx context.Context, taskId string, body SetTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// FetchUsers request
+	FetchUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUser request with any body
+	CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LoginUser request with any body
+	LoginUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LoginUser(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LogoutUser request
+	LogoutUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FetchCurrentUser request
+	FetchCurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUser request
+	DeleteUser(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetVersion request
 	GetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteWorkerTag request
+	DeleteWorkerTag(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FetchWorkerTag request
+	FetchWorkerTag(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateWorkerTag request with any body
+	UpdateWorkerTagWithBody(ctx context.Context, tagId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateWorkerTag(ctx context.Context, tagId string, body UpdateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FetchWorkerTags request
+	FetchWorkerTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWorkerTag request with any body
+	CreateWorkerTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWorkerTag(ctx context.Context, body CreateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FetchWorkers request
 	FetchWorkers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -204,6 +284,11 @@ type ClientInterface interface {
 
 	RequestWorkerStatusChange(ctx context.Context, workerId string, body RequestWorkerStatusChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SetWorkerTags request with any body
+	SetWorkerTagsWithBody(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetWorkerTags(ctx context.Context, workerId string, body SetWorkerTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FetchWorkerSleepSchedule request
 	FetchWorkerSleepSchedule(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -234,7 +319,7 @@ type ClientInterface interface {
 	WorkerStateChanged(ctx context.Context, body WorkerStateChangedJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ScheduleTask request
-	ScheduleTask(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ScheduleTask(ctx context.Context, params *ScheduleTaskParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// TaskUpdate request with any body
 	TaskUpdateWithBody(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -320,6 +405,18 @@ func (c *Client) CheckSharedStoragePath(ctx context.Context, body CheckSharedSto
 	return c.Client.Do(req)
 }
 
+func (c *Client) BackupDatabase(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBackupDatabaseRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetConfigurationFile(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetConfigurationFileRequest(c.Server)
 	if err != nil {
@@ -392,6 +489,30 @@ func (c *Client) SubmitJob(ctx context.Context, body SubmitJobJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
+func (c *Client) SubmitJobDryRunWithBody(ctx context.Context, params *SubmitJobDryRunParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitJobDryRunRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitJobDryRun(ctx context.Context, params *SubmitJobDryRunParams, body SubmitJobDryRunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitJobDryRunRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) FetchGlobalLastRenderedInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewFetchGlobalLastRenderedInfoRequest(c.Server)
 	if err != nil {
@@ -404,6 +525,30 @@ func (c *Client) FetchGlobalLastRenderedInfo(ctx context.Context, reqEditors ...
 	return c.Client.Do(req)
 }
 
+func (c *Client) DeleteJobMassWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteJobMassRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteJobMass(ctx context.Context, body DeleteJobMassJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteJobMassRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) QueryJobsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewQueryJobsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -440,6 +585,30 @@ func (c *Client) GetJobType(ctx context.Context, typeName string, reqEditors ...
 	return c.Client.Do(req)
 }
 
+func (c *Client) ValidateJobTypeWithBody(ctx context.Context, typeName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateJobTypeRequestWithBody(c.Server, typeName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateJobType(ctx context.Context, typeName string, body ValidateJobTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateJobTypeRequest(c.Server, typeName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetJobTypes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetJobTypesRequest(c.Server)
 	if err != nil {
@@ -452,6 +621,18 @@ func (c *Client) GetJobTypes(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
+func (c *Client) DeleteJob(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteJobRequest(c.Server, jobId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) FetchJob(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewFetchJobRequest(c.Server, jobId)
 	if err != nil {
@@ -500,6 +681,42 @@ func (c *Client) FetchJobBlocklist(ctx context.Context, jobId string, reqEditors
 	return c.Client.Do(req)
 }
 
+func (c *Client) EditJobWithBody(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditJobRequestWithBody(c.Server, jobId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditJob(ctx context.Context, jobId string, body EditJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditJobRequest(c.Server, jobId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FetchJobHistory(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFetchJobHistoryRequest(c.Server, jobId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) FetchJobLastRenderedInfo(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewFetchJobLastRenderedInfoRequest(c.Server, jobId)
 	if err != nil {
@@ -512,6 +729,54 @@ func (c *Client) FetchJobLastRenderedInfo(ctx context.Context, jobId string, req
 	return c.Client.Do(req)
 }
 
+func (c *Client) SetJobMaxWorkersWithBody(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetJobMaxWorkersRequestWithBody(c.Server, jobId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetJobMaxWorkers(ctx context.Context, jobId string, body SetJobMaxWorkersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetJobMaxWorkersRequest(c.Server, jobId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetJobPriorityWithBody(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetJobPriorityRequestWithBody(c.Server, jobId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetJobPriority(ctx context.Context, jobId string, body SetJobPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetJobPriorityRequest(c.Server, jobId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) SetJobStatusWithBody(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewSetJobStatusRequestWithBody(c.Server, jobId, contentType, body)
 	if err != nil {
@@ -680,8 +945,8 @@ func (c *Client) SetTaskStatus(ctx context.Context, taskId string, body SetTaskS
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetVersionRequest(c.Server)
+func (c *Client) FetchUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFetchUsersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -692,8 +957,8 @@ func (c *Client) GetVersion(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) FetchWorkers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFetchWorkersRequest(c.Server)
+func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -704,8 +969,8 @@ func (c *Client) FetchWorkers(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) FetchWorker(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFetchWorkerRequest(c.Server, workerId)
+func (c *Client) CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -716,8 +981,8 @@ func (c *Client) FetchWorker(ctx context.Context, workerId string, reqEditors ..
 	return c.Client.Do(req)
 }
 
-func (c *Client) RequestWorkerStatusChangeWithBody(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRequestWorkerStatusChangeRequestWithBody(c.Server, workerId, contentType, body)
+func (c *Client) LoginUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginUserRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -728,8 +993,8 @@ func (c *Client) RequestWorkerStatusChangeWithBody(ctx context.Context, workerId
 	return c.Client.Do(req)
 }
 
-func (c *Client) RequestWorkerStatusChange(ctx context.Context, workerId string, body RequestWorkerStatusChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRequestWorkerStatusChangeRequest(c.Server, workerId, body)
+func (c *Client) LoginUser(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginUserRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -740,8 +1005,8 @@ func (c *Client) RequestWorkerStatusChange(ctx context.Context, workerId string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) FetchWorkerSleepSchedule(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFetchWorkerSleepScheduleRequest(c.Server, workerId)
+func (c *Client) LogoutUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogoutUserRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -752,8 +1017,8 @@ func (c *Client) FetchWorkerSleepSchedule(ctx context.Context, workerId string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetWorkerSleepScheduleWithBody(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetWorkerSleepScheduleRequestWithBody(c.Server, workerId, contentType, body)
+func (c *Client) FetchCurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFetchCurrentUserRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -764,8 +1029,8 @@ func (c *Client) SetWorkerSleepScheduleWithBody(ctx context.Context, workerId st
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetWorkerSleepSchedule(ctx context.Context, workerId string, body SetWorkerSleepScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetWorkerSleepScheduleRequest(c.Server, workerId, body)
+func (c *Client) DeleteUser(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserRequest(c.Server, userId)
 	if err != nil {
 		return nil, err
 	}
@@ -776,8 +1041,8 @@ func (c *Client) SetWorkerSleepSchedule(ctx context.Context, workerId string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) RegisterWorkerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRegisterWorkerRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVersionRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -788,8 +1053,8 @@ func (c *Client) RegisterWorkerWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) RegisterWorker(ctx context.Context, body RegisterWorkerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRegisterWorkerRequest(c.Server, body)
+func (c *Client) DeleteWorkerTag(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWorkerTagRequest(c.Server, tagId)
 	if err != nil {
 		return nil, err
 	}
@@ -800,8 +1065,8 @@ func (c *Client) RegisterWorker(ctx context.Context, body RegisterWorkerJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) SignOff(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSignOffRequest(c.Server)
+func (c *Client) FetchWorkerTag(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFetchWorkerTagRequest(c.Server, tagId)
 	if err != nil {
 		return nil, err
 	}
@@ -812,8 +1077,8 @@ func (c *Client) SignOff(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 	return c.Client.Do(req)
 }
 
-func (c *Client) SignOnWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSignOnRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateWorkerTagWithBody(ctx context.Context, tagId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWorkerTagRequestWithBody(c.Server, tagId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -824,8 +1089,8 @@ func (c *Client) SignOnWithBody(ctx context.Context, contentType string, body io
 	return c.Client.Do(req)
 }
 
-func (c *Client) SignOn(ctx context.Context, body SignOnJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSignOnRequest(c.Server, body)
+func (c *Client) UpdateWorkerTag(ctx context.Context, tagId string, body UpdateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWorkerTagRequest(c.Server, tagId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -836,8 +1101,8 @@ func (c *Client) SignOn(ctx context.Context, body SignOnJSONRequestBody, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkerState(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkerStateRequest(c.Server)
+func (c *Client) FetchWorkerTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFetchWorkerTagsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -848,8 +1113,8 @@ func (c *Client) WorkerState(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkerStateChangedWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkerStateChangedRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateWorkerTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWorkerTagRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -860,8 +1125,8 @@ func (c *Client) WorkerStateChangedWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) WorkerStateChanged(ctx context.Context, body WorkerStateChangedJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWorkerStateChangedRequest(c.Server, body)
+func (c *Client) CreateWorkerTag(ctx context.Context, body CreateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWorkerTagRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -872,8 +1137,8 @@ func (c *Client) WorkerStateChanged(ctx context.Context, body WorkerStateChanged
 	return c.Client.Do(req)
 }
 
-func (c *Client) ScheduleTask(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewScheduleTaskRequest(c.Server)
+func (c *Client) FetchWorkers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFetchWorkersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -884,8 +1149,8 @@ func (c *Client) ScheduleTask(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) TaskUpdateWithBody(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTaskUpdateRequestWithBody(c.Server, taskId, contentType, body)
+func (c *Client) FetchWorker(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFetchWorkerRequest(c.Server, workerId)
 	if err != nil {
 		return nil, err
 	}
@@ -896,8 +1161,8 @@ func (c *Client) TaskUpdateWithBody(ctx context.Context, taskId string, contentT
 	return c.Client.Do(req)
 }
 
-func (c *Client) TaskUpdate(ctx context.Context, taskId string, body TaskUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTaskUpdateRequest(c.Server, taskId, body)
+func (c *Client) RequestWorkerStatusChangeWithBody(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestWorkerStatusChangeRequestWithBody(c.Server, workerId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -908,8 +1173,8 @@ func (c *Client) TaskUpdate(ctx context.Context, taskId string, body TaskUpdateJ
 	return c.Client.Do(req)
 }
 
-func (c *Client) MayWorkerRun(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMayWorkerRunRequest(c.Server, taskId)
+func (c *Client) RequestWorkerStatusChange(ctx context.Context, workerId string, body RequestWorkerStatusChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestWorkerStatusChangeRequest(c.Server, workerId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -920,8 +1185,8 @@ func (c *Client) MayWorkerRun(ctx context.Context, taskId string, reqEditors ...
 	return c.Client.Do(req)
 }
 
-func (c *Client) TaskOutputProducedWithBody(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTaskOutputProducedRequestWithBody(c.Server, taskId, contentType, body)
+func (c *Client) SetWorkerTagsWithBody(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetWorkerTagsRequestWithBody(c.Server, workerId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -932,27 +1197,231 @@ func (c *Client) TaskOutputProducedWithBody(ctx context.Context, taskId string,
 	return c.Client.Do(req)
 }
 
-// NewGetConfigurationRequest generates requests for GetConfiguration
-func NewGetConfigurationRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) SetWorkerTags(ctx context.Context, workerId string, body SetWorkerTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetWorkerTagsRequest(c.Server, workerId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v3/configuration")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) FetchWorkerSleepSchedule(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFetchWorkerSleepScheduleRequest(c.Server, workerId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetWorkerSleepScheduleWithBody(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetWorkerSleepScheduleRequestWithBody(c.Server, workerId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetWorkerSleepSchedule(ctx context.Context, workerId string, body SetWorkerSleepScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetWorkerSleepScheduleRequest(c.Server, workerId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegisterWorkerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterWorkerRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegisterWorker(ctx context.Context, body RegisterWorkerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterWorkerRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SignOff(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSignOffRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SignOnWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSignOnRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SignOn(ctx context.Context, body SignOnJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSignOnRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WorkerState(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerStateRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WorkerStateChangedWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerStateChangedRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WorkerStateChanged(ctx context.Context, body WorkerStateChangedJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWorkerStateChangedRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ScheduleTask(ctx context.Context, params *ScheduleTaskParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewScheduleTaskRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TaskUpdateWithBody(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTaskUpdateRequestWithBody(c.Server, taskId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TaskUpdate(ctx context.Context, taskId string, body TaskUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTaskUpdateRequest(c.Server, taskId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MayWorkerRun(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMayWorkerRunRequest(c.Server, taskId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TaskOutputProducedWithBody(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTaskOutputProducedRequestWithBody(c.Server, taskId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetConfigurationRequest generates requests for GetConfiguration
+func NewGetConfigurationRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/configuration")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
 		return nil, err
 	}
 
@@ -1066,6 +1535,33 @@ func NewCheckSharedStoragePathRequestWithBody(server string, contentType string,
 	return req, nil
 }
 
+// NewBackupDatabaseRequest generates requests for BackupDatabase
+func NewBackupDatabaseRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/configuration/database-backup")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetConfigurationFileRequest generates requests for GetConfigurationFile
 func NewGetConfigurationFileRequest(server string) (*http.Request, error) {
 	var err error
@@ -1214,8 +1710,19 @@ func NewSubmitJobRequestWithBody(server string, contentType string, body io.Read
 	return req, nil
 }
 
-// NewFetchGlobalLastRenderedInfoRequest generates requests for FetchGlobalLastRenderedInfo
-func NewFetchGlobalLastRenderedInfoRequest(server string) (*http.Request, error) {
+// NewSubmitJobDryRunRequest calls the generic SubmitJobDryRun builder with application/json body
+func NewSubmitJobDryRunRequest(server string, params *SubmitJobDryRunParams, body SubmitJobDryRunJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitJobDryRunRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewSubmitJobDryRunRequestWithBody generates requests for SubmitJobDryRun with any type of body
+func NewSubmitJobDryRunRequestWithBody(server string, params *SubmitJobDryRunParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1223,7 +1730,7 @@ func NewFetchGlobalLastRenderedInfoRequest(server string) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/last-rendered")
+	operationPath := fmt.Sprintf("/api/v3/jobs/dry-run")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1233,27 +1740,38 @@ func NewFetchGlobalLastRenderedInfoRequest(server string) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	queryValues := queryURL.Query()
+
+	if params.WorkerPlatform != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "worker_platform", runtime.ParamLocationQuery, *params.WorkerPlatform); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
 
-	return req, nil
-}
+	queryURL.RawQuery = queryValues.Encode()
 
-// NewQueryJobsRequest calls the generic QueryJobs builder with application/json body
-func NewQueryJobsRequest(server string, body QueryJobsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewQueryJobsRequestWithBody(server, "application/json", bodyReader)
-}
 
-// NewQueryJobsRequestWithBody generates requests for QueryJobs with any type of body
-func NewQueryJobsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFetchGlobalLastRenderedInfoRequest generates requests for FetchGlobalLastRenderedInfo
+func NewFetchGlobalLastRenderedInfoRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1261,7 +1779,7 @@ func NewQueryJobsRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/query")
+	operationPath := fmt.Sprintf("/api/v3/jobs/last-rendered")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1271,33 +1789,35 @@ func NewQueryJobsRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetJobTypeRequest generates requests for GetJobType
-func NewGetJobTypeRequest(server string, typeName string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "typeName", runtime.ParamLocationPath, typeName)
+// NewDeleteJobMassRequest calls the generic DeleteJobMass builder with application/json body
+func NewDeleteJobMassRequest(server string, body DeleteJobMassJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteJobMassRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDeleteJobMassRequestWithBody generates requests for DeleteJobMass with any type of body
+func NewDeleteJobMassRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/type/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/mass-delete")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1307,16 +1827,29 @@ func NewGetJobTypeRequest(server string, typeName string) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetJobTypesRequest generates requests for GetJobTypes
-func NewGetJobTypesRequest(server string) (*http.Request, error) {
+// NewQueryJobsRequest calls the generic QueryJobs builder with application/json body
+func NewQueryJobsRequest(server string, body QueryJobsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryJobsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewQueryJobsRequestWithBody generates requests for QueryJobs with any type of body
+func NewQueryJobsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1324,7 +1857,7 @@ func NewGetJobTypesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/types")
+	operationPath := fmt.Sprintf("/api/v3/jobs/query")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1334,21 +1867,23 @@ func NewGetJobTypesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewFetchJobRequest generates requests for FetchJob
-func NewFetchJobRequest(server string, jobId string) (*http.Request, error) {
+// NewGetJobTypeRequest generates requests for GetJobType
+func NewGetJobTypeRequest(server string, typeName string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "typeName", runtime.ParamLocationPath, typeName)
 	if err != nil {
 		return nil, err
 	}
@@ -1358,7 +1893,7 @@ func NewFetchJobRequest(server string, jobId string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/type/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1376,24 +1911,24 @@ func NewFetchJobRequest(server string, jobId string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewRemoveJobBlocklistRequest calls the generic RemoveJobBlocklist builder with application/json body
-func NewRemoveJobBlocklistRequest(server string, jobId string, body RemoveJobBlocklistJSONRequestBody) (*http.Request, error) {
+// NewValidateJobTypeRequest calls the generic ValidateJobType builder with application/json body
+func NewValidateJobTypeRequest(server string, typeName string, body ValidateJobTypeJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewRemoveJobBlocklistRequestWithBody(server, jobId, "application/json", bodyReader)
+	return NewValidateJobTypeRequestWithBody(server, typeName, "application/json", bodyReader)
 }
 
-// NewRemoveJobBlocklistRequestWithBody generates requests for RemoveJobBlocklist with any type of body
-func NewRemoveJobBlocklistRequestWithBody(server string, jobId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewValidateJobTypeRequestWithBody generates requests for ValidateJobType with any type of body
+func NewValidateJobTypeRequestWithBody(server string, typeName string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "typeName", runtime.ParamLocationPath, typeName)
 	if err != nil {
 		return nil, err
 	}
@@ -1403,7 +1938,7 @@ func NewRemoveJobBlocklistRequestWithBody(server string, jobId string, contentTy
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/%s/blocklist", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/type/%s/validate", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1413,7 +1948,7 @@ func NewRemoveJobBlocklistRequestWithBody(server string, jobId string, contentTy
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1423,8 +1958,35 @@ func NewRemoveJobBlocklistRequestWithBody(server string, jobId string, contentTy
 	return req, nil
 }
 
-// NewFetchJobBlocklistRequest generates requests for FetchJobBlocklist
-func NewFetchJobBlocklistRequest(server string, jobId string) (*http.Request, error) {
+// NewGetJobTypesRequest generates requests for GetJobTypes
+func NewGetJobTypesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/jobs/types")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteJobRequest generates requests for DeleteJob
+func NewDeleteJobRequest(server string, jobId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1439,7 +2001,7 @@ func NewFetchJobBlocklistRequest(server string, jobId string) (*http.Request, er
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/%s/blocklist", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1449,7 +2011,7 @@ func NewFetchJobBlocklistRequest(server string, jobId string) (*http.Request, er
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1457,8 +2019,8 @@ func NewFetchJobBlocklistRequest(server string, jobId string) (*http.Request, er
 	return req, nil
 }
 
-// NewFetchJobLastRenderedInfoRequest generates requests for FetchJobLastRenderedInfo
-func NewFetchJobLastRenderedInfoRequest(server string, jobId string) (*http.Request, error) {
+// NewFetchJobRequest generates requests for FetchJob
+func NewFetchJobRequest(server string, jobId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1473,7 +2035,7 @@ func NewFetchJobLastRenderedInfoRequest(server string, jobId string) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/%s/last-rendered", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1491,19 +2053,19 @@ func NewFetchJobLastRenderedInfoRequest(server string, jobId string) (*http.Requ
 	return req, nil
 }
 
-// NewSetJobStatusRequest calls the generic SetJobStatus builder with application/json body
-func NewSetJobStatusRequest(server string, jobId string, body SetJobStatusJSONRequestBody) (*http.Request, error) {
+// NewRemoveJobBlocklistRequest calls the generic RemoveJobBlocklist builder with application/json body
+func NewRemoveJobBlocklistRequest(server string, jobId string, body RemoveJobBlocklistJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSetJobStatusRequestWithBody(server, jobId, "application/json", bodyReader)
+	return NewRemoveJobBlocklistRequestWithBody(server, jobId, "application/json", bodyReader)
 }
 
-// NewSetJobStatusRequestWithBody generates requests for SetJobStatus with any type of body
-func NewSetJobStatusRequestWithBody(server string, jobId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewRemoveJobBlocklistRequestWithBody generates requests for RemoveJobBlocklist with any type of body
+func NewRemoveJobBlocklistRequestWithBody(server string, jobId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1518,7 +2080,7 @@ func NewSetJobStatusRequestWithBody(server string, jobId string, contentType str
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/%s/setstatus", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/blocklist", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1528,7 +2090,7 @@ func NewSetJobStatusRequestWithBody(server string, jobId string, contentType str
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1538,8 +2100,8 @@ func NewSetJobStatusRequestWithBody(server string, jobId string, contentType str
 	return req, nil
 }
 
-// NewFetchJobTasksRequest generates requests for FetchJobTasks
-func NewFetchJobTasksRequest(server string, jobId string) (*http.Request, error) {
+// NewFetchJobBlocklistRequest generates requests for FetchJobBlocklist
+func NewFetchJobBlocklistRequest(server string, jobId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1554,7 +2116,7 @@ func NewFetchJobTasksRequest(server string, jobId string) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/jobs/%s/tasks", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/blocklist", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1572,27 +2134,34 @@ func NewFetchJobTasksRequest(server string, jobId string) (*http.Request, error)
 	return req, nil
 }
 
-// NewShamanCheckoutRequest calls the generic ShamanCheckout builder with application/json body
-func NewShamanCheckoutRequest(server string, body ShamanCheckoutJSONRequestBody) (*http.Request, error) {
+// NewEditJobRequest calls the generic EditJob builder with application/json body
+func NewEditJobRequest(server string, jobId string, body EditJobJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewShamanCheckoutRequestWithBody(server, "application/json", bodyReader)
+	return NewEditJobRequestWithBody(server, jobId, "application/json", bodyReader)
 }
 
-// NewShamanCheckoutRequestWithBody generates requests for ShamanCheckout with any type of body
-func NewShamanCheckoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewEditJobRequestWithBody generates requests for EditJob with any type of body
+func NewEditJobRequestWithBody(server string, jobId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/shaman/checkout/create")
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/edit", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1612,27 +2181,23 @@ func NewShamanCheckoutRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewShamanCheckoutRequirementsRequest calls the generic ShamanCheckoutRequirements builder with application/json body
-func NewShamanCheckoutRequirementsRequest(server string, body ShamanCheckoutRequirementsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewFetchJobHistoryRequest generates requests for FetchJobHistory
+func NewFetchJobHistoryRequest(server string, jobId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewShamanCheckoutRequirementsRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewShamanCheckoutRequirementsRequestWithBody generates requests for ShamanCheckoutRequirements with any type of body
-func NewShamanCheckoutRequirementsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/shaman/checkout/requirements")
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/history", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1642,30 +2207,21 @@ func NewShamanCheckoutRequirementsRequestWithBody(server string, contentType str
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewShamanFileStoreCheckRequest generates requests for ShamanFileStoreCheck
-func NewShamanFileStoreCheckRequest(server string, checksum string, filesize int) (*http.Request, error) {
+// NewFetchJobLastRenderedInfoRequest generates requests for FetchJobLastRenderedInfo
+func NewFetchJobLastRenderedInfoRequest(server string, jobId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "checksum", runtime.ParamLocationPath, checksum)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "filesize", runtime.ParamLocationPath, filesize)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -1675,7 +2231,7 @@ func NewShamanFileStoreCheckRequest(server string, checksum string, filesize int
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/shaman/files/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/last-rendered", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1693,20 +2249,24 @@ func NewShamanFileStoreCheckRequest(server string, checksum string, filesize int
 	return req, nil
 }
 
-// NewShamanFileStoreRequestWithBody generates requests for ShamanFileStore with any type of body
-func NewShamanFileStoreRequestWithBody(server string, checksum string, filesize int, params *ShamanFileStoreParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "checksum", runtime.ParamLocationPath, checksum)
+// NewSetJobMaxWorkersRequest calls the generic SetJobMaxWorkers builder with application/json body
+func NewSetJobMaxWorkersRequest(server string, jobId string, body SetJobMaxWorkersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetJobMaxWorkersRequestWithBody(server, jobId, "application/json", bodyReader)
+}
 
-	var pathParam1 string
+// NewSetJobMaxWorkersRequestWithBody generates requests for SetJobMaxWorkers with any type of body
+func NewSetJobMaxWorkersRequestWithBody(server string, jobId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "filesize", runtime.ParamLocationPath, filesize)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -1716,7 +2276,7 @@ func NewShamanFileStoreRequestWithBody(server string, checksum string, filesize
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/shaman/files/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/setmaxworkers", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1733,38 +2293,27 @@ func NewShamanFileStoreRequestWithBody(server string, checksum string, filesize
 
 	req.Header.Add("Content-Type", contentType)
 
-	if params.XShamanCanDeferUpload != nil {
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Shaman-Can-Defer-Upload", runtime.ParamLocationHeader, *params.XShamanCanDeferUpload)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Shaman-Can-Defer-Upload", headerParam0)
-	}
-
-	if params.XShamanOriginalFilename != nil {
-		var headerParam1 string
-
-		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Shaman-Original-Filename", runtime.ParamLocationHeader, *params.XShamanOriginalFilename)
-		if err != nil {
-			return nil, err
-		}
+	return req, nil
+}
 
-		req.Header.Set("X-Shaman-Original-Filename", headerParam1)
+// NewSetJobPriorityRequest calls the generic SetJobPriority builder with application/json body
+func NewSetJobPriorityRequest(server string, jobId string, body SetJobPriorityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewSetJobPriorityRequestWithBody(server, jobId, "application/json", bodyReader)
 }
 
-// NewFetchTaskRequest generates requests for FetchTask
-func NewFetchTaskRequest(server string, taskId string) (*http.Request, error) {
+// NewSetJobPriorityRequestWithBody generates requests for SetJobPriority with any type of body
+func NewSetJobPriorityRequestWithBody(server string, jobId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -1774,7 +2323,7 @@ func NewFetchTaskRequest(server string, taskId string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/tasks/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/setpriority", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1784,21 +2333,34 @@ func NewFetchTaskRequest(server string, taskId string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewFetchTaskLogInfoRequest generates requests for FetchTaskLogInfo
-func NewFetchTaskLogInfoRequest(server string, taskId string) (*http.Request, error) {
+// NewSetJobStatusRequest calls the generic SetJobStatus builder with application/json body
+func NewSetJobStatusRequest(server string, jobId string, body SetJobStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetJobStatusRequestWithBody(server, jobId, "application/json", bodyReader)
+}
+
+// NewSetJobStatusRequestWithBody generates requests for SetJobStatus with any type of body
+func NewSetJobStatusRequestWithBody(server string, jobId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -1808,7 +2370,7 @@ func NewFetchTaskLogInfoRequest(server string, taskId string) (*http.Request, er
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/tasks/%s/log", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/setstatus", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1818,21 +2380,23 @@ func NewFetchTaskLogInfoRequest(server string, taskId string) (*http.Request, er
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// NewFetchTaskLogTailRequest generates requests for FetchTaskLogTail
-func NewFetchTaskLogTailRequest(server string, taskId string) (*http.Request, error) {
+// NewFetchJobTasksRequest generates requests for FetchJobTasks
+func NewFetchJobTasksRequest(server string, jobId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -1842,7 +2406,7 @@ func NewFetchTaskLogTailRequest(server string, taskId string) (*http.Request, er
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/tasks/%s/logtail", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/jobs/%s/tasks", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1860,34 +2424,27 @@ func NewFetchTaskLogTailRequest(server string, taskId string) (*http.Request, er
 	return req, nil
 }
 
-// NewSetTaskStatusRequest calls the generic SetTaskStatus builder with application/json body
-func NewSetTaskStatusRequest(server string, taskId string, body SetTaskStatusJSONRequestBody) (*http.Request, error) {
+// NewShamanCheckoutRequest calls the generic ShamanCheckout builder with application/json body
+func NewShamanCheckoutRequest(server string, body ShamanCheckoutJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSetTaskStatusRequestWithBody(server, taskId, "application/json", bodyReader)
+	return NewShamanCheckoutRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewSetTaskStatusRequestWithBody generates requests for SetTaskStatus with any type of body
-func NewSetTaskStatusRequestWithBody(server string, taskId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewShamanCheckoutRequestWithBody generates requests for ShamanCheckout with any type of body
+func NewShamanCheckoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/tasks/%s/setstatus", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/shaman/checkout/create")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1907,8 +2464,19 @@ func NewSetTaskStatusRequestWithBody(server string, taskId string, contentType s
 	return req, nil
 }
 
-// NewGetVersionRequest generates requests for GetVersion
-func NewGetVersionRequest(server string) (*http.Request, error) {
+// NewShamanCheckoutRequirementsRequest calls the generic ShamanCheckoutRequirements builder with application/json body
+func NewShamanCheckoutRequirementsRequest(server string, body ShamanCheckoutRequirementsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewShamanCheckoutRequirementsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewShamanCheckoutRequirementsRequestWithBody generates requests for ShamanCheckoutRequirements with any type of body
+func NewShamanCheckoutRequirementsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1916,7 +2484,7 @@ func NewGetVersionRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/version")
+	operationPath := fmt.Sprintf("/api/v3/shaman/checkout/requirements")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1926,24 +2494,40 @@ func NewGetVersionRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewFetchWorkersRequest generates requests for FetchWorkers
-func NewFetchWorkersRequest(server string) (*http.Request, error) {
+// NewShamanFileStoreCheckRequest generates requests for ShamanFileStoreCheck
+func NewShamanFileStoreCheckRequest(server string, checksum string, filesize int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "checksum", runtime.ParamLocationPath, checksum)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "filesize", runtime.ParamLocationPath, filesize)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers")
+	operationPath := fmt.Sprintf("/api/v3/shaman/files/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1961,13 +2545,20 @@ func NewFetchWorkersRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewFetchWorkerRequest generates requests for FetchWorker
-func NewFetchWorkerRequest(server string, workerId string) (*http.Request, error) {
+// NewShamanFileStoreRequestWithBody generates requests for ShamanFileStore with any type of body
+func NewShamanFileStoreRequestWithBody(server string, checksum string, filesize int, params *ShamanFileStoreParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "checksum", runtime.ParamLocationPath, checksum)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "filesize", runtime.ParamLocationPath, filesize)
 	if err != nil {
 		return nil, err
 	}
@@ -1977,7 +2568,7 @@ func NewFetchWorkerRequest(server string, workerId string) (*http.Request, error
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/shaman/files/%s/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1987,32 +2578,45 @@ func NewFetchWorkerRequest(server string, workerId string) (*http.Request, error
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	req.Header.Add("Content-Type", contentType)
 
-// NewRequestWorkerStatusChangeRequest calls the generic RequestWorkerStatusChange builder with application/json body
-func NewRequestWorkerStatusChangeRequest(server string, workerId string, body RequestWorkerStatusChangeJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
+	if params.XShamanCanDeferUpload != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Shaman-Can-Defer-Upload", runtime.ParamLocationHeader, *params.XShamanCanDeferUpload)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Shaman-Can-Defer-Upload", headerParam0)
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewRequestWorkerStatusChangeRequestWithBody(server, workerId, "application/json", bodyReader)
+
+	if params.XShamanOriginalFilename != nil {
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Shaman-Original-Filename", runtime.ParamLocationHeader, *params.XShamanOriginalFilename)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Shaman-Original-Filename", headerParam1)
+	}
+
+	return req, nil
 }
 
-// NewRequestWorkerStatusChangeRequestWithBody generates requests for RequestWorkerStatusChange with any type of body
-func NewRequestWorkerStatusChangeRequestWithBody(server string, workerId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewFetchTaskRequest generates requests for FetchTask
+func NewFetchTaskRequest(server string, taskId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
 	if err != nil {
 		return nil, err
 	}
@@ -2022,7 +2626,7 @@ func NewRequestWorkerStatusChangeRequestWithBody(server string, workerId string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s/setstatus", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/tasks/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2032,23 +2636,21 @@ func NewRequestWorkerStatusChangeRequestWithBody(server string, workerId string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewFetchWorkerSleepScheduleRequest generates requests for FetchWorkerSleepSchedule
-func NewFetchWorkerSleepScheduleRequest(server string, workerId string) (*http.Request, error) {
+// NewFetchTaskLogInfoRequest generates requests for FetchTaskLogInfo
+func NewFetchTaskLogInfoRequest(server string, taskId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
 	if err != nil {
 		return nil, err
 	}
@@ -2058,7 +2660,7 @@ func NewFetchWorkerSleepScheduleRequest(server string, workerId string) (*http.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s/sleep-schedule", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/tasks/%s/log", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2076,24 +2678,13 @@ func NewFetchWorkerSleepScheduleRequest(server string, workerId string) (*http.R
 	return req, nil
 }
 
-// NewSetWorkerSleepScheduleRequest calls the generic SetWorkerSleepSchedule builder with application/json body
-func NewSetWorkerSleepScheduleRequest(server string, workerId string, body SetWorkerSleepScheduleJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSetWorkerSleepScheduleRequestWithBody(server, workerId, "application/json", bodyReader)
-}
-
-// NewSetWorkerSleepScheduleRequestWithBody generates requests for SetWorkerSleepSchedule with any type of body
-func NewSetWorkerSleepScheduleRequestWithBody(server string, workerId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewFetchTaskLogTailRequest generates requests for FetchTaskLogTail
+func NewFetchTaskLogTailRequest(server string, taskId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
 	if err != nil {
 		return nil, err
 	}
@@ -2103,7 +2694,7 @@ func NewSetWorkerSleepScheduleRequestWithBody(server string, workerId string, co
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s/sleep-schedule", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/tasks/%s/logtail", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2113,37 +2704,42 @@ func NewSetWorkerSleepScheduleRequestWithBody(server string, workerId string, co
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewRegisterWorkerRequest calls the generic RegisterWorker builder with application/json body
-func NewRegisterWorkerRequest(server string, body RegisterWorkerJSONRequestBody) (*http.Request, error) {
+// NewSetTaskStatusRequest calls the generic SetTaskStatus builder with application/json body
+func NewSetTaskStatusRequest(server string, taskId string, body SetTaskStatusJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewRegisterWorkerRequestWithBody(server, "application/json", bodyReader)
+	return NewSetTaskStatusRequestWithBody(server, taskId, "application/json", bodyReader)
 }
 
-// NewRegisterWorkerRequestWithBody generates requests for RegisterWorker with any type of body
-func NewRegisterWorkerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewSetTaskStatusRequestWithBody generates requests for SetTaskStatus with any type of body
+func NewSetTaskStatusRequestWithBody(server string, taskId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/register-worker")
+	operationPath := fmt.Sprintf("/api/v3/tasks/%s/setstatus", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2163,8 +2759,8 @@ func NewRegisterWorkerRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewSignOffRequest generates requests for SignOff
-func NewSignOffRequest(server string) (*http.Request, error) {
+// NewFetchUsersRequest generates requests for FetchUsers
+func NewFetchUsersRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2172,7 +2768,7 @@ func NewSignOffRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/sign-off")
+	operationPath := fmt.Sprintf("/api/v3/users")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2182,7 +2778,7 @@ func NewSignOffRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2190,19 +2786,19 @@ func NewSignOffRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewSignOnRequest calls the generic SignOn builder with application/json body
-func NewSignOnRequest(server string, body SignOnJSONRequestBody) (*http.Request, error) {
+// NewCreateUserRequest calls the generic CreateUser builder with application/json body
+func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSignOnRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewSignOnRequestWithBody generates requests for SignOn with any type of body
-func NewSignOnRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateUserRequestWithBody generates requests for CreateUser with any type of body
+func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2210,7 +2806,7 @@ func NewSignOnRequestWithBody(server string, contentType string, body io.Reader)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/sign-on")
+	operationPath := fmt.Sprintf("/api/v3/users")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2230,8 +2826,19 @@ func NewSignOnRequestWithBody(server string, contentType string, body io.Reader)
 	return req, nil
 }
 
-// NewWorkerStateRequest generates requests for WorkerState
-func NewWorkerStateRequest(server string) (*http.Request, error) {
+// NewLoginUserRequest calls the generic LoginUser builder with application/json body
+func NewLoginUserRequest(server string, body LoginUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginUserRequestWithBody generates requests for LoginUser with any type of body
+func NewLoginUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2239,7 +2846,7 @@ func NewWorkerStateRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/state")
+	operationPath := fmt.Sprintf("/api/v3/users/login")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2249,27 +2856,18 @@ func NewWorkerStateRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	req.Header.Add("Content-Type", contentType)
 
-// NewWorkerStateChangedRequest calls the generic WorkerStateChanged builder with application/json body
-func NewWorkerStateChangedRequest(server string, body WorkerStateChangedJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewWorkerStateChangedRequestWithBody(server, "application/json", bodyReader)
+	return req, nil
 }
 
-// NewWorkerStateChangedRequestWithBody generates requests for WorkerStateChanged with any type of body
-func NewWorkerStateChangedRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewLogoutUserRequest generates requests for LogoutUser
+func NewLogoutUserRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2277,7 +2875,7 @@ func NewWorkerStateChangedRequestWithBody(server string, contentType string, bod
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/state-changed")
+	operationPath := fmt.Sprintf("/api/v3/users/logout")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2287,18 +2885,16 @@ func NewWorkerStateChangedRequestWithBody(server string, contentType string, bod
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewScheduleTaskRequest generates requests for ScheduleTask
-func NewScheduleTaskRequest(server string) (*http.Request, error) {
+// NewFetchCurrentUserRequest generates requests for FetchCurrentUser
+func NewFetchCurrentUserRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2306,7 +2902,7 @@ func NewScheduleTaskRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/task")
+	operationPath := fmt.Sprintf("/api/v3/users/me")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2316,7 +2912,7 @@ func NewScheduleTaskRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2324,24 +2920,13 @@ func NewScheduleTaskRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewTaskUpdateRequest calls the generic TaskUpdate builder with application/json body
-func NewTaskUpdateRequest(server string, taskId string, body TaskUpdateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewTaskUpdateRequestWithBody(server, taskId, "application/json", bodyReader)
-}
-
-// NewTaskUpdateRequestWithBody generates requests for TaskUpdate with any type of body
-func NewTaskUpdateRequestWithBody(server string, taskId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteUserRequest generates requests for DeleteUser
+func NewDeleteUserRequest(server string, userId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
 	if err != nil {
 		return nil, err
 	}
@@ -2351,7 +2936,7 @@ func NewTaskUpdateRequestWithBody(server string, taskId string, contentType stri
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/task/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/users/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2361,33 +2946,24 @@ func NewTaskUpdateRequestWithBody(server string, taskId string, contentType stri
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewMayWorkerRunRequest generates requests for MayWorkerRun
-func NewMayWorkerRunRequest(server string, taskId string) (*http.Request, error) {
+// NewGetVersionRequest generates requests for GetVersion
+func NewGetVersionRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/task/%s/may-i-run", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/version")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2405,13 +2981,13 @@ func NewMayWorkerRunRequest(server string, taskId string) (*http.Request, error)
 	return req, nil
 }
 
-// NewTaskOutputProducedRequestWithBody generates requests for TaskOutputProduced with any type of body
-func NewTaskOutputProducedRequestWithBody(server string, taskId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteWorkerTagRequest generates requests for DeleteWorkerTag
+func NewDeleteWorkerTagRequest(server string, tagId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tag_id", runtime.ParamLocationPath, tagId)
 	if err != nil {
 		return nil, err
 	}
@@ -2421,7 +2997,7 @@ func NewTaskOutputProducedRequestWithBody(server string, taskId string, contentT
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v3/worker/task/%s/output-produced", pathParam0)
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tag/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2431,223 +3007,1983 @@ func NewTaskOutputProducedRequestWithBody(server string, taskId string, contentT
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// NewFetchWorkerTagRequest generates requests for FetchWorkerTag
+func NewFetchWorkerTagRequest(server string, tagId string) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tag_id", runtime.ParamLocationPath, tagId)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetConfiguration request
-	GetConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigurationResponse, error)
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tag/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// FindBlenderExePath request
-	FindBlenderExePathWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FindBlenderExePathResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// CheckBlenderExePath request with any body
-	CheckBlenderExePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckBlenderExePathResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	CheckBlenderExePathWithResponse(ctx context.Context, body CheckBlenderExePathJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckBlenderExePathResponse, error)
+	return req, nil
+}
 
-	// CheckSharedStoragePath request with any body
-	CheckSharedStoragePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckSharedStoragePathResponse, error)
+// NewUpdateWorkerTagRequest calls the generic UpdateWorkerTag builder with application/json body
+func NewUpdateWorkerTagRequest(server string, tagId string, body UpdateWorkerTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateWorkerTagRequestWithBody(server, tagId, "application/json", bodyReader)
+}
 
-	CheckSharedStoragePathWithResponse(ctx context.Context, body CheckSharedStoragePathJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckSharedStoragePathResponse, error)
+// NewUpdateWorkerTagRequestWithBody generates requests for UpdateWorkerTag with any type of body
+func NewUpdateWorkerTagRequestWithBody(server string, tagId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetConfigurationFile request
-	GetConfigurationFileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigurationFileResponse, error)
+	var pathParam0 string
 
-	// SaveSetupAssistantConfig request with any body
-	SaveSetupAssistantConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveSetupAssistantConfigResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tag_id", runtime.ParamLocationPath, tagId)
+	if err != nil {
+		return nil, err
+	}
 
-	SaveSetupAssistantConfigWithResponse(ctx context.Context, body SaveSetupAssistantConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveSetupAssistantConfigResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetVariables request
-	GetVariablesWithResponse(ctx context.Context, audience ManagerVariableAudience, platform string, reqEditors ...RequestEditorFn) (*GetVariablesResponse, error)
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tag/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// SubmitJob request with any body
-	SubmitJobWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitJobResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	SubmitJobWithResponse(ctx context.Context, body SubmitJobJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitJobResponse, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// FetchGlobalLastRenderedInfo request
-	FetchGlobalLastRenderedInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchGlobalLastRenderedInfoResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// QueryJobs request with any body
-	QueryJobsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryJobsResponse, error)
+	return req, nil
+}
 
-	QueryJobsWithResponse(ctx context.Context, body QueryJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryJobsResponse, error)
+// NewFetchWorkerTagsRequest generates requests for FetchWorkerTags
+func NewFetchWorkerTagsRequest(server string) (*http.Request, error) {
+	var err error
 
-	// GetJobType request
-	GetJobTypeWithResponse(ctx context.Context, typeName string, reqEditors ...RequestEditorFn) (*GetJobTypeResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetJobTypes request
-	GetJobTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJobTypesResponse, error)
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// FetchJob request
-	FetchJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// RemoveJobBlocklist request with any body
-	RemoveJobBlocklistWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveJobBlocklistResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	RemoveJobBlocklistWithResponse(ctx context.Context, jobId string, body RemoveJobBlocklistJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveJobBlocklistResponse, error)
+	return req, nil
+}
 
-	// FetchJobBlocklist request
-	FetchJobBlocklistWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobBlocklistResponse, error)
+// NewCreateWorkerTagRequest calls the generic CreateWorkerTag builder with application/json body
+func NewCreateWorkerTagRequest(server string, body CreateWorkerTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWorkerTagRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// FetchJobLastRenderedInfo request
-	FetchJobLastRenderedInfoWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobLastRenderedInfoResponse, error)
+// NewCreateWorkerTagRequestWithBody generates requests for CreateWorkerTag with any type of body
+func NewCreateWorkerTagRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// SetJobStatus request with any body
-	SetJobStatusWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetJobStatusResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	SetJobStatusWithResponse(ctx context.Context, jobId string, body SetJobStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetJobStatusResponse, error)
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// FetchJobTasks request
-	FetchJobTasksWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobTasksResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ShamanCheckout request with any body
-	ShamanCheckoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanCheckoutResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	ShamanCheckoutWithResponse(ctx context.Context, body ShamanCheckoutJSONRequestBody, reqEditors ...RequestEditorFn) (*ShamanCheckoutResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// ShamanCheckoutRequirements request with any body
-	ShamanCheckoutRequirementsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanCheckoutRequirementsResponse, error)
+	return req, nil
+}
 
-	ShamanCheckoutRequirementsWithResponse(ctx context.Context, body ShamanCheckoutRequirementsJSONRequestBody, reqEditors ...RequestEditorFn) (*ShamanCheckoutRequirementsResponse, error)
+// NewFetchWorkersRequest generates requests for FetchWorkers
+func NewFetchWorkersRequest(server string) (*http.Request, error) {
+	var err error
 
-	// ShamanFileStoreCheck request
-	ShamanFileStoreCheckWithResponse(ctx context.Context, checksum string, filesize int, reqEditors ...RequestEditorFn) (*ShamanFileStoreCheckResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// ShamanFileStore request with any body
-	ShamanFileStoreWithBodyWithResponse(ctx context.Context, checksum string, filesize int, params *ShamanFileStoreParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanFileStoreResponse, error)
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// FetchTask request
-	FetchTaskWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// FetchTaskLogInfo request
-	FetchTaskLogInfoWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskLogInfoResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// FetchTaskLogTail request
-	FetchTaskLogTailWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskLogTailResponse, error)
+	return req, nil
+}
 
-	// SetTaskStatus request with any body
-	SetTaskStatusWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTaskStatusResponse, error)
+// NewFetchWorkerRequest generates requests for FetchWorker
+func NewFetchWorkerRequest(server string, workerId string) (*http.Request, error) {
+	var err error
 
-	SetTaskStatusWithResponse(ctx context.Context, taskId string, body SetTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTaskStatusResponse, error)
+	var pathParam0 string
 
-	// GetVersion request
-	GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
 
-	// FetchWorkers request
-	FetchWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchWorkersResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// FetchWorker request
-	FetchWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*FetchWorkerResponse, error)
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// RequestWorkerStatusChange request with any body
-	RequestWorkerStatusChangeWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestWorkerStatusChangeResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	RequestWorkerStatusChangeWithResponse(ctx context.Context, workerId string, body RequestWorkerStatusChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestWorkerStatusChangeResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// FetchWorkerSleepSchedule request
+	return req, nil
+}
+
+// NewRequestWorkerStatusChangeRequest calls the generic RequestWorkerStatusChange builder with application/json body
+func NewRequestWorkerStatusChangeRequest(server string, workerId string, body RequestWorkerStatusChangeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRequestWorkerStatusChangeRequestWithBody(server, workerId, "application/json", bodyReader)
+}
+
+// NewRequestWorkerStatusChangeRequestWithBody generates requests for RequestWorkerStatusChange with any type of body
+func NewRequestWorkerStatusChangeRequestWithBody(server string, workerId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s/setstatus", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetWorkerTagsRequest calls the generic SetWorkerTags builder with application/json body
+func NewSetWorkerTagsRequest(server string, workerId string, body SetWorkerTagsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetWorkerTagsRequestWithBody(server, workerId, "application/json", bodyReader)
+}
+
+// NewSetWorkerTagsRequestWithBody generates requests for SetWorkerTags with any type of body
+func NewSetWorkerTagsRequestWithBody(server string, workerId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s/settags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFetchWorkerSleepScheduleRequest generates requests for FetchWorkerSleepSchedule
+func NewFetchWorkerSleepScheduleRequest(server string, workerId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s/sleep-schedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetWorkerSleepScheduleRequest calls the generic SetWorkerSleepSchedule builder with application/json body
+func NewSetWorkerSleepScheduleRequest(server string, workerId string, body SetWorkerSleepScheduleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetWorkerSleepScheduleRequestWithBody(server, workerId, "application/json", bodyReader)
+}
+
+// NewSetWorkerSleepScheduleRequestWithBody generates requests for SetWorkerSleepSchedule with any type of body
+func NewSetWorkerSleepScheduleRequestWithBody(server string, workerId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker-mgt/workers/%s/sleep-schedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRegisterWorkerRequest calls the generic RegisterWorker builder with application/json body
+func NewRegisterWorkerRequest(server string, body RegisterWorkerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegisterWorkerRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRegisterWorkerRequestWithBody generates requests for RegisterWorker with any type of body
+func NewRegisterWorkerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/register-worker")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSignOffRequest generates requests for SignOff
+func NewSignOffRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/sign-off")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSignOnRequest calls the generic SignOn builder with application/json body
+func NewSignOnRequest(server string, body SignOnJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSignOnRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSignOnRequestWithBody generates requests for SignOn with any type of body
+func NewSignOnRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/sign-on")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWorkerStateRequest generates requests for WorkerState
+func NewWorkerStateRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/state")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWorkerStateChangedRequest calls the generic WorkerStateChanged builder with application/json body
+func NewWorkerStateChangedRequest(server string, body WorkerStateChangedJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWorkerStateChangedRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewWorkerStateChangedRequestWithBody generates requests for WorkerStateChanged with any type of body
+func NewWorkerStateChangedRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/state-changed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewScheduleTaskRequest generates requests for ScheduleTask
+func NewScheduleTaskRequest(server string, params *ScheduleTaskParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/task")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Wait != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "wait", runtime.ParamLocationQuery, *params.Wait); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Running != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "running", runtime.ParamLocationQuery, *params.Running); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTaskUpdateRequest calls the generic TaskUpdate builder with application/json body
+func NewTaskUpdateRequest(server string, taskId string, body TaskUpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTaskUpdateRequestWithBody(server, taskId, "application/json", bodyReader)
+}
+
+// NewTaskUpdateRequestWithBody generates requests for TaskUpdate with any type of body
+func NewTaskUpdateRequestWithBody(server string, taskId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/task/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewMayWorkerRunRequest generates requests for MayWorkerRun
+func NewMayWorkerRunRequest(server string, taskId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/task/%s/may-i-run", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTaskOutputProducedRequestWithBody generates requests for TaskOutputProduced with any type of body
+func NewTaskOutputProducedRequestWithBody(server string, taskId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task_id", runtime.ParamLocationPath, taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v3/worker/task/%s/output-produced", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetConfiguration request
+	GetConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigurationResponse, error)
+
+	// FindBlenderExePath request
+	FindBlenderExePathWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FindBlenderExePathResponse, error)
+
+	// CheckBlenderExePath request with any body
+	CheckBlenderExePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckBlenderExePathResponse, error)
+
+	CheckBlenderExePathWithResponse(ctx context.Context, body CheckBlenderExePathJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckBlenderExePathResponse, error)
+
+	// CheckSharedStoragePath request with any body
+	CheckSharedStoragePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckSharedStoragePathResponse, error)
+
+	CheckSharedStoragePathWithResponse(ctx context.Context, body CheckSharedStoragePathJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckSharedStoragePathResponse, error)
+
+	// BackupDatabase request
+	BackupDatabaseWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*BackupDatabaseResponse, error)
+
+	// GetConfigurationFile request
+	GetConfigurationFileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigurationFileResponse, error)
+
+	// SaveSetupAssistantConfig request with any body
+	SaveSetupAssistantConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveSetupAssistantConfigResponse, error)
+
+	SaveSetupAssistantConfigWithResponse(ctx context.Context, body SaveSetupAssistantConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveSetupAssistantConfigResponse, error)
+
+	// GetVariables request
+	GetVariablesWithResponse(ctx context.Context, audience ManagerVariableAudience, platform string, reqEditors ...RequestEditorFn) (*GetVariablesResponse, error)
+
+	// SubmitJob request with any body
+	SubmitJobWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitJobResponse, error)
+
+	SubmitJobWithResponse(ctx context.Context, body SubmitJobJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitJobResponse, error)
+
+	// SubmitJobDryRun request with any body
+	SubmitJobDryRunWithBodyWithResponse(ctx context.Context, params *SubmitJobDryRunParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitJobDryRunResponse, error)
+
+	SubmitJobDryRunWithResponse(ctx context.Context, params *SubmitJobDryRunParams, body SubmitJobDryRunJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitJobDryRunResponse, error)
+
+	// FetchGlobalLastRenderedInfo request
+	FetchGlobalLastRenderedInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchGlobalLastRenderedInfoResponse, error)
+
+	// DeleteJobMass request with any body
+	DeleteJobMassWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteJobMassResponse, error)
+
+	DeleteJobMassWithResponse(ctx context.Context, body DeleteJobMassJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteJobMassResponse, error)
+
+	// QueryJobs request with any body
+	QueryJobsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryJobsResponse, error)
+
+	QueryJobsWithResponse(ctx context.Context, body QueryJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryJobsResponse, error)
+
+	// GetJobType request
+	GetJobTypeWithResponse(ctx context.Context, typeName string, reqEditors ...RequestEditorFn) (*GetJobTypeResponse, error)
+
+	// ValidateJobType request with any body
+	ValidateJobTypeWithBodyWithResponse(ctx context.Context, typeName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateJobTypeResponse, error)
+
+	ValidateJobTypeWithResponse(ctx context.Context, typeName string, body ValidateJobTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateJobTypeResponse, error)
+
+	// GetJobTypes request
+	GetJobTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJobTypesResponse, error)
+
+	// DeleteJob request
+	DeleteJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*DeleteJobResponse, error)
+
+	// FetchJob request
+	FetchJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobResponse, error)
+
+	// RemoveJobBlocklist request with any body
+	RemoveJobBlocklistWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveJobBlocklistResponse, error)
+
+	RemoveJobBlocklistWithResponse(ctx context.Context, jobId string, body RemoveJobBlocklistJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveJobBlocklistResponse, error)
+
+	// FetchJobBlocklist request
+	FetchJobBlocklistWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobBlocklistResponse, error)
+
+	// EditJob request with any body
+	EditJobWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditJobResponse, error)
+
+	EditJobWithResponse(ctx context.Context, jobId string, body EditJobJSONRequestBody, reqEditors ...RequestEditorFn) (*EditJobResponse, error)
+
+	// FetchJobHistory request
+	FetchJobHistoryWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobHistoryResponse, error)
+
+	// FetchJobLastRenderedInfo request
+	FetchJobLastRenderedInfoWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobLastRenderedInfoResponse, error)
+
+	// SetJobMaxWorkers request with any body
+	SetJobMaxWorkersWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetJobMaxWorkersResponse, error)
+
+	SetJobMaxWorkersWithResponse(ctx context.Context, jobId string, body SetJobMaxWorkersJSONRequestBody, reqEditors ...RequestEditorFn) (*SetJobMaxWorkersResponse, error)
+
+	// SetJobPriority request with any body
+	SetJobPriorityWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetJobPriorityResponse, error)
+
+	SetJobPriorityWithResponse(ctx context.Context, jobId string, body SetJobPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*SetJobPriorityResponse, error)
+
+	// SetJobStatus request with any body
+	SetJobStatusWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetJobStatusResponse, error)
+
+	SetJobStatusWithResponse(ctx context.Context, jobId string, body SetJobStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetJobStatusResponse, error)
+
+	// FetchJobTasks request
+	FetchJobTasksWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobTasksResponse, error)
+
+	// ShamanCheckout request with any body
+	ShamanCheckoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanCheckoutResponse, error)
+
+	ShamanCheckoutWithResponse(ctx context.Context, body ShamanCheckoutJSONRequestBody, reqEditors ...RequestEditorFn) (*ShamanCheckoutResponse, error)
+
+	// ShamanCheckoutRequirements request with any body
+	ShamanCheckoutRequirementsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanCheckoutRequirementsResponse, error)
+
+	ShamanCheckoutRequirementsWithResponse(ctx context.Context, body ShamanCheckoutRequirementsJSONRequestBody, reqEditors ...RequestEditorFn) (*ShamanCheckoutRequirementsResponse, error)
+
+	// ShamanFileStoreCheck request
+	ShamanFileStoreCheckWithResponse(ctx context.Context, checksum string, filesize int, reqEditors ...RequestEditorFn) (*ShamanFileStoreCheckResponse, error)
+
+	// ShamanFileStore request with any body
+	ShamanFileStoreWithBodyWithResponse(ctx context.Context, checksum string, filesize int, params *ShamanFileStoreParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanFileStoreResponse, error)
+
+	// FetchTask request
+	FetchTaskWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskResponse, error)
+
+	// FetchTaskLogInfo request
+	FetchTaskLogInfoWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskLogInfoResponse, error)
+
+	// FetchTaskLogTail request
+	FetchTaskLogTailWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskLogTailResponse, error)
+
+	// SetTaskStatus request with any body
+	SetTaskStatusWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTaskStatusResponse, error)
+
+	SetTaskStatusWithResponse(ctx context.Context, taskId string, body SetTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTaskStatusResponse, error)
+
+	// FetchUsers request
+	FetchUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchUsersResponse, error)
+
+	// CreateUser request with any body
+	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+
+	// LoginUser request with any body
+	LoginUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserResponse, error)
+
+	LoginUserWithResponse(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginUserResponse, error)
+
+	// LogoutUser request
+	LogoutUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutUserResponse, error)
+
+	// FetchCurrentUser request
+	FetchCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchCurrentUserResponse, error)
+
+	// DeleteUser request
+	DeleteUserWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error)
+
+	// GetVersion request
+	GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error)
+
+	// DeleteWorkerTag request
+	DeleteWorkerTagWithResponse(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*DeleteWorkerTagResponse, error)
+
+	// FetchWorkerTag request
+	FetchWorkerTagWithResponse(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*FetchWorkerTagResponse, error)
+
+	// UpdateWorkerTag request with any body
+	UpdateWorkerTagWithBodyWithResponse(ctx context.Context, tagId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWorkerTagResponse, error)
+
+	UpdateWorkerTagWithResponse(ctx context.Context, tagId string, body UpdateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWorkerTagResponse, error)
+
+	// FetchWorkerTags request
+	FetchWorkerTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchWorkerTagsResponse, error)
+
+	// CreateWorkerTag request with any body
+	CreateWorkerTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWorkerTagResponse, error)
+
+	CreateWorkerTagWithResponse(ctx context.Context, body CreateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWorkerTagResponse, error)
+
+	// FetchWorkers request
+	FetchWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchWorkersResponse, error)
+
+	// FetchWorker request
+	FetchWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*FetchWorkerResponse, error)
+
+	// RequestWorkerStatusChange request with any body
+	RequestWorkerStatusChangeWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestWorkerStatusChangeResponse, error)
+
+	RequestWorkerStatusChangeWithResponse(ctx context.Context, workerId string, body RequestWorkerStatusChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestWorkerStatusChangeResponse, error)
+
+	// SetWorkerTags request with any body
+	SetWorkerTagsWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerTagsResponse, error)
+
+	SetWorkerTagsWithResponse(ctx context.Context, workerId string, body SetWorkerTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerTagsResponse, error)
+
+	// FetchWorkerSleepSchedule request
 	FetchWorkerSleepScheduleWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*FetchWorkerSleepScheduleResponse, error)
 
-	// SetWorkerSleepSchedule request with any body
-	SetWorkerSleepScheduleWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerSleepScheduleResponse, error)
+	// SetWorkerSleepSchedule request with any body
+	SetWorkerSleepScheduleWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerSleepScheduleResponse, error)
+
+	SetWorkerSleepScheduleWithResponse(ctx context.Context, workerId string, body SetWorkerSleepScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerSleepScheduleResponse, error)
+
+	// RegisterWorker request with any body
+	RegisterWorkerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error)
+
+	RegisterWorkerWithResponse(ctx context.Context, body RegisterWorkerJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error)
+
+	// SignOff request
+	SignOffWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SignOffResponse, error)
+
+	// SignOn request with any body
+	SignOnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignOnResponse, error)
+
+	SignOnWithResponse(ctx context.Context, body SignOnJSONRequestBody, reqEditors ...RequestEditorFn) (*SignOnResponse, error)
+
+	// WorkerState request
+	WorkerStateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WorkerStateResponse, error)
+
+	// WorkerStateChanged request with any body
+	WorkerStateChangedWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerStateChangedResponse, error)
+
+	WorkerStateChangedWithResponse(ctx context.Context, body WorkerStateChangedJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerStateChangedResponse, error)
+
+	// ScheduleTask request
+	ScheduleTaskWithResponse(ctx context.Context, params *ScheduleTaskParams, reqEditors ...RequestEditorFn) (*ScheduleTaskResponse, error)
+
+	// TaskUpdate request with any body
+	TaskUpdateWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TaskUpdateResponse, error)
+
+	TaskUpdateWithResponse(ctx context.Context, taskId string, body TaskUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TaskUpdateResponse, error)
+
+	// MayWorkerRun request
+	MayWorkerRunWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*MayWorkerRunResponse, error)
+
+	// TaskOutputProduced request with any body
+	TaskOutputProducedWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TaskOutputProducedResponse, error)
+}
+
+type GetConfigurationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ManagerConfiguration
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConfigurationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConfigurationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindBlenderExePathResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BlenderPathFindResult
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FindBlenderExePathResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindBlenderExePathResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CheckBlenderExePathResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BlenderPathCheckResult
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckBlenderExePathResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckBlenderExePathResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CheckSharedStoragePathResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PathCheckResult
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckSharedStoragePathResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckSharedStoragePathResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BackupDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseBackupResult
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r BackupDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BackupDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConfigurationFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		AdditionalProperties map[string]interface{} `json:"-"`
+	}
+	YAML200     *string
+	JSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConfigurationFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConfigurationFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SaveSetupAssistantConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SaveSetupAssistantConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SaveSetupAssistantConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVariablesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ManagerVariables
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVariablesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVariablesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Job
+	JSON412      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitJobDryRunResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthoredJob
+	JSON412      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitJobDryRunResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitJobDryRunResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FetchGlobalLastRenderedInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobLastRenderedImageInfo
+}
+
+// Status returns HTTPResponse.Status
+func (r FetchGlobalLastRenderedInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchGlobalLastRenderedInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteJobMassResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobMassDeletionResult
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteJobMassResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteJobMassResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryJobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobsQueryResult
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJobTypeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AvailableJobType
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidateJobTypeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobTypeValidationResult
+	JSON404      *Error
+	JSON422      *JobScriptError
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateJobTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateJobTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJobTypesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AvailableJobTypes
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobTypesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobTypesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FetchJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Job
+}
+
+// Status returns HTTPResponse.Status
+func (r FetchJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveJobBlocklistResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveJobBlocklistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveJobBlocklistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FetchJobBlocklistResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobBlocklist
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FetchJobBlocklistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchJobBlocklistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EditJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSON409      *Error
+	JSON422      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r EditJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EditJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FetchJobHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobHistory
+	JSON404      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FetchJobHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchJobHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FetchJobLastRenderedInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobLastRenderedImageInfo
+}
+
+// Status returns HTTPResponse.Status
+func (r FetchJobLastRenderedInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchJobLastRenderedInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetJobMaxWorkersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetJobMaxWorkersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetJobMaxWorkersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetJobPriorityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetJobPriorityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetJobPriorityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetJobStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetJobStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetJobStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FetchJobTasksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobTasksSummary
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FetchJobTasksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchJobTasksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ShamanCheckoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ShamanCheckoutResult
+	JSON409      *Error
+	JSON424      *Error
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ShamanCheckoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ShamanCheckoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ShamanCheckoutRequirementsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ShamanRequirementsResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ShamanCheckoutRequirementsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ShamanCheckoutRequirementsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ShamanFileStoreCheckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ShamanSingleFileStatus
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ShamanFileStoreCheckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ShamanFileStoreCheckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ShamanFileStoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ShamanFileStoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ShamanFileStoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FetchTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Task
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FetchTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FetchTaskLogInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskLogInfo
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FetchTaskLogInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchTaskLogInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FetchTaskLogTailResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FetchTaskLogTailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchTaskLogTailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetTaskStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetTaskStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetTaskStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FetchUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r FetchUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	SetWorkerSleepScheduleWithResponse(ctx context.Context, workerId string, body SetWorkerSleepScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerSleepScheduleResponse, error)
+type CreateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *Error
+}
 
-	// RegisterWorker request with any body
-	RegisterWorkerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error)
+// Status returns HTTPResponse.Status
+func (r CreateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	RegisterWorkerWithResponse(ctx context.Context, body RegisterWorkerJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// SignOff request
-	SignOffWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SignOffResponse, error)
+type LoginUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserLoginResponse
+	JSONDefault  *Error
+}
 
-	// SignOn request with any body
-	SignOnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignOnResponse, error)
+// Status returns HTTPResponse.Status
+func (r LoginUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	SignOnWithResponse(ctx context.Context, body SignOnJSONRequestBody, reqEditors ...RequestEditorFn) (*SignOnResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// WorkerState request
-	WorkerStateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WorkerStateResponse, error)
+type LogoutUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
 
-	// WorkerStateChanged request with any body
-	WorkerStateChangedWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerStateChangedResponse, error)
+// Status returns HTTPResponse.Status
+func (r LogoutUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	WorkerStateChangedWithResponse(ctx context.Context, body WorkerStateChangedJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerStateChangedResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogoutUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// ScheduleTask request
-	ScheduleTaskWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ScheduleTaskResponse, error)
+type FetchCurrentUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSONDefault  *Error
+}
 
-	// TaskUpdate request with any body
-	TaskUpdateWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TaskUpdateResponse, error)
+// Status returns HTTPResponse.Status
+func (r FetchCurrentUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	TaskUpdateWithResponse(ctx context.Context, taskId string, body TaskUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TaskUpdateResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchCurrentUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// MayWorkerRun request
-	MayWorkerRunWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*MayWorkerRunResponse, error)
+type DeleteUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
 
-	// TaskOutputProduced request with any body
-	TaskOutputProducedWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TaskOutputProducedResponse, error)
+// Status returns HTTPResponse.Status
+func (r DeleteUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
-type GetConfigurationResponse struct {
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVersionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ManagerConfiguration
+	JSON200      *FlamencoVersion
 }
 
 // Status returns HTTPResponse.Status
-func (r GetConfigurationResponse) Status() string {
+func (r GetVersionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2655,22 +4991,21 @@ func (r GetConfigurationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetConfigurationResponse) StatusCode() int {
+func (r GetVersionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FindBlenderExePathResponse struct {
+type DeleteWorkerTagResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *BlenderPathFindResult
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r FindBlenderExePathResponse) Status() string {
+func (r DeleteWorkerTagResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2678,22 +5013,22 @@ func (r FindBlenderExePathResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FindBlenderExePathResponse) StatusCode() int {
+func (r DeleteWorkerTagResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CheckBlenderExePathResponse struct {
+type FetchWorkerTagResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *BlenderPathCheckResult
+	JSON200      *WorkerTag
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r CheckBlenderExePathResponse) Status() string {
+func (r FetchWorkerTagResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2701,22 +5036,21 @@ func (r CheckBlenderExePathResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CheckBlenderExePathResponse) StatusCode() int {
+func (r FetchWorkerTagResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CheckSharedStoragePathResponse struct {
+type UpdateWorkerTagResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PathCheckResult
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r CheckSharedStoragePathResponse) Status() string {
+func (r UpdateWorkerTagResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2724,24 +5058,155 @@ func (r CheckSharedStoragePathResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CheckSharedStoragePathResponse) StatusCode() int {
+func (r UpdateWorkerTagResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetConfigurationFileResponse struct {
+type FetchWorkerTagsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		AdditionalProperties map[string]interface{} `json:"-"`
+	JSON200      *WorkerTagList
+}
+
+// Status returns HTTPResponse.Status
+func (r FetchWorkerTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchWorkerTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWorkerTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkerTag
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWorkerTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWorkerTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FetchWorkersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkerList
+}
+
+// Status returns HTTPResponse.Status
+func (r FetchWorkersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchWorkersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FetchWorkerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Worker
+}
+
+// Status returns HTTPResponse.Status
+func (r FetchWorkerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FetchWorkerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequestWorkerStatusChangeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RequestWorkerStatusChangeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestWorkerStatusChangeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetWorkerTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetWorkerTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetWorkerTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	YAML200 *string
+	return 0
+}
+
+type FetchWorkerSleepScheduleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkerSleepSchedule
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetConfigurationFileResponse) Status() string {
+func (r FetchWorkerSleepScheduleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2749,21 +5214,21 @@ func (r GetConfigurationFileResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetConfigurationFileResponse) StatusCode() int {
+func (r FetchWorkerSleepScheduleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SaveSetupAssistantConfigResponse struct {
+type SetWorkerSleepScheduleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r SaveSetupAssistantConfigResponse) Status() string {
+func (r SetWorkerSleepScheduleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2771,21 +5236,22 @@ func (r SaveSetupAssistantConfigResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SaveSetupAssistantConfigResponse) StatusCode() int {
+func (r SetWorkerSleepScheduleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetVariablesResponse struct {
+type RegisterWorkerResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ManagerVariables
+	JSON200      *RegisteredWorker
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetVariablesResponse) Status() string {
+func (r RegisterWorkerResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2793,23 +5259,21 @@ func (r GetVariablesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetVariablesResponse) StatusCode() int {
+func (r RegisterWorkerResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type SubmitJobResponse struct {
+type SignOffResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Job
-	JSON412      *Error
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r SubmitJobResponse) Status() string {
+func (r SignOffResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2817,21 +5281,22 @@ func (r SubmitJobResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r SubmitJobResponse) StatusCode() int {
+func (r SignOffResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FetchGlobalLastRenderedInfoResponse struct {
+type SignOnResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *JobLastRenderedImageInfo
+	JSON200      *WorkerStateChange
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r FetchGlobalLastRenderedInfoResponse) Status() string {
+func (r SignOnResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2839,22 +5304,22 @@ func (r FetchGlobalLastRenderedInfoResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FetchGlobalLastRenderedInfoResponse) StatusCode() int {
+func (r SignOnResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type QueryJobsResponse struct {
+type WorkerStateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *JobsQueryResult
+	JSON200      *WorkerStateChange
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r QueryJobsResponse) Status() string {
+func (r WorkerStateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2862,21 +5327,21 @@ func (r QueryJobsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r QueryJobsResponse) StatusCode() int {
+func (r WorkerStateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetJobTypeResponse struct {
+type WorkerStateChangedResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AvailableJobType
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r GetJobTypeResponse) Status() string {
+func (r WorkerStateChangedResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2884,21 +5349,23 @@ func (r GetJobTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetJobTypeResponse) StatusCode() int {
+func (r WorkerStateChangedResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetJobTypesResponse struct {
+type ScheduleTaskResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AvailableJobTypes
+	JSON200      *AssignedTask
+	JSON403      *SecurityError
+	JSON423      *WorkerStateChange
 }
 
 // Status returns HTTPResponse.Status
-func (r GetJobTypesResponse) Status() string {
+func (r ScheduleTaskResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2906,21 +5373,21 @@ func (r GetJobTypesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetJobTypesResponse) StatusCode() int {
+func (r ScheduleTaskResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FetchJobResponse struct {
+type TaskUpdateResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Job
+	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r FetchJobResponse) Status() string {
+func (r TaskUpdateResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2928,21 +5395,22 @@ func (r FetchJobResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FetchJobResponse) StatusCode() int {
+func (r TaskUpdateResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type RemoveJobBlocklistResponse struct {
+type MayWorkerRunResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *MayKeepRunning
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r RemoveJobBlocklistResponse) Status() string {
+func (r MayWorkerRunResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2950,22 +5418,25 @@ func (r RemoveJobBlocklistResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r RemoveJobBlocklistResponse) StatusCode() int {
+func (r MayWorkerRunResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FetchJobBlocklistResponse struct {
+type TaskOutputProducedResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *JobBlocklist
+	JSON411      *Error
+	JSON413      *Error
+	JSON415      *Error
+	JSON429      *Error
 	JSONDefault  *Error
 }
 
 // Status returns HTTPResponse.Status
-func (r FetchJobBlocklistResponse) Status() string {
+func (r TaskOutputProducedResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2973,1147 +5444,1455 @@ func (r FetchJobBlocklistResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r FetchJobBlocklistResponse) StatusCode() int {
+func (r TaskOutputProducedResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type FetchJobLastRenderedInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *JobLastRenderedImageInfo
+// GetConfigurationWithResponse request returning *GetConfigurationResponse
+func (c *ClientWithResponses) GetConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigurationResponse, error) {
+	rsp, err := c.GetConfiguration(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigurationResponse(rsp)
+}
+
+// FindBlenderExePathWithResponse request returning *FindBlenderExePathResponse
+func (c *ClientWithResponses) FindBlenderExePathWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FindBlenderExePathResponse, error) {
+	rsp, err := c.FindBlenderExePath(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindBlenderExePathResponse(rsp)
+}
+
+// CheckBlenderExePathWithBodyWithResponse request with arbitrary body returning *CheckBlenderExePathResponse
+func (c *ClientWithResponses) CheckBlenderExePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckBlenderExePathResponse, error) {
+	rsp, err := c.CheckBlenderExePathWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckBlenderExePathResponse(rsp)
+}
+
+func (c *ClientWithResponses) CheckBlenderExePathWithResponse(ctx context.Context, body CheckBlenderExePathJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckBlenderExePathResponse, error) {
+	rsp, err := c.CheckBlenderExePath(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckBlenderExePathResponse(rsp)
+}
+
+// CheckSharedStoragePathWithBodyWithResponse request with arbitrary body returning *CheckSharedStoragePathResponse
+func (c *ClientWithResponses) CheckSharedStoragePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckSharedStoragePathResponse, error) {
+	rsp, err := c.CheckSharedStoragePathWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckSharedStoragePathResponse(rsp)
+}
+
+func (c *ClientWithResponses) CheckSharedStoragePathWithResponse(ctx context.Context, body CheckSharedStoragePathJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckSharedStoragePathResponse, error) {
+	rsp, err := c.CheckSharedStoragePath(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckSharedStoragePathResponse(rsp)
+}
+
+// BackupDatabaseWithResponse request returning *BackupDatabaseResponse
+func (c *ClientWithResponses) BackupDatabaseWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*BackupDatabaseResponse, error) {
+	rsp, err := c.BackupDatabase(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBackupDatabaseResponse(rsp)
+}
+
+// GetConfigurationFileWithResponse request returning *GetConfigurationFileResponse
+func (c *ClientWithResponses) GetConfigurationFileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigurationFileResponse, error) {
+	rsp, err := c.GetConfigurationFile(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigurationFileResponse(rsp)
+}
+
+// SaveSetupAssistantConfigWithBodyWithResponse request with arbitrary body returning *SaveSetupAssistantConfigResponse
+func (c *ClientWithResponses) SaveSetupAssistantConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveSetupAssistantConfigResponse, error) {
+	rsp, err := c.SaveSetupAssistantConfigWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSaveSetupAssistantConfigResponse(rsp)
+}
+
+func (c *ClientWithResponses) SaveSetupAssistantConfigWithResponse(ctx context.Context, body SaveSetupAssistantConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveSetupAssistantConfigResponse, error) {
+	rsp, err := c.SaveSetupAssistantConfig(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSaveSetupAssistantConfigResponse(rsp)
+}
+
+// GetVariablesWithResponse request returning *GetVariablesResponse
+func (c *ClientWithResponses) GetVariablesWithResponse(ctx context.Context, audience ManagerVariableAudience, platform string, reqEditors ...RequestEditorFn) (*GetVariablesResponse, error) {
+	rsp, err := c.GetVariables(ctx, audience, platform, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVariablesResponse(rsp)
+}
+
+// SubmitJobWithBodyWithResponse request with arbitrary body returning *SubmitJobResponse
+func (c *ClientWithResponses) SubmitJobWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitJobResponse, error) {
+	rsp, err := c.SubmitJobWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitJobResponse(rsp)
+}
+
+func (c *ClientWithResponses) SubmitJobWithResponse(ctx context.Context, body SubmitJobJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitJobResponse, error) {
+	rsp, err := c.SubmitJob(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitJobResponse(rsp)
+}
+
+// SubmitJobDryRunWithBodyWithResponse request with arbitrary body returning *SubmitJobDryRunResponse
+func (c *ClientWithResponses) SubmitJobDryRunWithBodyWithResponse(ctx context.Context, params *SubmitJobDryRunParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitJobDryRunResponse, error) {
+	rsp, err := c.SubmitJobDryRunWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitJobDryRunResponse(rsp)
+}
+
+func (c *ClientWithResponses) SubmitJobDryRunWithResponse(ctx context.Context, params *SubmitJobDryRunParams, body SubmitJobDryRunJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitJobDryRunResponse, error) {
+	rsp, err := c.SubmitJobDryRun(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitJobDryRunResponse(rsp)
+}
+
+// FetchGlobalLastRenderedInfoWithResponse request returning *FetchGlobalLastRenderedInfoResponse
+func (c *ClientWithResponses) FetchGlobalLastRenderedInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchGlobalLastRenderedInfoResponse, error) {
+	rsp, err := c.FetchGlobalLastRenderedInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFetchGlobalLastRenderedInfoResponse(rsp)
+}
+
+// DeleteJobMassWithBodyWithResponse request with arbitrary body returning *DeleteJobMassResponse
+func (c *ClientWithResponses) DeleteJobMassWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteJobMassResponse, error) {
+	rsp, err := c.DeleteJobMassWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteJobMassResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteJobMassWithResponse(ctx context.Context, body DeleteJobMassJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteJobMassResponse, error) {
+	rsp, err := c.DeleteJobMass(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteJobMassResponse(rsp)
+}
+
+// QueryJobsWithBodyWithResponse request with arbitrary body returning *QueryJobsResponse
+func (c *ClientWithResponses) QueryJobsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryJobsResponse, error) {
+	rsp, err := c.QueryJobsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryJobsResponse(rsp)
+}
+
+func (c *ClientWithResponses) QueryJobsWithResponse(ctx context.Context, body QueryJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryJobsResponse, error) {
+	rsp, err := c.QueryJobs(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryJobsResponse(rsp)
+}
+
+// GetJobTypeWithResponse request returning *GetJobTypeResponse
+func (c *ClientWithResponses) GetJobTypeWithResponse(ctx context.Context, typeName string, reqEditors ...RequestEditorFn) (*GetJobTypeResponse, error) {
+	rsp, err := c.GetJobType(ctx, typeName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobTypeResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r FetchJobLastRenderedInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ValidateJobTypeWithBodyWithResponse request with arbitrary body returning *ValidateJobTypeResponse
+func (c *ClientWithResponses) ValidateJobTypeWithBodyWithResponse(ctx context.Context, typeName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateJobTypeResponse, error) {
+	rsp, err := c.ValidateJobTypeWithBody(ctx, typeName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseValidateJobTypeResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FetchJobLastRenderedInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) ValidateJobTypeWithResponse(ctx context.Context, typeName string, body ValidateJobTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateJobTypeResponse, error) {
+	rsp, err := c.ValidateJobType(ctx, typeName, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseValidateJobTypeResponse(rsp)
 }
 
-type SetJobStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+// GetJobTypesWithResponse request returning *GetJobTypesResponse
+func (c *ClientWithResponses) GetJobTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJobTypesResponse, error) {
+	rsp, err := c.GetJobTypes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobTypesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r SetJobStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteJobWithResponse request returning *DeleteJobResponse
+func (c *ClientWithResponses) DeleteJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*DeleteJobResponse, error) {
+	rsp, err := c.DeleteJob(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteJobResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SetJobStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FetchJobWithResponse request returning *FetchJobResponse
+func (c *ClientWithResponses) FetchJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobResponse, error) {
+	rsp, err := c.FetchJob(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseFetchJobResponse(rsp)
 }
 
-type FetchJobTasksResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *JobTasksSummary
-	JSONDefault  *Error
+// RemoveJobBlocklistWithBodyWithResponse request with arbitrary body returning *RemoveJobBlocklistResponse
+func (c *ClientWithResponses) RemoveJobBlocklistWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveJobBlocklistResponse, error) {
+	rsp, err := c.RemoveJobBlocklistWithBody(ctx, jobId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveJobBlocklistResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r FetchJobTasksResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) RemoveJobBlocklistWithResponse(ctx context.Context, jobId string, body RemoveJobBlocklistJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveJobBlocklistResponse, error) {
+	rsp, err := c.RemoveJobBlocklist(ctx, jobId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseRemoveJobBlocklistResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FetchJobTasksResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FetchJobBlocklistWithResponse request returning *FetchJobBlocklistResponse
+func (c *ClientWithResponses) FetchJobBlocklistWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobBlocklistResponse, error) {
+	rsp, err := c.FetchJobBlocklist(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseFetchJobBlocklistResponse(rsp)
 }
 
-type ShamanCheckoutResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ShamanCheckoutResult
-	JSON409      *Error
-	JSON424      *Error
-	JSONDefault  *Error
+// EditJobWithBodyWithResponse request with arbitrary body returning *EditJobResponse
+func (c *ClientWithResponses) EditJobWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditJobResponse, error) {
+	rsp, err := c.EditJobWithBody(ctx, jobId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditJobResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ShamanCheckoutResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) EditJobWithResponse(ctx context.Context, jobId string, body EditJobJSONRequestBody, reqEditors ...RequestEditorFn) (*EditJobResponse, error) {
+	rsp, err := c.EditJob(ctx, jobId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEditJobResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ShamanCheckoutResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FetchJobHistoryWithResponse request returning *FetchJobHistoryResponse
+func (c *ClientWithResponses) FetchJobHistoryWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobHistoryResponse, error) {
+	rsp, err := c.FetchJobHistory(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseFetchJobHistoryResponse(rsp)
 }
 
-type ShamanCheckoutRequirementsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ShamanRequirementsResponse
-	JSONDefault  *Error
+// FetchJobLastRenderedInfoWithResponse request returning *FetchJobLastRenderedInfoResponse
+func (c *ClientWithResponses) FetchJobLastRenderedInfoWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobLastRenderedInfoResponse, error) {
+	rsp, err := c.FetchJobLastRenderedInfo(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFetchJobLastRenderedInfoResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ShamanCheckoutRequirementsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// SetJobMaxWorkersWithBodyWithResponse request with arbitrary body returning *SetJobMaxWorkersResponse
+func (c *ClientWithResponses) SetJobMaxWorkersWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetJobMaxWorkersResponse, error) {
+	rsp, err := c.SetJobMaxWorkersWithBody(ctx, jobId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseSetJobMaxWorkersResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ShamanCheckoutRequirementsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) SetJobMaxWorkersWithResponse(ctx context.Context, jobId string, body SetJobMaxWorkersJSONRequestBody, reqEditors ...RequestEditorFn) (*SetJobMaxWorkersResponse, error) {
+	rsp, err := c.SetJobMaxWorkers(ctx, jobId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSetJobMaxWorkersResponse(rsp)
 }
 
-type ShamanFileStoreCheckResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ShamanSingleFileStatus
-	JSONDefault  *Error
+// SetJobPriorityWithBodyWithResponse request with arbitrary body returning *SetJobPriorityResponse
+func (c *ClientWithResponses) SetJobPriorityWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetJobPriorityResponse, error) {
+	rsp, err := c.SetJobPriorityWithBody(ctx, jobId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetJobPriorityResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ShamanFileStoreCheckResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) SetJobPriorityWithResponse(ctx context.Context, jobId string, body SetJobPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*SetJobPriorityResponse, error) {
+	rsp, err := c.SetJobPriority(ctx, jobId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseSetJobPriorityResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ShamanFileStoreCheckResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// SetJobStatusWithBodyWithResponse request with arbitrary body returning *SetJobStatusResponse
+func (c *ClientWithResponses) SetJobStatusWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetJobStatusResponse, error) {
+	rsp, err := c.SetJobStatusWithBody(ctx, jobId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSetJobStatusResponse(rsp)
 }
 
-type ShamanFileStoreResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+func (c *ClientWithResponses) SetJobStatusWithResponse(ctx context.Context, jobId string, body SetJobStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetJobStatusResponse, error) {
+	rsp, err := c.SetJobStatus(ctx, jobId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetJobStatusResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ShamanFileStoreResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FetchJobTasksWithResponse request returning *FetchJobTasksResponse
+func (c *ClientWithResponses) FetchJobTasksWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobTasksResponse, error) {
+	rsp, err := c.FetchJobTasks(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFetchJobTasksResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ShamanFileStoreResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ShamanCheckoutWithBodyWithResponse request with arbitrary body returning *ShamanCheckoutResponse
+func (c *ClientWithResponses) ShamanCheckoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanCheckoutResponse, error) {
+	rsp, err := c.ShamanCheckoutWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseShamanCheckoutResponse(rsp)
 }
 
-type FetchTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Task
-	JSONDefault  *Error
+func (c *ClientWithResponses) ShamanCheckoutWithResponse(ctx context.Context, body ShamanCheckoutJSONRequestBody, reqEditors ...RequestEditorFn) (*ShamanCheckoutResponse, error) {
+	rsp, err := c.ShamanCheckout(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShamanCheckoutResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r FetchTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ShamanCheckoutRequirementsWithBodyWithResponse request with arbitrary body returning *ShamanCheckoutRequirementsResponse
+func (c *ClientWithResponses) ShamanCheckoutRequirementsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanCheckoutRequirementsResponse, error) {
+	rsp, err := c.ShamanCheckoutRequirementsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseShamanCheckoutRequirementsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FetchTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) ShamanCheckoutRequirementsWithResponse(ctx context.Context, body ShamanCheckoutRequirementsJSONRequestBody, reqEditors ...RequestEditorFn) (*ShamanCheckoutRequirementsResponse, error) {
+	rsp, err := c.ShamanCheckoutRequirements(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseShamanCheckoutRequirementsResponse(rsp)
 }
 
-type FetchTaskLogInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TaskLogInfo
-	JSONDefault  *Error
+// ShamanFileStoreCheckWithResponse request returning *ShamanFileStoreCheckResponse
+func (c *ClientWithResponses) ShamanFileStoreCheckWithResponse(ctx context.Context, checksum string, filesize int, reqEditors ...RequestEditorFn) (*ShamanFileStoreCheckResponse, error) {
+	rsp, err := c.ShamanFileStoreCheck(ctx, checksum, filesize, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShamanFileStoreCheckResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r FetchTaskLogInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ShamanFileStoreWithBodyWithResponse request with arbitrary body returning *ShamanFileStoreResponse
+func (c *ClientWithResponses) ShamanFileStoreWithBodyWithResponse(ctx context.Context, checksum string, filesize int, params *ShamanFileStoreParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanFileStoreResponse, error) {
+	rsp, err := c.ShamanFileStoreWithBody(ctx, checksum, filesize, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseShamanFileStoreResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FetchTaskLogInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FetchTaskWithResponse request returning *FetchTaskResponse
+func (c *ClientWithResponses) FetchTaskWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskResponse, error) {
+	rsp, err := c.FetchTask(ctx, taskId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFetchTaskResponse(rsp)
+}
+
+// FetchTaskLogInfoWithResponse request returning *FetchTaskLogInfoResponse
+func (c *ClientWithResponses) FetchTaskLogInfoWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskLogInfoResponse, error) {
+	rsp, err := c.FetchTaskLogInfo(ctx, taskId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type FetchTaskLogTailResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+	return ParseFetchTaskLogInfoResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r FetchTaskLogTailResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FetchTaskLogTailWithResponse request returning *FetchTaskLogTailResponse
+func (c *ClientWithResponses) FetchTaskLogTailWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskLogTailResponse, error) {
+	rsp, err := c.FetchTaskLogTail(ctx, taskId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFetchTaskLogTailResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FetchTaskLogTailResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// SetTaskStatusWithBodyWithResponse request with arbitrary body returning *SetTaskStatusResponse
+func (c *ClientWithResponses) SetTaskStatusWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTaskStatusResponse, error) {
+	rsp, err := c.SetTaskStatusWithBody(ctx, taskId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSetTaskStatusResponse(rsp)
 }
 
-type SetTaskStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+func (c *ClientWithResponses) SetTaskStatusWithResponse(ctx context.Context, taskId string, body SetTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTaskStatusResponse, error) {
+	rsp, err := c.SetTaskStatus(ctx, taskId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTaskStatusResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r SetTaskStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FetchUsersWithResponse request returning *FetchUsersResponse
+func (c *ClientWithResponses) FetchUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchUsersResponse, error) {
+	rsp, err := c.FetchUsers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFetchUsersResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SetTaskStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResponse
+func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateUserResponse(rsp)
 }
 
-type GetVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *FlamencoVersion
+func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// LoginUserWithBodyWithResponse request with arbitrary body returning *LoginUserResponse
+func (c *ClientWithResponses) LoginUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginUserResponse, error) {
+	rsp, err := c.LoginUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseLoginUserResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) LoginUserWithResponse(ctx context.Context, body LoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginUserResponse, error) {
+	rsp, err := c.LoginUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseLoginUserResponse(rsp)
 }
 
-type FetchWorkersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkerList
+// LogoutUserWithResponse request returning *LogoutUserResponse
+func (c *ClientWithResponses) LogoutUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutUserResponse, error) {
+	rsp, err := c.LogoutUser(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutUserResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r FetchWorkersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FetchCurrentUserWithResponse request returning *FetchCurrentUserResponse
+func (c *ClientWithResponses) FetchCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchCurrentUserResponse, error) {
+	rsp, err := c.FetchCurrentUser(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFetchCurrentUserResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FetchWorkersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DeleteUserWithResponse request returning *DeleteUserResponse
+func (c *ClientWithResponses) DeleteUserWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error) {
+	rsp, err := c.DeleteUser(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDeleteUserResponse(rsp)
 }
 
-type FetchWorkerResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Worker
+// GetVersionWithResponse request returning *GetVersionResponse
+func (c *ClientWithResponses) GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error) {
+	rsp, err := c.GetVersion(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVersionResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r FetchWorkerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteWorkerTagWithResponse request returning *DeleteWorkerTagResponse
+func (c *ClientWithResponses) DeleteWorkerTagWithResponse(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*DeleteWorkerTagResponse, error) {
+	rsp, err := c.DeleteWorkerTag(ctx, tagId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteWorkerTagResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FetchWorkerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FetchWorkerTagWithResponse request returning *FetchWorkerTagResponse
+func (c *ClientWithResponses) FetchWorkerTagWithResponse(ctx context.Context, tagId string, reqEditors ...RequestEditorFn) (*FetchWorkerTagResponse, error) {
+	rsp, err := c.FetchWorkerTag(ctx, tagId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseFetchWorkerTagResponse(rsp)
 }
 
-type RequestWorkerStatusChangeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+// UpdateWorkerTagWithBodyWithResponse request with arbitrary body returning *UpdateWorkerTagResponse
+func (c *ClientWithResponses) UpdateWorkerTagWithBodyWithResponse(ctx context.Context, tagId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWorkerTagResponse, error) {
+	rsp, err := c.UpdateWorkerTagWithBody(ctx, tagId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWorkerTagResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r RequestWorkerStatusChangeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UpdateWorkerTagWithResponse(ctx context.Context, tagId string, body UpdateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWorkerTagResponse, error) {
+	rsp, err := c.UpdateWorkerTag(ctx, tagId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateWorkerTagResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RequestWorkerStatusChangeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FetchWorkerTagsWithResponse request returning *FetchWorkerTagsResponse
+func (c *ClientWithResponses) FetchWorkerTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchWorkerTagsResponse, error) {
+	rsp, err := c.FetchWorkerTags(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseFetchWorkerTagsResponse(rsp)
 }
 
-type FetchWorkerSleepScheduleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkerSleepSchedule
-	JSONDefault  *Error
+// CreateWorkerTagWithBodyWithResponse request with arbitrary body returning *CreateWorkerTagResponse
+func (c *ClientWithResponses) CreateWorkerTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWorkerTagResponse, error) {
+	rsp, err := c.CreateWorkerTagWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWorkerTagResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r FetchWorkerSleepScheduleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CreateWorkerTagWithResponse(ctx context.Context, body CreateWorkerTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWorkerTagResponse, error) {
+	rsp, err := c.CreateWorkerTag(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateWorkerTagResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FetchWorkerSleepScheduleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FetchWorkersWithResponse request returning *FetchWorkersResponse
+func (c *ClientWithResponses) FetchWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchWorkersResponse, error) {
+	rsp, err := c.FetchWorkers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseFetchWorkersResponse(rsp)
 }
 
-type SetWorkerSleepScheduleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+// FetchWorkerWithResponse request returning *FetchWorkerResponse
+func (c *ClientWithResponses) FetchWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*FetchWorkerResponse, error) {
+	rsp, err := c.FetchWorker(ctx, workerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFetchWorkerResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r SetWorkerSleepScheduleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// RequestWorkerStatusChangeWithBodyWithResponse request with arbitrary body returning *RequestWorkerStatusChangeResponse
+func (c *ClientWithResponses) RequestWorkerStatusChangeWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestWorkerStatusChangeResponse, error) {
+	rsp, err := c.RequestWorkerStatusChangeWithBody(ctx, workerId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseRequestWorkerStatusChangeResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SetWorkerSleepScheduleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) RequestWorkerStatusChangeWithResponse(ctx context.Context, workerId string, body RequestWorkerStatusChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestWorkerStatusChangeResponse, error) {
+	rsp, err := c.RequestWorkerStatusChange(ctx, workerId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseRequestWorkerStatusChangeResponse(rsp)
 }
 
-type RegisterWorkerResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RegisteredWorker
-	JSONDefault  *Error
+// SetWorkerTagsWithBodyWithResponse request with arbitrary body returning *SetWorkerTagsResponse
+func (c *ClientWithResponses) SetWorkerTagsWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerTagsResponse, error) {
+	rsp, err := c.SetWorkerTagsWithBody(ctx, workerId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetWorkerTagsResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r RegisterWorkerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) SetWorkerTagsWithResponse(ctx context.Context, workerId string, body SetWorkerTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerTagsResponse, error) {
+	rsp, err := c.SetWorkerTags(ctx, workerId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseSetWorkerTagsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RegisterWorkerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FetchWorkerSleepScheduleWithResponse request returning *FetchWorkerSleepScheduleResponse
+func (c *ClientWithResponses) FetchWorkerSleepScheduleWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*FetchWorkerSleepScheduleResponse, error) {
+	rsp, err := c.FetchWorkerSleepSchedule(ctx, workerId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseFetchWorkerSleepScheduleResponse(rsp)
 }
 
-type SignOffResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+// SetWorkerSleepScheduleWithBodyWithResponse request with arbitrary body returning *SetWorkerSleepScheduleResponse
+func (c *ClientWithResponses) SetWorkerSleepScheduleWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerSleepScheduleResponse, error) {
+	rsp, err := c.SetWorkerSleepScheduleWithBody(ctx, workerId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetWorkerSleepScheduleResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r SignOffResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) SetWorkerSleepScheduleWithResponse(ctx context.Context, workerId string, body SetWorkerSleepScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerSleepScheduleResponse, error) {
+	rsp, err := c.SetWorkerSleepSchedule(ctx, workerId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseSetWorkerSleepScheduleResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SignOffResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// RegisterWorkerWithBodyWithResponse request with arbitrary body returning *RegisterWorkerResponse
+func (c *ClientWithResponses) RegisterWorkerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error) {
+	rsp, err := c.RegisterWorkerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseRegisterWorkerResponse(rsp)
 }
 
-type SignOnResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkerStateChange
-	JSONDefault  *Error
+func (c *ClientWithResponses) RegisterWorkerWithResponse(ctx context.Context, body RegisterWorkerJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error) {
+	rsp, err := c.RegisterWorker(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterWorkerResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r SignOnResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// SignOffWithResponse request returning *SignOffResponse
+func (c *ClientWithResponses) SignOffWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SignOffResponse, error) {
+	rsp, err := c.SignOff(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseSignOffResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SignOnResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// SignOnWithBodyWithResponse request with arbitrary body returning *SignOnResponse
+func (c *ClientWithResponses) SignOnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignOnResponse, error) {
+	rsp, err := c.SignOnWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSignOnResponse(rsp)
 }
 
-type WorkerStateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WorkerStateChange
-	JSONDefault  *Error
+func (c *ClientWithResponses) SignOnWithResponse(ctx context.Context, body SignOnJSONRequestBody, reqEditors ...RequestEditorFn) (*SignOnResponse, error) {
+	rsp, err := c.SignOn(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSignOnResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkerStateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// WorkerStateWithResponse request returning *WorkerStateResponse
+func (c *ClientWithResponses) WorkerStateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WorkerStateResponse, error) {
+	rsp, err := c.WorkerState(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseWorkerStateResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkerStateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WorkerStateChangedWithBodyWithResponse request with arbitrary body returning *WorkerStateChangedResponse
+func (c *ClientWithResponses) WorkerStateChangedWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerStateChangedResponse, error) {
+	rsp, err := c.WorkerStateChangedWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWorkerStateChangedResponse(rsp)
 }
 
-type WorkerStateChangedResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+func (c *ClientWithResponses) WorkerStateChangedWithResponse(ctx context.Context, body WorkerStateChangedJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerStateChangedResponse, error) {
+	rsp, err := c.WorkerStateChanged(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWorkerStateChangedResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r WorkerStateChangedResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ScheduleTaskWithResponse request returning *ScheduleTaskResponse
+func (c *ClientWithResponses) ScheduleTaskWithResponse(ctx context.Context, params *ScheduleTaskParams, reqEditors ...RequestEditorFn) (*ScheduleTaskResponse, error) {
+	rsp, err := c.ScheduleTask(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseScheduleTaskResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WorkerStateChangedResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TaskUpdateWithBodyWithResponse request with arbitrary body returning *TaskUpdateResponse
+func (c *ClientWithResponses) TaskUpdateWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TaskUpdateResponse, error) {
+	rsp, err := c.TaskUpdateWithBody(ctx, taskId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTaskUpdateResponse(rsp)
 }
 
-type ScheduleTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AssignedTask
-	JSON403      *SecurityError
-	JSON423      *WorkerStateChange
+func (c *ClientWithResponses) TaskUpdateWithResponse(ctx context.Context, taskId string, body TaskUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TaskUpdateResponse, error) {
+	rsp, err := c.TaskUpdate(ctx, taskId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTaskUpdateResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ScheduleTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// MayWorkerRunWithResponse request returning *MayWorkerRunResponse
+func (c *ClientWithResponses) MayWorkerRunWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*MayWorkerRunResponse, error) {
+	rsp, err := c.MayWorkerRun(ctx, taskId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseMayWorkerRunResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ScheduleTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// TaskOutputProducedWithBodyWithResponse request with arbitrary body returning *TaskOutputProducedResponse
+func (c *ClientWithResponses) TaskOutputProducedWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TaskOutputProducedResponse, error) {
+	rsp, err := c.TaskOutputProducedWithBody(ctx, taskId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseTaskOutputProducedResponse(rsp)
 }
 
-type TaskUpdateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
-}
+// ParseGetConfigurationResponse parses an HTTP response from a GetConfigurationWithResponse call
+func ParseGetConfigurationResponse(rsp *http.Response) (*GetConfigurationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r TaskUpdateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetConfigurationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TaskUpdateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ManagerConfiguration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type MayWorkerRunResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MayKeepRunning
-	JSONDefault  *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r MayWorkerRunResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseFindBlenderExePathResponse parses an HTTP response from a FindBlenderExePathWithResponse call
+func ParseFindBlenderExePathResponse(rsp *http.Response) (*FindBlenderExePathResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MayWorkerRunResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &FindBlenderExePathResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type TaskOutputProducedResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON411      *Error
-	JSON413      *Error
-	JSON415      *Error
-	JSON429      *Error
-	JSONDefault  *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BlenderPathFindResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r TaskOutputProducedResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TaskOutputProducedResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseCheckBlenderExePathResponse parses an HTTP response from a CheckBlenderExePathWithResponse call
+func ParseCheckBlenderExePathResponse(rsp *http.Response) (*CheckBlenderExePathResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// GetConfigurationWithResponse request returning *GetConfigurationResponse
-func (c *ClientWithResponses) GetConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigurationResponse, error) {
-	rsp, err := c.GetConfiguration(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CheckBlenderExePathResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BlenderPathCheckResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseGetConfigurationResponse(rsp)
-}
 
-// FindBlenderExePathWithResponse request returning *FindBlenderExePathResponse
-func (c *ClientWithResponses) FindBlenderExePathWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FindBlenderExePathResponse, error) {
-	rsp, err := c.FindBlenderExePath(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseFindBlenderExePathResponse(rsp)
+	return response, nil
 }
 
-// CheckBlenderExePathWithBodyWithResponse request with arbitrary body returning *CheckBlenderExePathResponse
-func (c *ClientWithResponses) CheckBlenderExePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckBlenderExePathResponse, error) {
-	rsp, err := c.CheckBlenderExePathWithBody(ctx, contentType, body, reqEditors...)
+// ParseCheckSharedStoragePathResponse parses an HTTP response from a CheckSharedStoragePathWithResponse call
+func ParseCheckSharedStoragePathResponse(rsp *http.Response) (*CheckSharedStoragePathResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCheckBlenderExePathResponse(rsp)
-}
 
-func (c *ClientWithResponses) CheckBlenderExePathWithResponse(ctx context.Context, body CheckBlenderExePathJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckBlenderExePathResponse, error) {
-	rsp, err := c.CheckBlenderExePath(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CheckSharedStoragePathResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCheckBlenderExePathResponse(rsp)
-}
 
-// CheckSharedStoragePathWithBodyWithResponse request with arbitrary body returning *CheckSharedStoragePathResponse
-func (c *ClientWithResponses) CheckSharedStoragePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckSharedStoragePathResponse, error) {
-	rsp, err := c.CheckSharedStoragePathWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCheckSharedStoragePathResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PathCheckResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-func (c *ClientWithResponses) CheckSharedStoragePathWithResponse(ctx context.Context, body CheckSharedStoragePathJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckSharedStoragePathResponse, error) {
-	rsp, err := c.CheckSharedStoragePath(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseCheckSharedStoragePathResponse(rsp)
+
+	return response, nil
 }
 
-// GetConfigurationFileWithResponse request returning *GetConfigurationFileResponse
-func (c *ClientWithResponses) GetConfigurationFileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigurationFileResponse, error) {
-	rsp, err := c.GetConfigurationFile(ctx, reqEditors...)
+// ParseBackupDatabaseResponse parses an HTTP response from a BackupDatabaseWithResponse call
+func ParseBackupDatabaseResponse(rsp *http.Response) (*BackupDatabaseResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetConfigurationFileResponse(rsp)
-}
 
-// SaveSetupAssistantConfigWithBodyWithResponse request with arbitrary body returning *SaveSetupAssistantConfigResponse
-func (c *ClientWithResponses) SaveSetupAssistantConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveSetupAssistantConfigResponse, error) {
-	rsp, err := c.SaveSetupAssistantConfigWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &BackupDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSaveSetupAssistantConfigResponse(rsp)
-}
 
-func (c *ClientWithResponses) SaveSetupAssistantConfigWithResponse(ctx context.Context, body SaveSetupAssistantConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveSetupAssistantConfigResponse, error) {
-	rsp, err := c.SaveSetupAssistantConfig(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseBackupResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseSaveSetupAssistantConfigResponse(rsp)
+
+	return response, nil
 }
 
-// GetVariablesWithResponse request returning *GetVariablesResponse
-func (c *ClientWithResponses) GetVariablesWithResponse(ctx context.Context, audience ManagerVariableAudience, platform string, reqEditors ...RequestEditorFn) (*GetVariablesResponse, error) {
-	rsp, err := c.GetVariables(ctx, audience, platform, reqEditors...)
+// ParseGetConfigurationFileResponse parses an HTTP response from a GetConfigurationFileWithResponse call
+func ParseGetConfigurationFileResponse(rsp *http.Response) (*GetConfigurationFileResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetVariablesResponse(rsp)
-}
 
-// SubmitJobWithBodyWithResponse request with arbitrary body returning *SubmitJobResponse
-func (c *ClientWithResponses) SubmitJobWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitJobResponse, error) {
-	rsp, err := c.SubmitJobWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetConfigurationFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSubmitJobResponse(rsp)
-}
 
-func (c *ClientWithResponses) SubmitJobWithResponse(ctx context.Context, body SubmitJobJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitJobResponse, error) {
-	rsp, err := c.SubmitJob(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			AdditionalProperties map[string]interface{} `json:"-"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest string
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
 	}
-	return ParseSubmitJobResponse(rsp)
+
+	return response, nil
 }
 
-// FetchGlobalLastRenderedInfoWithResponse request returning *FetchGlobalLastRenderedInfoResponse
-func (c *ClientWithResponses) FetchGlobalLastRenderedInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchGlobalLastRenderedInfoResponse, error) {
-	rsp, err := c.FetchGlobalLastRenderedInfo(ctx, reqEditors...)
+// ParseSaveSetupAssistantConfigResponse parses an HTTP response from a SaveSetupAssistantConfigWithResponse call
+func ParseSaveSetupAssistantConfigResponse(rsp *http.Response) (*SaveSetupAssistantConfigResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFetchGlobalLastRenderedInfoResponse(rsp)
-}
 
-// QueryJobsWithBodyWithResponse request with arbitrary body returning *QueryJobsResponse
-func (c *ClientWithResponses) QueryJobsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryJobsResponse, error) {
-	rsp, err := c.QueryJobsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SaveSetupAssistantConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseQueryJobsResponse(rsp)
-}
 
-func (c *ClientWithResponses) QueryJobsWithResponse(ctx context.Context, body QueryJobsJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryJobsResponse, error) {
-	rsp, err := c.QueryJobs(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseQueryJobsResponse(rsp)
+
+	return response, nil
 }
 
-// GetJobTypeWithResponse request returning *GetJobTypeResponse
-func (c *ClientWithResponses) GetJobTypeWithResponse(ctx context.Context, typeName string, reqEditors ...RequestEditorFn) (*GetJobTypeResponse, error) {
-	rsp, err := c.GetJobType(ctx, typeName, reqEditors...)
+// ParseGetVariablesResponse parses an HTTP response from a GetVariablesWithResponse call
+func ParseGetVariablesResponse(rsp *http.Response) (*GetVariablesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetJobTypeResponse(rsp)
-}
 
-// GetJobTypesWithResponse request returning *GetJobTypesResponse
-func (c *ClientWithResponses) GetJobTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJobTypesResponse, error) {
-	rsp, err := c.GetJobTypes(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetVariablesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetJobTypesResponse(rsp)
-}
 
-// FetchJobWithResponse request returning *FetchJobResponse
-func (c *ClientWithResponses) FetchJobWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobResponse, error) {
-	rsp, err := c.FetchJob(ctx, jobId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ManagerVariables
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseFetchJobResponse(rsp)
+
+	return response, nil
 }
 
-// RemoveJobBlocklistWithBodyWithResponse request with arbitrary body returning *RemoveJobBlocklistResponse
-func (c *ClientWithResponses) RemoveJobBlocklistWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RemoveJobBlocklistResponse, error) {
-	rsp, err := c.RemoveJobBlocklistWithBody(ctx, jobId, contentType, body, reqEditors...)
+// ParseSubmitJobResponse parses an HTTP response from a SubmitJobWithResponse call
+func ParseSubmitJobResponse(rsp *http.Response) (*SubmitJobResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRemoveJobBlocklistResponse(rsp)
-}
 
-func (c *ClientWithResponses) RemoveJobBlocklistWithResponse(ctx context.Context, jobId string, body RemoveJobBlocklistJSONRequestBody, reqEditors ...RequestEditorFn) (*RemoveJobBlocklistResponse, error) {
-	rsp, err := c.RemoveJobBlocklist(ctx, jobId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SubmitJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseRemoveJobBlocklistResponse(rsp)
-}
 
-// FetchJobBlocklistWithResponse request returning *FetchJobBlocklistResponse
-func (c *ClientWithResponses) FetchJobBlocklistWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobBlocklistResponse, error) {
-	rsp, err := c.FetchJobBlocklist(ctx, jobId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseFetchJobBlocklistResponse(rsp)
-}
 
-// FetchJobLastRenderedInfoWithResponse request returning *FetchJobLastRenderedInfoResponse
-func (c *ClientWithResponses) FetchJobLastRenderedInfoWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobLastRenderedInfoResponse, error) {
-	rsp, err := c.FetchJobLastRenderedInfo(ctx, jobId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseFetchJobLastRenderedInfoResponse(rsp)
+	return response, nil
 }
 
-// SetJobStatusWithBodyWithResponse request with arbitrary body returning *SetJobStatusResponse
-func (c *ClientWithResponses) SetJobStatusWithBodyWithResponse(ctx context.Context, jobId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetJobStatusResponse, error) {
-	rsp, err := c.SetJobStatusWithBody(ctx, jobId, contentType, body, reqEditors...)
+// ParseSubmitJobDryRunResponse parses an HTTP response from a SubmitJobDryRunWithResponse call
+func ParseSubmitJobDryRunResponse(rsp *http.Response) (*SubmitJobDryRunResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSetJobStatusResponse(rsp)
-}
 
-func (c *ClientWithResponses) SetJobStatusWithResponse(ctx context.Context, jobId string, body SetJobStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetJobStatusResponse, error) {
-	rsp, err := c.SetJobStatus(ctx, jobId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SubmitJobDryRunResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSetJobStatusResponse(rsp)
-}
 
-// FetchJobTasksWithResponse request returning *FetchJobTasksResponse
-func (c *ClientWithResponses) FetchJobTasksWithResponse(ctx context.Context, jobId string, reqEditors ...RequestEditorFn) (*FetchJobTasksResponse, error) {
-	rsp, err := c.FetchJobTasks(ctx, jobId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseFetchJobTasksResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthoredJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// ShamanCheckoutWithBodyWithResponse request with arbitrary body returning *ShamanCheckoutResponse
-func (c *ClientWithResponses) ShamanCheckoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanCheckoutResponse, error) {
-	rsp, err := c.ShamanCheckoutWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseShamanCheckoutResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-func (c *ClientWithResponses) ShamanCheckoutWithResponse(ctx context.Context, body ShamanCheckoutJSONRequestBody, reqEditors ...RequestEditorFn) (*ShamanCheckoutResponse, error) {
-	rsp, err := c.ShamanCheckout(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseShamanCheckoutResponse(rsp)
+
+	return response, nil
 }
 
-// ShamanCheckoutRequirementsWithBodyWithResponse request with arbitrary body returning *ShamanCheckoutRequirementsResponse
-func (c *ClientWithResponses) ShamanCheckoutRequirementsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanCheckoutRequirementsResponse, error) {
-	rsp, err := c.ShamanCheckoutRequirementsWithBody(ctx, contentType, body, reqEditors...)
+// ParseFetchGlobalLastRenderedInfoResponse parses an HTTP response from a FetchGlobalLastRenderedInfoWithResponse call
+func ParseFetchGlobalLastRenderedInfoResponse(rsp *http.Response) (*FetchGlobalLastRenderedInfoResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseShamanCheckoutRequirementsResponse(rsp)
-}
 
-func (c *ClientWithResponses) ShamanCheckoutRequirementsWithResponse(ctx context.Context, body ShamanCheckoutRequirementsJSONRequestBody, reqEditors ...RequestEditorFn) (*ShamanCheckoutRequirementsResponse, error) {
-	rsp, err := c.ShamanCheckoutRequirements(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FetchGlobalLastRenderedInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseShamanCheckoutRequirementsResponse(rsp)
-}
 
-// ShamanFileStoreCheckWithResponse request returning *ShamanFileStoreCheckResponse
-func (c *ClientWithResponses) ShamanFileStoreCheckWithResponse(ctx context.Context, checksum string, filesize int, reqEditors ...RequestEditorFn) (*ShamanFileStoreCheckResponse, error) {
-	rsp, err := c.ShamanFileStoreCheck(ctx, checksum, filesize, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JobLastRenderedImageInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseShamanFileStoreCheckResponse(rsp)
+
+	return response, nil
 }
 
-// ShamanFileStoreWithBodyWithResponse request with arbitrary body returning *ShamanFileStoreResponse
-func (c *ClientWithResponses) ShamanFileStoreWithBodyWithResponse(ctx context.Context, checksum string, filesize int, params *ShamanFileStoreParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShamanFileStoreResponse, error) {
-	rsp, err := c.ShamanFileStoreWithBody(ctx, checksum, filesize, params, contentType, body, reqEditors...)
+// ParseDeleteJobMassResponse parses an HTTP response from a DeleteJobMassWithResponse call
+func ParseDeleteJobMassResponse(rsp *http.Response) (*DeleteJobMassResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseShamanFileStoreResponse(rsp)
-}
 
-// FetchTaskWithResponse request returning *FetchTaskResponse
-func (c *ClientWithResponses) FetchTaskWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskResponse, error) {
-	rsp, err := c.FetchTask(ctx, taskId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteJobMassResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseFetchTaskResponse(rsp)
-}
 
-// FetchTaskLogInfoWithResponse request returning *FetchTaskLogInfoResponse
-func (c *ClientWithResponses) FetchTaskLogInfoWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskLogInfoResponse, error) {
-	rsp, err := c.FetchTaskLogInfo(ctx, taskId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JobMassDeletionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseFetchTaskLogInfoResponse(rsp)
+
+	return response, nil
 }
 
-// FetchTaskLogTailWithResponse request returning *FetchTaskLogTailResponse
-func (c *ClientWithResponses) FetchTaskLogTailWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*FetchTaskLogTailResponse, error) {
-	rsp, err := c.FetchTaskLogTail(ctx, taskId, reqEditors...)
+// ParseQueryJobsResponse parses an HTTP response from a QueryJobsWithResponse call
+func ParseQueryJobsResponse(rsp *http.Response) (*QueryJobsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFetchTaskLogTailResponse(rsp)
-}
 
-// SetTaskStatusWithBodyWithResponse request with arbitrary body returning *SetTaskStatusResponse
-func (c *ClientWithResponses) SetTaskStatusWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTaskStatusResponse, error) {
-	rsp, err := c.SetTaskStatusWithBody(ctx, taskId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &QueryJobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSetTaskStatusResponse(rsp)
-}
 
-func (c *ClientWithResponses) SetTaskStatusWithResponse(ctx context.Context, taskId string, body SetTaskStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTaskStatusResponse, error) {
-	rsp, err := c.SetTaskStatus(ctx, taskId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JobsQueryResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseSetTaskStatusResponse(rsp)
+
+	return response, nil
 }
 
-// GetVersionWithResponse request returning *GetVersionResponse
-func (c *ClientWithResponses) GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error) {
-	rsp, err := c.GetVersion(ctx, reqEditors...)
+// ParseGetJobTypeResponse parses an HTTP response from a GetJobTypeWithResponse call
+func ParseGetJobTypeResponse(rsp *http.Response) (*GetJobTypeResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetVersionResponse(rsp)
-}
 
-// FetchWorkersWithResponse request returning *FetchWorkersResponse
-func (c *ClientWithResponses) FetchWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FetchWorkersResponse, error) {
-	rsp, err := c.FetchWorkers(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetJobTypeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseFetchWorkersResponse(rsp)
-}
 
-// FetchWorkerWithResponse request returning *FetchWorkerResponse
-func (c *ClientWithResponses) FetchWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*FetchWorkerResponse, error) {
-	rsp, err := c.FetchWorker(ctx, workerId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AvailableJobType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseFetchWorkerResponse(rsp)
+
+	return response, nil
 }
 
-// RequestWorkerStatusChangeWithBodyWithResponse request with arbitrary body returning *RequestWorkerStatusChangeResponse
-func (c *ClientWithResponses) RequestWorkerStatusChangeWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestWorkerStatusChangeResponse, error) {
-	rsp, err := c.RequestWorkerStatusChangeWithBody(ctx, workerId, contentType, body, reqEditors...)
+// ParseValidateJobTypeResponse parses an HTTP response from a ValidateJobTypeWithResponse call
+func ParseValidateJobTypeResponse(rsp *http.Response) (*ValidateJobTypeResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRequestWorkerStatusChangeResponse(rsp)
-}
 
-func (c *ClientWithResponses) RequestWorkerStatusChangeWithResponse(ctx context.Context, workerId string, body RequestWorkerStatusChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestWorkerStatusChangeResponse, error) {
-	rsp, err := c.RequestWorkerStatusChange(ctx, workerId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ValidateJobTypeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseRequestWorkerStatusChangeResponse(rsp)
-}
 
-// FetchWorkerSleepScheduleWithResponse request returning *FetchWorkerSleepScheduleResponse
-func (c *ClientWithResponses) FetchWorkerSleepScheduleWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*FetchWorkerSleepScheduleResponse, error) {
-	rsp, err := c.FetchWorkerSleepSchedule(ctx, workerId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JobTypeValidationResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest JobScriptError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseFetchWorkerSleepScheduleResponse(rsp)
-}
 
-// SetWorkerSleepScheduleWithBodyWithResponse request with arbitrary body returning *SetWorkerSleepScheduleResponse
-func (c *ClientWithResponses) SetWorkerSleepScheduleWithBodyWithResponse(ctx context.Context, workerId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerSleepScheduleResponse, error) {
-	rsp, err := c.SetWorkerSleepScheduleWithBody(ctx, workerId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseSetWorkerSleepScheduleResponse(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) SetWorkerSleepScheduleWithResponse(ctx context.Context, workerId string, body SetWorkerSleepScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerSleepScheduleResponse, error) {
-	rsp, err := c.SetWorkerSleepSchedule(ctx, workerId, body, reqEditors...)
+// ParseGetJobTypesResponse parses an HTTP response from a GetJobTypesWithResponse call
+func ParseGetJobTypesResponse(rsp *http.Response) (*GetJobTypesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSetWorkerSleepScheduleResponse(rsp)
-}
 
-// RegisterWorkerWithBodyWithResponse request with arbitrary body returning *RegisterWorkerResponse
-func (c *ClientWithResponses) RegisterWorkerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error) {
-	rsp, err := c.RegisterWorkerWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetJobTypesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseRegisterWorkerResponse(rsp)
-}
 
-func (c *ClientWithResponses) RegisterWorkerWithResponse(ctx context.Context, body RegisterWorkerJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterWorkerResponse, error) {
-	rsp, err := c.RegisterWorker(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseRegisterWorkerResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AvailableJobTypes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// SignOffWithResponse request returning *SignOffResponse
-func (c *ClientWithResponses) SignOffWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SignOffResponse, error) {
-	rsp, err := c.SignOff(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseSignOffResponse(rsp)
-}
 
-// SignOnWithBodyWithResponse request with arbitrary body returning *SignOnResponse
-func (c *ClientWithResponses) SignOnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignOnResponse, error) {
-	rsp, err := c.SignOnWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseSignOnResponse(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) SignOnWithResponse(ctx context.Context, body SignOnJSONRequestBody, reqEditors ...RequestEditorFn) (*SignOnResponse, error) {
-	rsp, err := c.SignOn(ctx, body, reqEditors...)
+// ParseDeleteJobResponse parses an HTTP response from a DeleteJobWithResponse call
+func ParseDeleteJobResponse(rsp *http.Response) (*DeleteJobResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSignOnResponse(rsp)
-}
 
-// WorkerStateWithResponse request returning *WorkerStateResponse
-func (c *ClientWithResponses) WorkerStateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WorkerStateResponse, error) {
-	rsp, err := c.WorkerState(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseWorkerStateResponse(rsp)
-}
 
-// WorkerStateChangedWithBodyWithResponse request with arbitrary body returning *WorkerStateChangedResponse
-func (c *ClientWithResponses) WorkerStateChangedWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WorkerStateChangedResponse, error) {
-	rsp, err := c.WorkerStateChangedWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseWorkerStateChangedResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) WorkerStateChangedWithResponse(ctx context.Context, body WorkerStateChangedJSONRequestBody, reqEditors ...RequestEditorFn) (*WorkerStateChangedResponse, error) {
-	rsp, err := c.WorkerStateChanged(ctx, body, reqEditors...)
+// ParseFetchJobResponse parses an HTTP response from a FetchJobWithResponse call
+func ParseFetchJobResponse(rsp *http.Response) (*FetchJobResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWorkerStateChangedResponse(rsp)
-}
 
-// ScheduleTaskWithResponse request returning *ScheduleTaskResponse
-func (c *ClientWithResponses) ScheduleTaskWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ScheduleTaskResponse, error) {
-	rsp, err := c.ScheduleTask(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FetchJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseScheduleTaskResponse(rsp)
-}
 
-// TaskUpdateWithBodyWithResponse request with arbitrary body returning *TaskUpdateResponse
-func (c *ClientWithResponses) TaskUpdateWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TaskUpdateResponse, error) {
-	rsp, err := c.TaskUpdateWithBody(ctx, taskId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseTaskUpdateResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) TaskUpdateWithResponse(ctx context.Context, taskId string, body TaskUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*TaskUpdateResponse, error) {
-	rsp, err := c.TaskUpdate(ctx, taskId, body, reqEditors...)
+// ParseRemoveJobBlocklistResponse parses an HTTP response from a RemoveJobBlocklistWithResponse call
+func ParseRemoveJobBlocklistResponse(rsp *http.Response) (*RemoveJobBlocklistResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseTaskUpdateResponse(rsp)
-}
 
-// MayWorkerRunWithResponse request returning *MayWorkerRunResponse
-func (c *ClientWithResponses) MayWorkerRunWithResponse(ctx context.Context, taskId string, reqEditors ...RequestEditorFn) (*MayWorkerRunResponse, error) {
-	rsp, err := c.MayWorkerRun(ctx, taskId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &RemoveJobBlocklistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseMayWorkerRunResponse(rsp)
-}
 
-// TaskOutputProducedWithBodyWithResponse request with arbitrary body returning *TaskOutputProducedResponse
-func (c *ClientWithResponses) TaskOutputProducedWithBodyWithResponse(ctx context.Context, taskId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TaskOutputProducedResponse, error) {
-	rsp, err := c.TaskOutputProducedWithBody(ctx, taskId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseTaskOutputProducedResponse(rsp)
+
+	return response, nil
 }
 
-// ParseGetConfigurationResponse parses an HTTP response from a GetConfigurationWithResponse call
-func ParseGetConfigurationResponse(rsp *http.Response) (*GetConfigurationResponse, error) {
+// ParseFetchJobBlocklistResponse parses an HTTP response from a FetchJobBlocklistWithResponse call
+func ParseFetchJobBlocklistResponse(rsp *http.Response) (*FetchJobBlocklistResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetConfigurationResponse{
+	response := &FetchJobBlocklistResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ManagerConfiguration
+		var dest JobBlocklist
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseFindBlenderExePathResponse parses an HTTP response from a FindBlenderExePathWithResponse call
-func ParseFindBlenderExePathResponse(rsp *http.Response) (*FindBlenderExePathResponse, error) {
+// ParseEditJobResponse parses an HTTP response from a EditJobWithResponse call
+func ParseEditJobResponse(rsp *http.Response) (*EditJobResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindBlenderExePathResponse{
+	response := &EditJobResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BlenderPathFindResult
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
@@ -4127,27 +6906,34 @@ func ParseFindBlenderExePathResponse(rsp *http.Response) (*FindBlenderExePathRes
 	return response, nil
 }
 
-// ParseCheckBlenderExePathResponse parses an HTTP response from a CheckBlenderExePathWithResponse call
-func ParseCheckBlenderExePathResponse(rsp *http.Response) (*CheckBlenderExePathResponse, error) {
+// ParseFetchJobHistoryResponse parses an HTTP response from a FetchJobHistoryWithResponse call
+func ParseFetchJobHistoryResponse(rsp *http.Response) (*FetchJobHistoryResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CheckBlenderExePathResponse{
+	response := &FetchJobHistoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BlenderPathCheckResult
+		var dest JobHistory
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4160,27 +6946,53 @@ func ParseCheckBlenderExePathResponse(rsp *http.Response) (*CheckBlenderExePathR
 	return response, nil
 }
 
-// ParseCheckSharedStoragePathResponse parses an HTTP response from a CheckSharedStoragePathWithResponse call
-func ParseCheckSharedStoragePathResponse(rsp *http.Response) (*CheckSharedStoragePathResponse, error) {
+// ParseFetchJobLastRenderedInfoResponse parses an HTTP response from a FetchJobLastRenderedInfoWithResponse call
+func ParseFetchJobLastRenderedInfoResponse(rsp *http.Response) (*FetchJobLastRenderedInfoResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CheckSharedStoragePathResponse{
+	response := &FetchJobLastRenderedInfoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PathCheckResult
+		var dest JobLastRenderedImageInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	}
+
+	return response, nil
+}
+
+// ParseSetJobMaxWorkersResponse parses an HTTP response from a SetJobMaxWorkersWithResponse call
+func ParseSetJobMaxWorkersResponse(rsp *http.Response) (*SetJobMaxWorkersResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetJobMaxWorkersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4193,50 +7005,48 @@ func ParseCheckSharedStoragePathResponse(rsp *http.Response) (*CheckSharedStorag
 	return response, nil
 }
 
-// ParseGetConfigurationFileResponse parses an HTTP response from a GetConfigurationFileWithResponse call
-func ParseGetConfigurationFileResponse(rsp *http.Response) (*GetConfigurationFileResponse, error) {
+// ParseSetJobPriorityResponse parses an HTTP response from a SetJobPriorityWithResponse call
+func ParseSetJobPriorityResponse(rsp *http.Response) (*SetJobPriorityResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetConfigurationFileResponse{
+	response := &SetJobPriorityResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			AdditionalProperties map[string]interface{} `json:"-"`
-		}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
-		var dest string
-		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.YAML200 = &dest
+		response.JSONDefault = &dest
 
 	}
 
 	return response, nil
 }
-
-// ParseSaveSetupAssistantConfigResponse parses an HTTP response from a SaveSetupAssistantConfigWithResponse call
-func ParseSaveSetupAssistantConfigResponse(rsp *http.Response) (*SaveSetupAssistantConfigResponse, error) {
+
+// ParseSetJobStatusResponse parses an HTTP response from a SetJobStatusWithResponse call
+func ParseSetJobStatusResponse(rsp *http.Response) (*SetJobStatusResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SaveSetupAssistantConfigResponse{
+	response := &SetJobStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4254,59 +7064,73 @@ func ParseSaveSetupAssistantConfigResponse(rsp *http.Response) (*SaveSetupAssist
 	return response, nil
 }
 
-// ParseGetVariablesResponse parses an HTTP response from a GetVariablesWithResponse call
-func ParseGetVariablesResponse(rsp *http.Response) (*GetVariablesResponse, error) {
+// ParseFetchJobTasksResponse parses an HTTP response from a FetchJobTasksWithResponse call
+func ParseFetchJobTasksResponse(rsp *http.Response) (*FetchJobTasksResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetVariablesResponse{
+	response := &FetchJobTasksResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ManagerVariables
+		var dest JobTasksSummary
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseSubmitJobResponse parses an HTTP response from a SubmitJobWithResponse call
-func ParseSubmitJobResponse(rsp *http.Response) (*SubmitJobResponse, error) {
+// ParseShamanCheckoutResponse parses an HTTP response from a ShamanCheckoutWithResponse call
+func ParseShamanCheckoutResponse(rsp *http.Response) (*ShamanCheckoutResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SubmitJobResponse{
+	response := &ShamanCheckoutResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Job
+		var dest ShamanCheckoutResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 424:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON424 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
@@ -4320,48 +7144,55 @@ func ParseSubmitJobResponse(rsp *http.Response) (*SubmitJobResponse, error) {
 	return response, nil
 }
 
-// ParseFetchGlobalLastRenderedInfoResponse parses an HTTP response from a FetchGlobalLastRenderedInfoWithResponse call
-func ParseFetchGlobalLastRenderedInfoResponse(rsp *http.Response) (*FetchGlobalLastRenderedInfoResponse, error) {
+// ParseShamanCheckoutRequirementsResponse parses an HTTP response from a ShamanCheckoutRequirementsWithResponse call
+func ParseShamanCheckoutRequirementsResponse(rsp *http.Response) (*ShamanCheckoutRequirementsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FetchGlobalLastRenderedInfoResponse{
+	response := &ShamanCheckoutRequirementsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JobLastRenderedImageInfo
+		var dest ShamanRequirementsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseQueryJobsResponse parses an HTTP response from a QueryJobsWithResponse call
-func ParseQueryJobsResponse(rsp *http.Response) (*QueryJobsResponse, error) {
+// ParseShamanFileStoreCheckResponse parses an HTTP response from a ShamanFileStoreCheckWithResponse call
+func ParseShamanFileStoreCheckResponse(rsp *http.Response) (*ShamanFileStoreCheckResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryJobsResponse{
+	response := &ShamanFileStoreCheckResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JobsQueryResult
+		var dest ShamanSingleFileStatus
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4379,93 +7210,107 @@ func ParseQueryJobsResponse(rsp *http.Response) (*QueryJobsResponse, error) {
 	return response, nil
 }
 
-// ParseGetJobTypeResponse parses an HTTP response from a GetJobTypeWithResponse call
-func ParseGetJobTypeResponse(rsp *http.Response) (*GetJobTypeResponse, error) {
+// ParseShamanFileStoreResponse parses an HTTP response from a ShamanFileStoreWithResponse call
+func ParseShamanFileStoreResponse(rsp *http.Response) (*ShamanFileStoreResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetJobTypeResponse{
+	response := &ShamanFileStoreResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AvailableJobType
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSONDefault = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetJobTypesResponse parses an HTTP response from a GetJobTypesWithResponse call
-func ParseGetJobTypesResponse(rsp *http.Response) (*GetJobTypesResponse, error) {
+// ParseFetchTaskResponse parses an HTTP response from a FetchTaskWithResponse call
+func ParseFetchTaskResponse(rsp *http.Response) (*FetchTaskResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetJobTypesResponse{
+	response := &FetchTaskResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AvailableJobTypes
+		var dest Task
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseFetchJobResponse parses an HTTP response from a FetchJobWithResponse call
-func ParseFetchJobResponse(rsp *http.Response) (*FetchJobResponse, error) {
+// ParseFetchTaskLogInfoResponse parses an HTTP response from a FetchTaskLogInfoWithResponse call
+func ParseFetchTaskLogInfoResponse(rsp *http.Response) (*FetchTaskLogInfoResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FetchJobResponse{
+	response := &FetchTaskLogInfoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Job
+		var dest TaskLogInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseRemoveJobBlocklistResponse parses an HTTP response from a RemoveJobBlocklistWithResponse call
-func ParseRemoveJobBlocklistResponse(rsp *http.Response) (*RemoveJobBlocklistResponse, error) {
+// ParseFetchTaskLogTailResponse parses an HTTP response from a FetchTaskLogTailWithResponse call
+func ParseFetchTaskLogTailResponse(rsp *http.Response) (*FetchTaskLogTailResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RemoveJobBlocklistResponse{
+	response := &FetchTaskLogTailResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4483,27 +7328,20 @@ func ParseRemoveJobBlocklistResponse(rsp *http.Response) (*RemoveJobBlocklistRes
 	return response, nil
 }
 
-// ParseFetchJobBlocklistResponse parses an HTTP response from a FetchJobBlocklistWithResponse call
-func ParseFetchJobBlocklistResponse(rsp *http.Response) (*FetchJobBlocklistResponse, error) {
+// ParseSetTaskStatusResponse parses an HTTP response from a SetTaskStatusWithResponse call
+func ParseSetTaskStatusResponse(rsp *http.Response) (*SetTaskStatusResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FetchJobBlocklistResponse{
+	response := &SetTaskStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JobBlocklist
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4516,46 +7354,60 @@ func ParseFetchJobBlocklistResponse(rsp *http.Response) (*FetchJobBlocklistRespo
 	return response, nil
 }
 
-// ParseFetchJobLastRenderedInfoResponse parses an HTTP response from a FetchJobLastRenderedInfoWithResponse call
-func ParseFetchJobLastRenderedInfoResponse(rsp *http.Response) (*FetchJobLastRenderedInfoResponse, error) {
+// ParseFetchUsersResponse parses an HTTP response from a FetchUsersWithResponse call
+func ParseFetchUsersResponse(rsp *http.Response) (*FetchUsersResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FetchJobLastRenderedInfoResponse{
+	response := &FetchUsersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JobLastRenderedImageInfo
+		var dest UserList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseSetJobStatusResponse parses an HTTP response from a SetJobStatusWithResponse call
-func ParseSetJobStatusResponse(rsp *http.Response) (*SetJobStatusResponse, error) {
+// ParseCreateUserResponse parses an HTTP response from a CreateUserWithResponse call
+func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SetJobStatusResponse{
+	response := &CreateUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4568,22 +7420,22 @@ func ParseSetJobStatusResponse(rsp *http.Response) (*SetJobStatusResponse, error
 	return response, nil
 }
 
-// ParseFetchJobTasksResponse parses an HTTP response from a FetchJobTasksWithResponse call
-func ParseFetchJobTasksResponse(rsp *http.Response) (*FetchJobTasksResponse, error) {
+// ParseLoginUserResponse parses an HTTP response from a LoginUserWithResponse call
+func ParseLoginUserResponse(rsp *http.Response) (*LoginUserResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FetchJobTasksResponse{
+	response := &LoginUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest JobTasksSummary
+		var dest UserLoginResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4601,41 +7453,20 @@ func ParseFetchJobTasksResponse(rsp *http.Response) (*FetchJobTasksResponse, err
 	return response, nil
 }
 
-// ParseShamanCheckoutResponse parses an HTTP response from a ShamanCheckoutWithResponse call
-func ParseShamanCheckoutResponse(rsp *http.Response) (*ShamanCheckoutResponse, error) {
+// ParseLogoutUserResponse parses an HTTP response from a LogoutUserWithResponse call
+func ParseLogoutUserResponse(rsp *http.Response) (*LogoutUserResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ShamanCheckoutResponse{
+	response := &LogoutUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ShamanCheckoutResult
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 424:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON424 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4648,22 +7479,22 @@ func ParseShamanCheckoutResponse(rsp *http.Response) (*ShamanCheckoutResponse, e
 	return response, nil
 }
 
-// ParseShamanCheckoutRequirementsResponse parses an HTTP response from a ShamanCheckoutRequirementsWithResponse call
-func ParseShamanCheckoutRequirementsResponse(rsp *http.Response) (*ShamanCheckoutRequirementsResponse, error) {
+// ParseFetchCurrentUserResponse parses an HTTP response from a FetchCurrentUserWithResponse call
+func ParseFetchCurrentUserResponse(rsp *http.Response) (*FetchCurrentUserResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ShamanCheckoutRequirementsResponse{
+	response := &FetchCurrentUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ShamanRequirementsResponse
+		var dest User
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4681,27 +7512,20 @@ func ParseShamanCheckoutRequirementsResponse(rsp *http.Response) (*ShamanCheckou
 	return response, nil
 }
 
-// ParseShamanFileStoreCheckResponse parses an HTTP response from a ShamanFileStoreCheckWithResponse call
-func ParseShamanFileStoreCheckResponse(rsp *http.Response) (*ShamanFileStoreCheckResponse, error) {
+// ParseDeleteUserResponse parses an HTTP response from a DeleteUserWithResponse call
+func ParseDeleteUserResponse(rsp *http.Response) (*DeleteUserResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ShamanFileStoreCheckResponse{
+	response := &DeleteUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ShamanSingleFileStatus
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4714,53 +7538,46 @@ func ParseShamanFileStoreCheckResponse(rsp *http.Response) (*ShamanFileStoreChec
 	return response, nil
 }
 
-// ParseShamanFileStoreResponse parses an HTTP response from a ShamanFileStoreWithResponse call
-func ParseShamanFileStoreResponse(rsp *http.Response) (*ShamanFileStoreResponse, error) {
+// ParseGetVersionResponse parses an HTTP response from a GetVersionWithResponse call
+func ParseGetVersionResponse(rsp *http.Response) (*GetVersionResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ShamanFileStoreResponse{
+	response := &GetVersionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FlamencoVersion
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSONDefault = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseFetchTaskResponse parses an HTTP response from a FetchTaskWithResponse call
-func ParseFetchTaskResponse(rsp *http.Response) (*FetchTaskResponse, error) {
+// ParseDeleteWorkerTagResponse parses an HTTP response from a DeleteWorkerTagWithResponse call
+func ParseDeleteWorkerTagResponse(rsp *http.Response) (*DeleteWorkerTagResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FetchTaskResponse{
+	response := &DeleteWorkerTagResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Task
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4773,22 +7590,22 @@ func ParseFetchTaskResponse(rsp *http.Response) (*FetchTaskResponse, error) {
 	return response, nil
 }
 
-// ParseFetchTaskLogInfoResponse parses an HTTP response from a FetchTaskLogInfoWithResponse call
-func ParseFetchTaskLogInfoResponse(rsp *http.Response) (*FetchTaskLogInfoResponse, error) {
+// ParseFetchWorkerTagResponse parses an HTTP response from a FetchWorkerTagWithResponse call
+func ParseFetchWorkerTagResponse(rsp *http.Response) (*FetchWorkerTagResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FetchTaskLogInfoResponse{
+	response := &FetchWorkerTagResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TaskLogInfo
+		var dest WorkerTag
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4806,15 +7623,15 @@ func ParseFetchTaskLogInfoResponse(rsp *http.Response) (*FetchTaskLogInfoRespons
 	return response, nil
 }
 
-// ParseFetchTaskLogTailResponse parses an HTTP response from a FetchTaskLogTailWithResponse call
-func ParseFetchTaskLogTailResponse(rsp *http.Response) (*FetchTaskLogTailResponse, error) {
+// ParseUpdateWorkerTagResponse parses an HTTP response from a UpdateWorkerTagWithResponse call
+func ParseUpdateWorkerTagResponse(rsp *http.Response) (*UpdateWorkerTagResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FetchTaskLogTailResponse{
+	response := &UpdateWorkerTagResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4832,53 +7649,60 @@ func ParseFetchTaskLogTailResponse(rsp *http.Response) (*FetchTaskLogTailRespons
 	return response, nil
 }
 
-// ParseSetTaskStatusResponse parses an HTTP response from a SetTaskStatusWithResponse call
-func ParseSetTaskStatusResponse(rsp *http.Response) (*SetTaskStatusResponse, error) {
+// ParseFetchWorkerTagsResponse parses an HTTP response from a FetchWorkerTagsWithResponse call
+func ParseFetchWorkerTagsResponse(rsp *http.Response) (*FetchWorkerTagsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SetTaskStatusResponse{
+	response := &FetchWorkerTagsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkerTagList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSONDefault = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetVersionResponse parses an HTTP response from a GetVersionWithResponse call
-func ParseGetVersionResponse(rsp *http.Response) (*GetVersionResponse, error) {
+// ParseCreateWorkerTagResponse parses an HTTP response from a CreateWorkerTagWithResponse call
+func ParseCreateWorkerTagResponse(rsp *http.Response) (*CreateWorkerTagResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetVersionResponse{
+	response := &CreateWorkerTagResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FlamencoVersion
+		var dest WorkerTag
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
 
 	return response, nil
@@ -4962,6 +7786,32 @@ func ParseRequestWorkerStatusChangeResponse(rsp *http.Response) (*RequestWorkerS
 	return response, nil
 }
 
+// ParseSetWorkerTagsResponse parses an HTTP response from a SetWorkerTagsWithResponse call
+func ParseSetWorkerTagsResponse(rsp *http.Response) (*SetWorkerTagsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetWorkerTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseFetchWorkerSleepScheduleResponse parses an HTTP response from a FetchWorkerSleepScheduleWithResponse call
 func ParseFetchWorkerSleepScheduleResponse(rsp *http.Response) (*FetchWorkerSleepScheduleResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -25,7 +25,10 @@ type ServerInterface interface {
 	// Validate a path for use as shared storage.
 	// (POST /api/v3/configuration/check/shared-storage)
 	CheckSharedStoragePath(ctx echo.Context) error
-	// Retrieve the configuration of Flamenco Manager.
+	// Back up the database to the configured backup directory. The Manager keeps running while the backup is made.
+	// (POST /api/v3/configuration/database-backup)
+	BackupDatabase(ctx echo.Context) error
+	// Retrieve the configuration of Flamenco Manager. Only admins can do this. Any password in the database DSN is redacted.
 	// (GET /api/v3/configuration/file)
 	GetConfigurationFile(ctx echo.Context) error
 	// Update the Manager's configuration, and restart it in fully functional mode.
@@ -37,18 +40,30 @@ type ServerInterface interface {
 	// Submit a new job for Flamenco Manager to execute.
 	// (POST /api/v3/jobs)
 	SubmitJob(ctx echo.Context) error
+	// Compile a job like `submitJob` does, but without storing anything in the database. Returns the compiled job with all its tasks.
+	// (POST /api/v3/jobs/dry-run)
+	SubmitJobDryRun(ctx echo.Context, params SubmitJobDryRunParams) error
 	// Get the URL that serves the last-rendered images.
 	// (GET /api/v3/jobs/last-rendered)
 	FetchGlobalLastRenderedInfo(ctx echo.Context) error
+	// Request deletion of all finished (completed, canceled, or failed) jobs that were last updated before the given timestamp.
+	// (POST /api/v3/jobs/mass-delete)
+	DeleteJobMass(ctx echo.Context) error
 	// Fetch list of jobs.
 	// (POST /api/v3/jobs/query)
 	QueryJobs(ctx echo.Context) error
 	// Get single job type and its parameters.
 	// (GET /api/v3/jobs/type/{typeName})
 	GetJobType(ctx echo.Context, typeName string) error
+	// Compile a job of this type with the given settings, without storing anything. This returns the tasks that the job compiler script produces, and is intended for developing job types.
+	// (POST /api/v3/jobs/type/{typeName}/validate)
+	ValidateJobType(ctx echo.Context, typeName string) error
 	// Get list of job types and their parameters.
 	// (GET /api/v3/jobs/types)
 	GetJobTypes(ctx echo.Context) error
+	// Request deletion of this job, including its tasks and any log files. The actual deletion happens in the background. Jobs that still have tasks running cannot be deleted.
+	// (DELETE /api/v3/jobs/{job_id})
+	DeleteJob(ctx echo.Context, jobId string) error
 	// Fetch info about the job.
 	// (GET /api/v3/jobs/{job_id})
 	FetchJob(ctx echo.Context, jobId string) error
@@ -58,10 +73,22 @@ type ServerInterface interface {
 	// Fetch the list of workers that are blocked from doing certain task types on this job.
 	// (GET /api/v3/jobs/{job_id}/blocklist)
 	FetchJobBlocklist(ctx echo.Context, jobId string) error
+	// Change settings of the job, and recompile it with those settings. Completed tasks are kept when the recompiled job has a task with the same name; all other tasks are replaced. Afterwards the job is queued.
+	// (POST /api/v3/jobs/{job_id}/edit)
+	EditJob(ctx echo.Context, jobId string) error
+	// Fetch the settings the job had before each time it was edited.
+	// (GET /api/v3/jobs/{job_id}/history)
+	FetchJobHistory(ctx echo.Context, jobId string) error
 	// Get the URL that serves the last-rendered images of this job.
 	// (GET /api/v3/jobs/{job_id}/last-rendered)
 	FetchJobLastRenderedInfo(ctx echo.Context, jobId string) error
 
+	// (POST /api/v3/jobs/{job_id}/setmaxworkers)
+	SetJobMaxWorkers(ctx echo.Context, jobId string) error
+
+	// (POST /api/v3/jobs/{job_id}/setpriority)
+	SetJobPriority(ctx echo.Context, jobId string) error
+
 	// (POST /api/v3/jobs/{job_id}/setstatus)
 	SetJobStatus(ctx echo.Context, jobId string) error
 	// Fetch a summary of all tasks of the given job.
@@ -92,9 +119,42 @@ type ServerInterface interface {
 
 	// (POST /api/v3/tasks/{task_id}/setstatus)
 	SetTaskStatus(ctx echo.Context, taskId string) error
+	// Get list of user accounts.
+	// (GET /api/v3/users)
+	FetchUsers(ctx echo.Context) error
+	// Create a new user account.
+	// (POST /api/v3/users)
+	CreateUser(ctx echo.Context) error
+	// Log in with a username and password. The returned token should be passed as bearer token in subsequent requests.
+	// (POST /api/v3/users/login)
+	LoginUser(ctx echo.Context) error
+	// Invalidate the token used to authenticate this request.
+	// (POST /api/v3/users/logout)
+	LogoutUser(ctx echo.Context) error
+	// Fetch the user that is authenticated by this request.
+	// (GET /api/v3/users/me)
+	FetchCurrentUser(ctx echo.Context) error
+	// Delete this user account. Jobs submitted by this user are kept, but no longer have an owner.
+	// (DELETE /api/v3/users/{user_id})
+	DeleteUser(ctx echo.Context, userId string) error
 	// Get the Flamenco version of this Manager
 	// (GET /api/v3/version)
 	GetVersion(ctx echo.Context) error
+	// Remove this worker tag. This unassigns all workers from the tag and removes it.
+	// (DELETE /api/v3/worker-mgt/tag/{tag_id})
+	DeleteWorkerTag(ctx echo.Context, tagId string) error
+	// Get a single worker tag.
+	// (GET /api/v3/worker-mgt/tag/{tag_id})
+	FetchWorkerTag(ctx echo.Context, tagId string) error
+	// Update an existing worker tag.
+	// (PUT /api/v3/worker-mgt/tag/{tag_id})
+	UpdateWorkerTag(ctx echo.Context, tagId string) error
+	// Get list of worker tags.
+	// (GET /api/v3/worker-mgt/tags)
+	FetchWorkerTags(ctx echo.Context) error
+	// Create a new worker tag.
+	// (POST /api/v3/worker-mgt/tags)
+	CreateWorkerTag(ctx echo.Context) error
 	// Get list of workers.
 	// (GET /api/v3/worker-mgt/workers)
 	FetchWorkers(ctx echo.Context) error
@@ -105,6 +165,9 @@ type ServerInterface interface {
 	// (POST /api/v3/worker-mgt/workers/{worker_id}/setstatus)
 	RequestWorkerStatusChange(ctx echo.Context, workerId string) error
 
+	// (POST /api/v3/worker-mgt/workers/{worker_id}/settags)
+	SetWorkerTags(ctx echo.Context, workerId string) error
+
 	// (GET /api/v3/worker-mgt/workers/{worker_id}/sleep-schedule)
 	FetchWorkerSleepSchedule(ctx echo.Context, workerId string) error
 
@@ -127,7 +190,7 @@ type ServerInterface interface {
 	WorkerStateChanged(ctx echo.Context) error
 	// Obtain a new task to execute
 	// (POST /api/v3/worker/task)
-	ScheduleTask(ctx echo.Context) error
+	ScheduleTask(ctx echo.Context, params ScheduleTaskParams) error
 	// Update the task, typically to indicate progress, completion, or failure.
 	// (POST /api/v3/worker/task/{task_id})
 	TaskUpdate(ctx echo.Context, taskId string) error
@@ -166,6 +229,8 @@ func (w *ServerInterfaceWrapper) FindBlenderExePath(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) CheckBlenderExePath(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(User_authScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.CheckBlenderExePath(ctx)
 	return err
@@ -175,15 +240,30 @@ func (w *ServerInterfaceWrapper) CheckBlenderExePath(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) CheckSharedStoragePath(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(User_authScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.CheckSharedStoragePath(ctx)
 	return err
 }
 
+// BackupDatabase converts echo context to params.
+func (w *ServerInterfaceWrapper) BackupDatabase(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.BackupDatabase(ctx)
+	return err
+}
+
 // GetConfigurationFile converts echo context to params.
 func (w *ServerInterfaceWrapper) GetConfigurationFile(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(User_authScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.GetConfigurationFile(ctx)
 	return err
@@ -193,6 +273,8 @@ func (w *ServerInterfaceWrapper) GetConfigurationFile(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) SaveSetupAssistantConfig(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(User_authScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.SaveSetupAssistantConfig(ctx)
 	return err
@@ -226,11 +308,33 @@ func (w *ServerInterfaceWrapper) GetVariables(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) SubmitJob(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(User_authScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.SubmitJob(ctx)
 	return err
 }
 
+// SubmitJobDryRun converts echo context to params.
+func (w *ServerInterfaceWrapper) SubmitJobDryRun(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubmitJobDryRunParams
+	// ------------- Optional query parameter "worker_platform" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "worker_platform", ctx.QueryParams(), &params.WorkerPlatform)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_platform: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SubmitJobDryRun(ctx, params)
+	return err
+}
+
 // FetchGlobalLastRenderedInfo converts echo context to params.
 func (w *ServerInterfaceWrapper) FetchGlobalLastRenderedInfo(ctx echo.Context) error {
 	var err error
@@ -240,6 +344,17 @@ func (w *ServerInterfaceWrapper) FetchGlobalLastRenderedInfo(ctx echo.Context) e
 	return err
 }
 
+// DeleteJobMass converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteJobMass(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteJobMass(ctx)
+	return err
+}
+
 // QueryJobs converts echo context to params.
 func (w *ServerInterfaceWrapper) QueryJobs(ctx echo.Context) error {
 	var err error
@@ -265,6 +380,24 @@ func (w *ServerInterfaceWrapper) GetJobType(ctx echo.Context) error {
 	return err
 }
 
+// ValidateJobType converts echo context to params.
+func (w *ServerInterfaceWrapper) ValidateJobType(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "typeName" -------------
+	var typeName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "typeName", runtime.ParamLocationPath, ctx.Param("typeName"), &typeName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter typeName: %s", err))
+	}
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ValidateJobType(ctx, typeName)
+	return err
+}
+
 // GetJobTypes converts echo context to params.
 func (w *ServerInterfaceWrapper) GetJobTypes(ctx echo.Context) error {
 	var err error
@@ -274,6 +407,24 @@ func (w *ServerInterfaceWrapper) GetJobTypes(ctx echo.Context) error {
 	return err
 }
 
+// DeleteJob converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteJob(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "job_id" -------------
+	var jobId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "job_id", runtime.ParamLocationPath, ctx.Param("job_id"), &jobId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter job_id: %s", err))
+	}
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteJob(ctx, jobId)
+	return err
+}
+
 // FetchJob converts echo context to params.
 func (w *ServerInterfaceWrapper) FetchJob(ctx echo.Context) error {
 	var err error
@@ -301,6 +452,8 @@ func (w *ServerInterfaceWrapper) RemoveJobBlocklist(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter job_id: %s", err))
 	}
 
+	ctx.Set(User_authScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.RemoveJobBlocklist(ctx, jobId)
 	return err
@@ -322,6 +475,40 @@ func (w *ServerInterfaceWrapper) FetchJobBlocklist(ctx echo.Context) error {
 	return err
 }
 
+// EditJob converts echo context to params.
+func (w *ServerInterfaceWrapper) EditJob(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "job_id" -------------
+	var jobId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "job_id", runtime.ParamLocationPath, ctx.Param("job_id"), &jobId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter job_id: %s", err))
+	}
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.EditJob(ctx, jobId)
+	return err
+}
+
+// FetchJobHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) FetchJobHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "job_id" -------------
+	var jobId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "job_id", runtime.ParamLocationPath, ctx.Param("job_id"), &jobId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter job_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FetchJobHistory(ctx, jobId)
+	return err
+}
+
 // FetchJobLastRenderedInfo converts echo context to params.
 func (w *ServerInterfaceWrapper) FetchJobLastRenderedInfo(ctx echo.Context) error {
 	var err error
@@ -338,6 +525,42 @@ func (w *ServerInterfaceWrapper) FetchJobLastRenderedInfo(ctx echo.Context) erro
 	return err
 }
 
+// SetJobMaxWorkers converts echo context to params.
+func (w *ServerInterfaceWrapper) SetJobMaxWorkers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "job_id" -------------
+	var jobId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "job_id", runtime.ParamLocationPath, ctx.Param("job_id"), &jobId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter job_id: %s", err))
+	}
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetJobMaxWorkers(ctx, jobId)
+	return err
+}
+
+// SetJobPriority converts echo context to params.
+func (w *ServerInterfaceWrapper) SetJobPriority(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "job_id" -------------
+	var jobId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "job_id", runtime.ParamLocationPath, ctx.Param("job_id"), &jobId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter job_id: %s", err))
+	}
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetJobPriority(ctx, jobId)
+	return err
+}
+
 // SetJobStatus converts echo context to params.
 func (w *ServerInterfaceWrapper) SetJobStatus(ctx echo.Context) error {
 	var err error
@@ -349,6 +572,8 @@ func (w *ServerInterfaceWrapper) SetJobStatus(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter job_id: %s", err))
 	}
 
+	ctx.Set(User_authScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.SetJobStatus(ctx, jobId)
 	return err
@@ -374,6 +599,8 @@ func (w *ServerInterfaceWrapper) FetchJobTasks(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) ShamanCheckout(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(User_authScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.ShamanCheckout(ctx)
 	return err
@@ -383,6 +610,8 @@ func (w *ServerInterfaceWrapper) ShamanCheckout(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) ShamanCheckoutRequirements(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(User_authScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.ShamanCheckoutRequirements(ctx)
 	return err
@@ -431,6 +660,8 @@ func (w *ServerInterfaceWrapper) ShamanFileStore(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filesize: %s", err))
 	}
 
+	ctx.Set(User_authScopes, []string{""})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params ShamanFileStoreParams
 
@@ -530,11 +761,84 @@ func (w *ServerInterfaceWrapper) SetTaskStatus(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter task_id: %s", err))
 	}
 
+	ctx.Set(User_authScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.SetTaskStatus(ctx, taskId)
 	return err
 }
 
+// FetchUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) FetchUsers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FetchUsers(ctx)
+	return err
+}
+
+// CreateUser converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateUser(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateUser(ctx)
+	return err
+}
+
+// LoginUser converts echo context to params.
+func (w *ServerInterfaceWrapper) LoginUser(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LoginUser(ctx)
+	return err
+}
+
+// LogoutUser converts echo context to params.
+func (w *ServerInterfaceWrapper) LogoutUser(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.LogoutUser(ctx)
+	return err
+}
+
+// FetchCurrentUser converts echo context to params.
+func (w *ServerInterfaceWrapper) FetchCurrentUser(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FetchCurrentUser(ctx)
+	return err
+}
+
+// DeleteUser converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, ctx.Param("user_id"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteUser(ctx, userId)
+	return err
+}
+
 // GetVersion converts echo context to params.
 func (w *ServerInterfaceWrapper) GetVersion(ctx echo.Context) error {
 	var err error
@@ -544,6 +848,78 @@ func (w *ServerInterfaceWrapper) GetVersion(ctx echo.Context) error {
 	return err
 }
 
+// DeleteWorkerTag converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteWorkerTag(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tag_id" -------------
+	var tagId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tag_id", runtime.ParamLocationPath, ctx.Param("tag_id"), &tagId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
+	}
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteWorkerTag(ctx, tagId)
+	return err
+}
+
+// FetchWorkerTag converts echo context to params.
+func (w *ServerInterfaceWrapper) FetchWorkerTag(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tag_id" -------------
+	var tagId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tag_id", runtime.ParamLocationPath, ctx.Param("tag_id"), &tagId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FetchWorkerTag(ctx, tagId)
+	return err
+}
+
+// UpdateWorkerTag converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateWorkerTag(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tag_id" -------------
+	var tagId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tag_id", runtime.ParamLocationPath, ctx.Param("tag_id"), &tagId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag_id: %s", err))
+	}
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateWorkerTag(ctx, tagId)
+	return err
+}
+
+// FetchWorkerTags converts echo context to params.
+func (w *ServerInterfaceWrapper) FetchWorkerTags(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FetchWorkerTags(ctx)
+	return err
+}
+
+// CreateWorkerTag converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateWorkerTag(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateWorkerTag(ctx)
+	return err
+}
+
 // FetchWorkers converts echo context to params.
 func (w *ServerInterfaceWrapper) FetchWorkers(ctx echo.Context) error {
 	var err error
@@ -580,11 +956,31 @@ func (w *ServerInterfaceWrapper) RequestWorkerStatusChange(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
 	}
 
+	ctx.Set(User_authScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.RequestWorkerStatusChange(ctx, workerId)
 	return err
 }
 
+// SetWorkerTags converts echo context to params.
+func (w *ServerInterfaceWrapper) SetWorkerTags(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker_id" -------------
+	var workerId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, ctx.Param("worker_id"), &workerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
+	}
+
+	ctx.Set(User_authScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetWorkerTags(ctx, workerId)
+	return err
+}
+
 // FetchWorkerSleepSchedule converts echo context to params.
 func (w *ServerInterfaceWrapper) FetchWorkerSleepSchedule(ctx echo.Context) error {
 	var err error
@@ -612,6 +1008,8 @@ func (w *ServerInterfaceWrapper) SetWorkerSleepSchedule(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
 	}
 
+	ctx.Set(User_authScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.SetWorkerSleepSchedule(ctx, workerId)
 	return err
@@ -676,8 +1074,24 @@ func (w *ServerInterfaceWrapper) ScheduleTask(ctx echo.Context) error {
 
 	ctx.Set(Worker_authScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScheduleTaskParams
+	// ------------- Optional query parameter "wait" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wait", ctx.QueryParams(), &params.Wait)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter wait: %s", err))
+	}
+
+	// ------------- Optional query parameter "running" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "running", ctx.QueryParams(), &params.Running)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter running: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.ScheduleTask(ctx)
+	err = w.Handler.ScheduleTask(ctx, params)
 	return err
 }
 
@@ -767,18 +1181,27 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v3/configuration/check/blender", wrapper.FindBlenderExePath)
 	router.POST(baseURL+"/api/v3/configuration/check/blender", wrapper.CheckBlenderExePath)
 	router.POST(baseURL+"/api/v3/configuration/check/shared-storage", wrapper.CheckSharedStoragePath)
+	router.POST(baseURL+"/api/v3/configuration/database-backup", wrapper.BackupDatabase)
 	router.GET(baseURL+"/api/v3/configuration/file", wrapper.GetConfigurationFile)
 	router.POST(baseURL+"/api/v3/configuration/setup-assistant", wrapper.SaveSetupAssistantConfig)
 	router.GET(baseURL+"/api/v3/configuration/variables/:audience/:platform", wrapper.GetVariables)
 	router.POST(baseURL+"/api/v3/jobs", wrapper.SubmitJob)
+	router.POST(baseURL+"/api/v3/jobs/dry-run", wrapper.SubmitJobDryRun)
 	router.GET(baseURL+"/api/v3/jobs/last-rendered", wrapper.FetchGlobalLastRenderedInfo)
+	router.POST(baseURL+"/api/v3/jobs/mass-delete", wrapper.DeleteJobMass)
 	router.POST(baseURL+"/api/v3/jobs/query", wrapper.QueryJobs)
 	router.GET(baseURL+"/api/v3/jobs/type/:typeName", wrapper.GetJobType)
+	router.POST(baseURL+"/api/v3/jobs/type/:typeName/validate", wrapper.ValidateJobType)
 	router.GET(baseURL+"/api/v3/jobs/types", wrapper.GetJobTypes)
+	router.DELETE(baseURL+"/api/v3/jobs/:job_id", wrapper.DeleteJob)
 	router.GET(baseURL+"/api/v3/jobs/:job_id", wrapper.FetchJob)
 	router.DELETE(baseURL+"/api/v3/jobs/:job_id/blocklist", wrapper.RemoveJobBlocklist)
 	router.GET(baseURL+"/api/v3/jobs/:job_id/blocklist", wrapper.FetchJobBlocklist)
+	router.POST(baseURL+"/api/v3/jobs/:job_id/edit", wrapper.EditJob)
+	router.GET(baseURL+"/api/v3/jobs/:job_id/history", wrapper.FetchJobHistory)
 	router.GET(baseURL+"/api/v3/jobs/:job_id/last-rendered", wrapper.FetchJobLastRenderedInfo)
+	router.POST(baseURL+"/api/v3/jobs/:job_id/setmaxworkers", wrapper.SetJobMaxWorkers)
+	router.POST(baseURL+"/api/v3/jobs/:job_id/setpriority", wrapper.SetJobPriority)
 	router.POST(baseURL+"/api/v3/jobs/:job_id/setstatus", wrapper.SetJobStatus)
 	router.GET(baseURL+"/api/v3/jobs/:job_id/tasks", wrapper.FetchJobTasks)
 	router.POST(baseURL+"/api/v3/shaman/checkout/create", wrapper.ShamanCheckout)
@@ -789,10 +1212,22 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/v3/tasks/:task_id/log", wrapper.FetchTaskLogInfo)
 	router.GET(baseURL+"/api/v3/tasks/:task_id/logtail", wrapper.FetchTaskLogTail)
 	router.POST(baseURL+"/api/v3/tasks/:task_id/setstatus", wrapper.SetTaskStatus)
+	router.GET(baseURL+"/api/v3/users", wrapper.FetchUsers)
+	router.POST(baseURL+"/api/v3/users", wrapper.CreateUser)
+	router.POST(baseURL+"/api/v3/users/login", wrapper.LoginUser)
+	router.POST(baseURL+"/api/v3/users/logout", wrapper.LogoutUser)
+	router.GET(baseURL+"/api/v3/users/me", wrapper.FetchCurrentUser)
+	router.DELETE(baseURL+"/api/v3/users/:user_id", wrapper.DeleteUser)
 	router.GET(baseURL+"/api/v3/version", wrapper.GetVersion)
+	router.DELETE(baseURL+"/api/v3/worker-mgt/tag/:tag_id", wrapper.DeleteWorkerTag)
+	router.GET(baseURL+"/api/v3/worker-mgt/tag/:tag_id", wrapper.FetchWorkerTag)
+	router.PUT(baseURL+"/api/v3/worker-mgt/tag/:tag_id", wrapper.UpdateWorkerTag)
+	router.GET(baseURL+"/api/v3/worker-mgt/tags", wrapper.FetchWorkerTags)
+	router.POST(baseURL+"/api/v3/worker-mgt/tags", wrapper.CreateWorkerTag)
 	router.GET(baseURL+"/api/v3/worker-mgt/workers", wrapper.FetchWorkers)
 	router.GET(baseURL+"/api/v3/worker-mgt/workers/:worker_id", wrapper.FetchWorker)
 	router.POST(baseURL+"/api/v3/worker-mgt/workers/:worker_id/setstatus", wrapper.RequestWorkerStatusChange)
+	router.POST(baseURL+"/api/v3/worker-mgt/workers/:worker_id/settags", wrapper.SetWorkerTags)
 	router.GET(baseURL+"/api/v3/worker-mgt/workers/:worker_id/sleep-schedule", wrapper.FetchWorkerSleepSchedule)
 	router.POST(baseURL+"/api/v3/worker-mgt/workers/:worker_id/sleep-schedule", wrapper.SetWorkerSleepSchedule)
 	router.POST(baseURL+"/api/v3/worker/register-worker", wrapper.RegisterWorker)
@@ -18,189 +18,257 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+R923IcN5bgryBqNkJ2bF0oUrIs9suqZcumW7K4ItXeiKaDRGWiqiBmAdkAkqVqBSPm",
-	"I/ZPdidiH3ae9gc8f7SBcwAkMhNZVZREiq3pBzdVmYnLwcG5Xz4MMrkspWDC6MHhh4HOFmxJ4c9nWvO5",
-	"YPkp1Zf23znTmeKl4VIMDhtPCdeEEmP/oppwY/+tWMb4FcvJdE3MgpHfpLpkajwYDkolS6YMZzBLJpdL",
-	"KnL4mxu2hD/+i2KzweHgXyb14iZuZZPn+MHgejgw65INDgdUKbq2/34np/Zr97M2iou5+/28VFwqbtbR",
-	"C1wYNmfKv4G/Jj4XdJl+sHlMbaiptm7Hwu8E37Q7ovqyfyFVxXP7YCbVkprBIf4wbL94PRwo9veKK5YP",
-	"Dv/mX7LAcXsJa4u20IJSBJJ4VcP6vH4P88rpO5YZu8BnV5QXdFqwX+T0hBljl9PBnBMu5gUjGp8TOSOU",
-	"/CKnxI6mEwiykDzDP5vj/LZggsz5FRNDUvAlN4BnV7Tguf1vxTQx0v6mGXGDjMlrUaxJpe0ayYqbBUGg",
-	"weR27oCCHeC3kS1nM1oVpruu0wUj7iGug+iFXAm3GFJppsjKrj1nhqklFzD/gmsPkjEOH42ZniL8MjFS",
-	"FoaXbiIu6oksPqoZzRgMynJu7NZxRLf+GS00G3aBaxZM2UXTopArYj9tL5TQmbHvLBh5J6dkQTWZMiaI",
-	"rqZLbgzLx+Q3WRU54cuyWJOcFQw/KwrC3nONA1J9qclMKhz6nZwOCRW5JSByWfLCvsPN+EzUiD6VsmBU",
-	"wI6uaNGFz/HaLKQg7H2pmNZcAvCnjNi3K2pYbmEkVY4b9OfAYCfNowvrCmcz7KLGJVt313CUM2H4jDPl",
-	"BgkoPyTLShu7nkrwv1eIiO7Q3rmLkJzHXgyq5om78EysCXtvFCVUzaulpTAe36blemw/1OMTuWTHeLfW",
-	"33xLMnsMlWa5fTNTjBqGW3X3bx2tob7iNWW5AQrx5ZLlnBpWrIlidihCYas5m3HB7QdDSwhgejvlEGAi",
-	"K+NWRJXhWVVQFc6hBx90NfXkcxPVTRCqE/dluOo3HuHUfX7FNXeX7IYj/NV+yQtLgNtU3OKYW9mOlPek",
-	"BkWLAFfTkX2CEEec82AlzyulmDDFmkhLKqkfF5A4IpZ6TC5+fnby848/nL84evnj+fGz058vUBDIuWKZ",
-	"kWpNSmoW5L+Si7PB5F/gf2eDC0LLkomc5XiETFRLu78ZL9i5fX8wHORc+T/hZ8e0FlQvWH5ev/l74o70",
-	"nUuXhjoIRLuPLiZyCKrJ0Q/+ysC2LeH4c2HXr8bkV0kE05acaKOqzFSKafINcAg9JDnP7FRUcaa/JVQx",
-	"oquylMq0t+4WP7TCw8G+3XQhqRkMAa933WSEOvHNDMg4THFPI4FlNCkcuXDfXBwSWqzoWsNLY3IBdB3o",
-	"6cUhogd87UjX2yPk5QBQxwEU+abgl4xQDzRC83wkxbdjcrFi09QwKzatuRZg3ZIKOmeWqA3JtDJESIMM",
-	"1M2CbAnweEwuFjzPmV2gYFdMwdB/auOyI412pchk7IsAHBBg7eyCFk1a40+rBijONACi4+AyGA5WbLr1",
-	"zNIY6YWgGk9QeOaavAIQKOSM3ABFpEvLtxISEzM0IXb9TPUivvHAZchRhwRo4rhVQaesINmCijkb4jLs",
-	"yGTFC//zmJzan7lGPiJFffiB7TKhK2U5C0UBLQgHzUnt/ahKYMfUsAZ5r2EIS7qZjO4n2Fm/SMmwHfGv",
-	"RZwdgcLlRXMO8Sy2EWyLDgmm/pJr4ykUkNx+xOgigRffP27jpw1O2LPreorUBt2FP6Zm8XzBsss3TDtx",
-	"uSXf00onLsMP9b8sDFaLtRcFzMIi3DdCmm8dnU4KS1yUVY90Do8QI1dUow5hMW/GRY6zeBKfHFif47RJ",
-	"lQRFngULC3WsRCpLt8ZJoQWYWXKlMEhY6ExWIk+uSctKZVsljuhITvCD9pEi0NyKwrDxnofuwLYc+Qsu",
-	"8vrEd8K/HoRJqF7dfViqFwsSVGuZcWqQJNvdnDNxdUXVwCFGvwDh7Qud83APiGJWqwARmxKNyqzTioHe",
-	"vWdZZdg2u0e/USFQ9uixh3Ga7kSfpI7lR6Wk6u7nJyaY4hlh9jFRTJdSaJay0OQJVP/59PSYoBmB2DeC",
-	"+B4GIkeWlWZFlaO+hZdiXUiaEy0RqwMAcbUN2FolEZbGBRo8uBTjM/HcTvZ47yBwHRAFQHOjhk6pZvbJ",
-	"tNJry50YgYX6RTnmJYWhXBBKHrxhRq1Hz6we+wBfXTAKeqFdHhc5z6hh2mm6qwXPFsTwJaqK9iiYNiSj",
-	"wgqNihnFrdL7QlqV2YslbkCuQXCxaEKtcOx5+QPt+J59Nys4Ewa4oCRaLplVDOdEMaqlADoC4hR7j5eH",
-	"04JMaXYpZzPkmMEy5EXJrllqybSm8xTutZALzr1+P4VZLwq6ZCKTf2VKO0PFjlh+VX+xeRX+RcfiU6v4",
-	"Bc1+tChezwaHf9tMZU68+GG/uh62F0wzw6+CEL2BIaGEpA3xX1jpx1swkjQaVewUYbEPQFriS6YNXZbx",
-	"SVpxaGSfJHlRYri3b49+8Cv8BYx+W+yFu5oqrUAULJVVmad3c+o3YdcAEMJXxztuqs2R7II96OppIxNm",
-	"OLLfr39HbPhzIbPLgmvTL1OtgCxrR4UUg7sJli6Wk4wpoA9g0UbJS1pqoUuW8RnP/BHvxNbi9fwojFqn",
-	"OFr3pc5V2mwaxv2c72QfDm/33M7WCdRDx5bgnov4kmrzBrgzy4+WdM6OxEx2j+FHIav5IqbsoOnRiACW",
-	"nGVWU5ujSJXz2Ywp+wyXCfYt+zWhZCG1GSlWUMOvGHn75qUnpxb9Rsoth3C7njE5lZYBoMaOiuubl0P7",
-	"k6X0ghpGzgYfLB+5nnyQIlhJdDWb8fdMX58NkNY2j8d+0IS9KpJXzQ3TEIu2GJtbBwJTRSP1HMUrZqhl",
-	"iUDW8hysbLQ4biJVe+KWWVFNuVFUrcnSDeahPyavpAK5pyzY+9j+4ZjhUuasQEWlsjyeXNDxdJxd2ItW",
-	"H7gF7CUDSyN7T+1YDvFhH4eDk1Jxw8gLxecLK5dWmqkxW1Je2FWvp4qJ/zZ1srpUc/8GIvbgBF4gJ+b/",
-	"/d8rVkRwbcDpJNIQ03AyqmI93wbC6cVPoEYoJovMQgB9JmXBjPvboR6XYjSjHN8If5RWuLZ//L1iFfxB",
-	"VbbgV9GfaCvC4UdOBIHH8HfF8HllYTKKZ0tKu2EPz0Gh75IdFD3S2gk+i2zkThxE28BnYTQt1A9E3y2r",
-	"B/VPqb7UJ9VySdU65YBalgWfcZaTwrEDdEJ489WYPEcJEaVQeFibnuxPlnDZ1xm18iDVl12xGb7aWfkB",
-	"N6Bb8A56d++l1/+9Yrjn6D6Bd2xw+NgKczVN6Ltl18MBuEbOp2twH7Y57u/+r3MuGhgfUNZh8+/XbZi4",
-	"hXwYLLngS3thHqZF1E+mXC94YQX2aU25hp4OvTz6y481GUo6OeRspllzoXuphdZw+nADz6HekeD07Si2",
-	"m91kV9Gpta/EG2YqJdBMatELfaPU32juRFvYwk0kn8iz3cbofuztsxQB3u96oVC8/8iL5Kxqz6WY8Xml",
-	"qEkqN1y/4EqbN5XYZAlC+6glxBzFEMvzZvbDWpF08xFVCV3bVINfErgoJTO2IjOaGan0kDizupBiBK5U",
-	"Kxll8XrJjKPZyUuzwdQ6tSyCsGVp1lajLWANYISvilw8MGTKet1rC7qk4kdQRfPN9q8TeBVXYRQVesYU",
-	"eXZ8BD4ib2pM28O0kYrO2UuZ0bT/+4fgYQILgGVA9lLAXO7j8VbNoj1Le3fD+IA3YMlfqeLeHNhGkHOz",
-	"kiua4EGvBRut6JpcuY/RAG7htpTagD3J6pmCoZkAvEeWbVmmWxY0A3cImSm5JBcfrLhzfeGEXq7QdT10",
-	"1ooF+Ns0mkko8fE6wehJvYmKnK5kYk200NJPmnf8LhQd9qsFc8svC2qsDDwKyhI60sEy5AaZrsOi+xAN",
-	"PtqumzgDWA1o/+UO5/WsyjkTTeOhUwudHKmTIlNrGL2JS22iUG306fCwV7QsLYzhlP2hELtl8Kmb4Knn",
-	"GDeT2PD6L4yVbyohkpE4R8G8tYouLsKALOmaXDJWWqIkvC0rLeosO/N0D7SWI3uEQhRA3wR5dsNqvekw",
-	"FjdJkISDYrFyeH1kHG2z1AKeXOAjy53YBbFbcQaYOBgEr4+dBOA9l/a/gr03zmuGRPrC8uqLIbloAuGC",
-	"vHp7cmoVoQsIjuhB9BY6twAZoNYHoxSWB/v5kXeANA/LOxs2X6yWeTwx/J37c76Y2yWz22X5do7ivCa7",
-	"OUvesLll24rlSH+7kKR5rpjWN4xJdPQ3fdPkzKyoYhuu4Taq9Vu4OSjXBZfkebAN6ZuJw58U1egYgAdV",
-	"HNnoATEcZBjTAiscRFDoWX3qtE5YVilu1sGX0qKAuxrVN1nTT5ipymdac22oMCh8ptxQsZAnp1a2s0TP",
-	"MgmQu+woJAzTpdbOXvIj+KnoDoFK/Y65LyWodbeQhCeIc7BkmXIFnzDQ/e1inMKD4tPJz8/2H3+H115X",
-	"yyHR/B8Q+DNdG6ZRIMuZtssjhVuUd3BlbrY6CKpl24LZwEuB5GdQh8CN5xKF0MHh4ODxdO/R04fZ/pPp",
-	"3sHBQf5wNn30eJbtPfn+KX24n9G976YP8+8e7eX7j797+uT7ven3e09y9njvUf5kb/8p27MD8X+wweHD",
-	"R/uPwM2BsxVyPudiHk/13cH0yX723cH06aP9R7P84cH06cGTvdn0u729757ufb+XHdCHj588fJLNDmj+",
-	"6NH+dwePpw+/f5J9R79/+njvydN6qv0n112d30PkOElt7a+R9OgVIcev46hEPw7wc5Amnb3X2XqdvhEO",
-	"AGg41UEpwviUaJIxORJEFjlTxDmZtLf1urFgXssB3lUaTcVnYTvk6IezARqFvHbsRiE8eAQprgJ0tQtn",
-	"bxnpoppPdMYEG1nqNcEg0NHRDxc9US8OZXZUfHHtL3jBTkqWbdWBcfBh85i236aa+6fMgvYZWtNap5IK",
-	"7/4I9HD+oDZigOLsQF/7C8yCCrLyzDyIiUOLHPGg4Bp20UrUh+bW15icRtLFpyNf6qjbDtjdjiQcdZfA",
-	"ORWMeqmLIuV1tMotOqLDaUmx5UGT9XhoyqhH9CtOmn4XNLHCJqmNx0yOAXTmQ9cyxpo0OuH4bvOUBfV0",
-	"a9gv7DYB/Bs3i9rgvxOovRKeATmb9oB+6MTUIclZyUQOaRECNDwUZ77ys9lV9oyOo8c90DnV2Gq96Xg7",
-	"fpxKXAq5EuByLiTNUR+zB9bQu+r942BvcDUQge/0tI8WPEDQaMCuV5a4JaHhTgSEO2Bv/YffPC8MEkpz",
-	"NTwtELMpUdFnnqUM46N0tgnZvO5MXVm54wUMFUIPANEsJ3Gv2d/Yexc4FeT6OEDrrnCgvpjhPtwOWsQT",
-	"hev2mXElIt+fijWYwtYkHK0r7s7/pjz3cxHCDURPZpfMHL3+RU7fgmsvmSCimQmZeUOirRwlr5gi/mtv",
-	"ToYQerBK6TF5YdkYW4EHaWgFXnbFZaXPcTUXKGFNa+ROxVF8pogmbx9pDvQrXcZZL+kcq8aib+TjivNB",
-	"QwbG46TnULGZYnpxHrzEG22dUWig04zc9+ifxt080Oiprh1IcGyYQaG1C8PS3lgP/wRHEM0WEOl4xfOK",
-	"orubrGCWORNMof1TkiUVaz+Iy6crFc0Mz2jR6y+6ORD7s19vGnH2CQFniTAzl/8aZcg2z3DTXYujovou",
-	"nTtyqeojT4QvhTBbe/GsPuNWmk4A2DEczCyq5VRAUM3Wg0oHeKVSA+qAMfwrTLIJUpb09Oe9njAB3qNA",
-	"hfBSaKtqXUx09O0FYVeg/EEyoZEuichz5+hN+9AC02H2mDz3Y2Lu05yZ+Dmq/OBisPfE3wf/70LONbpT",
-	"BWMuHrwseMZNsfbTThmSSnDo2UfrYdiI1V4xJcq/a8eQAlN0vjES1tOYeuZR5p2cfgsyo33dvvJA2/UQ",
-	"cJZY3E/RW1luZTaJo3ntXSa7pkumBvFJJt4A3E/0MQrayCZUJqQS9Q9WUBpvZw0tRJXlpqzKzVuPtIWw",
-	"DIi8qv+VVBT6QJHwa1BDLrk90dmNYBCC0YriFzmFINmi+C34Nh3ro/qykHN8GF/rjas+pfrypZz3UbFT",
-	"dwlItqjEpZMcwMsc7qyScklyhgwux4cuC8AuCW4rvZI8tx/nuOkm90nhsd1J11ZuFxGQyC1tTF7RdcgB",
-	"WFaF4SUE1guGBkD23iQ9UJ6WbUTVU/Qx3AwLayppt7EJE+3wu4htpwDJfrkNgNER3Fyk28dJbnHo/I0D",
-	"1XcD2/AmXG27COj8QZ8qAzZLeHzMN3cp2gTW7FxnGyPqN2AikpNdcBHf3ISNLuTA4+NHqAXOh7oDBlko",
-	"nmvGEuKFJYI+KItrvyorZdn3fUZXlHK5W5LGdkRc+dV/Kip2vLOf8NV5FkKCd/24EZ9wm4h9gwSiLbju",
-	"x0miepwrlEzXrp13UV6zkcQnRrWMNbuE3356kLt7cPDH/yT/8a9//Nsf//7H//7j3/7jX//4P3/8+x//",
-	"K1ZhQDeNo1HdLOfZMh8cDj64f16De6gSl+dorzmwezJW9TunVc6lj1ed8YI5N+MEtZaJnk3eyalGd9fD",
-	"/YMxDBkf8vGvP9l/lnpwuP9oOJgpurQ3fvBw9HBvMByA0qPPpTq/4jmTVomGXwbDgaxMWRksB8HeGyYQ",
-	"Hwbj0oXOwFbcW9114UxhZZM0uFzdis54SkqzcTxXjASrIJzXURmDgovqfYTRENU3cqB22t6gY/iKMWeL",
-	"hhbyPnYtXbXFVBEjyDYt3r/as/lOFCTK0mJO9Fobtqxzbdy3rQIDRkJZoLngmhHTDld0LzsLCbhfC7li",
-	"apRRzYJ31k3hF+Uiac/wXM4GQ3I2WHGRy5XGf+RUrbjAv2XJxFTn9h/MZGNyEqaSy5IaHqpK/SQfaHKh",
-	"KgFq10+vX59c/ImoSpALCCOTBcm5NpB+AHGbVqmjIRuhlBpqTIRFWpb4TPsMNFoQu6NhYx/kbIAqrjob",
-	"eB+oK46FLigvwkF1i1IxS6moJmeDiKc90GG8s0EN+6XUVn0FLfqSEcO0meRsWs1d0QxNGNUcylM45dcu",
-	"oNLMBenxjOQyg7JEkEhXFI2dJWXtPiuQ/eF89woXQ5LJksd29It2nYOxHe0iVD3q1sg4df/yEMQKRiwn",
-	"3NliZpwVOckl0+KBIUtqMnADEJqZihZhpE78wSlWWwJLhW6XzgA8kkUehfo3y221K5eE8lveJHQmjhoL",
-	"5JrIJfKoYe0ShGzpdUm19mL9TiG3XXNY4sKnmGq6nOCpV9CwgCCEtGtvNPceep/gPSR8zMZkymZSsToy",
-	"NoqMHt9MO/mcRQhvI80XE2rOp+tzH6B8k7wiJxsn1rqjJnUDpQukayOrbLFV6kPZX6yDnG3/Lw9p1D7U",
-	"+GYy9pev0XhbedE+a/cmJ75rLnVbJ0yVh4yLQIbLtKUepDMWpXOA7a+ETrHIGwOjEaiLkS3ok6za6YAE",
-	"S2jAp96yCg0bTvYupkTGn60zV6pIT/z2zUtCjS8jEc1OuNGsmIXgJbkShaT5LkHHte0onCKmHsP++07l",
-	"5omrIUU1pPlpOTOjduZqynZYT3ifskzjW/0RaaZxxmZXN6y0Iayb5F6jO9YWkI3SZ7UTD0TBcY8VZGfL",
-	"130ihh9rrtqRIvmZ+k5qk70anwWHKeTaIQW1B4Qjo1qCmHdW7e3tf4euHqBYcGJQyQWL/0AVvWdWyg2n",
-	"B0EdssQcoT8R6UwFrRf4XEjFcvINyDfSJ1ldeHrrDLFCGsIUdcksoQBJW4K1y/p2m6W2m5ZWcOGqdjon",
-	"NARPPtAkC6UhMafMLs2HvCC5Jq+vmFopbhjKtVxWulgjWMMyfQWBpPiQsuK/lHNnnQ80AB0FXiD3FSXt",
-	"ouFUYEJGVcF7aniZBgm8AZVIIledwNHy3yASKQaRqBkD/QgUWS4wEQ/HScT3bcr9+DQqsOGS+UlTl6je",
-	"4251dpxZMKS0d3Ijy/Nojy3J4Ji4Zx3z7sZ8l92MC/1jfXoui3HKzXbIgBq0E8WLINXIaqkrI6WzWK5/",
-	"75TzcJULmtzIE7v6lF/uUjqni7M31U3aKLI5JsuP3o+cmFHVl639kRlTLFNYCeCzY0tb5sCZGkecnGJD",
-	"JSwHUT4Xr0WrBoQzCj87PoLC4FEa1HkwpQ/0is7nTI0q3jf54d+8kdiKhLNlyeauSu+oLtM6GA6WXGeJ",
-	"AhD9ZTo7i7l9iPuLlgZyZ0UbAF4wVp5YlbdKpSfCY6Ldc1foyGk5Pvf6xFBlIHiDiRx9UIH9Anvl6C2C",
-	"YK2crptqRBiba+SzbEyelWXBoXJVsXY18qT9kINZ5SKna30uZ+crxi4vIAAd3mn+bl+GOgHjM5FYIYgs",
-	"guw/Gi1kpcjPPx++elWXoMCquTUGxiMPDgdLSUxFzILMFEQs5OcgFB4OHn5/uLeHaZROJ3H+BW1X4N/a",
-	"e2rf6iBYc5JulD7N2EizkiqMPVjJUcGgTrGvKuWgbtmGHQsIHmOXPWAm35wNlhKNw6byduFvx+RHqK6w",
-	"ZFRocjZgV0yt7Xi+dlQHUev9R5wdANqTC+tB8yEddRcAtX24Ng8KYw+b0GyMG614w70w1LA+lc85GVWc",
-	"8L27kzKpsEWD7bSovEUjQwwyXdFL1kWuj/Gm7h6Y2/guji2yUMf0A1zXcEC1JSn2ECAddTgwTLtX5Gxm",
-	"ZeWkHt7vqk0UhMHSlkisam3IJdvXqSn2xwsXJpJQWPV5Qf+x3pyy3czjd+4bVDHizgFApGoTOMoDtVri",
-	"tDBNZlxwvWgZs28ck7rLKQ7D/jacZ5+J4M9U82yDOPbR2v+XC3D4XCnlny38IBImmoD4a+0M9K56BInD",
-	"dK592YuPs1Jslxm8G2Q3bapZHuzDxxpF01G6CU3hFF0x2OKpUSUGBtEuG97KPMtY+D+nVSov7a1mCuqW",
-	"cB2XGDn6YUhKqvVKqtw/QjHYlaexQo7XoWvZ3iImAAYutr1G9U4XxpSD62uo/41GZwj0y0wkA4cTP2V0",
-	"6cyl+KU+nExmPnSDy0m3JgvGSJIXVC1dSDEUNRoMBwXPmEsecvP8dPzy6qAz/mq1Gs9FNZZqPnHf6Mm8",
-	"LEYH470xE+OFWWKpQm6KxmrddBF2HQ4ejvfGIAXJkgla8sHh4AB+wvQ3OJkJLfnk6mCStatZzVGxCeVP",
-	"jnKoCm2aZa8symDmEYy2v7fnoWolfYvBVtDEvMPJO2fFRbzdsQJOcz44vCbQhcXqImRAIQp6umpXjN7M",
-	"ZmGEWadAvqFzjTUYDAXdpB7jR5GXkrtsibnrbtQZMBxFGPR6mAbvBFyrE68q9QH7BRf5n0Mtg2NMWLw1",
-	"cKfLsyfg/UJWoi5tADJwKIjf7Hz1WdaFNTUS6zgJBbBXlsGvlITmWI2Te8FdwLtUZCkVI89fHvly7Ggw",
-	"hDgETVYUIhhAmvLbSSFFKXXipCDvPXFUwGr+LPP1Z4NGq35PAiy+EL1Uzt4M3m+sWSPRqY8pRrePR416",
-	"IN2V/tq8uENcJIYdwJHOuGD3D6f+SgsORn8aY9PHIFMLT53n4Koe37fFqQ9yK1HRC6pYPnIphKBY9aPs",
-	"Cbx8gu9+Uaw9vjP8/E+BmLDgCCMRKxpFdfqR8Qbj9CIj1AHYVYp4gUUDPunIb1Cb+XrYGGtNl0VzrLZc",
-	"vA1B2gfxBlo9XLG04NGVEzaexrMsYzr060sV8UwMGYLzhDQEN/YA/EqvSyaeHR/5PLWikCuUrC98X6uJ",
-	"kyTdgV6QkmaX9rDPRP9xa2aqckR9Wal+snNCr1iyktXtEJ7kVEmmGYPV0m56hejdQspHiVD9FjJAROCK",
-	"TWlZenNFblWkWVUUdWau711o5cr7R0re1m7tOvizceS+DScyOQ51juwO12RWCWxtV0CZ9y3obREihdm9",
-	"Bct6cTBE+k4+UFe883rywftLrjdRo7paZ7PDzt8+DLgFmSsW4jQ3P/og1pedEfommk2n1Oi1Vd4TE0Y+",
-	"n/4J20Tr99tXzWqw3ZxGer2sLifb1snIW103iG42CNwS6o24GQp9NloGYvOcVFQomVJdV2KaKrnSjZhn",
-	"ZzG8oZrY3COgdZtat69WA8d9WesecgpRtVj14FboZ6MfTveQoSmhdBH5HfS8TTFuw4LAYllZtokEyYVC",
-	"W/7n4laiWgYaoP3o4f7tE17LF9ByFWK+oWViLpnv/uRjw5svJCPDuYbchGJN8oq1OkRlNFtEfS9xKLgP",
-	"UpJCYtPKu+Q58ID48pZNSoA4RqgvDgILbd+RqHdazFCwRntjuF+agfLMXcrOpZo06if0G2GYyRY/FXJK",
-	"G1nQELR6u+jdV0thB0o7TEsqp740hE9GWFjmS8U62Qqnh2BDA50FNVivSPeVotBbjuk1lCbFVhl13OMc",
-	"AN2znNb5/d33skiTRmgW4PLbb4M01u00UjJWu+AbeuOheQIm9Izvmlo2uif0YxFANVJ9Xcwx9gOAFCQ+",
-	"s8QK6AsQLNe0AD4c3xuqAvc25ExZwO+GkHV/ixm01IAy9iInWqrQfb+Bhpa2Tj7Y//5Kl2yjmOlbp+4i",
-	"ZPoB743M120A2yMO4LM26XARLYEbpVsVbzifKCGg2WvNdcRPnYve4TT04A6BlpSUw0t1L98EAItOv19o",
-	"KQoVa3YGYj1VYLBhvC4IP6AL8Hozc0TpcztGhwSEfnze5qD8/csIlNwXMWqTlxb38r0dNwsn+JHIo87l",
-	"vZCfTJvNCguGYerNY3jDlvKKNVob3uWB3ApvrbeSkqSr0mpT36xc7YjQivFbV3pMAUSivM4Axx2NOj7C",
-	"hWYZKyElkgmjONMoM0EmpZvkbnneW8Hel5hgCuE9XdujXVRYratIaS95BIIEjm68318Gr27vom9ELhB0",
-	"NyCYlX3n0iA8o5REuP33CRWQRoF83tfX1O8B0CSX4NdOtjdt9K7dwF/QWB1QLS5I189fbqKKtRUj1MO+",
-	"BqT8J9f3mkf9EbpfctCQ07MZgTQzdTRZj6kMJL6TkCn2z80eGwmTPbamZuQkmExhLbuono96i9a64VZU",
-	"B+aIJrT9/b4MTd96qbkg550Cd3cw1vpwTR0q5AbB6suT1g0oHeSF1ib9voLdbwMSh2qvG6kfdC/9Skhe",
-	"oxNrDytGGHOm48RF3WEs94zrUrduSLcMbWL9FiJs2IWdpnfskQj7Ek58Bf8JpvBvIITNxje35DhoTpIy",
-	"kcVl7n3kB3FdQO7OMpZsXJLyEPvmHdDzy3UYibwMSAP3nt4+AoaV0EIxmq9dORRHhB/diR9DMbKy/8HT",
-	"A1u7mIPHjlzoFkTrWvhQzAg7nhAAJRhFpXC2hzu7wlXrCrdu8HPsK0Tr9i7oUNTrZcHFpau3jwjqIICe",
-	"JYO+fweUSmM36FphxOL1GDLtSr27KjUZLQp02HAduSxq4oBAbfvO3YIo0fFlgsU02k1RxehGmhF3LNiV",
-	"csQne6tUJNU1Y1eC8gVoSbJpRGq9oQgmVOqSICLFBzGM083sO67LAm7xfl0ZaEpSd3SKYeBa3WC4SCmV",
-	"0e7i40lZNdRtbCvCP8N4Jer9nIFttAcMLYS97xSba+AqarKDnXMNL4p6Cd1bAsNOPvjGK9eTD/AL/8cG",
-	"a3/cg0Eq9tzhYkto27mlDnTV7kp4/tUbOQmG3Y73dcEb340i1LpJzOp3v8usdYel32/94nX6buyoO9+r",
-	"SxTnvNX9QZKdYhrxKNF92US8A0b+50bGYUpRdUSFN7truH59OZsxRUL7GV85r3DxfmeD/b3vzwYBsepS",
-	"LJBhDSZpUynhm2TX29NBjsNoldDvp3PgGLQJHbex0bZcMikYYYWGceoKLKllArYAABeMYkC6A+H/GOE0",
-	"o+dUjH6w+xy9hQEGCRhGzYlTMJSKz7mgBcxpx4d2yFjipZBxSZjQF4mbqPqk62vEY6oNVVtCrzQqCOXw",
-	"BhSZhH6VO+zttVvY6IVb2GCrI3UXeUZmhpmRNorRZZNCBNV6yoW938PtYcXPcQ7daqb2EbYaL4Z2zTT7",
-	"e99ve92hYwMRHcnBWKknyRGU+9yqAxjJNGVmxRyyO3BGrkrvv/SFMGehGZxUHboTRGePy6DsPE7UUWw0",
-	"wtlya/0NrG+OQ7xSycwVmJky+2GYf7pu3DuUKC56r9AhgQ7gLotWGD+BN8XdcRzWFg4EnMFFYvXzHfKr",
-	"hNhg14em8RDu50yqjE+LNckK6cpQ/Xx6ekwyKQSD2GBf3lFCmrcjvC41WzfOixH2nmaGaLpkTpI00pdF",
-	"JbmsrJCHH+jxmfCnijGVeJvqkr+JEyBTma97WWkcEW2nqLWLLlhiyREsNpMPrvreFge6626wQ0xIKOZ3",
-	"Py16rmpR0hiN+fdiJu+pta5ZVnKDTS7xxYaTn7iaZZtP31fB/FqQwO9nEy5AXUuPDz0++LbEBB8uqCYC",
-	"SrmRNTP3C51ip1mnhCiGkS0ZZqLi3rc4FVweUctTFnrVbEE845p2bUW+U/vi/UE+w96bSVlQLm6Yl3Xa",
-	"Bs7XgleRK59qQ2ZsFXUkWsT9vHaiXvEnYTxfR3EjVu3maI3KIt4pVn1+C2SnOO1X72tFFvgVOFux5ijE",
-	"QCzpGs3wbDZjmfFiLdTUxxGoJitWFO59b4GH9gaMupSeRbWkQmPYHgin4Ja74rSbZjR2BVE02HWhCpK/",
-	"URiDAxervlcXhAttGM1bWZZRiZre3LVQ6PHWWLqPFfVTfXQRjhB02mi3Ued8bc6veh51Qay0q1IUTMDG",
-	"JcWgNlmsCa2nS0joeAyj5dxMosqU/Zyy7o93a2COymsmIPwXUMf9Wvvjg6MCnB6W9V7TgTj+U4+zDc0/",
-	"Vc2kC7zJB1fiZ6u2EwqsbucLYch7K+yGUvqd4/KlonYMHV6Fql5bD80eds4MVFb3BZCCIL3bCe3Cxh2R",
-	"7VbQuuuj+/xMfUNVsPvA3e8J4+1FwN3Yr8foGyBlwVg50lGl1G1UpFla9WsiKc2d7VKjBKy0jVqymwJD",
-	"Q5U1p/UkvryfaNirc9wDjLg1SrUNGex5CrbqnOJH+w5CLdvQNkobqf5J6JNlkFLFrRlCNdAEmrfkciwl",
-	"yNSo7qfTxx/xxSDP3N75N0qX98sawJdwUXca9uIhwfJ+caijH9wfp4dfvvN71N1WG3jW4YH1kVjRuf5S",
-	"J5BK87kYydlsg9GEz8Xr2WywywW9f7B0BT+BxDZKff4NqofWYHtF1WVc45Nq4ksSbwH4c1oU6H7zWoqR",
-	"pHB6pS8SYBUXaDH4QDEyh3QWN/y491TElkMRt3q13RT9lzq09rzLG90t0P1PcaV3RsNnlVkwYbCAviu7",
-	"Z7HB+wb7tLFPxkn0rBsJM6BHoNFEiNcHnsRY4yK7k4JxdGqDL40csFKvGNSF1/sEUgHVEnu+uN9YdXMM",
-	"8SGLoca5wjAgse4BQi8qjLK6Un2ahCWq2t+2Th0mSmktgU3iVj9OQv0npjy/xb3s0UrPnNE5C42JNaGZ",
-	"JRsFyzE/HCMBHUUZNY38Hl2gAD4XdQSaozJMjQqZ0QIIHC3056ZqV6yxm0qnsNV3Purhs04ed4EQt1eN",
-	"wZUs741TcF33QqGhPnL1q3TG/zrOOCQq/lbbPR7tHXzGSoaIYr2IecyUr3n0AxMcSadLSEmbJtEn5Fie",
-	"61gCGDUkWvrHtCjkClMWHFjc1qEfPRFy5TxSB3fLYPxFogKCLNGQbaVwWB2GSkIKBrTUDqFGeOFueGmd",
-	"mZyG8SNobLtNgFNe4VTpclRJl1D/dYk6Fn4F3lW3k77r6GSjqBPGx1s13Fhdd2rqltRBS7rZ68Bhki8N",
-	"oKULUAxjw7X5IgbdT2ROUdVPbKFo1iXPwJkWN3wslZwrpvWQuA6wUA5UKjKjvKgU28phPF/RTOQNR4gF",
-	"tx/dEjIrGm2/KZMlXY/4SFX9ftJXdO1MKZX4KqKsXtH1Xxgr37g2JF+XeoaRDE6MqcPxI4k5+L10zKBU",
-	"JciEXDJW+v4scfNR114VCkIKS9A1oQTbFccyad08uBEUuhGROxI9KHvRylprCg3Mt6K2rExZmVGpZF5l",
-	"mwR9Syxfw8vH/t17wRygjsPkXcnmNw2PH7pvSzH/UpH1+ztG1oP052LGfZG4Rw8f3v5Fe8nE3CxCNuqf",
-	"4iKgOc+x/LelspQ4EIzcJ5go4VZ6cPsrPaZrCKCGCqRUuYKOjx4+vgs3QmjiSF6xnFNyui6dxwxQjCBG",
-	"eWFyGuL/6/rDcRTEo/2nd1Ms1ickIacE0iGh08+azOzFdoWOXXy7WShpTMFct/h/KskDEw8soJdSG6JY",
-	"hukYoRwM7BflgSj9gANwqtLHqtSOECZ0pVgICgLp3Z2ywX7VOZ8zjc1RWmdMnod0EEjeOv71J4DzL8c/",
-	"/kQcKtlBy4IKETrJ7SzwmEW1nArKCz2BLths5ckSV1gEx1N7gtTfi0EAUXXlqTk2jpoMIiNUp1VyM8ik",
-	"U1TXY0pgBxB11c3s+kVOvZkUZLS/V0xxi351od1hq6TduFGHRCcGfXZ81Cz1G5vI5HJZCddfnJtFsmlC",
-	"w4GbmMBhw6uwJgKdD3rrgmPpU7sNe1eULPyKOpOB0zGRu4j5IGEW4BN1MouDIBSPsP9+J6chRT+ew+Wf",
-	"XP9+/f8DAAD//xuFDkmA1wAA",
+	"H4sIAAAAAAAC/+y97XIcN5IA+CqI3ouQHddsUp+2NX9OlmSbHsnSitT44kYOEl2F7oZZDfQUUGz1KBix",
+	"D3FvcrcR9+P2172A940uMhNAoapQ3UWKpCTvzA+P2FUFJBKJRH7nh1GmlyuthLJm9PjDyGQLseT4zyfG",
+	"yLkS+TE3Z/B3LkxWypWVWo0eN54yaRhnFv7FDZMW/i5FJuS5yNl0w+xCsF91eSbKyWg8WpV6JUorBc6S",
+	"6eWSqxz/La1Y4j/+l1LMRo9H/7ZfA7fvINt/Sh+MLsYju1mJ0eMRL0u+gb9/11P42v1sbCnV3P1+siql",
+	"LqXdRC9IZcVclP4N+jXxueLL9IPtYxrLbbVzOYC/I3oTVsTNWT8gVSVzeDDT5ZLb0WP6Ydx+8WI8KsU/",
+	"KlmKfPT47/4lQI5bS4AtWkILSxFKYqjG9X79FubV099FZgHAJ5Vd6FLkP+tpl2J+1lNHHmtu2KrUeZXV",
+	"9PG7njJAjyxEyeizMZuKmS6FoyhjYWgmFb6fc8un3IjJO9WhqVyshMrNiVb0VwzF4TPD9MxPaZhdcMuU",
+	"EDmzmk0FwlAIK3I/uV1IQ9BxxYzlpQUiDqS6Yy+6RLrk70/WeBhMF7qX/L1cVkumquVUlAAonRsHJ4AA",
+	"3zKtari4xdUYvhTMyqWYsP9DlJotBVfwmQAEGqY0K+RSWsJXl1qXwnLA6S56/VlPX/pXr342HH0uPdvZ",
+	"NiNh4E38BZwuYa1UczMA3iP/Kh3K0p7wmRVlAvsahkcKk0vhCWC9kNki0OhaFgVT2takECgg51bswZdJ",
+	"MuDmbDiT8wcJeW+CiHpZBFHWieXz7vIIkczyOVGTWeiqyJl4L7LK1oQ+ZnLGuNo01jaI0zjm4jhFxEvC",
+	"ZkV01jwJ4/jQemxtYzHpW+m4voNumslc58VFaxcqCwwsXtTbtxHTQswM5FpaCWQZZaU+jmdd8ZTfzEXW",
+	"S2VhR1oITZLROZcFnxai5g9dxB9JNS8Ec9QLO8AZ3GIwmkmIMgsts9QG/roQis3luVBjYsK4k+e8kDn8",
+	"txIGttEuhBHMDTJhr1SxYZUBGNla2gUjrODkjd3cuXu5mPGqsInTAiRODwkOYAlr5YBhlRElWwPsubCi",
+	"XEqF8wNlOZRMaPhozPQU4Zd9q3Vh5cpNJFU9EdBNOeOZwEFFLi0snUZ08M94YcS4i1y45ABoXhR6zeDT",
+	"NqAMOX7gAQtu2FQIxUw1XUprRT5hvyIzlMtVsWG5KAR9VhRMvJeGBsSjN9NlxCq5ylkpiKfAO60Ldqp1",
+	"IbjCFZ3zoouf1xu70IqJ96tSGCO1cgca3q64JVaky5wW6PdBEHdqbF2AK+xN4mCfiU1CJMqFsnImRekG",
+	"CSQ/ZsvKWICnUvIfFRGiVAGPnhY78+hzUZYyv+QOxhsGfAsY24Kruch37t8YWHy98/jahB0vpAnbI4x/",
+	"MGZnQqxou0zEO3GD+/YPzjov54nj/URtmHhvS854Oa9QQvFHaLraTOBDMznSS/Ga2MXmq69ZBpRVGWLg",
+	"WSm4v4AdS9lMRgmuVXPDS5wKuVyKXHIrig0rBQzFOGIxFzOpJHwwpttFGtzRMW6zrqyDiJdWZlXBy0Ba",
+	"PSgy1dSz/K3CTZf3Hrkvo0vjkiMcu8/PpZHT4ioj/A2+lAXcJe2bB46Ng2zgZXJUo6J1p1TTPXgSKSEB",
+	"rexpVZZC2WLDNHB/7sfFcxnxfzNhpz89Ofrp+bOTHw5fPD95/eT4p1PSwnNZiszqcsNW3C7Y/8pO3432",
+	"/w3/9250yvgKL8actlCoagnrm8lCnMD7o/Eol6X/J/7srtsFNwuRn9Rv/pY49n370r0WHAai1Ue8hi49",
+	"btjhM39kcNnAC78vAP5ywn7RTAkDx9bYsspsVQrDvsJLz4xZLjOYipdSmK8ZLwUz1WqlS9teugN+PJLK",
+	"3r8Hiy40t6Mx0vXQRUakE5/MQIzjlEBgNd6CTabNTt03p48ZL9Z8Y/ClCTtFVodXxOljIg/82nHjt4ck",
+	"niBCHVMs2VeFPBOMe6Qxnud7Wn09YadrMU0NsxbT+iJGqltyxeeodo3ZtLKo+qBM4GZp8NvThcxzAQAq",
+	"cS5KHPovbVp2rDEhi6PkDrMrXjR5jd+tGqE002g8qvEyGo/WYrpzz9IU6eW6mk5IaZCGvUQUlHTZS4sc",
+	"kS+FFWVCCBRJ1esnbhbxiceLkx12WIBh7gIu+FQU7vYbExgwMqmf9LO73qShe0SrevODJCGUqVAb8EYC",
+	"f182J4XzUa3gA9BhG+y9xiGCdDkD2fWq+cM05+7p7MrEab2VFtjQVnE3d7F8IKiEWPBCGut5HHxv+kmr",
+	"S0be+na1hR837tKeVddTpBboWMZrbhdPFyI7eyOM0yFaSg+vTOI4Pav/AhysFxsvTNgFkOxXStuvHadP",
+	"SpBSraoelQUfEU2Dko+KFdDuTKqcZvGXRHJgc0LTJvU0J4iKACi9C8dSaTtJS4ZwHSYhxUECoDNdqTwJ",
+	"k9FVme2UWaItOaIP2ltKSHMQhWHjNY/dhu3Y8h+kyusdH0R/PQST0Ee763j8IXB4FDC4MTqT3BJTh9Wc",
+	"CHV+zsuRI4x+EcRbWTr74R6wUqxKYQB0xpkhDd9ZDpBjOmvYDrdFv0Uk3A3RY4/jNN+JPkltyzNnhPqe",
+	"Z2fVqu8cpokQcOzvnSl+zwDBYzIgi5j/bLe9tPBdQ/e8LHXClPqjUKKUGRPwmJXCrLQyIuX+yRMH8afj",
+	"49eMfBQM3gjqSRiIHRomVVZUeW2tW/FNoXnOjKYzF7aXoG3sfFE40KQiI5TUavJOPYXJHh7cD7cqijqx",
+	"KRCeTCuzgdtXMATUA+UuZ60sl4pxdueNsOVm7wmornfo1YXgqMoDeFLlMuNWGKfcOjuzXJJ2D9gXxnpF",
+	"uBS2lGCn+EGDlcOLXW5ANPBbENZyDsK/l1XuGHevw7tZIYWy8FeumdFLAbr8nJWCG62Qy6G4KN7T0Za8",
+	"QJrRsxlJBMFa50XllBfBGD5PnYwWPeG+1++nKOuHgi+FyvTfRGmcbalJOzPBUerv0s8r/AcvmH9lm1Tn",
+	"yYXQYxDhubBAMev6Qtjg75XBzVkSQoab4HrZxXm9uO0I8y+6sVIIc143XhSvZqPHf9/Oro+8JAhfXYzb",
+	"uOWZledBn9lys5OwaizzX8S+qeRlR9aOFIeGBzAsnAFj+XI12LuCBjtx4s6MyE94Snbww5JEIpSz8/mF",
+	"EMx4VYeBnB3WCFuzAnhJGvaPSlQiRyXJj9M6J1tBlnna0u+R+rOexmP1mepXpZ6XwiQOwWtRZkJZPm/Y",
+	"GpDUg/0s2L7GjM/npZjDzrBZqZf4gR8cBpDWxAayJXkqR4/vHhyMR2Abxr8Oxlf2hIPAHhzh1SpPE0lj",
+	"E5Hw6NXhnrjKiHIXLG/hnY50lY9q6q1BjLzp4dT8dvEbHcjvC52dFdLYfv1gHXt4eSmQk6MpW+QsEyXe",
+	"JoB5p0VouFvMSmRyJjN/ygaJaDE8z5UtNylW1X2pw3i3Ryk4F+QgD094u4dBtnagHjoOSujhhc9zmZCT",
+	"ruQ3boERxuiZ+Sdp4BLuTi6ULeUldLp6rJ7tagHmx98OV9jVJj2+EZku89qMJHJpuwLbqhTnUlfm5IoO",
+	"+Jq3p91kwc/ODUIg8qEnu4WL+BLpAt2DoRfc2DeonYj8cMnn4lDNdBfS50pX80UsO+I1wCMRayVFJpjV",
+	"c5IgcjmbiRKeEbjoIYCvGWcLbexeKQpu5blgb9+88AIbsLe90oHDJMAzYccaREyyeZLp782LMfwEsqTi",
+	"VrB3ow8gqV7sf9Aq8H5TzWbyvTAX70YpbzZ80DyvZZHUVN0wDRLeIf60Nganikbq2YqX3Jhn7l7tU3rA",
+	"hCFzMzDKB3hr6tL+CNd4wqQi80FLOhKFyNI+09dBH2z6/EhBoPVoAj+hUYFPB031FAnQHf4H90KEmbUo",
+	"RXyZNsMIwkkiBWcq3Nz5cHGnhac2jL34eu/Cn56ixbNLANcXTCWuHEu1TfZprTsGt2/NURwWz3NJiszr",
+	"5v3bFX8b/shyKm3Jyw3zwTae6UzYS9hVkvrex44Tp2UuNWwtKkkVKM/slE+mk+wUZJKazwGKzgS6KMV7",
+	"DmO5ncF1PB4drUppBfuhlPOFHZHENRFLLguAejMthfrfps5Ep8u5f4NkgNERvsCO7P/3/56LYnSRxtNr",
+	"F/fRRxnbIlPatg3/as+WHCFuewwdz50VwV2aLY/G9Rk8hunfmS6qpUoZv+D36CTAFqIBZMx0yQ6YnLFK",
+	"nSm9VpPkwIVUIiXCKvERg0bWgq7t9Gd+zgnzNCZzb6cNqPhiitcVAsgq3II0IJ7/EBwWwN5tBmuZLcLE",
+	"DkFhA/ooKZKZ0ofblpXo+TYoUd5UitoGTspVBscW53cKHf7biQlSq70Zl/RG+McKDMHwD7oTR+MRL7OF",
+	"PI/+SZ5RGn4v6MQjwomoBD2v4CDvxbOBJ45jLEbSRhtW03d4ySSVpgt6FoW7uFNDPrEkcVxW/WyL+169",
+	"c2D1bC7EHpqjarnkKen6CCKL5EyKnBVO8aN4Ii9vT9hTshySdRIf1i5X+AnETXhdcLATcnPW5S6XizLF",
+	"2HMH8ADRpvfOAnfT3yCajacFmiO8I8j8oD2LpKA23ogjal3uVwtJvg0Nr7niPvH0OmN+WwD2B8j+rKfm",
+	"3ytBNBhdzyixjB4/bIZ6913aF+MRRp2dTDcwXcfW8Zv/14lUDV4UmInjM79dtLfVAfKhFpzu7gpIv6Ig",
+	"9IMsrChBmPGDjb1Y8+Lwr89rqSYZbKVnMyOagCatWzWePlzCImwGXgV9K4r995dZVbRrXQOArUpF4Rqo",
+	"G7gTSp+Ql0Uahku4jM0pSm9pk3Q/9W5R+S5lP7k6Y3MOgqdazeS8KgNva8IjzQ+yNPZNpbb5kylOA65I",
+	"Sco8yBwz+LB2+Lj5WFkpU8d2BPUPhXLOZmLNZjyzujRj5sJ7lFZ7GKUqlGVZDC96+5gugx3RkwybwuXN",
+	"xHJlN6BYFoKcHJQToO5YNhW9YX4LvuTqObqM8u1e9CN8laCwJVdmJkr25PUhrCxEBKW96sbqks/FC531",
+	"3CrPQqQbeupAIIBDgXO5j3cLdO1Z2qsbxxu8hUr+xkvpgwraBHJi13rNEzLBKyX21nzDzt3HFIgDeFtq",
+	"Y9ErDb4JJcidBw+NxKu0FKuCZxgmQ9b60w8g5l6cOtORLCkqeOyMBguM+zPkw+DMJ+2F0AnuHd3seK0T",
+	"MPHCaD9p3on/4hRUu14IB/6q4Ba0lb1gpkZoKC/QDTLdBKD7CA0/2m0Vdm70GtH+ywH79aTKpVDNEIQ6",
+	"JwXUUpMUYVvDmG231DYO1Rqne4e95CuMVMZd9pvCFBooNEWjhcmSDP8l3/xViNWbSqlkksNhcENH3k7n",
+	"lGBLvsFYaVbS5yEFJSGtdebpbmgt1/cI6aQQvAmaxhZovYs/Fv9rv12wU6wdXR+GfCfnw2Wn9AhuJ3HK",
+	"YCnO+9i1ucEkiO+5hv8q8d666D1i0qdwV5+O2WkTCafs5dujYzYV7BSDtHsIvWMkaiAyYK0PRykq/0Ws",
+	"35pUihv8yniW6YrM1OTO6o9vWfL3L4Sa28Xo8aMHaOvyf95NOSO5MWtd5k5o8q9+m3i11LuDtAHYN/Be",
+	"b+CMm84Nl8JEiEc69AFl6biZK4a/fLr4uE8WxpbBckU+MGZoaPDZGzGXxopS5HQTdTHJ89w7ui+Rou1u",
+	"ouRDo2d2zUuxhSENi1qtJdwQZ3IS/JPmcorBdeTGeVTFid4eEeNRRlkGCOEowkIP9KndOhJZVUq7CUbR",
+	"tuY+MAxoW/zPkbDVCsoMGMuVJTE8ZdmMxV09BSk3DmHAUVgYpsvpnCH6Ocb98QGpI/2Bjp9KZO0uIYlP",
+	"FGwRZJ0KrT0SaJWaYaYUqn4kSB799OTew0d07E21HDMj/4mpGNONFYZE01wYAI8VDigfkpe52eq0lJbT",
+	"AGfDYCViP6M6KWky1ySOjx6P7j+cHjz47m5275vpwf379/O7s+mDh7Ps4Jtvv+N372X84NH0bv7owUF+",
+	"7+Gj77759mD67cE3uXh48CD/5uDed+IABpL/FKPHdx/ce3AxDrMVej6HJINoqkf3p9/cyx7dn3734N6D",
+	"WX73/vS7+98czKaPDg4efXfw7UF2n999+M3db7LZfZ4/eHDv0f2H07vffpM94t9+9/Dgm+/qqe59c9G1",
+	"fniMvO6P16zlaK8SOsklzhPz46Bkg3K18x8737HTvMIGIA/nJqiHlDEQTTJhh4rpIhclc7FmwYXqxsJ5",
+	"4Qb4vTLkJ3sXlsMOn70bkbnS2wncKEyGGEZOUKDWeuosT3umqOb7JhNK7AH32qdMw73DZ6c9eQiOZAaa",
+	"AAh28AMcrUS20xpAg4+b27T7NNW3f8pgDc/IztvalVQO8RXIw8UktQkDTQgO9XX8gV2A39Nf5kFgHgNx",
+	"xIOir9flj3Cf/1kfY3YcSRcfT3yprW77XoZtSdjqLoNzyij3Uhcnzut4lQM64sNDIqxf6Ho8MurUI3qI",
+	"k06JBU9A2GS18ZjJMZDPpHxeTR492umYBmjceON+YbeJ4F+lXdROqUGo9uaIDNnZtAf1Yyemjhml8WPu",
+	"vUJdl8SZP/neDJU9o+3ocVx1djW232/b3o6v0flxMewRguxJM6VqGUkDCQ0Wp3M5jfXKggcKGg3c9coS",
+	"NyQ03IqAcAvXW//mN/eLQg/StxrtForZnMV5fv5KGcdb6aw0unncRXkOcscPsgj2nFIwJDS4Sdxr8Jt4",
+	"71I9glwfB0bcFg3UBzOch5shi3iicNyumVYi9v2xVEN1UpqMo3XE3f5f9s69Lka4henp7EzYw1c/6+lb",
+	"dHImU/aNsKH8y5gZoSyDEhfMf+0N6xgChPY5A5k6JVNiDT+aMTutg1ARmtMQTeeJOxWXeU1ZAt4+0hzo",
+	"lygyhsrD2W56QQPoS3n74hCskBP/MOlDvaYsBpetERLD4pSNO6ZOZwCz9oKfh/Iil09pKMWsFGZxElz8",
+	"W83UUfaVU+Xc9xTqEQDE0WrfH9IZJeEb40Izjfez4J/ow4NwEKlyeS7zilPkCFvjLHOhREmmaw3JYRs/",
+	"iKsysyp5ZmXGi15X3+V3vb/o02VTOgZndKy5OXEhqYO2olFCxn1Y3yxBH4ZrTqMldSZFkRtXyGgqwiB1",
+	"pbHfqXYFBs02s4F2WPtlTypJt7xVk+S28bI4ir2PqTm06LJGSyLcvJMY5SBNJ6wPTPmwi2o5VRgNupOu",
+	"0gH56bhrnxRC/wqTbMMUsPb+4lVHQqGf0r8dUgS5Yaf7Jvr2lIlzVK6xfI7VrmyGl36iN+EhINMdxAl7",
+	"6sekah9zYePnZFJBZxYca/cr838Xem7Ice+LwYn3q0Jm0hYbP+1U0FWErmN4tBk3ch21it+FMbQi8v4K",
+	"PF7CNqeeeZL5XU+/RpkcXodX7hiAh6FbDoOnE/eZXu28zBNb88o754YWCEoN4osieAN7/6VKebFWN7Gy",
+	"zypV/wDs4vIVEvVqWx2h7UuPtLEABkZf1n8lFbE+VCT8RtyyM6lyd+oH48CDxYsC4ndGY/jXr8GL7kQL",
+	"bs4KPaeH8bHeCjVEKrzQ8z4uduwOAcsWlTpzkhnGM4QzW2q9ZLmgSyCnhy4vHEDC08rPtczh45wW3bws",
+	"U3QMK+n6IgCIuFAjgDZhL/kmZIUvq8LKFaZaK0EGVnAm91UMPdlFqsfkw7kcFdZcEpaxjRJh+CFi8TE3",
+	"HvtJuRiR0RGMXYzr1STjOEP50sm1w9A2vsyttlvEdv62j5WxmxWjLyM54y70ic43ktPbhPWmJMCUSBVE",
+	"AucS3Zqtu+UEEBsbcgbozW2nwAXV+HNwBXWP5hhCuYDFEyNEQqwB5uvDDsGjQ1CBdAfv+8onUWmQYVL4",
+	"7gOw9tB/7BHoeN0/4quTLCQhDP24EYFzs6rN4PoQO2jdj5Mk9bgURLIwWu2UjSqIWc18iY6WEW5IgPnH",
+	"Z4W5B/f/+D/Zf//HH//5x3/98X//8Z///R9//D9//Ncf/1esOqHNIY63drOcZMt89Hj0wf15gW6/Sp2d",
+	"kB3uPqzJgoZ8wqtcah+RDfYr5z7eJ21p38z2wchDbsy79+5PcMh4k1//8iP8uTKjx2BHnJV8CSd+dHfv",
+	"LtgYUdkyJ7o8OZe50KPH7pfReKQrCwWPYNYT8d4KRfQwmqxccBguxb3VhYtmCpDtp9HlKkR2xiu1tlvH",
+	"c2U/kSTKkzraZlRIVb2PKBrjVvccqp2WObq47cr97K2yEi0uatws6U7OKGenPnXZS6cThknpIAzS1MZN",
+	"DtkNqIaRixXTr8aRqgxDwjuMG7YWRdEqGPPZdg3QJdNLd8CvnPV6s90EdpgSb6IxQFzAW+k6cau531PB",
+	"FlzlImdYO1eHXYjpcdnoMYBRpB7hNUUGgo2L916muEzqSHaLJKFmqebMbIwVy7pSgPu2VWDSaiylPFfS",
+	"CGbbYeLuZWfexGAPqGBS7mXciBAL4qbwQLkMhnfELSCA5N1oLVWu14b+yHm5lor+rVdCTU0OfwibTdhR",
+	"mEovV9zKUCj9R33HsNOyUojhH1+9Ojr9CysrxU4xfFcXLJfGYhreKXMmDh6y8lbaYI3RACQIak+Mr7nC",
+	"CwYrGjfWwd6NyOBTvhv5iAtHLuTwrlmMFeWqRC7FDXs3iiStOyaM925U436pDRhz0KZ0JpgVxu7nYlrN",
+	"XTKrYYIbieVJTbNQFQVHy4zlOsOy1Fg6pigaK+tnPj2xiifDK5yOWaZXMratnrarVE5gtNNQ9bpbI/W4",
+	"xaSpgrXImXTHD22zLNfCQNbKklvXrINnFqzhfqROtBPiF+RONIS1SqciHekij1KsmhXI25Vrg0LlDaTv",
+	"1GEDQGla5xzd2vDzdLPixngl9yY6evhbDF88fBYyP8Zk+/NsCn1ycC/4OrNTwYBb5lVBx99fIpKi3yl5",
+	"KLoxxkhd7ppKXjstHvaRXUW6TC4l3vZ0CvH6MNnwMX3KMNkgklD0b8zkREw8Hw9ZGFEWzuRy9onrbB5y",
+	"E/XUKHnzZLqJhY7BOcVOS03AOtCWcgmzC+q5VldAp0NKvoEo5zVe+L88kKdPa7mctnvJlihfkrlmRzOy",
+	"m6vQtg4ZAEMpbWiltrZVKNUHrV52ZCLa0fjMmanT1aLgV8an1FBBoLlaz5pW6I/yp6VDzYDBwZO2PXrc",
+	"CJ/qUkpkdt45c1UW6YmhiBW3TviJZ2fSGlHMQliqXiuIbxmSTlJbrcMuUpEqXH/frly+bEYokBFS2Y2e",
+	"2b123YyU16Ke8HOqbBGf6iuUtoirRHStQ5WxTHTLodXkTlxMN9oM1NEOKHZPeuygg23uH8GEPxsmelVD",
+	"90BO5mfq2+FtHjZ6FiJSMA/di57acXdSHYli31UHB/cekXMaOR3uNFYjJtEUO108AU0k7DqG+WlXt/cv",
+	"TDsbROsFOVfYn+0rlMe0T0A+9Xz6tO7PJ0ruEj39w46WAWB9vcu31E3ZBtcdrtxXcMZw+juGZaF9C+Vb",
+	"A2g+CJLYPHt1Lsp1Ka0wzNu8sbysigro+mJdSXEn5Xd8oefOnxh4B7k2vRTvu74A0LgrOKHgZSF7quR/",
+	"KjnFNlj2JbhakqjrVMKk3lQKzInIBOrOaOSQipLjaZxEpPm2LMSP41pbDrefNHV4d+f5Xj97/ajk3Xhh",
+	"vWm78PULVza3CTvl5A9VRxA7u+Jb22n+LTD0XKooeH1wI4A6F3qQghs+2AFIHZXdhES8X8lkNfRWW1M8",
+	"svpMKOa+uEQTU/isO8H3gpeidINazXgFBnZLvBHCRQB5yvrI8DRnu3JZZgJqHNbvhurD4hudypl+a7zJ",
+	"gqwKLmiXqzwK5fJ+B7GJGwc+yZdS0bfuVbCC0Pe1z9YNFtodurNpmv2N4BGe96VUSQmz5mjDyrw7t2Uo",
+	"8tUmGrk6iThaS295zdyzjvt5a571MDNz/1iloMYlg1sG+devJf/a8kt0GyIIjnnPSOZs6ADmbJh0F+1X",
+	"I6e7bg+QzuG++K1TJdRVlGtK7F6wq2ntxZDi5d0r5rJ2ozahbufY24qoeqqYS2P7qjZdsV6AyEph048+",
+	"ku469edwpsYWJ6fY0g4i0VArUfLLnR1fToU+QrcqBolrSBtt9CAtK5fo4ILtG67GpRBUq7CREeRjSGP/",
+	"bbKlMl9xbKMntxfU2VH27Wk0TMRq6kVB6D95Flz3XfiBPCzgPRDveWa9v62D1mxVnWQ6fdFK1fLDPn39",
+	"luHLfQVQl7rcnCyn/WPxJVwTMNabJy/RdrMUc95rv7nYQggRV237bsp8jWWeVM58OQhW9tAGkkQ79669",
+	"dT7EIsSvjO5P7o/Go/mqGj0eZVXOW7i8+6iBjkcPH95/dHFDBFIXSI4HbF5MY2aqDJOWT1treczewWLe",
+	"jU6RhoxAzRRJR+S1vpc1qZACpo0/GJcnrl8SRBVXp95RabeX0J5sIbCB4/cT3ZGcq1eqVYmSmO8IysA5",
+	"ecfTXEQuZs3nc1HuVbKP9UEpedqZ0Xg0my1XYu56lu7VTSsxUsFkiTKUW5oWfoT40VnIDdwVzrBjCm23",
+	"noLtmnYfdQVGHcrMkv80pIk4NuAayycCSY79N62eJrAfUiuR44WglWCwBJxnwn6NWg7HcjPMYUKKQTu5",
+	"YFB/tZ6Ls7NX/ZfoUSHE6si5QhNRoPA4uEpdKwhn3fd19Y4wnAMYrFA5KWLBfASrxV881nO+aZrPw9jS",
+	"kJ1ITNiT1apAtgW3MGW6afhQohvzNOcbc6JnJ2shzk4xpR7faf4OL6Mbd/JOJSCEnZOK3Xuwt9BVyX76",
+	"6fHLl3V5UdJc6nMdjzx6PFpqZitmF2xWwnsqP4ExIebt28cHB1QYitbiI+soBse9dfAdvNWNEGtM0tmJ",
+	"Fc/EnhErXlK0/1rvFcJaUYa+Gw7roJDAWCjECnHWg2b21bvRUlMAiq187MnXE/YcsOZY47uROBflBsbz",
+	"3TU6R7hef2R4QYT2VPfyqPmQTssr7eDh2npFGHvcxGZj3AjiLefCciv6XB0uvLaMi/kND89NOiqiwQYB",
+	"lbdunpBVzdf8THSJ6ypxxMNTjRvfxdk8gHUqqEBwjUfcAEuBTShLjUqyMO4VPZthrfl+60AqSDkh+eMD",
+	"x6xqa74rpFgX24AfT+mfpwlLojkp+D8324vQNWs0uiAVMpHHAW7IpOowG9LxarO68yIY5ruHfFzS6pBd",
+	"HIf1bdnPPtfY99zIbIuKfWWz7KcL7b+uInnXFngfiVlNRPytDjj0QeqEEkfp0viSplfzsu2WGY5TAVvH",
+	"fB5bTdiTEO7pXV/FhuIMZxt//fM5kzYKrMQgXPTlTILy7dzpK7jB9azOMgNjFjMS/uZKoLOpe213zELr",
+	"dvRwrtmPr98yitoOXq3nz//2/PnEI+fx6MfXb/fwt1RcdyPN9tL5WpbPJ+wpLTLEJ6M8g1EeVISYUsoo",
+	"wIFwh7GnnJVc5XrJcMDgEjNGzlWnh9HH+Wx22GKO+XwgV64ZcSAC06ZfvwIghEQjiLnvjHVNva38iFuX",
+	"l3bZXJdJtQvRdnDM2XBDebMXxoerRuOkE9MT5tdjij0MWxhdCxdkasQCm6B0LGvH1wm4VhJsBT0vofhl",
+	"sEEU4DFCV2Aob+ysdq6IK50hckjUThtgnNIwQTXQ20GRjQLzdHwQiXgLoyeoRsvC2lUUxJqGHgCEkwNz",
+	"1hc/O3w2Zt4n5h+RXdTVLefWv1pGxt5JAx64g9vgXGB7eYrUwrzczEZmiXBdHAu+dDFG9KV5vL8/c08n",
+	"Uu93tWhKaWY/8HLpfEFY7R7bA2XCee0Ct3xxfr8z/nq9nsxVBQlQ++4bsz9fFXv3JwcToSYLu6SWWNIW",
+	"DWjddNHV9Hh0d3IwQRVKr4TiK4lWOPiJqoEhVe3zldw/v7+ftdsczMnSHQjnMAeghW32Q0BzCTokcbR7",
+	"Bwceq0Lh9xy0VKKp/d9d6BOduYGl0ZvzXVx0kK7gRBahIBQdHy+UAcRkjmvWiZ01mkCjUQuZ1N8xdWX0",
+	"W2OM5ypfaelqscwpIrw7YNiKMOjFOI3efTx9+9561YdsaML/fSjt+prqt90YutPd/xP4/kFXqq70igq0",
+	"+3ZCJ8Kl51wTXFRiOAHHUehgvhbKsnWp1XzS2v0fpKtPoUu21KVgT18c+m7/FC2DiRJQ1hRTLCjp5vtg",
+	"VuwQxUqbxE5hGdDEVuFl/r3ON9eGjVY58wRaXKQ4rFiEgsCuhLcmvj+6uB06apRH7kL6S/PgjglIhJC2",
+	"dCaV+Gxoyl3IKEhEV/Hf8W6vKc51hRKMx7R2FVJrUbELqjuvx3ffRtu8k+WYBS9FvufqraGk1k/QR/jy",
+	"Eb37SWn69a1R77/IlpYT0SvRTKM+eT+pXmKcXlL1WTd7U56dVat+Kv0enz9zr9/k5ejnoBn7aQN8ygQ1",
+	"Gl2WPP/iKAGWyKoVCk1+J3xd56h+s1tlqyC01xHOhFgFC0vdX8p/JR1q3qnt1PSSY38r95WzBdTpkh68",
+	"LbSE5XmHSrNQQfJjqegSbT0vxo2xNnxZNMdq65a7WNGXRmpvhC2lOBdpAb0jT5Pmyutwu5xsehP2RG0i",
+	"VVE1affZ0S9UozznWWhavYXonmSZMKZN8nE7tRRsPl1XacsIdXcQlFcroZ68PvR13IpCr0mVPcVcYMWL",
+	"fae6Oco9ZSuenQGHfKf66doIW632uG9r0c8jj/i5SHbSuJm7PDlVUkqN0Wo1MxCTk7jaHyQiIVpUj5xn",
+	"LaZ8tfJm0lwzzmYVBiK5QpvWNdgBRe5LOyhv6xSLmvs1CIKiS0vhqgZgtC+sf8NmlcqIIWF37x339xFP",
+	"Hsf+diq9FBoqA+x/4K7J2sX+Bx/PdrGNKddd1cajVWiGj0iSgFBXytwZUvzoo9j05hzKlzE0dFrCXVyM",
+	"kxNGMXn9E7Z59283bymp0TboqkiaScKudUwk7K2hrn3wmlc9eJ7vabWjNATRZmjIJqZUBmHGMxQscp3M",
+	"qGbAvEOfiGmp16ZRI2EnH09abZprRLJu8/L20WrQuG8/2sNsMSqcajLfCHeNKzUlNvln6rBMwekd8rxJ",
+	"vWkLQOh9rOBSJYbkSifA7Wh1u3CxQWw/uHvv5tky3BpkSA41IgQ423It6Bqva0k0X0hWkpAGa5kUG5ZX",
+	"tbxMNZ8zni088YWh8DxoyMdSc3fV39qN9DxuqH+pm4go0DnbYC2wjPYJojq0WIMivm6o027jjP7cLLsh",
+	"3JHtHLn9vNzslZUacPSelRvo1dq5Ofor+tT8wImOVK3SWwl5GfhSHmqsrL03thE/4RUeX1JgYJ0bXUal",
+	"bibslV2Ici2Nv4gbcNiqVAQHNd9oi7uu7QIGhXU4cVgHMU+82/6BvcLD5eY8NXHc+fY77ZNxOcdHbpXN",
+	"+R7t/2J3/zPY3VPaPNfZgRqXGc9pThF3pNmtXfgqHEpYO1cbEvlbx3PCqMu68WebaIMqHFnqByCt7wTQ",
+	"EHF62CeNQWJ6gCKw1C4nbVR57/c9CZstfiz0lDdqNWOBi5sVI/oqvg8yfiT1xWNfpc0XicJ0D642qYr3",
+	"fYIxVLSgGkuiPHd5JYnPzY4L7xX66DEqLKqRMEdE94DT2r8lN2aPmhb034bP8DlU1+PG3JAw6kZ/5rod",
+	"HIlCZH0+2me+obJxAS2USald94XJbcuqMeD91lz/hrc8IoeEQ43QN9rnTb4QhuYDjPJoacBzfEwl+yqk",
+	"7o+jwpa6ZFT/5Ouo9uZalCIu8BMV3vRXTag8tZOVEcXWkWs4DbdMqyxxCEho6SX/f4fHrhT9DZG+wTmS",
+	"5p5277smxc+FvXVyJ2B3urQQq5Fjy0UVUp03rI8oZyAq4BnwCU+w2fjhJz8Ctb8ULq9Q0BEQP4wr07GG",
+	"a3gmCyvcRZ4zozE+u0uGINnsf4D/QpnrrTYtV+9wkEXLD/jZGJjaVRt7pXJ61r4/XSpMkAUBp9IaVmNi",
+	"x/5EFbRcGc6ZzMJ4O/dl3zvN+zmG91vezjbdCEcCuN0yem7hI4z5DTUtIyUqqoVsF7fOnpqQb/euRsU3",
+	"c6dnkaJFmtTBg2sDbJsmFSoiB5p2MZfwK18SST64d+86sXSEIGyDqrcyKVcME0lul0W/VeL9ioq1+tmv",
+	"rHp5a3SMay9k1DVa+7Qwp7SWkepFaY6NlmBtzK1KnVeZt19jWp2lMkqgzObiXBR65cRBBGy3wnZcbhhx",
+	"Mf/NuKm0WRfLDhnySbZmBlwyZnSLd0HS2xBeqnGTuBeiG5re8SVSZDn8bqinCmbIej86KPxAEdkXpCV6",
+	"JapHeRp0C4RihP13wK6Y8d+GeD2pD4OT2v80uocvDDx2TRvRZOLtH0gOqKfrOTWcJV+vK+0cxqFUsmC+",
+	"hXCReQlRqhPoj+lOubGQ+UGVHnBwb6zNuFLaYjVKQTXL0qe4307ySUnlVnw40nfdawvZLUMGsq2dJiv6",
+	"KJRWwjz9voO6Py10dlaEIjTpI/tGLPU5HNnvw9u3uSE3Is/VS0nd9tWqEIZ9tXbFKkKe/teuG0aJGIlK",
+	"rwc8Doyy8KeVZ5lYoTlAKFtKXy0Fy/y5Sb4ksYIoJazFNbCGGyNC0GVP/6ehuptjA1tJDy2iW8gPmO0c",
+	"GCoMEtXX9iLNpyaUFgdDQ26zmlVdtsKvAckk13hdiBKNBbauctFY4XZhhWLLAqnFV2C/sLIvcrklxOt5",
+	"7mMOvnCOBwvpU20aSmuoiLxLTX2QrrTsu9mWIqiSQacAx7af7NPplLU6efjMQfHdrUCBs9fZ0aT7gA7p",
+	"OkZj8Wtv6JU22Ahz6SXR61R7eyF9pUL52UAZtSBHBJK7YgK1NwwN2VGTHf/6diJ4pz4HtjVUbXZFFjxS",
+	"6oprPkTQrRY7V9N6tak/mLCnoY+WE8JLwc7Eytbd1KNj41tpuxrPAYFYDgh4zl/QvUBttOvxQhwCewJb",
+	"tOZlbsLGSMOo9PpOddot1i66Cw6u7u6ytzDahTRWk2dh64X/k3vvz3Hd+9X0c4Q7hjnUjJkucoFF3225",
+	"YTNZGvsZscnPULiob67Qej4wUKqxhcWgqI+856NDpAgYD973WzNQmLhEAEDbHU/e/z8DyX/hUQbNrb5C",
+	"xEFy0FA9fjsBGWGX/H1U4LUnIg/tkS/5+7oT+Bcun9ZroYunj2GC9LDs68n4EWKrLwnCTdDK/8V6hwlI",
+	"w4SI5WUaaUYyxvbDEjcj2XZUXvv3vviD4ley+5h43FzxVPiJ/nU0bvpo+I3qyNfbab8uELaN8o9C85ov",
+	"m+4bvZ/6DBmNYniYOYOwXPEEHDWG69L/vXvdb6jZlPMetQByKYwYMRFydnwFPvdqVMX7S6buYOFuocCv",
+	"egiJo0q7U4g+xrf+HJIzriVUykqbhwnHUU3tTsfkz1FZ4w7ujQ+KbNSsj6hhkHKWXLEnIrPgS+6KcujK",
+	"7lOvwi1sEt9/6l6/qeyy5iSpACKBFmt0xYZ6HExXtxzR2AS0P17Iv4FskVCcx7kZt2dYDZDwohQ837h+",
+	"s45F35qIghG7tHtoEYXImLcGchpaGK2bN2CHbCr6wBCVGMyqlTC3e4Sr1hG+nFEU957xulwFWQXNZllI",
+	"deaMmkS+Dj+UrWMp4MChrDLU3KJ2gFYr6l4JSCKq9JadjBcFJcFIE+W11ayDUN5Ov3YAcWbio4bAhKbR",
+	"SEel4Fs5Stnq+zGEr8T7fqM8Jp4oVHMdyG4+AadpghvKzHXhraZu21Bi1ihexRsxjquPwzuuqYILbvmC",
+	"DhTshGHcE32MIVyMt7qvdGl9zgftIy9Fo5fEtuPwhMplcJ9ZFq6c9oA8BIE51RztZyVBUbMsfJcCgQII",
+	"3TOEw+5/wJlMtbzY/4C/yH9uifAmPEABGaiXJZ46St2ag3r005N7Dx8xP4+nG5hsMhqnpEP/6qUijsed",
+	"eaOuwDN0iUQNgROz+tUPmbXuCfLbjR/LIwwrJ5y7Kt6fV4Wc9BFrHqK4QDr2Sp3Jmord6SJibnrDovOy",
+	"jbUHivyfTYzjpDmVmIpjyb5bifNp52ImSne/h3scsYESwbvRvYNv340CYdV9ZzHzehqlage3KS3PBBmQ",
+	"8oPpAnD3e2PDKbOUF0bTGEYvhVaCicLgOHW72RSYdYb3QnAqMehQ+L/v0TR7T7naewbr3HuLA6SSvUNd",
+	"/TQOdSnnUvEC54TxJ+xw5vrZQqpv7RF20sSY/M5UhJtiPuskdlo3tqgdhxLeinGJb+RiWs3nUs2HrO2V",
+	"A2zvBwfY9WSy68wKu2dsKfiyySGCWj6ViqNreGf5rqc0h4np/+reAfi6awC6d/DtrtcdOTYI0bEcyk7/",
+	"JjlC6T4HVYJyx6fCrkWIEUB0RnHcof+lC99FAKhDfdnhO0Gw9rSMitLDLiBP6RD7uk/bT60/gfXJcYS3",
+	"KnXm+lxOBXwY5p9uGueOJIrT3iP0mMGenbqWC67rao2O244l+SghD+8NV+qj/1Ziv2gbdYdqPMTTO9Nl",
+	"JqdQCaHQrqbFT8fHr1mmlaKMYWJvXFGUiGPLLiPVNHZTUHM+Ci8hOdNq7KQNn+S6AhGQPoC4Hb/nVNKH",
+	"zlrdSCGxP2yq803vRRsX5IIpas2ki5ZYrkRb0P4H+D+fe9BvLsSy9YPSz2i4z9ZW6JqaJo3g1MpFzfRn",
+	"agekhEWAcoe1L/HFlp3fd+3bt+/+Cz0fHGnxJRCBX882WoAMj0APPfHobXkKP1xwdLvB9xthPy9yiqM6",
+	"4l75hXZxlXCzUF1yWvsOd4WrltkK5fBDTnYQnuWyGER8x/Di50N8Vry3+6uCS3XJ6qjHbeT8WegqClzn",
+	"xrKZWLMCa2tERHbH0LIHcK/4kzCentOYW6lqmIMXW6sM9/BeG1Vdv/WyXsn/FB8vXYF/eicvLpOShJd8",
+	"QwZ+MZuJzHqhF+I63AhQw18UhXvf2/YBq0vBXb3JRbXkylCCG4qu6A48l7xbA7Pu4gUnCNvt+fNGMXZ4",
+	"7OpTd8qkMlbwvFUgGLCywxX9Fl+5weseJnjRkzf0V9QvqddQlulKWfOlZK7GycqtBdTER/jf0iQEnVBv",
+	"qePxTXCnX8QaR+9hSjHgaElHeG7XczsYvshj+6UQSXAygr4cLyZNJJEyGS+8Ton19c6Da8NqV/x80jn2",
+	"+9jpq/8WfuEbgd0Q6eG5hzm2ePNwmVkpcqGs5IW5fcpzEPY78PCFqIIjhAl8PmWNXqCO5OoEIoVh1zRQ",
+	"JHzxezJzBdOzxb5wtcUDXqPiodSozb0gFeRIGdg6Zb3U0CpmkSJcB5DVjQKRSeLUld1KnbqygTyHGDsJ",
+	"8AWuBFfgS/x8MeziMIDMbFgQJtdZHTfjc61cIwly15aAYtjslZHYk6XYLio8JXEwvSm3cyF4ibQybgVJ",
+	"anibbl6YS4PdC8fMIHmGAN3OqF8ArdR6Grzk/FSmQSWuNPtwSjlsWhFqk3ih53OR70lVo6hJOh8Q0kFF",
+	"VBz57Fb63JA3X0YlKWn4wh9fCDkQbmm3G4IGlTsxvn5yIAl6yeVxkltRUSleUfo8JabXqu1kTtHNyyCg",
+	"kL/aWT6bcAySXKLmzb2dINwrN8iBfNUgP9WVO0z6gdh53Xs67qCwvVsB+bGor01l3OaGeBfrai6T6wxw",
+	"W0+XcDiQ3ri3nNt9y+f7H6h374DzWjffHXwR83l9DX+59UDidunYbBp1+0pRh16DYX9rp6aHkiqwdgpz",
+	"gjEMphbXm1xvwo4CIluQfn1kXk/Sc9tGi//sjOiBy8RA9mJ6iIlxfg2XzapK7Ci16mlu6fXrWzt301fk",
+	"bSLsipEFQOc0YH3UqfvAl3TSaWfgpsPga+zHNIScGqQ4dojAShLESxlvn500G95hmwtbam6FDfRZ6X4N",
+	"SzGTLSX71vFr/SdxiyHskx+Ryx2NT8KJG2YwVO3dH/gwVLQMWo6LwYDYcxd8AUE9WFDv7dsvIRexx5p2",
+	"yXNKAleTRtPHMkpi33Uyb+FYbrece1h3HUozCEeuLID/1K2xGQSWsh50kbf/gf6xO7SFJhmkD4YhP9vI",
+	"BreYfhY6tGYiLXXoBcRyYTkVK6qnucQODfHZOiMuraPh77zlrbupWyFe0xaL9e27cr8IL2sveQ7ztXp6",
+	"vxTJevmpN8igIT79KYj0mM8HUWhXJGOHz0xDo409ACW8/BHKAA15x8BEppEKl/M/Bxk/QZ2f1mf1x1Fu",
+	"IcRqD5aRV4UYcjsewRdH/oM/01XZXNmQxvqAecQg8xjcVn4pCHwudCvx5Semy0tpSYGnfVKKuLEbeBcx",
+	"+AIx7V28Mt/yQ3x5JoxBYqEuvYnGxkw6cQhawUWlmEtjRblHf2+TCunFIMXfHHXQVGVvIxNvpNDMQ3+r",
+	"GrzHhMj7lYCOz+DzyTv04DeU6476Mfot8VHdnb/+0iSICi7QPT2bbRHZ5Fy9ms0G+Rk+P1zG59Vx27QZ",
+	"4yUvz6ITyThEVs4KqcQuhD8FIw668EINMs0KYWPNnMw70JD2TinYHOtTu+EnvbuidmyKutGj7aboP9RL",
+	"YXnOLf8ENjlQzkR/ZO8XTIZP4niSd9XBwb1HDIVcl/7UZ4P4aJqk1EKrcQZKerA6uqlkveFJirXc9ovN",
+	"0a6NPjVxIKRe4Q2B173iqtKs/4vPm6ouTyG+ZoOgKtA+GIerTQ8Seklhj97M+1lYZ7Py0U1bksJEKZ2m",
+	"tl6bQKeXll+/YM7juLrbN0KC865n3iiBVjBgG4XI49ASx1H2mnkMnlzQBSdVwIrnMqLcK3TGC2RwLs70",
+	"OrnauWispko5FzBkf8s96+TxdK5ni4JCLVIjMq1yhG7NpXWt56kPBRTnz/QyqoQy9pWICD+YQIuho+9G",
+	"SvtS7P5laDv/q+tDRp3rPUgNJLihDJPLpcglt6LYkF/KvwHZEwicWeiSPucqOtgUGWSq1QoeR0lXfgCw",
+	"ZlHd41OAcQ+ClPZWuihO2UxwW2GuHHq0fJgNGsD7+9lzaRup/0upoMbr6PHBeEidiGabOG/ar7tHuQdL",
+	"TSHbxwthBIZaKW3ZgmO7OMAqn3Opmm46fzS4YrCHuJ2qrpSP6k2lmBLvrbNDLS+PP1Noa66IO7fGBvqk",
+	"FUszwHwQfuBlyTc33Z8ULXYi7005dugU70VW2S1WpF86J6Muiv1rbf17cHD/+mrZOFbay4Bfi9K1r2DP",
+	"hJIij6rWpR1PlN7lRDueWXlOfgyB9Ocec6itJPIILW7ppZwvLFN67ZLL7t+uIFWfCoBSk5sStE2EjoIX",
+	"sRLbXAPs/sTQxXLJy8k5QXkYP8LGrlsDacobVsqoxFv6Mmim/6evBRiSolT+DImSbiV9x9HpAFIRiD7K",
+	"80q2PTdWovrzd+kPcK9BBHWMI6Yk39OK4sbjsfHYfBJ33UcKYW9ryyCsfAx9q2SGgaRWhwJIbFXqeSmM",
+	"GTPXBN63zIHe71UpdkpSXn4yQuUNNzeg24+OHRtFKXaflP0l3+zJvbLqjxF+yTfOZFipP0XBhJd881ch",
+	"Vm/c1fvnMkNQcpIT1+u6W5FmGKIaTHxBgRi0z86EWAW5KyQns1cIHBIzLJ5LZRh3HYRi3Sv4o5sx7lsJ",
+	"uaO5olEjgqwFkzR1xvR20taVXVV2z/UYzrdfCq/w5df+3c/icsCeIfu/r8T8snWwxu7blZp/qhJa9waW",
+	"0ELpzxWHgsJjVA/r7s0ftBdCze0iFKX9Cy7OVUbKZY5XEXJZzhwK9twnVBHNQXr/5iF9zTdYC8lqzQpe",
+	"zl3v87sPb8OZVitCL0EfZcfYURtwhSTGiKKiVm9uL31uTDPG7cG9W+qx5zZS0k2JrENrtgSD2AwOtms5",
+	"7sI27KLU1mLrMiOK2RcleVANMdKQjWWlyCjJLLQewvWSPBBVEpOInGrl4y9qh59QpipFSIhB6d3tMnx5",
+	"BzL/5sJY1N1ae8yehiQ3rNL4+pcfEc8/v37+o9epYdBVwZVqt5/bLfDYRbWcKi4Lsw8VyaRYe7YkS2q4",
+	"5Lk9I+7vxSDEKCTxEDevymL0eLQ/ioytbWbVSt4LuUBupYFSwnWAGUdd8wZ0Y3buAJTRwAAggfzinonN",
+	"Ts2TRrMCkxj0yetD5JsBqtgUrJfLSpG4idaoNuiTdhhDYgJHDVEm2pPXh+MQZtaorgGTolkDlwFnpdRF",
+	"3IupMRk617sTutJuYZaZDGXm4PA6DGJsNvyN3SVdTe14DldKrjt+KocVwE0UBWimTJrRxW8X//8AX/yY",
+	"ccQ/AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
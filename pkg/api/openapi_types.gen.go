@@ -10,6 +10,7 @@ import (
 )
 
 const (
+	User_authScopes   = "user_auth.Scopes"
 	Worker_authScopes = "worker_auth.Scopes"
 )
 
@@ -80,6 +81,8 @@ const (
 	JobStatusRequeueing JobStatus = "requeueing"
 
 	JobStatusUnderConstruction JobStatus = "under-construction"
+
+	JobStatusWaiting JobStatus = "waiting"
 )
 
 // Defines values for ManagerVariableAudience.
@@ -135,6 +138,13 @@ const (
 	TaskStatusSoftFailed TaskStatus = "soft-failed"
 )
 
+// Defines values for UserRole.
+const (
+	UserRoleAdmin UserRole = "admin"
+
+	UserRoleUser UserRole = "user"
+)
+
 // Defines values for WorkerStatus.
 const (
 	WorkerStatusAsleep WorkerStatus = "asleep"
@@ -163,6 +173,44 @@ type AssignedTask struct {
 	Uuid        string     `json:"uuid"`
 }
 
+// Job as it was produced by the job compiler script, before it is stored in the database.
+type AuthoredJob struct {
+	// IDs of the jobs that need to be completed before this job can start.
+	DependsOn []string `json:"depends_on"`
+
+	// Maximum number of Workers that can work on this job at the same time. Zero means there is no limit.
+	MaxWorkers int `json:"max_workers"`
+
+	// Arbitrary metadata strings. More complex structures can be modeled by using `a.b.c` notation for the key.
+	Metadata JobMetadata `json:"metadata"`
+	Name     string      `json:"name"`
+	Priority int         `json:"priority"`
+
+	// Resources that a Worker needs to offer in order to run a job. Only Workers that meet all requirements get tasks of the job.
+	Requirements *WorkerRequirements `json:"requirements,omitempty"`
+	Settings     JobSettings         `json:"settings"`
+
+	// Moment in time before which the job will not start.
+	StartAfter *time.Time     `json:"start_after,omitempty"`
+	Tasks      []AuthoredTask `json:"tasks"`
+	Type       string         `json:"type"`
+
+	// Worker tag that should execute this job, if any.
+	WorkerTag *string `json:"worker_tag,omitempty"`
+}
+
+// Task as it was produced by the job compiler script, before it is stored in the database.
+type AuthoredTask struct {
+	Commands []Command `json:"commands"`
+
+	// UUIDs of the tasks that need to be completed before this one can run.
+	Dependencies []string `json:"dependencies"`
+	Name         string   `json:"name"`
+	Priority     int      `json:"priority"`
+	Type         string   `json:"type"`
+	Uuid         string   `json:"uuid"`
+}
+
 // Single setting of a Job types.
 type AvailableJobSetting struct {
 	// When given, limit the valid values to these choices. Only usable with string type.
@@ -183,6 +231,9 @@ type AvailableJobSetting struct {
 	// Identifier for the setting, must be unique within the job type.
 	Key string `json:"key"`
 
+	// Whether this setting can be changed after the job has been submitted, by editing the job. This recompiles the job, keeping its completed tasks.
+	Overridable *bool `json:"overridable,omitempty"`
+
 	// Any extra arguments to the bpy.props.SomeProperty() call used to create this property.
 	Propargs *map[string]interface{} `json:"propargs,omitempty"`
 
@@ -211,10 +262,13 @@ type AvailableJobSettingVisibility string
 // Job type supported by this Manager, and its parameters.
 type AvailableJobType struct {
 	// Hash of the job type. If the job settings or the label change, this etag will change. This is used on job submission to ensure that the submitted job settings are up to date.
-	Etag     string                `json:"etag"`
-	Label    string                `json:"label"`
-	Name     string                `json:"name"`
-	Settings []AvailableJobSetting `json:"settings"`
+	Etag  string `json:"etag"`
+	Label string `json:"label"`
+	Name  string `json:"name"`
+
+	// Resources that a Worker needs to offer in order to run a job. Only Workers that meet all requirements get tasks of the job.
+	Requirements *WorkerRequirements   `json:"requirements,omitempty"`
+	Settings     []AvailableJobSetting `json:"settings"`
 }
 
 // List of job types supported by this Manager.
@@ -250,6 +304,12 @@ type Command struct {
 	Parameters map[string]interface{} `json:"parameters"`
 }
 
+// DatabaseBackupResult defines model for DatabaseBackupResult.
+type DatabaseBackupResult struct {
+	// Path of the backup file, on the Manager.
+	Path string `json:"path"`
+}
+
 // Generic error response.
 type Error struct {
 	// HTTP status code of this response. Is included in the payload so that a single object represents all error information.
@@ -260,8 +320,10 @@ type Error struct {
 
 // FlamencoVersion defines model for FlamencoVersion.
 type FlamencoVersion struct {
-	Name    string `json:"name"`
-	Version string `json:"version"`
+	// Optional features supported by this Manager, so that clients can detect whether they can use them.
+	Features *[]string `json:"features,omitempty"`
+	Name     string    `json:"name"`
+	Version  string    `json:"version"`
 }
 
 // Job defines model for Job.
@@ -275,12 +337,21 @@ type Job struct {
 	// Creation timestamp
 	Created time.Time `json:"created"`
 
+	// Timestamp of when deletion of this job was requested. Only set when the job is queued for deletion.
+	DeleteRequestedAt *time.Time `json:"delete_requested_at,omitempty"`
+
 	// UUID of the Job
-	Id     string    `json:"id"`
-	Status JobStatus `json:"status"`
+	Id string `json:"id"`
+
+	// Percentage of the job that has been completed, aggregated from the progress of its tasks.
+	Progress *int      `json:"progress,omitempty"`
+	Status   JobStatus `json:"status"`
 
 	// Timestamp of last update.
 	Updated time.Time `json:"updated"`
+
+	// User account.
+	User *User `json:"user,omitempty"`
 }
 
 // List of workers that are not allowed certain task types on a specific job.
@@ -293,17 +364,76 @@ type JobBlocklistEntry struct {
 	WorkerName *string `json:"worker_name,omitempty"`
 }
 
+// JobEdit defines model for JobEdit.
+type JobEdit struct {
+	Settings JobSettings `json:"settings"`
+}
+
+// JobHistory defines model for JobHistory.
+type JobHistory struct {
+	Entries []JobHistoryEntry `json:"entries"`
+}
+
+// Record of a job edit.
+type JobHistoryEntry struct {
+	PreviousSettings JobSettings `json:"previous_settings"`
+
+	// When the job was edited.
+	Timestamp time.Time `json:"timestamp"`
+}
+
 // Enough information for a client to piece together different strings to form a host-relative URL to the last-rendered image. To construct the URL, concatenate "{base}/{one of the suffixes}".
 type JobLastRenderedImageInfo struct {
 	Base     string   `json:"base"`
 	Suffixes []string `json:"suffixes"`
 }
 
+// JobMassDeletionResult defines model for JobMassDeletionResult.
+type JobMassDeletionResult struct {
+	// IDs of the jobs that are queued for deletion.
+	JobIds []string `json:"job_ids"`
+}
+
+// Parameters to determine which jobs to delete.
+type JobMassDeletionSelection struct {
+	// Finished jobs that were last updated before this timestamp will be deleted.
+	FinishedBefore time.Time `json:"finished_before"`
+}
+
+// JobMaxWorkersChange defines model for JobMaxWorkersChange.
+type JobMaxWorkersChange struct {
+	// Maximum number of Workers that can work on the job at the same time. Zero means there is no limit.
+	MaxWorkers int `json:"max_workers"`
+}
+
 // Arbitrary metadata strings. More complex structures can be modeled by using `a.b.c` notation for the key.
 type JobMetadata struct {
 	AdditionalProperties map[string]string `json:"-"`
 }
 
+// JobPriorityChange defines model for JobPriorityChange.
+type JobPriorityChange struct {
+	Priority int `json:"priority"`
+}
+
+// Error in a job compiler script.
+type JobScriptError struct {
+	// HTTP status code of this response.
+	Code int32 `json:"code"`
+
+	// Column number of the error, or 0 if unknown.
+	Column int `json:"column"`
+
+	// Line number of the error, or 0 if unknown.
+	Line int `json:"line"`
+
+	// The JavaScript error message.
+	Message string `json:"message"`
+
+	// Filename of the script that produced the error.
+	Script string `json:"script"`
+}
+
 // JobSettings defines model for JobSettings.
 type JobSettings struct {
 	AdditionalProperties map[string]interface{} `json:"-"`
@@ -324,6 +454,18 @@ type JobTasksSummary struct {
 	Tasks *[]TaskSummary `json:"tasks,omitempty"`
 }
 
+// Sample job to compile with a job type.
+type JobTypeValidation struct {
+	// Arbitrary metadata strings. More complex structures can be modeled by using `a.b.c` notation for the key.
+	Metadata *JobMetadata `json:"metadata,omitempty"`
+	Settings JobSettings  `json:"settings"`
+}
+
+// JobTypeValidationResult defines model for JobTypeValidationResult.
+type JobTypeValidationResult struct {
+	Tasks []AuthoredTask `json:"tasks"`
+}
+
 // JobsQuery defines model for JobsQuery.
 type JobsQuery struct {
 	Limit *int `json:"limit,omitempty"`
@@ -391,6 +533,15 @@ type MayKeepRunning struct {
 	StatusChangeRequested bool `json:"statusChangeRequested"`
 }
 
+// User account to create.
+type NewUser struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+
+	// Users can submit jobs, and manage the jobs they submitted. Admins can manage all jobs, the Workers, and the user accounts.
+	Role UserRole `json:"role"`
+}
+
 // PathCheckInput defines model for PathCheckInput.
 type PathCheckInput struct {
 	Path string `json:"path"`
@@ -498,6 +649,9 @@ type SocketIOJobUpdate struct {
 	PreviousStatus *JobStatus `json:"previous_status,omitempty"`
 	Priority       int        `json:"priority"`
 
+	// Percentage of the job that has been completed. Only included when the job's progress may have changed.
+	Progress *int `json:"progress,omitempty"`
+
 	// Indicates that the client should refresh all the job's tasks. This is sent for mass updates, where updating each individual task would generate too many updates to be practical.
 	RefreshTasks bool      `json:"refresh_tasks"`
 	Status       JobStatus `json:"status"`
@@ -505,6 +659,9 @@ type SocketIOJobUpdate struct {
 
 	// Timestamp of last update
 	Updated time.Time `json:"updated"`
+
+	// Indicates that the job has been deleted from the Manager. The other fields describe the job as it was just before deletion.
+	WasDeleted *bool `json:"was_deleted,omitempty"`
 }
 
 // Indicator that the last-rendered image of this job was updated.
@@ -552,7 +709,10 @@ type SocketIOTaskUpdate struct {
 	// Name of the task
 	Name           string      `json:"name"`
 	PreviousStatus *TaskStatus `json:"previous_status,omitempty"`
-	Status         TaskStatus  `json:"status"`
+
+	// Percentage of the task that has been completed.
+	Progress *int       `json:"progress,omitempty"`
+	Status   TaskStatus `json:"status"`
 
 	// Timestamp of last update
 	Updated time.Time `json:"updated"`
@@ -581,12 +741,21 @@ type SocketIOWorkerUpdate struct {
 
 // Job definition submitted to Flamenco.
 type SubmittedJob struct {
+	// IDs of the jobs that need to be completed before this job can start. Until then, the job will be in status `waiting`. When any of these jobs fails or is canceled, this job will fail as well.
+	DependsOn *[]string `json:"depends_on,omitempty"`
+
+	// Maximum number of Workers that can work on this job at the same time. Zero or ommitted means there is no limit.
+	MaxWorkers *int `json:"max_workers,omitempty"`
+
 	// Arbitrary metadata strings. More complex structures can be modeled by using `a.b.c` notation for the key.
 	Metadata *JobMetadata `json:"metadata,omitempty"`
 	Name     string       `json:"name"`
 	Priority int          `json:"priority"`
 	Settings *JobSettings `json:"settings,omitempty"`
 
+	// When given, no tasks of this job will be handed out to Workers before this moment in time. If ommitted, the job can start immediately.
+	StartAfter *time.Time `json:"start_after,omitempty"`
+
 	// Operating system of the submitter. This is used to recognise two-way variables. This should be a lower-case version of the platform, like "linux", "windows", "darwin", "openbsd", etc. Should be ompatible with Go's `runtime.GOOS`; run `go tool dist list` to get a list of possible platforms.
 	// As a special case, the platform "manager" can be given, which will be interpreted as "the Manager's platform". This is mostly to make test/debug scripts easier, as they can use a static document on all platforms.
 	SubmitterPlatform string `json:"submitter_platform"`
@@ -595,6 +764,9 @@ type SubmittedJob struct {
 	// Hash of the job type, copied from the `AvailableJobType.etag` property of the job type. The job will be rejected if this field doesn't match the actual job type on the Manager. This prevents job submission with old settings, after the job compiler script has been updated.
 	// If this field is ommitted, the check is bypassed.
 	TypeEtag *string `json:"type_etag,omitempty"`
+
+	// Worker tag that should execute this job. When a tag ID is given, only Workers in that tag will be scheduled to work on it. If empty or ommitted, all workers can work on this job.
+	WorkerTag *string `json:"worker_tag,omitempty"`
 }
 
 // The task as it exists in the Manager database, i.e. before variable replacement.
@@ -612,8 +784,11 @@ type Task struct {
 	LastTouched *time.Time `json:"last_touched,omitempty"`
 	Name        string     `json:"name"`
 	Priority    int        `json:"priority"`
-	Status      TaskStatus `json:"status"`
-	TaskType    string     `json:"task_type"`
+
+	// Percentage of the task that has been completed.
+	Progress *int       `json:"progress,omitempty"`
+	Status   TaskStatus `json:"status"`
+	TaskType string     `json:"task_type"`
 
 	// Timestamp of last update.
 	Updated time.Time `json:"updated"`
@@ -649,6 +824,7 @@ type TaskSummary struct {
 	Id       string     `json:"id"`
 	Name     string     `json:"name"`
 	Priority int        `json:"priority"`
+	Progress *int       `json:"progress,omitempty"`
 	Status   TaskStatus `json:"status"`
 	TaskType string     `json:"task_type"`
 	Updated  time.Time  `json:"updated"`
@@ -660,7 +836,10 @@ type TaskUpdate struct {
 	Activity *string `json:"activity,omitempty"`
 
 	// Log lines for this task, will be appended to logs sent earlier.
-	Log        *string     `json:"log,omitempty"`
+	Log *string `json:"log,omitempty"`
+
+	// Percentage of the task that has been completed.
+	Progress   *int        `json:"progress,omitempty"`
 	TaskStatus *TaskStatus `json:"taskStatus,omitempty"`
 }
 
@@ -671,6 +850,41 @@ type TaskWorker struct {
 	Name    string `json:"name"`
 }
 
+// User account.
+type User struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+
+	// Users can submit jobs, and manage the jobs they submitted. Admins can manage all jobs, the Workers, and the user accounts.
+	Role UserRole `json:"role"`
+}
+
+// UserList defines model for UserList.
+type UserList struct {
+	Users []User `json:"users"`
+}
+
+// UserLoginRequest defines model for UserLoginRequest.
+type UserLoginRequest struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+// UserLoginResponse defines model for UserLoginResponse.
+type UserLoginResponse struct {
+	// Moment in time the token expires.
+	Expires time.Time `json:"expires"`
+
+	// Bearer token to authenticate subsequent requests.
+	Token string `json:"token"`
+
+	// User account.
+	User User `json:"user"`
+}
+
+// Users can submit jobs, and manage the jobs they submitted. Admins can manage all jobs, the Workers, and the user accounts.
+type UserRole string
+
 // Worker defines model for Worker.
 type Worker struct {
 	// Embedded struct due to allOf(#/components/schemas/WorkerSummary)
@@ -680,8 +894,12 @@ type Worker struct {
 	IpAddress string `json:"ip_address"`
 
 	// Operating system of the Worker
-	Platform           string   `json:"platform"`
-	SupportedTaskTypes []string `json:"supported_task_types"`
+	Platform string `json:"platform"`
+
+	// Hardware and software resources that a Worker offers.
+	Resources          *WorkerResources `json:"resources,omitempty"`
+	SupportedTaskTypes []string         `json:"supported_task_types"`
+	Tags               *[]WorkerTag     `json:"tags,omitempty"`
 
 	// Task assigned to a Worker.
 	Task *WorkerTask `json:"task,omitempty"`
@@ -700,11 +918,56 @@ type WorkerRegistration struct {
 	SupportedTaskTypes []string `json:"supported_task_types"`
 }
 
+// Resources that a Worker needs to offer in order to run a job. Only Workers that meet all requirements get tasks of the job.
+type WorkerRequirements struct {
+	// Capabilities the Worker needs to have. The values have to match exactly.
+	Capabilities *WorkerRequirements_Capabilities `json:"capabilities,omitempty"`
+
+	// Minimum number of CPU cores.
+	CpuCores *int `json:"cpu_cores,omitempty"`
+
+	// Minimum amount of RAM, in megabytes.
+	MemoryMb *int `json:"memory_mb,omitempty"`
+}
+
+// Capabilities the Worker needs to have. The values have to match exactly.
+type WorkerRequirements_Capabilities struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Hardware and software resources that a Worker offers.
+type WorkerResources struct {
+	// Arbitrary capabilities of the Worker, such as `blender_version: "3.3"`. These are matched with the capabilities that jobs require.
+	Capabilities *WorkerResources_Capabilities `json:"capabilities,omitempty"`
+
+	// Number of CPU cores. Zero means unknown.
+	CpuCores *int `json:"cpu_cores,omitempty"`
+
+	// Amount of RAM, in megabytes. Zero means unknown.
+	MemoryMb *int `json:"memory_mb,omitempty"`
+}
+
+// Arbitrary capabilities of the Worker, such as `blender_version: "3.3"`. These are matched with the capabilities that jobs require.
+type WorkerResources_Capabilities struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
 // WorkerSignOn defines model for WorkerSignOn.
 type WorkerSignOn struct {
-	Name               string   `json:"name"`
-	SoftwareVersion    string   `json:"software_version"`
-	SupportedTaskTypes []string `json:"supported_task_types"`
+	Name string `json:"name"`
+
+	// Hardware and software resources that a Worker offers.
+	Resources          *WorkerResources `json:"resources,omitempty"`
+	SoftwareVersion    string           `json:"software_version"`
+	SupportedTaskTypes []string         `json:"supported_task_types"`
+
+	// Number of tasks of each task type that the Worker can run at the same time. Task types that are not mentioned get one slot each. Without this, the Worker runs one task at a time.
+	TaskSlots *WorkerSignOn_TaskSlots `json:"task_slots,omitempty"`
+}
+
+// Number of tasks of each task type that the Worker can run at the same time. Task types that are not mentioned get one slot each. Without this, the Worker runs one task at a time.
+type WorkerSignOn_TaskSlots struct {
+	AdditionalProperties map[string]int `json:"-"`
 }
 
 // Sleep schedule for a single Worker. Start and end time indicate the time of each day at which the schedule is active. Applies only when today is in `days_of_week`, or when `days_of_week` is empty.
@@ -753,6 +1016,25 @@ type WorkerSummary struct {
 	Version string `json:"version"`
 }
 
+// Tag of workers. A job can optionally specify which tag it should be limited to. Workers can be part of multiple tags simultaneously.
+type WorkerTag struct {
+	Description *string `json:"description,omitempty"`
+
+	// UUID of the tag. Can be ommitted when creating a new tag, in which case a random UUID will be assigned.
+	Id   *string `json:"id,omitempty"`
+	Name string  `json:"name"`
+}
+
+// Request to change which tags this Worker is assigned to.
+type WorkerTagChangeRequest struct {
+	TagIds []string `json:"tag_ids"`
+}
+
+// WorkerTagList defines model for WorkerTagList.
+type WorkerTagList struct {
+	Tags []WorkerTag `json:"tags"`
+}
+
 // WorkerTask defines model for WorkerTask.
 type WorkerTask struct {
 	// Embedded struct due to allOf(#/components/schemas/TaskSummary)
@@ -773,12 +1055,36 @@ type SaveSetupAssistantConfigJSONBody SetupAssistantConfig
 // SubmitJobJSONBody defines parameters for SubmitJob.
 type SubmitJobJSONBody SubmittedJob
 
+// SubmitJobDryRunJSONBody defines parameters for SubmitJobDryRun.
+type SubmitJobDryRunJSONBody SubmittedJob
+
+// SubmitJobDryRunParams defines parameters for SubmitJobDryRun.
+type SubmitJobDryRunParams struct {
+	// When given, variables in the task commands are replaced as they would be for a Worker running on this platform, like "linux", "windows", or "darwin". Otherwise the commands are returned as stored in the database, with only two-way variables replaced.
+	WorkerPlatform *string `json:"worker_platform,omitempty"`
+}
+
+// DeleteJobMassJSONBody defines parameters for DeleteJobMass.
+type DeleteJobMassJSONBody JobMassDeletionSelection
+
 // QueryJobsJSONBody defines parameters for QueryJobs.
 type QueryJobsJSONBody JobsQuery
 
+// ValidateJobTypeJSONBody defines parameters for ValidateJobType.
+type ValidateJobTypeJSONBody JobTypeValidation
+
 // RemoveJobBlocklistJSONBody defines parameters for RemoveJobBlocklist.
 type RemoveJobBlocklistJSONBody JobBlocklist
 
+// EditJobJSONBody defines parameters for EditJob.
+type EditJobJSONBody JobEdit
+
+// SetJobMaxWorkersJSONBody defines parameters for SetJobMaxWorkers.
+type SetJobMaxWorkersJSONBody JobMaxWorkersChange
+
+// SetJobPriorityJSONBody defines parameters for SetJobPriority.
+type SetJobPriorityJSONBody JobPriorityChange
+
 // SetJobStatusJSONBody defines parameters for SetJobStatus.
 type SetJobStatusJSONBody JobStatusChange
 
@@ -800,9 +1106,24 @@ type ShamanFileStoreParams struct {
 // SetTaskStatusJSONBody defines parameters for SetTaskStatus.
 type SetTaskStatusJSONBody TaskStatusChange
 
+// CreateUserJSONBody defines parameters for CreateUser.
+type CreateUserJSONBody NewUser
+
+// LoginUserJSONBody defines parameters for LoginUser.
+type LoginUserJSONBody UserLoginRequest
+
+// UpdateWorkerTagJSONBody defines parameters for UpdateWorkerTag.
+type UpdateWorkerTagJSONBody WorkerTag
+
+// CreateWorkerTagJSONBody defines parameters for CreateWorkerTag.
+type CreateWorkerTagJSONBody WorkerTag
+
 // RequestWorkerStatusChangeJSONBody defines parameters for RequestWorkerStatusChange.
 type RequestWorkerStatusChangeJSONBody WorkerStatusChangeRequest
 
+// SetWorkerTagsJSONBody defines parameters for SetWorkerTags.
+type SetWorkerTagsJSONBody WorkerTagChangeRequest
+
 // SetWorkerSleepScheduleJSONBody defines parameters for SetWorkerSleepSchedule.
 type SetWorkerSleepScheduleJSONBody WorkerSleepSchedule
 
@@ -815,6 +1136,15 @@ type SignOnJSONBody WorkerSignOn
 // WorkerStateChangedJSONBody defines parameters for WorkerStateChanged.
 type WorkerStateChangedJSONBody WorkerStateChanged
 
+// ScheduleTaskParams defines parameters for ScheduleTask.
+type ScheduleTaskParams struct {
+	// Number of seconds to wait for a task to become available, before responding with "no tasks available". Without this parameter the Manager responds immediately. The Manager may wait shorter than requested. Only supported when the Manager lists the `task-long-poll` feature in its version info.
+	Wait *int `json:"wait,omitempty"`
+
+	// Tasks that the Worker is running at the moment. These are not handed out again, so that the Worker can ask for another task to run next to them. Only supported when the Manager lists the `task-slots` feature in its version info.
+	Running *[]string `json:"running,omitempty"`
+}
+
 // TaskUpdateJSONBody defines parameters for TaskUpdate.
 type TaskUpdateJSONBody TaskUpdate
 
@@ -830,12 +1160,30 @@ type SaveSetupAssistantConfigJSONRequestBody SaveSetupAssistantConfigJSONBody
 // SubmitJobJSONRequestBody defines body for SubmitJob for application/json ContentType.
 type SubmitJobJSONRequestBody SubmitJobJSONBody
 
+// SubmitJobDryRunJSONRequestBody defines body for SubmitJobDryRun for application/json ContentType.
+type SubmitJobDryRunJSONRequestBody SubmitJobDryRunJSONBody
+
+// DeleteJobMassJSONRequestBody defines body for DeleteJobMass for application/json ContentType.
+type DeleteJobMassJSONRequestBody DeleteJobMassJSONBody
+
 // QueryJobsJSONRequestBody defines body for QueryJobs for application/json ContentType.
 type QueryJobsJSONRequestBody QueryJobsJSONBody
 
+// ValidateJobTypeJSONRequestBody defines body for ValidateJobType for application/json ContentType.
+type ValidateJobTypeJSONRequestBody ValidateJobTypeJSONBody
+
 // RemoveJobBlocklistJSONRequestBody defines body for RemoveJobBlocklist for application/json ContentType.
 type RemoveJobBlocklistJSONRequestBody RemoveJobBlocklistJSONBody
 
+// EditJobJSONRequestBody defines body for EditJob for application/json ContentType.
+type EditJobJSONRequestBody EditJobJSONBody
+
+// SetJobMaxWorkersJSONRequestBody defines body for SetJobMaxWorkers for application/json ContentType.
+type SetJobMaxWorkersJSONRequestBody SetJobMaxWorkersJSONBody
+
+// SetJobPriorityJSONRequestBody defines body for SetJobPriority for application/json ContentType.
+type SetJobPriorityJSONRequestBody SetJobPriorityJSONBody
+
 // SetJobStatusJSONRequestBody defines body for SetJobStatus for application/json ContentType.
 type SetJobStatusJSONRequestBody SetJobStatusJSONBody
 
@@ -848,9 +1196,24 @@ type ShamanCheckoutRequirementsJSONRequestBody ShamanCheckoutRequirementsJSONBod
 // SetTaskStatusJSONRequestBody defines body for SetTaskStatus for application/json ContentType.
 type SetTaskStatusJSONRequestBody SetTaskStatusJSONBody
 
+// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
+type CreateUserJSONRequestBody CreateUserJSONBody
+
+// LoginUserJSONRequestBody defines body for LoginUser for application/json ContentType.
+type LoginUserJSONRequestBody LoginUserJSONBody
+
+// UpdateWorkerTagJSONRequestBody defines body for UpdateWorkerTag for application/json ContentType.
+type UpdateWorkerTagJSONRequestBody UpdateWorkerTagJSONBody
+
+// CreateWorkerTagJSONRequestBody defines body for CreateWorkerTag for application/json ContentType.
+type CreateWorkerTagJSONRequestBody CreateWorkerTagJSONBody
+
 // RequestWorkerStatusChangeJSONRequestBody defines body for RequestWorkerStatusChange for application/json ContentType.
 type RequestWorkerStatusChangeJSONRequestBody RequestWorkerStatusChangeJSONBody
 
+// SetWorkerTagsJSONRequestBody defines body for SetWorkerTags for application/json ContentType.
+type SetWorkerTagsJSONRequestBody SetWorkerTagsJSONBody
+
 // SetWorkerSleepScheduleJSONRequestBody defines body for SetWorkerSleepSchedule for application/json ContentType.
 type SetWorkerSleepScheduleJSONRequestBody SetWorkerSleepScheduleJSONBody
 
@@ -1130,3 +1493,162 @@ func (a ManagerVariables) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(object)
 }
+
+// Getter for additional properties for WorkerRequirements_Capabilities. Returns the specified
+// element and whether it was found
+func (a WorkerRequirements_Capabilities) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for WorkerRequirements_Capabilities
+func (a *WorkerRequirements_Capabilities) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for WorkerRequirements_Capabilities to handle AdditionalProperties
+func (a *WorkerRequirements_Capabilities) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for WorkerRequirements_Capabilities to handle AdditionalProperties
+func (a WorkerRequirements_Capabilities) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for WorkerResources_Capabilities. Returns the specified
+// element and whether it was found
+func (a WorkerResources_Capabilities) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for WorkerResources_Capabilities
+func (a *WorkerResources_Capabilities) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for WorkerResources_Capabilities to handle AdditionalProperties
+func (a *WorkerResources_Capabilities) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for WorkerResources_Capabilities to handle AdditionalProperties
+func (a WorkerResources_Capabilities) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for WorkerSignOn_TaskSlots. Returns the specified
+// element and whether it was found
+func (a WorkerSignOn_TaskSlots) Get(fieldName string) (value int, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for WorkerSignOn_TaskSlots
+func (a *WorkerSignOn_TaskSlots) Set(fieldName string, value int) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]int)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for WorkerSignOn_TaskSlots to handle AdditionalProperties
+func (a *WorkerSignOn_TaskSlots) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]int)
+		for fieldName, fieldBuf := range object {
+			var fieldVal int
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for WorkerSignOn_TaskSlots to handle AdditionalProperties
+func (a WorkerSignOn_TaskSlots) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
@@ -193,3 +193,25 @@ func (s *Store) RemoveStoredFile(filePath string) error {
 	}
 	return s.removeFile(filePath)
 }
+
+// StoredSize returns the total size in bytes of the files in the 'stored'
+// storage bin. This walks the entire storage directory, so it can be slow on
+// large file stores.
+func (s *Store) StoredSize() (int64, error) {
+	var totalSize int64
+	err := filepath.WalkDir(s.StoragePath(), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		totalSize += info.Size()
+		return nil
+	})
+	return totalSize, err
+}
@@ -150,3 +150,27 @@ func TestMoveToStored(t *testing.T) {
 	assert.NoDirExists(t, filepath.Dir(tempLocation))
 	assert.NoDirExists(t, filepath.Dir(filepath.Dir(tempLocation)))
 }
+
+func TestStoredSize(t *testing.T) {
+	store := CreateTestStore()
+	defer CleanupTestStore(store)
+
+	size, err := store.StoredSize()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), size)
+
+	store.MustStoreFileForTest("abcdefxxx", 4, []byte("1234"))
+	store.MustStoreFileForTest("feedbeef", 3, []byte("123"))
+
+	// Files that are still being uploaded should not be counted.
+	file, err := store.OpenForUpload("ffffffff", 5)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	_, _ = file.Write([]byte("12345"))
+	file.Close()
+
+	size, err = store.StoredSize()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), size)
+}
@@ -153,3 +153,8 @@ func (s *Server) FileStore(ctx context.Context, file io.ReadCloser, checksum str
 	// the caller without relying on types declared in the `fileserver` package?
 	return err
 }
+
+// FileStoreSize returns the total size in bytes of the files in the file store.
+func (s *Server) FileStoreSize() (int64, error) {
+	return s.fileStore.StoredSize()
+}
@@ -46,7 +46,8 @@ class ApiClient {
          * @type {Array.<String>}
          */
         this.authentications = {
-            'worker_auth': {type: 'basic'}
+            'worker_auth': {type: 'basic'},
+            'user_auth': {type: 'bearer'}
         }
 
         /**
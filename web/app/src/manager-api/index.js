@@ -14,6 +14,8 @@
 
 import ApiClient from './ApiClient';
 import AssignedTask from './model/AssignedTask';
+import AuthoredJob from './model/AuthoredJob';
+import AuthoredTask from './model/AuthoredTask';
 import AvailableJobSetting from './model/AvailableJobSetting';
 import AvailableJobSettingSubtype from './model/AvailableJobSettingSubtype';
 import AvailableJobSettingType from './model/AvailableJobSettingType';
@@ -23,21 +25,33 @@ import AvailableJobTypes from './model/AvailableJobTypes';
 import BlenderPathCheckResult from './model/BlenderPathCheckResult';
 import BlenderPathSource from './model/BlenderPathSource';
 import Command from './model/Command';
+import DatabaseBackupResult from './model/DatabaseBackupResult';
 import Error from './model/Error';
 import FlamencoVersion from './model/FlamencoVersion';
 import Job from './model/Job';
 import JobAllOf from './model/JobAllOf';
 import JobBlocklistEntry from './model/JobBlocklistEntry';
+import JobEdit from './model/JobEdit';
+import JobHistory from './model/JobHistory';
+import JobHistoryEntry from './model/JobHistoryEntry';
 import JobLastRenderedImageInfo from './model/JobLastRenderedImageInfo';
+import JobMassDeletionResult from './model/JobMassDeletionResult';
+import JobMassDeletionSelection from './model/JobMassDeletionSelection';
+import JobMaxWorkersChange from './model/JobMaxWorkersChange';
+import JobPriorityChange from './model/JobPriorityChange';
+import JobScriptError from './model/JobScriptError';
 import JobStatus from './model/JobStatus';
 import JobStatusChange from './model/JobStatusChange';
 import JobTasksSummary from './model/JobTasksSummary';
+import JobTypeValidation from './model/JobTypeValidation';
+import JobTypeValidationResult from './model/JobTypeValidationResult';
 import JobsQuery from './model/JobsQuery';
 import JobsQueryResult from './model/JobsQueryResult';
 import ManagerConfiguration from './model/ManagerConfiguration';
 import ManagerVariable from './model/ManagerVariable';
 import ManagerVariableAudience from './model/ManagerVariableAudience';
 import MayKeepRunning from './model/MayKeepRunning';
+import NewUser from './model/NewUser';
 import PathCheckInput from './model/PathCheckInput';
 import PathCheckResult from './model/PathCheckResult';
 import RegisteredWorker from './model/RegisteredWorker';
@@ -67,10 +81,17 @@ import TaskStatusChange from './model/TaskStatusChange';
 import TaskSummary from './model/TaskSummary';
 import TaskUpdate from './model/TaskUpdate';
 import TaskWorker from './model/TaskWorker';
+import User from './model/User';
+import UserList from './model/UserList';
+import UserLoginRequest from './model/UserLoginRequest';
+import UserLoginResponse from './model/UserLoginResponse';
+import UserRole from './model/UserRole';
 import Worker from './model/Worker';
 import WorkerAllOf from './model/WorkerAllOf';
 import WorkerList from './model/WorkerList';
 import WorkerRegistration from './model/WorkerRegistration';
+import WorkerRequirements from './model/WorkerRequirements';
+import WorkerResources from './model/WorkerResources';
 import WorkerSignOn from './model/WorkerSignOn';
 import WorkerSleepSchedule from './model/WorkerSleepSchedule';
 import WorkerStateChange from './model/WorkerStateChange';
@@ -78,11 +99,15 @@ import WorkerStateChanged from './model/WorkerStateChanged';
 import WorkerStatus from './model/WorkerStatus';
 import WorkerStatusChangeRequest from './model/WorkerStatusChangeRequest';
 import WorkerSummary from './model/WorkerSummary';
+import WorkerTag from './model/WorkerTag';
+import WorkerTagChangeRequest from './model/WorkerTagChangeRequest';
+import WorkerTagList from './model/WorkerTagList';
 import WorkerTask from './model/WorkerTask';
 import WorkerTaskAllOf from './model/WorkerTaskAllOf';
 import JobsApi from './manager/JobsApi';
 import MetaApi from './manager/MetaApi';
 import ShamanApi from './manager/ShamanApi';
+import UsersApi from './manager/UsersApi';
 import WorkerApi from './manager/WorkerApi';
 import WorkerMgtApi from './manager/WorkerMgtApi';
 
@@ -131,6 +156,18 @@ export {
      */
     AssignedTask,
 
+    /**
+     * The AuthoredJob model constructor.
+     * @property {module:model/AuthoredJob}
+     */
+    AuthoredJob,
+
+    /**
+     * The AuthoredTask model constructor.
+     * @property {module:model/AuthoredTask}
+     */
+    AuthoredTask,
+
     /**
      * The AvailableJobSetting model constructor.
      * @property {module:model/AvailableJobSetting}
@@ -185,6 +222,12 @@ export {
      */
     Command,
 
+    /**
+     * The DatabaseBackupResult model constructor.
+     * @property {module:model/DatabaseBackupResult}
+     */
+    DatabaseBackupResult,
+
     /**
      * The Error model constructor.
      * @property {module:model/Error}
@@ -215,12 +258,60 @@ export {
      */
     JobBlocklistEntry,
 
+    /**
+     * The JobEdit model constructor.
+     * @property {module:model/JobEdit}
+     */
+    JobEdit,
+
+    /**
+     * The JobHistory model constructor.
+     * @property {module:model/JobHistory}
+     */
+    JobHistory,
+
+    /**
+     * The JobHistoryEntry model constructor.
+     * @property {module:model/JobHistoryEntry}
+     */
+    JobHistoryEntry,
+
     /**
      * The JobLastRenderedImageInfo model constructor.
      * @property {module:model/JobLastRenderedImageInfo}
      */
     JobLastRenderedImageInfo,
 
+    /**
+     * The JobMassDeletionResult model constructor.
+     * @property {module:model/JobMassDeletionResult}
+     */
+    JobMassDeletionResult,
+
+    /**
+     * The JobMassDeletionSelection model constructor.
+     * @property {module:model/JobMassDeletionSelection}
+     */
+    JobMassDeletionSelection,
+
+    /**
+     * The JobMaxWorkersChange model constructor.
+     * @property {module:model/JobMaxWorkersChange}
+     */
+    JobMaxWorkersChange,
+
+    /**
+     * The JobPriorityChange model constructor.
+     * @property {module:model/JobPriorityChange}
+     */
+    JobPriorityChange,
+
+    /**
+     * The JobScriptError model constructor.
+     * @property {module:model/JobScriptError}
+     */
+    JobScriptError,
+
     /**
      * The JobStatus model constructor.
      * @property {module:model/JobStatus}
@@ -239,6 +330,18 @@ export {
      */
     JobTasksSummary,
 
+    /**
+     * The JobTypeValidation model constructor.
+     * @property {module:model/JobTypeValidation}
+     */
+    JobTypeValidation,
+
+    /**
+     * The JobTypeValidationResult model constructor.
+     * @property {module:model/JobTypeValidationResult}
+     */
+    JobTypeValidationResult,
+
     /**
      * The JobsQuery model constructor.
      * @property {module:model/JobsQuery}
@@ -275,6 +378,12 @@ export {
      */
     MayKeepRunning,
 
+    /**
+     * The NewUser model constructor.
+     * @property {module:model/NewUser}
+     */
+    NewUser,
+
     /**
      * The PathCheckInput model constructor.
      * @property {module:model/PathCheckInput}
@@ -449,6 +558,36 @@ export {
      */
     TaskWorker,
 
+    /**
+     * The User model constructor.
+     * @property {module:model/User}
+     */
+    User,
+
+    /**
+     * The UserList model constructor.
+     * @property {module:model/UserList}
+     */
+    UserList,
+
+    /**
+     * The UserLoginRequest model constructor.
+     * @property {module:model/UserLoginRequest}
+     */
+    UserLoginRequest,
+
+    /**
+     * The UserLoginResponse model constructor.
+     * @property {module:model/UserLoginResponse}
+     */
+    UserLoginResponse,
+
+    /**
+     * The UserRole model constructor.
+     * @property {module:model/UserRole}
+     */
+    UserRole,
+
     /**
      * The Worker model constructor.
      * @property {module:model/Worker}
@@ -473,6 +612,18 @@ export {
      */
     WorkerRegistration,
 
+    /**
+     * The WorkerRequirements model constructor.
+     * @property {module:model/WorkerRequirements}
+     */
+    WorkerRequirements,
+
+    /**
+     * The WorkerResources model constructor.
+     * @property {module:model/WorkerResources}
+     */
+    WorkerResources,
+
     /**
      * The WorkerSignOn model constructor.
      * @property {module:model/WorkerSignOn}
@@ -515,6 +666,24 @@ export {
      */
     WorkerSummary,
 
+    /**
+     * The WorkerTag model constructor.
+     * @property {module:model/WorkerTag}
+     */
+    WorkerTag,
+
+    /**
+     * The WorkerTagChangeRequest model constructor.
+     * @property {module:model/WorkerTagChangeRequest}
+     */
+    WorkerTagChangeRequest,
+
+    /**
+     * The WorkerTagList model constructor.
+     * @property {module:model/WorkerTagList}
+     */
+    WorkerTagList,
+
     /**
      * The WorkerTask model constructor.
      * @property {module:model/WorkerTask}
@@ -545,6 +714,12 @@ export {
     */
     ShamanApi,
 
+    /**
+    * The UsersApi service constructor.
+    * @property {module:manager/UsersApi}
+    */
+    UsersApi,
+
     /**
     * The WorkerApi service constructor.
     * @property {module:manager/WorkerApi}
@@ -13,14 +13,24 @@
 
 
 import ApiClient from "../ApiClient";
+import AuthoredJob from '../model/AuthoredJob';
 import AvailableJobType from '../model/AvailableJobType';
 import AvailableJobTypes from '../model/AvailableJobTypes';
 import Error from '../model/Error';
 import Job from '../model/Job';
 import JobBlocklistEntry from '../model/JobBlocklistEntry';
+import JobEdit from '../model/JobEdit';
+import JobHistory from '../model/JobHistory';
 import JobLastRenderedImageInfo from '../model/JobLastRenderedImageInfo';
+import JobMassDeletionResult from '../model/JobMassDeletionResult';
+import JobMassDeletionSelection from '../model/JobMassDeletionSelection';
+import JobMaxWorkersChange from '../model/JobMaxWorkersChange';
+import JobPriorityChange from '../model/JobPriorityChange';
+import JobScriptError from '../model/JobScriptError';
 import JobStatusChange from '../model/JobStatusChange';
 import JobTasksSummary from '../model/JobTasksSummary';
+import JobTypeValidation from '../model/JobTypeValidation';
+import JobTypeValidationResult from '../model/JobTypeValidationResult';
 import JobsQuery from '../model/JobsQuery';
 import JobsQueryResult from '../model/JobsQueryResult';
 import SubmittedJob from '../model/SubmittedJob';
@@ -48,6 +58,149 @@ export default class JobsApi {
 
 
 
+    /**
+     * Request deletion of this job, including its tasks and any log files. The actual deletion happens in the background. Jobs that still have tasks running cannot be deleted. 
+     * @param {String} jobId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing HTTP response
+     */
+    deleteJobWithHttpInfo(jobId) {
+      let postBody = null;
+      // verify the required parameter 'jobId' is set
+      if (jobId === undefined || jobId === null) {
+        throw new Error("Missing the required parameter 'jobId' when calling deleteJob");
+      }
+
+      let pathParams = {
+        'job_id': jobId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = null;
+      return this.apiClient.callApi(
+        '/api/v3/jobs/{job_id}', 'DELETE',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Request deletion of this job, including its tasks and any log files. The actual deletion happens in the background. Jobs that still have tasks running cannot be deleted. 
+     * @param {String} jobId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}
+     */
+    deleteJob(jobId) {
+      return this.deleteJobWithHttpInfo(jobId)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+    /**
+     * Request deletion of all finished (completed, canceled, or failed) jobs that were last updated before the given timestamp. 
+     * @param {module:model/JobMassDeletionSelection} jobMassDeletionSelection Determines which jobs to delete.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/JobMassDeletionResult} and HTTP response
+     */
+    deleteJobMassWithHttpInfo(jobMassDeletionSelection) {
+      let postBody = jobMassDeletionSelection;
+      // verify the required parameter 'jobMassDeletionSelection' is set
+      if (jobMassDeletionSelection === undefined || jobMassDeletionSelection === null) {
+        throw new Error("Missing the required parameter 'jobMassDeletionSelection' when calling deleteJobMass");
+      }
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = ['application/json'];
+      let accepts = ['application/json'];
+      let returnType = JobMassDeletionResult;
+      return this.apiClient.callApi(
+        '/api/v3/jobs/mass-delete', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Request deletion of all finished (completed, canceled, or failed) jobs that were last updated before the given timestamp. 
+     * @param {module:model/JobMassDeletionSelection} jobMassDeletionSelection Determines which jobs to delete.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/JobMassDeletionResult}
+     */
+    deleteJobMass(jobMassDeletionSelection) {
+      return this.deleteJobMassWithHttpInfo(jobMassDeletionSelection)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+    /**
+     * Change settings of the job, and recompile it with those settings. Completed tasks are kept when the recompiled job has a task with the same name; all other tasks are replaced. Afterwards the job is queued. 
+     * @param {String} jobId 
+     * @param {module:model/JobEdit} jobEdit The settings to change.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing HTTP response
+     */
+    editJobWithHttpInfo(jobId, jobEdit) {
+      let postBody = jobEdit;
+      // verify the required parameter 'jobId' is set
+      if (jobId === undefined || jobId === null) {
+        throw new Error("Missing the required parameter 'jobId' when calling editJob");
+      }
+      // verify the required parameter 'jobEdit' is set
+      if (jobEdit === undefined || jobEdit === null) {
+        throw new Error("Missing the required parameter 'jobEdit' when calling editJob");
+      }
+
+      let pathParams = {
+        'job_id': jobId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = ['application/json'];
+      let accepts = ['application/json'];
+      let returnType = null;
+      return this.apiClient.callApi(
+        '/api/v3/jobs/{job_id}/edit', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Change settings of the job, and recompile it with those settings. Completed tasks are kept when the recompiled job has a task with the same name; all other tasks are replaced. Afterwards the job is queued. 
+     * @param {String} jobId 
+     * @param {module:model/JobEdit} jobEdit The settings to change.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}
+     */
+    editJob(jobId, jobEdit) {
+      return this.editJobWithHttpInfo(jobId, jobEdit)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * Get the URL that serves the last-rendered images.
      * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/JobLastRenderedImageInfo} and HTTP response
@@ -179,6 +332,52 @@ export default class JobsApi {
     }
 
 
+    /**
+     * Fetch the settings the job had before each time it was edited.
+     * @param {String} jobId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/JobHistory} and HTTP response
+     */
+    fetchJobHistoryWithHttpInfo(jobId) {
+      let postBody = null;
+      // verify the required parameter 'jobId' is set
+      if (jobId === undefined || jobId === null) {
+        throw new Error("Missing the required parameter 'jobId' when calling fetchJobHistory");
+      }
+
+      let pathParams = {
+        'job_id': jobId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = JobHistory;
+      return this.apiClient.callApi(
+        '/api/v3/jobs/{job_id}/history', 'GET',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Fetch the settings the job had before each time it was edited.
+     * @param {String} jobId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/JobHistory}
+     */
+    fetchJobHistory(jobId) {
+      return this.fetchJobHistoryWithHttpInfo(jobId)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * Get the URL that serves the last-rendered images of this job.
      * @param {String} jobId 
@@ -564,7 +763,7 @@ export default class JobsApi {
       let formParams = {
       };
 
-      let authNames = [];
+      let authNames = ['user_auth'];
       let contentTypes = ['application/json'];
       let accepts = ['application/json'];
       let returnType = null;
@@ -590,6 +789,106 @@ export default class JobsApi {
     }
 
 
+    /**
+     * @param {String} jobId 
+     * @param {module:model/JobMaxWorkersChange} jobMaxWorkersChange The new maximum number of Workers.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing HTTP response
+     */
+    setJobMaxWorkersWithHttpInfo(jobId, jobMaxWorkersChange) {
+      let postBody = jobMaxWorkersChange;
+      // verify the required parameter 'jobId' is set
+      if (jobId === undefined || jobId === null) {
+        throw new Error("Missing the required parameter 'jobId' when calling setJobMaxWorkers");
+      }
+      // verify the required parameter 'jobMaxWorkersChange' is set
+      if (jobMaxWorkersChange === undefined || jobMaxWorkersChange === null) {
+        throw new Error("Missing the required parameter 'jobMaxWorkersChange' when calling setJobMaxWorkers");
+      }
+
+      let pathParams = {
+        'job_id': jobId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = ['application/json'];
+      let accepts = ['application/json'];
+      let returnType = null;
+      return this.apiClient.callApi(
+        '/api/v3/jobs/{job_id}/setmaxworkers', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * @param {String} jobId 
+     * @param {module:model/JobMaxWorkersChange} jobMaxWorkersChange The new maximum number of Workers.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}
+     */
+    setJobMaxWorkers(jobId, jobMaxWorkersChange) {
+      return this.setJobMaxWorkersWithHttpInfo(jobId, jobMaxWorkersChange)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+    /**
+     * @param {String} jobId 
+     * @param {module:model/JobPriorityChange} jobPriorityChange The new priority.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing HTTP response
+     */
+    setJobPriorityWithHttpInfo(jobId, jobPriorityChange) {
+      let postBody = jobPriorityChange;
+      // verify the required parameter 'jobId' is set
+      if (jobId === undefined || jobId === null) {
+        throw new Error("Missing the required parameter 'jobId' when calling setJobPriority");
+      }
+      // verify the required parameter 'jobPriorityChange' is set
+      if (jobPriorityChange === undefined || jobPriorityChange === null) {
+        throw new Error("Missing the required parameter 'jobPriorityChange' when calling setJobPriority");
+      }
+
+      let pathParams = {
+        'job_id': jobId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = ['application/json'];
+      let accepts = ['application/json'];
+      let returnType = null;
+      return this.apiClient.callApi(
+        '/api/v3/jobs/{job_id}/setpriority', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * @param {String} jobId 
+     * @param {module:model/JobPriorityChange} jobPriorityChange The new priority.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}
+     */
+    setJobPriority(jobId, jobPriorityChange) {
+      return this.setJobPriorityWithHttpInfo(jobId, jobPriorityChange)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * @param {String} jobId 
      * @param {module:model/JobStatusChange} jobStatusChange The status change to request.
@@ -616,7 +915,7 @@ export default class JobsApi {
       let formParams = {
       };
 
-      let authNames = [];
+      let authNames = ['user_auth'];
       let contentTypes = ['application/json'];
       let accepts = ['application/json'];
       let returnType = null;
@@ -666,7 +965,7 @@ export default class JobsApi {
       let formParams = {
       };
 
-      let authNames = [];
+      let authNames = ['user_auth'];
       let contentTypes = ['application/json'];
       let accepts = ['application/json'];
       let returnType = null;
@@ -711,7 +1010,7 @@ export default class JobsApi {
       let formParams = {
       };
 
-      let authNames = [];
+      let authNames = ['user_auth'];
       let contentTypes = ['application/json'];
       let accepts = ['application/json'];
       let returnType = Job;
@@ -735,4 +1034,107 @@ export default class JobsApi {
     }
 
 
+    /**
+     * Compile a job like `submitJob` does, but without storing anything in the database. Returns the compiled job with all its tasks. 
+     * @param {module:model/SubmittedJob} submittedJob Job to compile
+     * @param {Object} opts Optional parameters
+     * @param {String} opts.workerPlatform When given, variables in the task commands are replaced as they would be for a Worker running on this platform, like \"linux\", \"windows\", or \"darwin\". Otherwise the commands are returned as stored in the database, with only two-way variables replaced. 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/AuthoredJob} and HTTP response
+     */
+    submitJobDryRunWithHttpInfo(submittedJob, opts) {
+      opts = opts || {};
+      let postBody = submittedJob;
+      // verify the required parameter 'submittedJob' is set
+      if (submittedJob === undefined || submittedJob === null) {
+        throw new Error("Missing the required parameter 'submittedJob' when calling submitJobDryRun");
+      }
+
+      let pathParams = {
+      };
+      let queryParams = {
+        'worker_platform': opts['workerPlatform']
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = ['application/json'];
+      let accepts = ['application/json'];
+      let returnType = AuthoredJob;
+      return this.apiClient.callApi(
+        '/api/v3/jobs/dry-run', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Compile a job like `submitJob` does, but without storing anything in the database. Returns the compiled job with all its tasks. 
+     * @param {module:model/SubmittedJob} submittedJob Job to compile
+     * @param {Object} opts Optional parameters
+     * @param {String} opts.workerPlatform When given, variables in the task commands are replaced as they would be for a Worker running on this platform, like \"linux\", \"windows\", or \"darwin\". Otherwise the commands are returned as stored in the database, with only two-way variables replaced. 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/AuthoredJob}
+     */
+    submitJobDryRun(submittedJob, opts) {
+      return this.submitJobDryRunWithHttpInfo(submittedJob, opts)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+    /**
+     * Compile a job of this type with the given settings, without storing anything. This returns the tasks that the job compiler script produces, and is intended for developing job types. 
+     * @param {String} typeName 
+     * @param {module:model/JobTypeValidation} jobTypeValidation Sample settings to compile the job with.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/JobTypeValidationResult} and HTTP response
+     */
+    validateJobTypeWithHttpInfo(typeName, jobTypeValidation) {
+      let postBody = jobTypeValidation;
+      // verify the required parameter 'typeName' is set
+      if (typeName === undefined || typeName === null) {
+        throw new Error("Missing the required parameter 'typeName' when calling validateJobType");
+      }
+      // verify the required parameter 'jobTypeValidation' is set
+      if (jobTypeValidation === undefined || jobTypeValidation === null) {
+        throw new Error("Missing the required parameter 'jobTypeValidation' when calling validateJobType");
+      }
+
+      let pathParams = {
+        'typeName': typeName
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = ['application/json'];
+      let accepts = ['application/json'];
+      let returnType = JobTypeValidationResult;
+      return this.apiClient.callApi(
+        '/api/v3/jobs/type/{typeName}/validate', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Compile a job of this type with the given settings, without storing anything. This returns the tasks that the job compiler script produces, and is intended for developing job types. 
+     * @param {String} typeName 
+     * @param {module:model/JobTypeValidation} jobTypeValidation Sample settings to compile the job with.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/JobTypeValidationResult}
+     */
+    validateJobType(typeName, jobTypeValidation) {
+      return this.validateJobTypeWithHttpInfo(typeName, jobTypeValidation)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
 }
@@ -14,6 +14,7 @@
 
 import ApiClient from "../ApiClient";
 import BlenderPathCheckResult from '../model/BlenderPathCheckResult';
+import DatabaseBackupResult from '../model/DatabaseBackupResult';
 import Error from '../model/Error';
 import FlamencoVersion from '../model/FlamencoVersion';
 import ManagerConfiguration from '../model/ManagerConfiguration';
@@ -43,6 +44,45 @@ export default class MetaApi {
 
 
 
+    /**
+     * Back up the database to the configured backup directory. The Manager keeps running while the backup is made. 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/DatabaseBackupResult} and HTTP response
+     */
+    backupDatabaseWithHttpInfo() {
+      let postBody = null;
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = DatabaseBackupResult;
+      return this.apiClient.callApi(
+        '/api/v3/configuration/database-backup', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Back up the database to the configured backup directory. The Manager keeps running while the backup is made. 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/DatabaseBackupResult}
+     */
+    backupDatabase() {
+      return this.backupDatabaseWithHttpInfo()
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * Validate a CLI command for use as way to start Blender
      * @param {Object} opts Optional parameters
@@ -62,7 +102,7 @@ export default class MetaApi {
       let formParams = {
       };
 
-      let authNames = [];
+      let authNames = ['user_auth'];
       let contentTypes = ['application/json'];
       let accepts = ['application/json'];
       let returnType = BlenderPathCheckResult;
@@ -106,7 +146,7 @@ export default class MetaApi {
       let formParams = {
       };
 
-      let authNames = [];
+      let authNames = ['user_auth'];
       let contentTypes = ['application/json'];
       let accepts = ['application/json'];
       let returnType = PathCheckResult;
@@ -210,7 +250,7 @@ export default class MetaApi {
 
 
     /**
-     * Retrieve the configuration of Flamenco Manager.
+     * Retrieve the configuration of Flamenco Manager. Only admins can do this. Any password in the database DSN is redacted. 
      * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link Object.<String, {String: Object}>} and HTTP response
      */
     getConfigurationFileWithHttpInfo() {
@@ -225,7 +265,7 @@ export default class MetaApi {
       let formParams = {
       };
 
-      let authNames = [];
+      let authNames = ['user_auth'];
       let contentTypes = [];
       let accepts = ['application/json', 'application/yaml'];
       let returnType = {'String': Object};
@@ -237,7 +277,7 @@ export default class MetaApi {
     }
 
     /**
-     * Retrieve the configuration of Flamenco Manager.
+     * Retrieve the configuration of Flamenco Manager. Only admins can do this. Any password in the database DSN is redacted. 
      * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link Object.<String, {String: Object}>}
      */
     getConfigurationFile() {
@@ -359,7 +399,7 @@ export default class MetaApi {
       let formParams = {
       };
 
-      let authNames = [];
+      let authNames = ['user_auth'];
       let contentTypes = ['application/json'];
       let accepts = ['application/json'];
       let returnType = null;
@@ -61,7 +61,7 @@ export default class ShamanApi {
       let formParams = {
       };
 
-      let authNames = [];
+      let authNames = ['user_auth'];
       let contentTypes = ['application/json'];
       let accepts = ['application/json'];
       let returnType = ShamanCheckoutResult;
@@ -106,7 +106,7 @@ export default class ShamanApi {
       let formParams = {
       };
 
-      let authNames = [];
+      let authNames = ['user_auth'];
       let contentTypes = ['application/json'];
       let accepts = ['application/json'];
       let returnType = ShamanRequirementsResponse;
@@ -169,7 +169,7 @@ export default class ShamanApi {
       let formParams = {
       };
 
-      let authNames = [];
+      let authNames = ['user_auth'];
       let contentTypes = ['application/octet-stream'];
       let accepts = ['application/json'];
       let returnType = null;
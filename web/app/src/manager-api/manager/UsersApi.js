@@ -0,0 +1,296 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+
+import ApiClient from "../ApiClient";
+import Error from '../model/Error';
+import NewUser from '../model/NewUser';
+import User from '../model/User';
+import UserList from '../model/UserList';
+import UserLoginRequest from '../model/UserLoginRequest';
+import UserLoginResponse from '../model/UserLoginResponse';
+
+/**
+* Users service.
+* @module manager/UsersApi
+* @version 0.0.0
+*/
+export default class UsersApi {
+
+    /**
+    * Constructs a new UsersApi. 
+    * @alias module:manager/UsersApi
+    * @class
+    * @param {module:ApiClient} [apiClient] Optional API client implementation to use,
+    * default to {@link module:ApiClient#instance} if unspecified.
+    */
+    constructor(apiClient) {
+        this.apiClient = apiClient || ApiClient.instance;
+    }
+
+
+
+    /**
+     * Create a new user account.
+     * @param {module:model/NewUser} newUser The user account to create.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/User} and HTTP response
+     */
+    createUserWithHttpInfo(newUser) {
+      let postBody = newUser;
+      // verify the required parameter 'newUser' is set
+      if (newUser === undefined || newUser === null) {
+        throw new Error("Missing the required parameter 'newUser' when calling createUser");
+      }
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = ['application/json'];
+      let accepts = ['application/json'];
+      let returnType = User;
+      return this.apiClient.callApi(
+        '/api/v3/users', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Create a new user account.
+     * @param {module:model/NewUser} newUser The user account to create.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/User}
+     */
+    createUser(newUser) {
+      return this.createUserWithHttpInfo(newUser)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+    /**
+     * Delete this user account. Jobs submitted by this user are kept, but no longer have an owner. 
+     * @param {String} userId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing HTTP response
+     */
+    deleteUserWithHttpInfo(userId) {
+      let postBody = null;
+      // verify the required parameter 'userId' is set
+      if (userId === undefined || userId === null) {
+        throw new Error("Missing the required parameter 'userId' when calling deleteUser");
+      }
+
+      let pathParams = {
+        'user_id': userId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = null;
+      return this.apiClient.callApi(
+        '/api/v3/users/{user_id}', 'DELETE',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Delete this user account. Jobs submitted by this user are kept, but no longer have an owner. 
+     * @param {String} userId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}
+     */
+    deleteUser(userId) {
+      return this.deleteUserWithHttpInfo(userId)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+    /**
+     * Fetch the user that is authenticated by this request.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/User} and HTTP response
+     */
+    fetchCurrentUserWithHttpInfo() {
+      let postBody = null;
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = User;
+      return this.apiClient.callApi(
+        '/api/v3/users/me', 'GET',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Fetch the user that is authenticated by this request.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/User}
+     */
+    fetchCurrentUser() {
+      return this.fetchCurrentUserWithHttpInfo()
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+    /**
+     * Get list of user accounts.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/UserList} and HTTP response
+     */
+    fetchUsersWithHttpInfo() {
+      let postBody = null;
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = UserList;
+      return this.apiClient.callApi(
+        '/api/v3/users', 'GET',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Get list of user accounts.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/UserList}
+     */
+    fetchUsers() {
+      return this.fetchUsersWithHttpInfo()
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+    /**
+     * Log in with a username and password. The returned token should be passed as bearer token in subsequent requests. 
+     * @param {module:model/UserLoginRequest} userLoginRequest User credentials.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/UserLoginResponse} and HTTP response
+     */
+    loginUserWithHttpInfo(userLoginRequest) {
+      let postBody = userLoginRequest;
+      // verify the required parameter 'userLoginRequest' is set
+      if (userLoginRequest === undefined || userLoginRequest === null) {
+        throw new Error("Missing the required parameter 'userLoginRequest' when calling loginUser");
+      }
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = ['application/json'];
+      let accepts = ['application/json'];
+      let returnType = UserLoginResponse;
+      return this.apiClient.callApi(
+        '/api/v3/users/login', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Log in with a username and password. The returned token should be passed as bearer token in subsequent requests. 
+     * @param {module:model/UserLoginRequest} userLoginRequest User credentials.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/UserLoginResponse}
+     */
+    loginUser(userLoginRequest) {
+      return this.loginUserWithHttpInfo(userLoginRequest)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+    /**
+     * Invalidate the token used to authenticate this request.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing HTTP response
+     */
+    logoutUserWithHttpInfo() {
+      let postBody = null;
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = null;
+      return this.apiClient.callApi(
+        '/api/v3/users/logout', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Invalidate the token used to authenticate this request.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}
+     */
+    logoutUser() {
+      return this.logoutUserWithHttpInfo()
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+}
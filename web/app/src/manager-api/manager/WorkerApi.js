@@ -137,14 +137,20 @@ export default class WorkerApi {
 
     /**
      * Obtain a new task to execute
+     * @param {Object} opts Optional parameters
+     * @param {Number} opts.wait Number of seconds to wait for a task to become available, before responding with \"no tasks available\". Without this parameter the Manager responds immediately. The Manager may wait shorter than requested. Only supported when the Manager lists the `task-long-poll` feature in its version info. 
+     * @param {Array.<String>} opts.running Tasks that the Worker is running at the moment. These are not handed out again, so that the Worker can ask for another task to run next to them. Only supported when the Manager lists the `task-slots` feature in its version info. 
      * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/AssignedTask} and HTTP response
      */
-    scheduleTaskWithHttpInfo() {
+    scheduleTaskWithHttpInfo(opts) {
+      opts = opts || {};
       let postBody = null;
 
       let pathParams = {
       };
       let queryParams = {
+        'wait': opts['wait'],
+        'running': this.apiClient.buildCollectionParam(opts['running'], 'multi')
       };
       let headerParams = {
       };
@@ -164,10 +170,13 @@ export default class WorkerApi {
 
     /**
      * Obtain a new task to execute
+     * @param {Object} opts Optional parameters
+     * @param {Number} opts.wait Number of seconds to wait for a task to become available, before responding with \"no tasks available\". Without this parameter the Manager responds immediately. The Manager may wait shorter than requested. Only supported when the Manager lists the `task-long-poll` feature in its version info. 
+     * @param {Array.<String>} opts.running Tasks that the Worker is running at the moment. These are not handed out again, so that the Worker can ask for another task to run next to them. Only supported when the Manager lists the `task-slots` feature in its version info. 
      * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/AssignedTask}
      */
-    scheduleTask() {
-      return this.scheduleTaskWithHttpInfo()
+    scheduleTask(opts) {
+      return this.scheduleTaskWithHttpInfo(opts)
         .then(function(response_and_data) {
           return response_and_data.data;
         });
@@ -18,6 +18,9 @@ import Worker from '../model/Worker';
 import WorkerList from '../model/WorkerList';
 import WorkerSleepSchedule from '../model/WorkerSleepSchedule';
 import WorkerStatusChangeRequest from '../model/WorkerStatusChangeRequest';
+import WorkerTag from '../model/WorkerTag';
+import WorkerTagChangeRequest from '../model/WorkerTagChangeRequest';
+import WorkerTagList from '../model/WorkerTagList';
 
 /**
 * WorkerMgt service.
@@ -39,6 +42,97 @@ export default class WorkerMgtApi {
 
 
 
+    /**
+     * Create a new worker tag.
+     * @param {module:model/WorkerTag} workerTag The worker tag.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/WorkerTag} and HTTP response
+     */
+    createWorkerTagWithHttpInfo(workerTag) {
+      let postBody = workerTag;
+      // verify the required parameter 'workerTag' is set
+      if (workerTag === undefined || workerTag === null) {
+        throw new Error("Missing the required parameter 'workerTag' when calling createWorkerTag");
+      }
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = ['application/json'];
+      let accepts = ['application/json'];
+      let returnType = WorkerTag;
+      return this.apiClient.callApi(
+        '/api/v3/worker-mgt/tags', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Create a new worker tag.
+     * @param {module:model/WorkerTag} workerTag The worker tag.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/WorkerTag}
+     */
+    createWorkerTag(workerTag) {
+      return this.createWorkerTagWithHttpInfo(workerTag)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+    /**
+     * Remove this worker tag. This unassigns all workers from the tag and removes it.
+     * @param {String} tagId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing HTTP response
+     */
+    deleteWorkerTagWithHttpInfo(tagId) {
+      let postBody = null;
+      // verify the required parameter 'tagId' is set
+      if (tagId === undefined || tagId === null) {
+        throw new Error("Missing the required parameter 'tagId' when calling deleteWorkerTag");
+      }
+
+      let pathParams = {
+        'tag_id': tagId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = null;
+      return this.apiClient.callApi(
+        '/api/v3/worker-mgt/tag/{tag_id}', 'DELETE',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Remove this worker tag. This unassigns all workers from the tag and removes it.
+     * @param {String} tagId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}
+     */
+    deleteWorkerTag(tagId) {
+      return this.deleteWorkerTagWithHttpInfo(tagId)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * Fetch info about the worker.
      * @param {String} workerId 
@@ -129,6 +223,91 @@ export default class WorkerMgtApi {
     }
 
 
+    /**
+     * Get a single worker tag.
+     * @param {String} tagId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/WorkerTag} and HTTP response
+     */
+    fetchWorkerTagWithHttpInfo(tagId) {
+      let postBody = null;
+      // verify the required parameter 'tagId' is set
+      if (tagId === undefined || tagId === null) {
+        throw new Error("Missing the required parameter 'tagId' when calling fetchWorkerTag");
+      }
+
+      let pathParams = {
+        'tag_id': tagId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = WorkerTag;
+      return this.apiClient.callApi(
+        '/api/v3/worker-mgt/tag/{tag_id}', 'GET',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Get a single worker tag.
+     * @param {String} tagId 
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/WorkerTag}
+     */
+    fetchWorkerTag(tagId) {
+      return this.fetchWorkerTagWithHttpInfo(tagId)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+    /**
+     * Get list of worker tags.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/WorkerTagList} and HTTP response
+     */
+    fetchWorkerTagsWithHttpInfo() {
+      let postBody = null;
+
+      let pathParams = {
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = [];
+      let contentTypes = [];
+      let accepts = ['application/json'];
+      let returnType = WorkerTagList;
+      return this.apiClient.callApi(
+        '/api/v3/worker-mgt/tags', 'GET',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Get list of worker tags.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with data of type {@link module:model/WorkerTagList}
+     */
+    fetchWorkerTags() {
+      return this.fetchWorkerTagsWithHttpInfo()
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
     /**
      * Get list of workers.
      * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing data of type {@link module:model/WorkerList} and HTTP response
@@ -194,7 +373,7 @@ export default class WorkerMgtApi {
       let formParams = {
       };
 
-      let authNames = [];
+      let authNames = ['user_auth'];
       let contentTypes = ['application/json'];
       let accepts = ['application/json'];
       let returnType = null;
@@ -244,7 +423,7 @@ export default class WorkerMgtApi {
       let formParams = {
       };
 
-      let authNames = [];
+      let authNames = ['user_auth'];
       let contentTypes = ['application/json'];
       let accepts = ['application/json'];
       let returnType = null;
@@ -268,4 +447,106 @@ export default class WorkerMgtApi {
     }
 
 
+    /**
+     * @param {String} workerId 
+     * @param {module:model/WorkerTagChangeRequest} workerTagChangeRequest The list of worker tag IDs this worker should be part of.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing HTTP response
+     */
+    setWorkerTagsWithHttpInfo(workerId, workerTagChangeRequest) {
+      let postBody = workerTagChangeRequest;
+      // verify the required parameter 'workerId' is set
+      if (workerId === undefined || workerId === null) {
+        throw new Error("Missing the required parameter 'workerId' when calling setWorkerTags");
+      }
+      // verify the required parameter 'workerTagChangeRequest' is set
+      if (workerTagChangeRequest === undefined || workerTagChangeRequest === null) {
+        throw new Error("Missing the required parameter 'workerTagChangeRequest' when calling setWorkerTags");
+      }
+
+      let pathParams = {
+        'worker_id': workerId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = ['application/json'];
+      let accepts = ['application/json'];
+      let returnType = null;
+      return this.apiClient.callApi(
+        '/api/v3/worker-mgt/workers/{worker_id}/settags', 'POST',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * @param {String} workerId 
+     * @param {module:model/WorkerTagChangeRequest} workerTagChangeRequest The list of worker tag IDs this worker should be part of.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}
+     */
+    setWorkerTags(workerId, workerTagChangeRequest) {
+      return this.setWorkerTagsWithHttpInfo(workerId, workerTagChangeRequest)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
+    /**
+     * Update an existing worker tag.
+     * @param {String} tagId 
+     * @param {module:model/WorkerTag} workerTag The updated worker tag.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}, with an object containing HTTP response
+     */
+    updateWorkerTagWithHttpInfo(tagId, workerTag) {
+      let postBody = workerTag;
+      // verify the required parameter 'tagId' is set
+      if (tagId === undefined || tagId === null) {
+        throw new Error("Missing the required parameter 'tagId' when calling updateWorkerTag");
+      }
+      // verify the required parameter 'workerTag' is set
+      if (workerTag === undefined || workerTag === null) {
+        throw new Error("Missing the required parameter 'workerTag' when calling updateWorkerTag");
+      }
+
+      let pathParams = {
+        'tag_id': tagId
+      };
+      let queryParams = {
+      };
+      let headerParams = {
+      };
+      let formParams = {
+      };
+
+      let authNames = ['user_auth'];
+      let contentTypes = ['application/json'];
+      let accepts = ['application/json'];
+      let returnType = null;
+      return this.apiClient.callApi(
+        '/api/v3/worker-mgt/tag/{tag_id}', 'PUT',
+        pathParams, queryParams, headerParams, formParams, postBody,
+        authNames, contentTypes, accepts, returnType, null
+      );
+    }
+
+    /**
+     * Update an existing worker tag.
+     * @param {String} tagId 
+     * @param {module:model/WorkerTag} workerTag The updated worker tag.
+     * @return {Promise} a {@link https://www.promisejs.org/|Promise}
+     */
+    updateWorkerTag(tagId, workerTag) {
+      return this.updateWorkerTagWithHttpInfo(tagId, workerTag)
+        .then(function(response_and_data) {
+          return response_and_data.data;
+        });
+    }
+
+
 }
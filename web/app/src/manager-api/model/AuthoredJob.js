@@ -0,0 +1,175 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+import AuthoredTask from './AuthoredTask';
+import WorkerRequirements from './WorkerRequirements';
+
+/**
+ * The AuthoredJob model module.
+ * @module model/AuthoredJob
+ * @version 0.0.0
+ */
+class AuthoredJob {
+    /**
+     * Constructs a new <code>AuthoredJob</code>.
+     * Job as it was produced by the job compiler script, before it is stored in the database. 
+     * @alias module:model/AuthoredJob
+     * @param name {String} 
+     * @param type {String} 
+     * @param priority {Number} 
+     * @param settings {Object.<String, Object>} 
+     * @param metadata {Object.<String, String>} 
+     * @param maxWorkers {Number} Maximum number of Workers that can work on this job at the same time. Zero means there is no limit. 
+     * @param dependsOn {Array.<String>} IDs of the jobs that need to be completed before this job can start.
+     * @param tasks {Array.<module:model/AuthoredTask>} 
+     */
+    constructor(name, type, priority, settings, metadata, maxWorkers, dependsOn, tasks) { 
+        
+        AuthoredJob.initialize(this, name, type, priority, settings, metadata, maxWorkers, dependsOn, tasks);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, name, type, priority, settings, metadata, maxWorkers, dependsOn, tasks) { 
+        obj['name'] = name;
+        obj['type'] = type;
+        obj['priority'] = priority;
+        obj['settings'] = settings;
+        obj['metadata'] = metadata;
+        obj['max_workers'] = maxWorkers;
+        obj['depends_on'] = dependsOn;
+        obj['tasks'] = tasks;
+    }
+
+    /**
+     * Constructs a <code>AuthoredJob</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/AuthoredJob} obj Optional instance to populate.
+     * @return {module:model/AuthoredJob} The populated <code>AuthoredJob</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new AuthoredJob();
+
+            if (data.hasOwnProperty('name')) {
+                obj['name'] = ApiClient.convertToType(data['name'], 'String');
+            }
+            if (data.hasOwnProperty('type')) {
+                obj['type'] = ApiClient.convertToType(data['type'], 'String');
+            }
+            if (data.hasOwnProperty('priority')) {
+                obj['priority'] = ApiClient.convertToType(data['priority'], 'Number');
+            }
+            if (data.hasOwnProperty('settings')) {
+                obj['settings'] = ApiClient.convertToType(data['settings'], {'String': Object});
+            }
+            if (data.hasOwnProperty('metadata')) {
+                obj['metadata'] = ApiClient.convertToType(data['metadata'], {'String': 'String'});
+            }
+            if (data.hasOwnProperty('worker_tag')) {
+                obj['worker_tag'] = ApiClient.convertToType(data['worker_tag'], 'String');
+            }
+            if (data.hasOwnProperty('max_workers')) {
+                obj['max_workers'] = ApiClient.convertToType(data['max_workers'], 'Number');
+            }
+            if (data.hasOwnProperty('depends_on')) {
+                obj['depends_on'] = ApiClient.convertToType(data['depends_on'], ['String']);
+            }
+            if (data.hasOwnProperty('start_after')) {
+                obj['start_after'] = ApiClient.convertToType(data['start_after'], 'Date');
+            }
+            if (data.hasOwnProperty('requirements')) {
+                obj['requirements'] = WorkerRequirements.constructFromObject(data['requirements']);
+            }
+            if (data.hasOwnProperty('tasks')) {
+                obj['tasks'] = ApiClient.convertToType(data['tasks'], [AuthoredTask]);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {String} name
+ */
+AuthoredJob.prototype['name'] = undefined;
+
+/**
+ * @member {String} type
+ */
+AuthoredJob.prototype['type'] = undefined;
+
+/**
+ * @member {Number} priority
+ */
+AuthoredJob.prototype['priority'] = undefined;
+
+/**
+ * @member {Object.<String, Object>} settings
+ */
+AuthoredJob.prototype['settings'] = undefined;
+
+/**
+ * Arbitrary metadata strings. More complex structures can be modeled by using `a.b.c` notation for the key.
+ * @member {Object.<String, String>} metadata
+ */
+AuthoredJob.prototype['metadata'] = undefined;
+
+/**
+ * Worker tag that should execute this job, if any.
+ * @member {String} worker_tag
+ */
+AuthoredJob.prototype['worker_tag'] = undefined;
+
+/**
+ * Maximum number of Workers that can work on this job at the same time. Zero means there is no limit. 
+ * @member {Number} max_workers
+ */
+AuthoredJob.prototype['max_workers'] = undefined;
+
+/**
+ * IDs of the jobs that need to be completed before this job can start.
+ * @member {Array.<String>} depends_on
+ */
+AuthoredJob.prototype['depends_on'] = undefined;
+
+/**
+ * Moment in time before which the job will not start.
+ * @member {Date} start_after
+ */
+AuthoredJob.prototype['start_after'] = undefined;
+
+/**
+ * @member {module:model/WorkerRequirements} requirements
+ */
+AuthoredJob.prototype['requirements'] = undefined;
+
+/**
+ * @member {Array.<module:model/AuthoredTask>} tasks
+ */
+AuthoredJob.prototype['tasks'] = undefined;
+
+
+
+
+
+
+export default AuthoredJob;
+
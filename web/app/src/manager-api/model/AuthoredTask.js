@@ -0,0 +1,126 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+import Command from './Command';
+
+/**
+ * The AuthoredTask model module.
+ * @module model/AuthoredTask
+ * @version 0.0.0
+ */
+class AuthoredTask {
+    /**
+     * Constructs a new <code>AuthoredTask</code>.
+     * Task as it was produced by the job compiler script, before it is stored in the database. 
+     * @alias module:model/AuthoredTask
+     * @param uuid {String} 
+     * @param name {String} 
+     * @param type {String} 
+     * @param priority {Number} 
+     * @param commands {Array.<module:model/Command>} 
+     * @param dependencies {Array.<String>} UUIDs of the tasks that need to be completed before this one can run.
+     */
+    constructor(uuid, name, type, priority, commands, dependencies) { 
+        
+        AuthoredTask.initialize(this, uuid, name, type, priority, commands, dependencies);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, uuid, name, type, priority, commands, dependencies) { 
+        obj['uuid'] = uuid;
+        obj['name'] = name;
+        obj['type'] = type;
+        obj['priority'] = priority;
+        obj['commands'] = commands;
+        obj['dependencies'] = dependencies;
+    }
+
+    /**
+     * Constructs a <code>AuthoredTask</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/AuthoredTask} obj Optional instance to populate.
+     * @return {module:model/AuthoredTask} The populated <code>AuthoredTask</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new AuthoredTask();
+
+            if (data.hasOwnProperty('uuid')) {
+                obj['uuid'] = ApiClient.convertToType(data['uuid'], 'String');
+            }
+            if (data.hasOwnProperty('name')) {
+                obj['name'] = ApiClient.convertToType(data['name'], 'String');
+            }
+            if (data.hasOwnProperty('type')) {
+                obj['type'] = ApiClient.convertToType(data['type'], 'String');
+            }
+            if (data.hasOwnProperty('priority')) {
+                obj['priority'] = ApiClient.convertToType(data['priority'], 'Number');
+            }
+            if (data.hasOwnProperty('commands')) {
+                obj['commands'] = ApiClient.convertToType(data['commands'], [Command]);
+            }
+            if (data.hasOwnProperty('dependencies')) {
+                obj['dependencies'] = ApiClient.convertToType(data['dependencies'], ['String']);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {String} uuid
+ */
+AuthoredTask.prototype['uuid'] = undefined;
+
+/**
+ * @member {String} name
+ */
+AuthoredTask.prototype['name'] = undefined;
+
+/**
+ * @member {String} type
+ */
+AuthoredTask.prototype['type'] = undefined;
+
+/**
+ * @member {Number} priority
+ */
+AuthoredTask.prototype['priority'] = undefined;
+
+/**
+ * @member {Array.<module:model/Command>} commands
+ */
+AuthoredTask.prototype['commands'] = undefined;
+
+/**
+ * UUIDs of the tasks that need to be completed before this one can run.
+ * @member {Array.<String>} dependencies
+ */
+AuthoredTask.prototype['dependencies'] = undefined;
+
+
+
+
+
+
+export default AuthoredTask;
+
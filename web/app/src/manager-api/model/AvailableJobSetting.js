@@ -88,6 +88,9 @@ class AvailableJobSetting {
             if (data.hasOwnProperty('editable')) {
                 obj['editable'] = ApiClient.convertToType(data['editable'], 'Boolean');
             }
+            if (data.hasOwnProperty('overridable')) {
+                obj['overridable'] = ApiClient.convertToType(data['overridable'], 'Boolean');
+            }
         }
         return obj;
     }
@@ -160,6 +163,13 @@ AvailableJobSetting.prototype['required'] = false;
  */
 AvailableJobSetting.prototype['editable'] = false;
 
+/**
+ * Whether this setting can be changed after the job has been submitted, by editing the job. This recompiles the job, keeping its completed tasks. 
+ * @member {Boolean} overridable
+ * @default false
+ */
+AvailableJobSetting.prototype['overridable'] = false;
+
 
 
 
@@ -13,6 +13,7 @@
 
 import ApiClient from '../ApiClient';
 import AvailableJobSetting from './AvailableJobSetting';
+import WorkerRequirements from './WorkerRequirements';
 
 /**
  * The AvailableJobType model module.
@@ -69,6 +70,9 @@ class AvailableJobType {
             if (data.hasOwnProperty('etag')) {
                 obj['etag'] = ApiClient.convertToType(data['etag'], 'String');
             }
+            if (data.hasOwnProperty('requirements')) {
+                obj['requirements'] = WorkerRequirements.constructFromObject(data['requirements']);
+            }
         }
         return obj;
     }
@@ -97,6 +101,11 @@ AvailableJobType.prototype['settings'] = undefined;
  */
 AvailableJobType.prototype['etag'] = undefined;
 
+/**
+ * @member {module:model/WorkerRequirements} requirements
+ */
+AvailableJobType.prototype['requirements'] = undefined;
+
 
 
 
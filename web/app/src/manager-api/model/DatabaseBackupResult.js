@@ -0,0 +1,74 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The DatabaseBackupResult model module.
+ * @module model/DatabaseBackupResult
+ * @version 0.0.0
+ */
+class DatabaseBackupResult {
+    /**
+     * Constructs a new <code>DatabaseBackupResult</code>.
+     * @alias module:model/DatabaseBackupResult
+     * @param path {String} Path of the backup file, on the Manager.
+     */
+    constructor(path) { 
+        
+        DatabaseBackupResult.initialize(this, path);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, path) { 
+        obj['path'] = path;
+    }
+
+    /**
+     * Constructs a <code>DatabaseBackupResult</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/DatabaseBackupResult} obj Optional instance to populate.
+     * @return {module:model/DatabaseBackupResult} The populated <code>DatabaseBackupResult</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new DatabaseBackupResult();
+
+            if (data.hasOwnProperty('path')) {
+                obj['path'] = ApiClient.convertToType(data['path'], 'String');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * Path of the backup file, on the Manager.
+ * @member {String} path
+ */
+DatabaseBackupResult.prototype['path'] = undefined;
+
+
+
+
+
+
+export default DatabaseBackupResult;
+
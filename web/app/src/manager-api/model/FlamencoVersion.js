@@ -57,6 +57,9 @@ class FlamencoVersion {
             if (data.hasOwnProperty('name')) {
                 obj['name'] = ApiClient.convertToType(data['name'], 'String');
             }
+            if (data.hasOwnProperty('features')) {
+                obj['features'] = ApiClient.convertToType(data['features'], ['String']);
+            }
         }
         return obj;
     }
@@ -74,6 +77,12 @@ FlamencoVersion.prototype['version'] = undefined;
  */
 FlamencoVersion.prototype['name'] = undefined;
 
+/**
+ * Optional features supported by this Manager, so that clients can detect whether they can use them. 
+ * @member {Array.<String>} features
+ */
+FlamencoVersion.prototype['features'] = undefined;
+
 
 
 
@@ -15,6 +15,7 @@ import ApiClient from '../ApiClient';
 import JobAllOf from './JobAllOf';
 import JobStatus from './JobStatus';
 import SubmittedJob from './SubmittedJob';
+import User from './User';
 
 /**
  * The Job model module.
@@ -90,6 +91,18 @@ class Job {
             if (data.hasOwnProperty('metadata')) {
                 obj['metadata'] = ApiClient.convertToType(data['metadata'], {'String': 'String'});
             }
+            if (data.hasOwnProperty('worker_tag')) {
+                obj['worker_tag'] = ApiClient.convertToType(data['worker_tag'], 'String');
+            }
+            if (data.hasOwnProperty('max_workers')) {
+                obj['max_workers'] = ApiClient.convertToType(data['max_workers'], 'Number');
+            }
+            if (data.hasOwnProperty('depends_on')) {
+                obj['depends_on'] = ApiClient.convertToType(data['depends_on'], ['String']);
+            }
+            if (data.hasOwnProperty('start_after')) {
+                obj['start_after'] = ApiClient.convertToType(data['start_after'], 'Date');
+            }
             if (data.hasOwnProperty('submitter_platform')) {
                 obj['submitter_platform'] = ApiClient.convertToType(data['submitter_platform'], 'String');
             }
@@ -108,6 +121,15 @@ class Job {
             if (data.hasOwnProperty('activity')) {
                 obj['activity'] = ApiClient.convertToType(data['activity'], 'String');
             }
+            if (data.hasOwnProperty('delete_requested_at')) {
+                obj['delete_requested_at'] = ApiClient.convertToType(data['delete_requested_at'], 'Date');
+            }
+            if (data.hasOwnProperty('user')) {
+                obj['user'] = User.constructFromObject(data['user']);
+            }
+            if (data.hasOwnProperty('progress')) {
+                obj['progress'] = ApiClient.convertToType(data['progress'], 'Number');
+            }
         }
         return obj;
     }
@@ -148,6 +170,30 @@ Job.prototype['settings'] = undefined;
  */
 Job.prototype['metadata'] = undefined;
 
+/**
+ * Worker tag that should execute this job. When a tag ID is given, only Workers in that tag will be scheduled to work on it. If empty or ommitted, all workers can work on this job. 
+ * @member {String} worker_tag
+ */
+Job.prototype['worker_tag'] = undefined;
+
+/**
+ * Maximum number of Workers that can work on this job at the same time. Zero or ommitted means there is no limit. 
+ * @member {Number} max_workers
+ */
+Job.prototype['max_workers'] = undefined;
+
+/**
+ * IDs of the jobs that need to be completed before this job can start. Until then, the job will be in status `waiting`. When any of these jobs fails or is canceled, this job will fail as well. 
+ * @member {Array.<String>} depends_on
+ */
+Job.prototype['depends_on'] = undefined;
+
+/**
+ * When given, no tasks of this job will be handed out to Workers before this moment in time. If ommitted, the job can start immediately. 
+ * @member {Date} start_after
+ */
+Job.prototype['start_after'] = undefined;
+
 /**
  * Operating system of the submitter. This is used to recognise two-way variables. This should be a lower-case version of the platform, like \"linux\", \"windows\", \"darwin\", \"openbsd\", etc. Should be ompatible with Go's `runtime.GOOS`; run `go tool dist list` to get a list of possible platforms. As a special case, the platform \"manager\" can be given, which will be interpreted as \"the Manager's platform\". This is mostly to make test/debug scripts easier, as they can use a static document on all platforms. 
  * @member {String} submitter_platform
@@ -183,6 +229,23 @@ Job.prototype['status'] = undefined;
  */
 Job.prototype['activity'] = undefined;
 
+/**
+ * Timestamp of when deletion of this job was requested. Only set when the job is queued for deletion. 
+ * @member {Date} delete_requested_at
+ */
+Job.prototype['delete_requested_at'] = undefined;
+
+/**
+ * @member {module:model/User} user
+ */
+Job.prototype['user'] = undefined;
+
+/**
+ * Percentage of the job that has been completed, aggregated from the progress of its tasks. 
+ * @member {Number} progress
+ */
+Job.prototype['progress'] = undefined;
+
 
 // Implement SubmittedJob interface:
 /**
@@ -212,6 +275,26 @@ SubmittedJob.prototype['settings'] = undefined;
  * @member {Object.<String, String>} metadata
  */
 SubmittedJob.prototype['metadata'] = undefined;
+/**
+ * Worker tag that should execute this job. When a tag ID is given, only Workers in that tag will be scheduled to work on it. If empty or ommitted, all workers can work on this job. 
+ * @member {String} worker_tag
+ */
+SubmittedJob.prototype['worker_tag'] = undefined;
+/**
+ * Maximum number of Workers that can work on this job at the same time. Zero or ommitted means there is no limit. 
+ * @member {Number} max_workers
+ */
+SubmittedJob.prototype['max_workers'] = undefined;
+/**
+ * IDs of the jobs that need to be completed before this job can start. Until then, the job will be in status `waiting`. When any of these jobs fails or is canceled, this job will fail as well. 
+ * @member {Array.<String>} depends_on
+ */
+SubmittedJob.prototype['depends_on'] = undefined;
+/**
+ * When given, no tasks of this job will be handed out to Workers before this moment in time. If ommitted, the job can start immediately. 
+ * @member {Date} start_after
+ */
+SubmittedJob.prototype['start_after'] = undefined;
 /**
  * Operating system of the submitter. This is used to recognise two-way variables. This should be a lower-case version of the platform, like \"linux\", \"windows\", \"darwin\", \"openbsd\", etc. Should be ompatible with Go's `runtime.GOOS`; run `go tool dist list` to get a list of possible platforms. As a special case, the platform \"manager\" can be given, which will be interpreted as \"the Manager's platform\". This is mostly to make test/debug scripts easier, as they can use a static document on all platforms. 
  * @member {String} submitter_platform
@@ -242,6 +325,20 @@ JobAllOf.prototype['status'] = undefined;
  * @member {String} activity
  */
 JobAllOf.prototype['activity'] = undefined;
+/**
+ * Timestamp of when deletion of this job was requested. Only set when the job is queued for deletion. 
+ * @member {Date} delete_requested_at
+ */
+JobAllOf.prototype['delete_requested_at'] = undefined;
+/**
+ * @member {module:model/User} user
+ */
+JobAllOf.prototype['user'] = undefined;
+/**
+ * Percentage of the job that has been completed, aggregated from the progress of its tasks. 
+ * @member {Number} progress
+ */
+JobAllOf.prototype['progress'] = undefined;
 
 
 
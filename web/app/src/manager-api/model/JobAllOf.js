@@ -13,6 +13,7 @@
 
 import ApiClient from '../ApiClient';
 import JobStatus from './JobStatus';
+import User from './User';
 
 /**
  * The JobAllOf model module.
@@ -73,6 +74,15 @@ class JobAllOf {
             if (data.hasOwnProperty('activity')) {
                 obj['activity'] = ApiClient.convertToType(data['activity'], 'String');
             }
+            if (data.hasOwnProperty('delete_requested_at')) {
+                obj['delete_requested_at'] = ApiClient.convertToType(data['delete_requested_at'], 'Date');
+            }
+            if (data.hasOwnProperty('user')) {
+                obj['user'] = User.constructFromObject(data['user']);
+            }
+            if (data.hasOwnProperty('progress')) {
+                obj['progress'] = ApiClient.convertToType(data['progress'], 'Number');
+            }
         }
         return obj;
     }
@@ -109,6 +119,23 @@ JobAllOf.prototype['status'] = undefined;
  */
 JobAllOf.prototype['activity'] = undefined;
 
+/**
+ * Timestamp of when deletion of this job was requested. Only set when the job is queued for deletion. 
+ * @member {Date} delete_requested_at
+ */
+JobAllOf.prototype['delete_requested_at'] = undefined;
+
+/**
+ * @member {module:model/User} user
+ */
+JobAllOf.prototype['user'] = undefined;
+
+/**
+ * Percentage of the job that has been completed, aggregated from the progress of its tasks. 
+ * @member {Number} progress
+ */
+JobAllOf.prototype['progress'] = undefined;
+
 
 
 
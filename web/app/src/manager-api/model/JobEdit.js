@@ -0,0 +1,73 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The JobEdit model module.
+ * @module model/JobEdit
+ * @version 0.0.0
+ */
+class JobEdit {
+    /**
+     * Constructs a new <code>JobEdit</code>.
+     * @alias module:model/JobEdit
+     * @param settings {Object.<String, Object>} The settings to change. Settings that are not mentioned keep their current value. Only settings that the job type declares as `overridable` can be changed. 
+     */
+    constructor(settings) { 
+        
+        JobEdit.initialize(this, settings);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, settings) { 
+        obj['settings'] = settings;
+    }
+
+    /**
+     * Constructs a <code>JobEdit</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/JobEdit} obj Optional instance to populate.
+     * @return {module:model/JobEdit} The populated <code>JobEdit</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new JobEdit();
+
+            if (data.hasOwnProperty('settings')) {
+                obj['settings'] = ApiClient.convertToType(data['settings'], {'String': Object});
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {Object.<String, Object>} settings
+ */
+JobEdit.prototype['settings'] = undefined;
+
+
+
+
+
+
+export default JobEdit;
+
@@ -0,0 +1,74 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+import JobHistoryEntry from './JobHistoryEntry';
+
+/**
+ * The JobHistory model module.
+ * @module model/JobHistory
+ * @version 0.0.0
+ */
+class JobHistory {
+    /**
+     * Constructs a new <code>JobHistory</code>.
+     * @alias module:model/JobHistory
+     * @param entries {Array.<module:model/JobHistoryEntry>} 
+     */
+    constructor(entries) { 
+        
+        JobHistory.initialize(this, entries);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, entries) { 
+        obj['entries'] = entries;
+    }
+
+    /**
+     * Constructs a <code>JobHistory</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/JobHistory} obj Optional instance to populate.
+     * @return {module:model/JobHistory} The populated <code>JobHistory</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new JobHistory();
+
+            if (data.hasOwnProperty('entries')) {
+                obj['entries'] = ApiClient.convertToType(data['entries'], [JobHistoryEntry]);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {Array.<module:model/JobHistoryEntry>} entries
+ */
+JobHistory.prototype['entries'] = undefined;
+
+
+
+
+
+
+export default JobHistory;
+
@@ -0,0 +1,85 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The JobHistoryEntry model module.
+ * @module model/JobHistoryEntry
+ * @version 0.0.0
+ */
+class JobHistoryEntry {
+    /**
+     * Constructs a new <code>JobHistoryEntry</code>.
+     * Record of a job edit.
+     * @alias module:model/JobHistoryEntry
+     * @param timestamp {Date} When the job was edited.
+     * @param previousSettings {Object.<String, Object>} The settings of the job before it was edited.
+     */
+    constructor(timestamp, previousSettings) { 
+        
+        JobHistoryEntry.initialize(this, timestamp, previousSettings);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, timestamp, previousSettings) { 
+        obj['timestamp'] = timestamp;
+        obj['previous_settings'] = previousSettings;
+    }
+
+    /**
+     * Constructs a <code>JobHistoryEntry</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/JobHistoryEntry} obj Optional instance to populate.
+     * @return {module:model/JobHistoryEntry} The populated <code>JobHistoryEntry</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new JobHistoryEntry();
+
+            if (data.hasOwnProperty('timestamp')) {
+                obj['timestamp'] = ApiClient.convertToType(data['timestamp'], 'Date');
+            }
+            if (data.hasOwnProperty('previous_settings')) {
+                obj['previous_settings'] = ApiClient.convertToType(data['previous_settings'], {'String': Object});
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * When the job was edited.
+ * @member {Date} timestamp
+ */
+JobHistoryEntry.prototype['timestamp'] = undefined;
+
+/**
+ * @member {Object.<String, Object>} previous_settings
+ */
+JobHistoryEntry.prototype['previous_settings'] = undefined;
+
+
+
+
+
+
+export default JobHistoryEntry;
+
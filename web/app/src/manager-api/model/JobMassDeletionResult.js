@@ -0,0 +1,74 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The JobMassDeletionResult model module.
+ * @module model/JobMassDeletionResult
+ * @version 0.0.0
+ */
+class JobMassDeletionResult {
+    /**
+     * Constructs a new <code>JobMassDeletionResult</code>.
+     * @alias module:model/JobMassDeletionResult
+     * @param jobIds {Array.<String>} IDs of the jobs that are queued for deletion.
+     */
+    constructor(jobIds) { 
+        
+        JobMassDeletionResult.initialize(this, jobIds);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, jobIds) { 
+        obj['job_ids'] = jobIds;
+    }
+
+    /**
+     * Constructs a <code>JobMassDeletionResult</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/JobMassDeletionResult} obj Optional instance to populate.
+     * @return {module:model/JobMassDeletionResult} The populated <code>JobMassDeletionResult</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new JobMassDeletionResult();
+
+            if (data.hasOwnProperty('job_ids')) {
+                obj['job_ids'] = ApiClient.convertToType(data['job_ids'], ['String']);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * IDs of the jobs that are queued for deletion.
+ * @member {Array.<String>} job_ids
+ */
+JobMassDeletionResult.prototype['job_ids'] = undefined;
+
+
+
+
+
+
+export default JobMassDeletionResult;
+
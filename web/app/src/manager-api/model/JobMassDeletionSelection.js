@@ -0,0 +1,75 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The JobMassDeletionSelection model module.
+ * @module model/JobMassDeletionSelection
+ * @version 0.0.0
+ */
+class JobMassDeletionSelection {
+    /**
+     * Constructs a new <code>JobMassDeletionSelection</code>.
+     * Parameters to determine which jobs to delete.
+     * @alias module:model/JobMassDeletionSelection
+     * @param finishedBefore {Date} Finished jobs that were last updated before this timestamp will be deleted. 
+     */
+    constructor(finishedBefore) { 
+        
+        JobMassDeletionSelection.initialize(this, finishedBefore);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, finishedBefore) { 
+        obj['finished_before'] = finishedBefore;
+    }
+
+    /**
+     * Constructs a <code>JobMassDeletionSelection</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/JobMassDeletionSelection} obj Optional instance to populate.
+     * @return {module:model/JobMassDeletionSelection} The populated <code>JobMassDeletionSelection</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new JobMassDeletionSelection();
+
+            if (data.hasOwnProperty('finished_before')) {
+                obj['finished_before'] = ApiClient.convertToType(data['finished_before'], 'Date');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * Finished jobs that were last updated before this timestamp will be deleted. 
+ * @member {Date} finished_before
+ */
+JobMassDeletionSelection.prototype['finished_before'] = undefined;
+
+
+
+
+
+
+export default JobMassDeletionSelection;
+
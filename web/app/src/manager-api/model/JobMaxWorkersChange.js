@@ -0,0 +1,74 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The JobMaxWorkersChange model module.
+ * @module model/JobMaxWorkersChange
+ * @version 0.0.0
+ */
+class JobMaxWorkersChange {
+    /**
+     * Constructs a new <code>JobMaxWorkersChange</code>.
+     * @alias module:model/JobMaxWorkersChange
+     * @param maxWorkers {Number} Maximum number of Workers that can work on the job at the same time. Zero means there is no limit. 
+     */
+    constructor(maxWorkers) { 
+        
+        JobMaxWorkersChange.initialize(this, maxWorkers);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, maxWorkers) { 
+        obj['max_workers'] = maxWorkers;
+    }
+
+    /**
+     * Constructs a <code>JobMaxWorkersChange</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/JobMaxWorkersChange} obj Optional instance to populate.
+     * @return {module:model/JobMaxWorkersChange} The populated <code>JobMaxWorkersChange</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new JobMaxWorkersChange();
+
+            if (data.hasOwnProperty('max_workers')) {
+                obj['max_workers'] = ApiClient.convertToType(data['max_workers'], 'Number');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * Maximum number of Workers that can work on the job at the same time. Zero means there is no limit. 
+ * @member {Number} max_workers
+ */
+JobMaxWorkersChange.prototype['max_workers'] = undefined;
+
+
+
+
+
+
+export default JobMaxWorkersChange;
+
@@ -0,0 +1,119 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The JobScriptError model module.
+ * @module model/JobScriptError
+ * @version 0.0.0
+ */
+class JobScriptError {
+    /**
+     * Constructs a new <code>JobScriptError</code>.
+     * Error in a job compiler script.
+     * @alias module:model/JobScriptError
+     * @param code {Number} HTTP status code of this response.
+     * @param message {String} The JavaScript error message.
+     * @param script {String} Filename of the script that produced the error.
+     * @param line {Number} Line number of the error, or 0 if unknown.
+     * @param column {Number} Column number of the error, or 0 if unknown.
+     */
+    constructor(code, message, script, line, column) { 
+        
+        JobScriptError.initialize(this, code, message, script, line, column);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, code, message, script, line, column) { 
+        obj['code'] = code;
+        obj['message'] = message;
+        obj['script'] = script;
+        obj['line'] = line;
+        obj['column'] = column;
+    }
+
+    /**
+     * Constructs a <code>JobScriptError</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/JobScriptError} obj Optional instance to populate.
+     * @return {module:model/JobScriptError} The populated <code>JobScriptError</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new JobScriptError();
+
+            if (data.hasOwnProperty('code')) {
+                obj['code'] = ApiClient.convertToType(data['code'], 'Number');
+            }
+            if (data.hasOwnProperty('message')) {
+                obj['message'] = ApiClient.convertToType(data['message'], 'String');
+            }
+            if (data.hasOwnProperty('script')) {
+                obj['script'] = ApiClient.convertToType(data['script'], 'String');
+            }
+            if (data.hasOwnProperty('line')) {
+                obj['line'] = ApiClient.convertToType(data['line'], 'Number');
+            }
+            if (data.hasOwnProperty('column')) {
+                obj['column'] = ApiClient.convertToType(data['column'], 'Number');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * HTTP status code of this response.
+ * @member {Number} code
+ */
+JobScriptError.prototype['code'] = undefined;
+
+/**
+ * The JavaScript error message.
+ * @member {String} message
+ */
+JobScriptError.prototype['message'] = undefined;
+
+/**
+ * Filename of the script that produced the error.
+ * @member {String} script
+ */
+JobScriptError.prototype['script'] = undefined;
+
+/**
+ * Line number of the error, or 0 if unknown.
+ * @member {Number} line
+ */
+JobScriptError.prototype['line'] = undefined;
+
+/**
+ * Column number of the error, or 0 if unknown.
+ * @member {Number} column
+ */
+JobScriptError.prototype['column'] = undefined;
+
+
+
+
+
+
+export default JobScriptError;
+
@@ -103,6 +103,13 @@ export default class JobStatus {
         "under-construction" = "under-construction";
 
     
+        /**
+         * value: "waiting"
+         * @const
+         */
+        "waiting" = "waiting";
+
+    
 
     /**
     * Returns a <code>JobStatus</code> enum value from a Javascript object name.
@@ -0,0 +1,83 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The JobTypeValidation model module.
+ * @module model/JobTypeValidation
+ * @version 0.0.0
+ */
+class JobTypeValidation {
+    /**
+     * Constructs a new <code>JobTypeValidation</code>.
+     * Sample job to compile with a job type.
+     * @alias module:model/JobTypeValidation
+     * @param settings {Object.<String, Object>} 
+     */
+    constructor(settings) { 
+        
+        JobTypeValidation.initialize(this, settings);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, settings) { 
+        obj['settings'] = settings;
+    }
+
+    /**
+     * Constructs a <code>JobTypeValidation</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/JobTypeValidation} obj Optional instance to populate.
+     * @return {module:model/JobTypeValidation} The populated <code>JobTypeValidation</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new JobTypeValidation();
+
+            if (data.hasOwnProperty('settings')) {
+                obj['settings'] = ApiClient.convertToType(data['settings'], {'String': Object});
+            }
+            if (data.hasOwnProperty('metadata')) {
+                obj['metadata'] = ApiClient.convertToType(data['metadata'], {'String': 'String'});
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {Object.<String, Object>} settings
+ */
+JobTypeValidation.prototype['settings'] = undefined;
+
+/**
+ * Arbitrary metadata strings. More complex structures can be modeled by using `a.b.c` notation for the key.
+ * @member {Object.<String, String>} metadata
+ */
+JobTypeValidation.prototype['metadata'] = undefined;
+
+
+
+
+
+
+export default JobTypeValidation;
+
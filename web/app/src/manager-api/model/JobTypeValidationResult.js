@@ -0,0 +1,74 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+import AuthoredTask from './AuthoredTask';
+
+/**
+ * The JobTypeValidationResult model module.
+ * @module model/JobTypeValidationResult
+ * @version 0.0.0
+ */
+class JobTypeValidationResult {
+    /**
+     * Constructs a new <code>JobTypeValidationResult</code>.
+     * @alias module:model/JobTypeValidationResult
+     * @param tasks {Array.<module:model/AuthoredTask>} 
+     */
+    constructor(tasks) { 
+        
+        JobTypeValidationResult.initialize(this, tasks);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, tasks) { 
+        obj['tasks'] = tasks;
+    }
+
+    /**
+     * Constructs a <code>JobTypeValidationResult</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/JobTypeValidationResult} obj Optional instance to populate.
+     * @return {module:model/JobTypeValidationResult} The populated <code>JobTypeValidationResult</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new JobTypeValidationResult();
+
+            if (data.hasOwnProperty('tasks')) {
+                obj['tasks'] = ApiClient.convertToType(data['tasks'], [AuthoredTask]);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {Array.<module:model/AuthoredTask>} tasks
+ */
+JobTypeValidationResult.prototype['tasks'] = undefined;
+
+
+
+
+
+
+export default JobTypeValidationResult;
+
@@ -0,0 +1,95 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+import UserRole from './UserRole';
+
+/**
+ * The NewUser model module.
+ * @module model/NewUser
+ * @version 0.0.0
+ */
+class NewUser {
+    /**
+     * Constructs a new <code>NewUser</code>.
+     * User account to create.
+     * @alias module:model/NewUser
+     * @param name {String} 
+     * @param password {String} 
+     * @param role {module:model/UserRole} 
+     */
+    constructor(name, password, role) { 
+        
+        NewUser.initialize(this, name, password, role);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, name, password, role) { 
+        obj['name'] = name;
+        obj['password'] = password;
+        obj['role'] = role;
+    }
+
+    /**
+     * Constructs a <code>NewUser</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/NewUser} obj Optional instance to populate.
+     * @return {module:model/NewUser} The populated <code>NewUser</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new NewUser();
+
+            if (data.hasOwnProperty('name')) {
+                obj['name'] = ApiClient.convertToType(data['name'], 'String');
+            }
+            if (data.hasOwnProperty('password')) {
+                obj['password'] = ApiClient.convertToType(data['password'], 'String');
+            }
+            if (data.hasOwnProperty('role')) {
+                obj['role'] = UserRole.constructFromObject(data['role']);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {String} name
+ */
+NewUser.prototype['name'] = undefined;
+
+/**
+ * @member {String} password
+ */
+NewUser.prototype['password'] = undefined;
+
+/**
+ * @member {module:model/UserRole} role
+ */
+NewUser.prototype['role'] = undefined;
+
+
+
+
+
+
+export default NewUser;
+
@@ -82,9 +82,15 @@ class SocketIOJobUpdate {
             if (data.hasOwnProperty('priority')) {
                 obj['priority'] = ApiClient.convertToType(data['priority'], 'Number');
             }
+            if (data.hasOwnProperty('progress')) {
+                obj['progress'] = ApiClient.convertToType(data['progress'], 'Number');
+            }
             if (data.hasOwnProperty('refresh_tasks')) {
                 obj['refresh_tasks'] = ApiClient.convertToType(data['refresh_tasks'], 'Boolean');
             }
+            if (data.hasOwnProperty('was_deleted')) {
+                obj['was_deleted'] = ApiClient.convertToType(data['was_deleted'], 'Boolean');
+            }
         }
         return obj;
     }
@@ -131,12 +137,24 @@ SocketIOJobUpdate.prototype['type'] = undefined;
  */
 SocketIOJobUpdate.prototype['priority'] = 50;
 
+/**
+ * Percentage of the job that has been completed. Only included when the job's progress may have changed. 
+ * @member {Number} progress
+ */
+SocketIOJobUpdate.prototype['progress'] = undefined;
+
 /**
  * Indicates that the client should refresh all the job's tasks. This is sent for mass updates, where updating each individual task would generate too many updates to be practical. 
  * @member {Boolean} refresh_tasks
  */
 SocketIOJobUpdate.prototype['refresh_tasks'] = undefined;
 
+/**
+ * Indicates that the job has been deleted from the Manager. The other fields describe the job as it was just before deletion. 
+ * @member {Boolean} was_deleted
+ */
+SocketIOJobUpdate.prototype['was_deleted'] = undefined;
+
 
 
 
@@ -82,6 +82,9 @@ class SocketIOTaskUpdate {
             if (data.hasOwnProperty('activity')) {
                 obj['activity'] = ApiClient.convertToType(data['activity'], 'String');
             }
+            if (data.hasOwnProperty('progress')) {
+                obj['progress'] = ApiClient.convertToType(data['progress'], 'Number');
+            }
         }
         return obj;
     }
@@ -127,6 +130,12 @@ SocketIOTaskUpdate.prototype['previous_status'] = undefined;
  */
 SocketIOTaskUpdate.prototype['activity'] = undefined;
 
+/**
+ * Percentage of the task that has been completed.
+ * @member {Number} progress
+ */
+SocketIOTaskUpdate.prototype['progress'] = undefined;
+
 
 
 
@@ -74,6 +74,18 @@ class SubmittedJob {
             if (data.hasOwnProperty('metadata')) {
                 obj['metadata'] = ApiClient.convertToType(data['metadata'], {'String': 'String'});
             }
+            if (data.hasOwnProperty('worker_tag')) {
+                obj['worker_tag'] = ApiClient.convertToType(data['worker_tag'], 'String');
+            }
+            if (data.hasOwnProperty('max_workers')) {
+                obj['max_workers'] = ApiClient.convertToType(data['max_workers'], 'Number');
+            }
+            if (data.hasOwnProperty('depends_on')) {
+                obj['depends_on'] = ApiClient.convertToType(data['depends_on'], ['String']);
+            }
+            if (data.hasOwnProperty('start_after')) {
+                obj['start_after'] = ApiClient.convertToType(data['start_after'], 'Date');
+            }
             if (data.hasOwnProperty('submitter_platform')) {
                 obj['submitter_platform'] = ApiClient.convertToType(data['submitter_platform'], 'String');
             }
@@ -117,6 +129,30 @@ SubmittedJob.prototype['settings'] = undefined;
  */
 SubmittedJob.prototype['metadata'] = undefined;
 
+/**
+ * Worker tag that should execute this job. When a tag ID is given, only Workers in that tag will be scheduled to work on it. If empty or ommitted, all workers can work on this job. 
+ * @member {String} worker_tag
+ */
+SubmittedJob.prototype['worker_tag'] = undefined;
+
+/**
+ * Maximum number of Workers that can work on this job at the same time. Zero or ommitted means there is no limit. 
+ * @member {Number} max_workers
+ */
+SubmittedJob.prototype['max_workers'] = undefined;
+
+/**
+ * IDs of the jobs that need to be completed before this job can start. Until then, the job will be in status `waiting`. When any of these jobs fails or is canceled, this job will fail as well. 
+ * @member {Array.<String>} depends_on
+ */
+SubmittedJob.prototype['depends_on'] = undefined;
+
+/**
+ * When given, no tasks of this job will be handed out to Workers before this moment in time. If ommitted, the job can start immediately. 
+ * @member {Date} start_after
+ */
+SubmittedJob.prototype['start_after'] = undefined;
+
 /**
  * Operating system of the submitter. This is used to recognise two-way variables. This should be a lower-case version of the platform, like \"linux\", \"windows\", \"darwin\", \"openbsd\", etc. Should be ompatible with Go's `runtime.GOOS`; run `go tool dist list` to get a list of possible platforms. As a special case, the platform \"manager\" can be given, which will be interpreted as \"the Manager's platform\". This is mostly to make test/debug scripts easier, as they can use a static document on all platforms. 
  * @member {String} submitter_platform
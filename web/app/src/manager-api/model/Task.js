@@ -98,6 +98,9 @@ class Task {
             if (data.hasOwnProperty('activity')) {
                 obj['activity'] = ApiClient.convertToType(data['activity'], 'String');
             }
+            if (data.hasOwnProperty('progress')) {
+                obj['progress'] = ApiClient.convertToType(data['progress'], 'Number');
+            }
             if (data.hasOwnProperty('commands')) {
                 obj['commands'] = ApiClient.convertToType(data['commands'], [Command]);
             }
@@ -164,6 +167,12 @@ Task.prototype['task_type'] = undefined;
  */
 Task.prototype['activity'] = undefined;
 
+/**
+ * Percentage of the task that has been completed.
+ * @member {Number} progress
+ */
+Task.prototype['progress'] = undefined;
+
 /**
  * @member {Array.<module:model/Command>} commands
  */
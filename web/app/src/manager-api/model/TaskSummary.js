@@ -79,6 +79,9 @@ class TaskSummary {
             if (data.hasOwnProperty('updated')) {
                 obj['updated'] = ApiClient.convertToType(data['updated'], 'Date');
             }
+            if (data.hasOwnProperty('progress')) {
+                obj['progress'] = ApiClient.convertToType(data['progress'], 'Number');
+            }
         }
         return obj;
     }
@@ -116,6 +119,11 @@ TaskSummary.prototype['task_type'] = undefined;
  */
 TaskSummary.prototype['updated'] = undefined;
 
+/**
+ * @member {Number} progress
+ */
+TaskSummary.prototype['progress'] = undefined;
+
 
 
 
@@ -58,6 +58,9 @@ class TaskUpdate {
             if (data.hasOwnProperty('log')) {
                 obj['log'] = ApiClient.convertToType(data['log'], 'String');
             }
+            if (data.hasOwnProperty('progress')) {
+                obj['progress'] = ApiClient.convertToType(data['progress'], 'Number');
+            }
         }
         return obj;
     }
@@ -82,6 +85,12 @@ TaskUpdate.prototype['activity'] = undefined;
  */
 TaskUpdate.prototype['log'] = undefined;
 
+/**
+ * Percentage of the task that has been completed.
+ * @member {Number} progress
+ */
+TaskUpdate.prototype['progress'] = undefined;
+
 
 
 
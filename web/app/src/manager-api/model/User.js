@@ -0,0 +1,95 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+import UserRole from './UserRole';
+
+/**
+ * The User model module.
+ * @module model/User
+ * @version 0.0.0
+ */
+class User {
+    /**
+     * Constructs a new <code>User</code>.
+     * User account.
+     * @alias module:model/User
+     * @param id {String} 
+     * @param name {String} 
+     * @param role {module:model/UserRole} 
+     */
+    constructor(id, name, role) { 
+        
+        User.initialize(this, id, name, role);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, id, name, role) { 
+        obj['id'] = id;
+        obj['name'] = name;
+        obj['role'] = role;
+    }
+
+    /**
+     * Constructs a <code>User</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/User} obj Optional instance to populate.
+     * @return {module:model/User} The populated <code>User</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new User();
+
+            if (data.hasOwnProperty('id')) {
+                obj['id'] = ApiClient.convertToType(data['id'], 'String');
+            }
+            if (data.hasOwnProperty('name')) {
+                obj['name'] = ApiClient.convertToType(data['name'], 'String');
+            }
+            if (data.hasOwnProperty('role')) {
+                obj['role'] = UserRole.constructFromObject(data['role']);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {String} id
+ */
+User.prototype['id'] = undefined;
+
+/**
+ * @member {String} name
+ */
+User.prototype['name'] = undefined;
+
+/**
+ * @member {module:model/UserRole} role
+ */
+User.prototype['role'] = undefined;
+
+
+
+
+
+
+export default User;
+
@@ -0,0 +1,74 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+import User from './User';
+
+/**
+ * The UserList model module.
+ * @module model/UserList
+ * @version 0.0.0
+ */
+class UserList {
+    /**
+     * Constructs a new <code>UserList</code>.
+     * @alias module:model/UserList
+     * @param users {Array.<module:model/User>} 
+     */
+    constructor(users) { 
+        
+        UserList.initialize(this, users);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, users) { 
+        obj['users'] = users;
+    }
+
+    /**
+     * Constructs a <code>UserList</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/UserList} obj Optional instance to populate.
+     * @return {module:model/UserList} The populated <code>UserList</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new UserList();
+
+            if (data.hasOwnProperty('users')) {
+                obj['users'] = ApiClient.convertToType(data['users'], [User]);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {Array.<module:model/User>} users
+ */
+UserList.prototype['users'] = undefined;
+
+
+
+
+
+
+export default UserList;
+
@@ -0,0 +1,83 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The UserLoginRequest model module.
+ * @module model/UserLoginRequest
+ * @version 0.0.0
+ */
+class UserLoginRequest {
+    /**
+     * Constructs a new <code>UserLoginRequest</code>.
+     * @alias module:model/UserLoginRequest
+     * @param name {String} 
+     * @param password {String} 
+     */
+    constructor(name, password) { 
+        
+        UserLoginRequest.initialize(this, name, password);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, name, password) { 
+        obj['name'] = name;
+        obj['password'] = password;
+    }
+
+    /**
+     * Constructs a <code>UserLoginRequest</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/UserLoginRequest} obj Optional instance to populate.
+     * @return {module:model/UserLoginRequest} The populated <code>UserLoginRequest</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new UserLoginRequest();
+
+            if (data.hasOwnProperty('name')) {
+                obj['name'] = ApiClient.convertToType(data['name'], 'String');
+            }
+            if (data.hasOwnProperty('password')) {
+                obj['password'] = ApiClient.convertToType(data['password'], 'String');
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * @member {String} name
+ */
+UserLoginRequest.prototype['name'] = undefined;
+
+/**
+ * @member {String} password
+ */
+UserLoginRequest.prototype['password'] = undefined;
+
+
+
+
+
+
+export default UserLoginRequest;
+
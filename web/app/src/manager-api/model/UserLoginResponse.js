@@ -0,0 +1,96 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+import User from './User';
+
+/**
+ * The UserLoginResponse model module.
+ * @module model/UserLoginResponse
+ * @version 0.0.0
+ */
+class UserLoginResponse {
+    /**
+     * Constructs a new <code>UserLoginResponse</code>.
+     * @alias module:model/UserLoginResponse
+     * @param token {String} Bearer token to authenticate subsequent requests.
+     * @param expires {Date} Moment in time the token expires.
+     * @param user {module:model/User} 
+     */
+    constructor(token, expires, user) { 
+        
+        UserLoginResponse.initialize(this, token, expires, user);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj, token, expires, user) { 
+        obj['token'] = token;
+        obj['expires'] = expires;
+        obj['user'] = user;
+    }
+
+    /**
+     * Constructs a <code>UserLoginResponse</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/UserLoginResponse} obj Optional instance to populate.
+     * @return {module:model/UserLoginResponse} The populated <code>UserLoginResponse</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new UserLoginResponse();
+
+            if (data.hasOwnProperty('token')) {
+                obj['token'] = ApiClient.convertToType(data['token'], 'String');
+            }
+            if (data.hasOwnProperty('expires')) {
+                obj['expires'] = ApiClient.convertToType(data['expires'], 'Date');
+            }
+            if (data.hasOwnProperty('user')) {
+                obj['user'] = User.constructFromObject(data['user']);
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * Bearer token to authenticate subsequent requests.
+ * @member {String} token
+ */
+UserLoginResponse.prototype['token'] = undefined;
+
+/**
+ * Moment in time the token expires.
+ * @member {Date} expires
+ */
+UserLoginResponse.prototype['expires'] = undefined;
+
+/**
+ * @member {module:model/User} user
+ */
+UserLoginResponse.prototype['user'] = undefined;
+
+
+
+
+
+
+export default UserLoginResponse;
+
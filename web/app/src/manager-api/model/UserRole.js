@@ -0,0 +1,46 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+/**
+* Enum class UserRole.
+* @enum {}
+* @readonly
+*/
+export default class UserRole {
+    
+        /**
+         * value: "user"
+         * @const
+         */
+        "user" = "user";
+
+    
+        /**
+         * value: "admin"
+         * @const
+         */
+        "admin" = "admin";
+
+    
+
+    /**
+    * Returns a <code>UserRole</code> enum value from a Javascript object name.
+    * @param {Object} data The plain JavaScript object containing the name of the enum value.
+    * @return {module:model/UserRole} The enum <code>UserRole</code> value.
+    */
+    static constructFromObject(object) {
+        return object;
+    }
+}
+
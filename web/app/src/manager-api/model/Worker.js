@@ -13,9 +13,11 @@
 
 import ApiClient from '../ApiClient';
 import WorkerAllOf from './WorkerAllOf';
+import WorkerResources from './WorkerResources';
 import WorkerStatus from './WorkerStatus';
 import WorkerStatusChangeRequest from './WorkerStatusChangeRequest';
 import WorkerSummary from './WorkerSummary';
+import WorkerTag from './WorkerTag';
 import WorkerTask from './WorkerTask';
 
 /**
@@ -101,6 +103,12 @@ class Worker {
             if (data.hasOwnProperty('task')) {
                 obj['task'] = WorkerTask.constructFromObject(data['task']);
             }
+            if (data.hasOwnProperty('tags')) {
+                obj['tags'] = ApiClient.convertToType(data['tags'], [WorkerTag]);
+            }
+            if (data.hasOwnProperty('resources')) {
+                obj['resources'] = WorkerResources.constructFromObject(data['resources']);
+            }
         }
         return obj;
     }
@@ -162,6 +170,16 @@ Worker.prototype['supported_task_types'] = undefined;
  */
 Worker.prototype['task'] = undefined;
 
+/**
+ * @member {Array.<module:model/WorkerTag>} tags
+ */
+Worker.prototype['tags'] = undefined;
+
+/**
+ * @member {module:model/WorkerResources} resources
+ */
+Worker.prototype['resources'] = undefined;
+
 
 // Implement WorkerSummary interface:
 /**
@@ -209,6 +227,14 @@ WorkerAllOf.prototype['supported_task_types'] = undefined;
  * @member {module:model/WorkerTask} task
  */
 WorkerAllOf.prototype['task'] = undefined;
+/**
+ * @member {Array.<module:model/WorkerTag>} tags
+ */
+WorkerAllOf.prototype['tags'] = undefined;
+/**
+ * @member {module:model/WorkerResources} resources
+ */
+WorkerAllOf.prototype['resources'] = undefined;
 
 
 
@@ -12,6 +12,8 @@
  */
 
 import ApiClient from '../ApiClient';
+import WorkerResources from './WorkerResources';
+import WorkerTag from './WorkerTag';
 import WorkerTask from './WorkerTask';
 
 /**
@@ -66,6 +68,12 @@ class WorkerAllOf {
             if (data.hasOwnProperty('task')) {
                 obj['task'] = WorkerTask.constructFromObject(data['task']);
             }
+            if (data.hasOwnProperty('tags')) {
+                obj['tags'] = ApiClient.convertToType(data['tags'], [WorkerTag]);
+            }
+            if (data.hasOwnProperty('resources')) {
+                obj['resources'] = WorkerResources.constructFromObject(data['resources']);
+            }
         }
         return obj;
     }
@@ -95,6 +103,16 @@ WorkerAllOf.prototype['supported_task_types'] = undefined;
  */
 WorkerAllOf.prototype['task'] = undefined;
 
+/**
+ * @member {Array.<module:model/WorkerTag>} tags
+ */
+WorkerAllOf.prototype['tags'] = undefined;
+
+/**
+ * @member {module:model/WorkerResources} resources
+ */
+WorkerAllOf.prototype['resources'] = undefined;
+
 
 
 
@@ -0,0 +1,91 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The WorkerRequirements model module.
+ * @module model/WorkerRequirements
+ * @version 0.0.0
+ */
+class WorkerRequirements {
+    /**
+     * Constructs a new <code>WorkerRequirements</code>.
+     * Resources that a Worker needs to offer in order to run a job. Only Workers that meet all requirements get tasks of the job. 
+     * @alias module:model/WorkerRequirements
+     */
+    constructor() { 
+        
+        WorkerRequirements.initialize(this);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj) { 
+    }
+
+    /**
+     * Constructs a <code>WorkerRequirements</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/WorkerRequirements} obj Optional instance to populate.
+     * @return {module:model/WorkerRequirements} The populated <code>WorkerRequirements</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new WorkerRequirements();
+
+            if (data.hasOwnProperty('cpu_cores')) {
+                obj['cpu_cores'] = ApiClient.convertToType(data['cpu_cores'], 'Number');
+            }
+            if (data.hasOwnProperty('memory_mb')) {
+                obj['memory_mb'] = ApiClient.convertToType(data['memory_mb'], 'Number');
+            }
+            if (data.hasOwnProperty('capabilities')) {
+                obj['capabilities'] = ApiClient.convertToType(data['capabilities'], {'String': 'String'});
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * Minimum number of CPU cores.
+ * @member {Number} cpu_cores
+ */
+WorkerRequirements.prototype['cpu_cores'] = undefined;
+
+/**
+ * Minimum amount of RAM, in megabytes.
+ * @member {Number} memory_mb
+ */
+WorkerRequirements.prototype['memory_mb'] = undefined;
+
+/**
+ * Capabilities the Worker needs to have. The values have to match exactly. 
+ * @member {Object.<String, String>} capabilities
+ */
+WorkerRequirements.prototype['capabilities'] = undefined;
+
+
+
+
+
+
+export default WorkerRequirements;
+
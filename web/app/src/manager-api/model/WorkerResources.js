@@ -0,0 +1,91 @@
+/**
+ * Flamenco manager
+ * Render Farm manager API
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ *
+ */
+
+import ApiClient from '../ApiClient';
+
+/**
+ * The WorkerResources model module.
+ * @module model/WorkerResources
+ * @version 0.0.0
+ */
+class WorkerResources {
+    /**
+     * Constructs a new <code>WorkerResources</code>.
+     * Hardware and software resources that a Worker offers.
+     * @alias module:model/WorkerResources
+     */
+    constructor() { 
+        
+        WorkerResources.initialize(this);
+    }
+
+    /**
+     * Initializes the fields of this object.
+     * This method is used by the constructors of any subclasses, in order to implement multiple inheritance (mix-ins).
+     * Only for internal use.
+     */
+    static initialize(obj) { 
+    }
+
+    /**
+     * Constructs a <code>WorkerResources</code> from a plain JavaScript object, optionally creating a new instance.
+     * Copies all relevant properties from <code>data</code> to <code>obj</code> if supplied or a new instance if not.
+     * @param {Object} data The plain JavaScript object bearing properties of interest.
+     * @param {module:model/WorkerResources} obj Optional instance to populate.
+     * @return {module:model/WorkerResources} The populated <code>WorkerResources</code> instance.
+     */
+    static constructFromObject(data, obj) {
+        if (data) {
+            obj = obj || new WorkerResources();
+
+            if (data.hasOwnProperty('cpu_cores')) {
+                obj['cpu_cores'] = ApiClient.convertToType(data['cpu_cores'], 'Number');
+            }
+            if (data.hasOwnProperty('memory_mb')) {
+                obj['memory_mb'] = ApiClient.convertToType(data['memory_mb'], 'Number');
+            }
+            if (data.hasOwnProperty('capabilities')) {
+                obj['capabilities'] = ApiClient.convertToType(data['capabilities'], {'String': 'String'});
+            }
+        }
+        return obj;
+    }
+
+
+}
+
+/**
+ * Number of CPU cores. Zero means unknown.
+ * @member {Number} cpu_cores
+ */
+WorkerResources.prototype['cpu_cores'] = undefined;
+
+/**
+ * Amount of RAM, in megabytes. Zero means unknown.
+ * @member {Number} memory_mb
+ */
+WorkerResources.prototype['memory_mb'] = undefined;
+
+/**
+ * Arbitrary capabilities of the Worker, such as `blender_version: \"3.3\"`. These are matched with the capabilities that jobs require. 
+ * @member {Object.<String, String>} capabilities
+ */
+WorkerResources.prototype['capabilities'] = undefined;
+
+
+
+
+
+
+export default WorkerResources;
+
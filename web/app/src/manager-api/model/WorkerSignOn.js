@@ -12,6 +12,7 @@
  */
 
 import ApiClient from '../ApiClient';
+import WorkerResources from './WorkerResources';
 
 /**
  * The WorkerSignOn model module.
@@ -62,6 +63,12 @@ class WorkerSignOn {
             if (data.hasOwnProperty('software_version')) {
                 obj['software_version'] = ApiClient.convertToType(data['software_version'], 'String');
             }
+            if (data.hasOwnProperty('resources')) {
+                obj['resources'] = WorkerResources.constructFromObject(data['resources']);
+            }
+            if (data.hasOwnProperty('task_slots')) {
+                obj['task_slots'] = ApiClient.convertToType(data['task_slots'], {'String': 'Number'});
+            }
         }
         return obj;
     }
@@ -84,6 +91,17 @@ WorkerSignOn.prototype['supported_task_types'] = undefined;
  */
 WorkerSignOn.prototype['software_version'] = undefined;
 
+/**
+ * @member {module:model/WorkerResources} resources
+ */
+WorkerSignOn.prototype['resources'] = undefined;
+
+/**
+ * Number of tasks of each task type that the Worker can run at the same time. Task types that are not mentioned get one slot each. Without this, the Worker runs one task at a time. 
+ * @member {Object.<String, Number>} task_slots
+ */
+WorkerSignOn.prototype['task_slots'] = undefined;
+
 
 
 
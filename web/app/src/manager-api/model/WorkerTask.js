@@ -87,6 +87,9 @@ class WorkerTask {
             if (data.hasOwnProperty('updated')) {
                 obj['updated'] = ApiClient.convertToType(data['updated'], 'Date');
             }
+            if (data.hasOwnProperty('progress')) {
+                obj['progress'] = ApiClient.convertToType(data['progress'], 'Number');
+            }
             if (data.hasOwnProperty('job_id')) {
                 obj['job_id'] = ApiClient.convertToType(data['job_id'], 'String');
             }
@@ -127,6 +130,11 @@ WorkerTask.prototype['task_type'] = undefined;
  */
 WorkerTask.prototype['updated'] = undefined;
 
+/**
+ * @member {Number} progress
+ */
+WorkerTask.prototype['progress'] = undefined;
+
 /**
  * @member {String} job_id
  */
@@ -158,6 +166,10 @@ TaskSummary.prototype['task_type'] = undefined;
  * @member {Date} updated
  */
 TaskSummary.prototype['updated'] = undefined;
+/**
+ * @member {Number} progress
+ */
+TaskSummary.prototype['progress'] = undefined;
 // Implement WorkerTaskAllOf interface:
 /**
  * @member {String} job_id
@@ -25,6 +25,11 @@ const router = createRouter({
       name: 'last-rendered',
       component: () => import('../views/LastRenderedView.vue'),
     },
+    {
+      path: '/login',
+      name: 'login',
+      component: () => import('../views/LoginView.vue'),
+    },
   ],
 })
 
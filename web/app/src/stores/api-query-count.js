@@ -1,6 +1,7 @@
 import { defineStore } from "pinia";
 import { ApiClient } from "@/manager-api";
 import * as urls from '@/urls'
+import { redirectToLogin, userToken } from '@/user-auth'
 
 /**
  * Keep track of running API queries.
@@ -34,9 +35,16 @@ export class CountingApiClient extends ApiClient {
     const apiQueryCount = useAPIQueryCount();
     apiQueryCount.num++;
 
+    // Without token, no Authorization header should be sent at all.
+    this.authentications['user_auth'].accessToken = userToken() || undefined;
+
     return super
       .callApi(path, httpMethod, pathParams, queryParams, headerParams, formParams,
                bodyParam, authNames, contentTypes, accepts, returnType, apiBasePath)
+      .catch((error) => {
+        if (error.status == 401) redirectToLogin();
+        throw error;
+      })
       .finally(() => {
         apiQueryCount.num--;
       });
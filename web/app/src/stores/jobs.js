@@ -57,6 +57,12 @@ export const useJobs = defineStore('jobs', {
         state.hasChanged = true;
       });
     },
+    setActiveJobProgress(progress) {
+      if (!this.activeJob) return;
+      this.$patch((state) => {
+        state.activeJob.progress = progress;
+      });
+    },
     deselectAllJobs() {
       this.$patch({
         activeJob: null,
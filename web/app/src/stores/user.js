@@ -0,0 +1,54 @@
+import { defineStore } from 'pinia'
+
+import * as API from '@/manager-api';
+import { apiClient } from '@/stores/api-query-count';
+import { forgetUserToken, storeUserToken } from '@/user-auth'
+
+const usersAPI = new API.UsersApi(apiClient);
+
+/**
+ * The logged-in user. This stays empty when user authentication is disabled on
+ * the Manager, as then there is nobody to log in.
+ */
+export const useUser = defineStore('user', {
+  state: () => ({
+    /** @type {API.User} */
+    user: null,
+  }),
+  actions: {
+    /**
+     * Fetch the user belonging to the stored token.
+     */
+    fetchCurrentUser() {
+      return usersAPI.fetchCurrentUser()
+        .then((user) => {
+          // Without user authentication, the Manager responds without content.
+          this.user = user || null;
+        });
+    },
+    /**
+     * Log in, and store the token for subsequent API calls.
+     * @param {string} name
+     * @param {string} password
+     */
+    login(name, password) {
+      const loginRequest = new API.UserLoginRequest(name, password);
+      return usersAPI.loginUser(loginRequest)
+        .then((response) => {
+          storeUserToken(response.token);
+          this.user = response.user;
+        });
+    },
+    /**
+     * Log out, and forget about the token. The token is forgotten even when
+     * logging out fails, as then it likely has expired already.
+     */
+    logout() {
+      return usersAPI.logoutUser()
+        .finally(() => {
+          forgetUserToken();
+          this.user = null;
+        });
+    },
+  },
+})
@@ -0,0 +1,38 @@
+import router from '@/router/index'
+
+// The login token is kept in local storage, so that it survives page reloads
+// and is shared between browser tabs.
+const TOKEN_STORAGE_KEY = "flamenco-user-token";
+
+/**
+ * @returns {string|null} the token to authenticate API calls with, or null when
+ * the user hasn't logged in.
+ */
+export function userToken() {
+  return localStorage.getItem(TOKEN_STORAGE_KEY);
+}
+
+/**
+ * @param {string} token the token obtained by logging in.
+ */
+export function storeUserToken(token) {
+  localStorage.setItem(TOKEN_STORAGE_KEY, token);
+}
+
+export function forgetUserToken() {
+  localStorage.removeItem(TOKEN_STORAGE_KEY);
+}
+
+/**
+ * Show the login page, and return to the current page after logging in.
+ *
+ * The Manager responds with "401 Unauthorized" when user authentication is
+ * enabled and the token is missing, invalid, or expired.
+ */
+export function redirectToLogin() {
+  const route = router.currentRoute.value;
+  if (route.name == 'login') return;
+
+  forgetUserToken();
+  router.push({ name: 'login', query: { redirect: route.fullPath } });
+}